@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/application/services"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/di"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/bus"
+	eventdomain "github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/outbox"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// eventSubscriber name of the API subscriber; all API replicas share its deliveries
+const eventSubscriber = "api"
+
+// startEventConsumer receives match and stats events written by the scheduler process
+// and bumps the data version of the affected tournament, which invalidates cached
+// tournament responses. Versions are stored in the database, so one replica handling
+// the event is enough for all of them.
+func startEventConsumer(ctx context.Context, container *di.Container, versions *services.TournamentVersionService) {
+	store, err := container.EventOutboxStore(ctx)
+	if err != nil {
+		logger.Warn(ctx, "Event consumer disabled", zap.Error(err))
+		return
+	}
+
+	dsn := ""
+	if dbConfig, err := container.Config().Database(ctx); err == nil {
+		dsn = dbConfig.URI()
+	}
+
+	invalidate := invalidateTournament(versions)
+	consumer := outbox.NewConsumer(eventSubscriber, store, dsn, outbox.DefaultOptions())
+	consumer.Subscribe(eventdomain.EventMatchCreated, invalidate)
+	consumer.Subscribe(eventdomain.EventMatchFinished, invalidate)
+	consumer.Subscribe(eventdomain.EventMatchUpdated, invalidate)
+	consumer.Subscribe(eventdomain.EventStatsUpdated, invalidate)
+
+	go func() {
+		if err := consumer.Run(ctx); err != nil {
+			logger.Error(ctx, "Event consumer stopped", zap.Error(err))
+		}
+	}()
+}
+
+// invalidateTournament bumps the version of the event's tournament. A redelivered
+// event bumps it once more, which only costs clients one extra full response.
+func invalidateTournament(versions *services.TournamentVersionService) bus.EventHandler {
+	return func(ctx context.Context, event events.Event) error {
+		// Match and stats payloads both carry the tournament
+		var data struct {
+			TournamentID string `json:"tournament_id"`
+		}
+		if err := outbox.Decode(event, &data); err != nil {
+			return err
+		}
+		if data.TournamentID == "" {
+			return nil
+		}
+		if err := versions.Bump(ctx, data.TournamentID); err != nil {
+			return err
+		}
+
+		logger.Debug(ctx, "Tournament data changed",
+			zap.String("event_id", event.EventID()),
+			zap.String("event_type", event.EventType()),
+			zap.String("tournament_id", data.TournamentID))
+		return nil
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -13,6 +14,9 @@ import (
 	router "github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/interfaces/http"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/interfaces/http/handlers"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/interfaces/http/middleware"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories"
+	schedulerApp "github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/application"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/config/modules"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/di"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"github.com/joho/godotenv"
@@ -46,6 +50,10 @@ func main() {
 	}
 	logger.Info(ctx, "Connected to database")
 
+	// Events from the scheduler process
+	tournamentVersions := services.NewTournamentVersionService(db)
+	startEventConsumer(ctx, container, tournamentVersions)
+
 	// Auth config
 	authConfig := services.AuthConfig{
 		JWTSecret:            getEnv("JWT_SECRET", "your-super-secret-key-change-in-production"),
@@ -60,6 +68,40 @@ func main() {
 	exploreService := services.NewExploreService(db)
 	explorePlayersService := services.NewExplorePlayersService(db)
 	exploreMatchesService := services.NewExploreMatchesService(db)
+	trajectoryService := services.NewTrajectoryService(db)
+	linesService, err := container.AnalyticsLinesService(ctx)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to create lines service", zap.Error(err))
+	}
+	goalieService, err := container.AnalyticsGoalieService(ctx)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to create goalie service", zap.Error(err))
+	}
+	disciplineService, err := container.AnalyticsDisciplineService(ctx)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to create discipline service", zap.Error(err))
+	}
+	clubService, err := container.AnalyticsClubService(ctx)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to create club service", zap.Error(err))
+	}
+	venueService, err := container.AnalyticsVenueService(ctx)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to create venue service", zap.Error(err))
+	}
+	schedulerConfig := loadSchedulerConfig(ctx)
+	schedulerAdminService := services.NewSchedulerAdminService(db, schedulerJobNames(schedulerConfig))
+	if schedulerConfig != nil {
+		schedulerAdminService.WithCadence(schedulerApp.NewCadencePlanner(schedulerConfig, repositories.NewTournamentPostgres(db)))
+	}
+	dataQualityService := services.NewDataQualityService(db)
+	// Every replica runs the export worker and serves downloads, so files go to shared storage
+	exportService := services.NewExportService(db, exploreService, os.Getenv("EXPORT_DIR"))
+	if err := exportService.CheckDir(); err != nil {
+		logger.Fatal(ctx, "EXPORT_DIR must point to a directory shared by all API replicas", zap.Error(err))
+	}
+	go exportService.RunWorker(ctx, 5*time.Second)
+	initMetrics(ctx, dataQualityService)
 
 	// Middleware
 	authMiddleware := middleware.NewAuthMiddleware(authService)
@@ -69,10 +111,23 @@ func main() {
 	statsHandler := handlers.NewStatsHandler(statsService)
 	rankingHandler := handlers.NewRankingHandler(rankingService)
 	authHandler := handlers.NewAuthHandler(authService)
-	exploreHandler := handlers.NewExploreHandler(exploreService, exploreMatchesService)
+	exploreHandler := handlers.NewExploreHandler(exploreService, exploreMatchesService).WithVersions(tournamentVersions)
 	explorePlayersHandler := handlers.NewExplorePlayersHandler(explorePlayersService)
 	exploreMatchesHandler := handlers.NewExploreMatchesHandler(exploreMatchesService)
 	imageProxyHandler := handlers.NewImageProxyHandler()
+	trajectoryHandler := handlers.NewTrajectoryHandler(trajectoryService)
+	linesHandler := handlers.NewLinesHandler(linesService)
+	goalieHandler := handlers.NewGoalieHandler(goalieService)
+	disciplineHandler := handlers.NewDisciplineHandler(disciplineService)
+	schedulerAdminHandler := handlers.NewSchedulerAdminHandler(schedulerAdminService)
+	retryAdminHandler := handlers.NewRetryAdminHandler(services.NewRetryAdminService(db))
+	playerHistoryHandler := handlers.NewPlayerHistoryHandler(services.NewPlayerHistoryService(db))
+	dataQualityHandler := handlers.NewDataQualityHandler(dataQualityService)
+	clubHandler := handlers.NewClubHandler(clubService)
+	venueHandler := handlers.NewVenueHandler(venueService)
+	calendarHandler := handlers.NewCalendarHandler(services.NewCalendarService(db))
+	exportHandler := handlers.NewExportHandler(exportService)
+	playerChartHandler := handlers.NewPlayerChartHandler(services.NewPlayerChartService(db))
 
 	// Router
 	allowedOrigins := []string{"*"} // TODO: configure from env
@@ -85,7 +140,21 @@ func main() {
 		explorePlayersHandler,
 		exploreMatchesHandler,
 		imageProxyHandler,
+		trajectoryHandler,
+		linesHandler,
+		goalieHandler,
+		disciplineHandler,
+		schedulerAdminHandler,
+		retryAdminHandler,
+		playerHistoryHandler,
+		dataQualityHandler,
+		clubHandler,
+		venueHandler,
+		calendarHandler,
+		exportHandler,
+		playerChartHandler,
 		authMiddleware,
+		strings.Split(getEnv("ADMIN_EMAILS", ""), ","),
 		allowedOrigins,
 	)
 	handler := apiRouter.Setup()
@@ -137,6 +206,29 @@ func getLoggerConfig() *logger.LoggerConfig {
 	}
 }
 
+// loadSchedulerConfig loads the scheduler config, nil if it cannot be loaded.
+func loadSchedulerConfig(ctx context.Context) *modules.SchedulerConfig {
+	cfg, err := modules.LoadSchedulerConfig(getEnv("SCHEDULER_CONFIG", "config/scheduler.yaml"))
+	if err != nil {
+		logger.Warn(ctx, "Scheduler config not loaded, admin commands accept any job name and cadence is unavailable", zap.Error(err))
+		return nil
+	}
+	return cfg
+}
+
+// schedulerJobNames returns job names from the scheduler config, nil without config.
+func schedulerJobNames(cfg *modules.SchedulerConfig) []string {
+	if cfg == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(cfg.Jobs))
+	for name := range cfg.Jobs {
+		names = append(names, name)
+	}
+	return names
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
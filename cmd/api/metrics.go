@@ -0,0 +1,21 @@
+package main
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/application/services"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// initMetrics sets up the Prometheus exporter served at /metrics and registers the API gauges
+func initMetrics(ctx context.Context, dataQuality *services.DataQualityService) {
+	if err := metrics.InitPrometheus(ctx, "hockey-api"); err != nil {
+		logger.Warn(ctx, "Prometheus metrics disabled", zap.Error(err))
+		return
+	}
+	if err := dataQuality.RegisterMetrics(); err != nil {
+		logger.Warn(ctx, "Failed to register data quality metrics", zap.Error(err))
+	}
+}
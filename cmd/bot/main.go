@@ -76,8 +76,8 @@ func main() {
 	startHandler := command.NewStartHandler(presenter, keyboard)
 	filterHandler := filter.NewHandler(presenter, keyboard, stateService)
 	searchHandler := search.NewHandler(presenter, keyboard, stateService, searchService)
-	profileHandler := profile.NewHandler(presenter, keyboard, profileService)
-	reportHandler := report.NewHandler(reportService)
+	profileHandler := profile.NewHandler(presenter, keyboard, profileService, reportService)
+	reportHandler := report.NewHandler(keyboard, reportService)
 	logger.Info(ctx, "✅ All handlers initialized")
 
 	// Router
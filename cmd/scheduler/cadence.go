@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/application"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// plannedTournaments возвращает турниры источника, запланированные на текущий запуск статистики
+func plannedTournaments(
+	ctx context.Context,
+	planner *application.CadencePlanner,
+	tournamentRepo *repositories.TournamentPostgres,
+	source string,
+) ([]*entities.Tournament, error) {
+	plan, err := planner.Plan(ctx, source, domain.CadenceStats)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx, "Cadence plan",
+		zap.String("source", source),
+		zap.Int("due", len(plan.Queue)),
+		zap.Int("scheduled", plan.Quota),
+		zap.Int("used_today", plan.UsedToday),
+		zap.Int("daily_budget", plan.DailyBudget),
+	)
+
+	return tournamentRepo.GetByIDs(ctx, plan.ScheduledIDs())
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// statsCheckpointBatch количество турниров статистики между контрольными точками
+const statsCheckpointBatch = 2
+
+// tournamentCheckpoint позиция задачи, обрабатывающей список турниров
+type tournamentCheckpoint struct {
+	Done []string `json:"done"`
+}
+
+// tournamentProgress хранит обработанные турниры в контрольной точке запуска.
+//
+// Турниры обрабатываются параллельно, поэтому позиция - множество готовых турниров, а не последний.
+type tournamentProgress struct {
+	run  domain.JobRun
+	mu   sync.Mutex
+	done map[string]bool
+}
+
+func newTournamentProgress(ctx context.Context, run domain.JobRun) *tournamentProgress {
+	p := &tournamentProgress{run: run, done: make(map[string]bool)}
+
+	var checkpoint tournamentCheckpoint
+	resumed, err := run.Checkpoint(&checkpoint)
+	if err != nil {
+		logger.Warn(ctx, "Ignoring broken checkpoint", zap.String("job", run.JobName), zap.Error(err))
+		return p
+	}
+	if resumed {
+		for _, id := range checkpoint.Done {
+			p.done[id] = true
+		}
+		logger.Info(ctx, "Resuming from checkpoint", zap.String("job", run.JobName), zap.Int("done", len(p.done)))
+	}
+	return p
+}
+
+// IsDone возвращает true, если турнир обработан прерванным запуском
+func (p *tournamentProgress) IsDone(tournamentID string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.done[tournamentID]
+}
+
+// MarkDone отмечает турнир обработанным и сохраняет контрольную точку
+func (p *tournamentProgress) MarkDone(ctx context.Context, tournamentID string) {
+	p.mu.Lock()
+	p.done[tournamentID] = true
+	checkpoint := tournamentCheckpoint{Done: make([]string, 0, len(p.done))}
+	for id := range p.done {
+		checkpoint.Done = append(checkpoint.Done, id)
+	}
+	// Сохраняем под блокировкой, чтобы более старая позиция не перезаписала новую
+	sort.Strings(checkpoint.Done)
+	err := p.run.SaveCheckpoint(ctx, checkpoint)
+	p.mu.Unlock()
+
+	p.run.Track("tournaments", 1)
+
+	if err != nil {
+		logger.Warn(ctx, "Failed to save checkpoint", zap.String("job", p.run.JobName), zap.Error(err))
+	}
+}
+
+// Pending возвращает турниры, не обработанные прерванным запуском
+func (p *tournamentProgress) Pending(tournaments []*entities.Tournament) []*entities.Tournament {
+	pending := make([]*entities.Tournament, 0, len(tournaments))
+	for _, t := range tournaments {
+		if !p.IsDone(t.ID) {
+			pending = append(pending, t)
+		}
+	}
+	return pending
+}
+
+// processInBatches обрабатывает турниры пачками и отмечает каждую завершённую пачку.
+//
+// Пачка, прерванная отменой ctx, не отмечается и будет обработана заново.
+func processInBatches(
+	ctx context.Context,
+	tournaments []*entities.Tournament,
+	progress *tournamentProgress,
+	process func(ctx context.Context, batch []*entities.Tournament) error,
+	onDone func(ctx context.Context, t *entities.Tournament),
+) error {
+	for start := 0; start < len(tournaments); start += statsCheckpointBatch {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		end := min(start+statsCheckpointBatch, len(tournaments))
+		batch := tournaments[start:end]
+		if err := process(ctx, batch); err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		for _, t := range batch {
+			onDone(ctx, t)
+			progress.MarkDone(ctx, t.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/application"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/config/modules"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/di"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/dryrun"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+var (
+	dryRunJob    = flag.String("dry-run", "", "Run the job against a rolled back transaction in the DRY_RUN_DATABASE_URL clone and report the changes it would make")
+	dryRunReport = flag.String("dry-run-report", "dry_run_report.json", "Path of the dry-run JSON report")
+)
+
+// runDryRun выполняет задачу через подключение dry-run к копии базы из
+// DRY_RUN_DATABASE_URL: парсеры работают как обычно, но ни одно изменение не
+// фиксируется, а итог сохраняется в отчёт. Рабочая база только сверяется с копией
+func runDryRun(ctx context.Context, config *modules.SchedulerConfig, jobName, reportPath string) error {
+	cloneURL := os.Getenv("DRY_RUN_DATABASE_URL")
+	if cloneURL == "" {
+		return errors.New("dry run needs a clone of the database: set DRY_RUN_DATABASE_URL")
+	}
+
+	container := di.NewContainer()
+
+	dbConfig, err := container.Config().Database(ctx)
+	if err != nil {
+		return err
+	}
+	production, err := sqlx.ConnectContext(ctx, "postgres", dbConfig.URI())
+	if err != nil {
+		return fmt.Errorf("connect to production database: %w", err)
+	}
+	recorder, err := dryrun.Open(ctx, cloneURL, jobName, production)
+	_ = production.Close()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = recorder.Close() }()
+	container.UseDB(recorder.DB())
+	enablePlayerHistory(ctx, container)
+
+	// Состояние планировщика (блокировки, контрольные точки, история) не нужно:
+	// задача запускается один раз и её позиция не сохраняется
+	scheduler := application.NewSchedulerService(config, nil, nil, nil, nil, nil)
+	registerHandlers(ctx, scheduler, container, config, repositories.NewTournamentPostgres(recorder.DB()))
+
+	job, ok := scheduler.GetHandlers()[jobName]
+	if !ok {
+		return fmt.Errorf("unknown job %q", jobName)
+	}
+
+	runCtx := ctx
+	if jobConfig, ok := config.Jobs[jobName]; ok && jobConfig.Timeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, jobConfig.Timeout)
+		defer cancel()
+	}
+	deadline, _ := runCtx.Deadline()
+
+	logger.Info(ctx, "🧪 Dry run of "+jobName)
+	run := domain.NewJobRun(uuid.New().String(), jobName, deadline, nil, nil)
+	runErr := job.Run(events.WithSession(runCtx, run.ID), run)
+	if runErr != nil {
+		logger.Warn(ctx, "Dry-run job failed", zap.String("job", jobName), zap.Error(runErr))
+	}
+
+	// Отчёт читается после задачи, контекст которой мог истечь
+	reportCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	report, err := recorder.Report(reportCtx, runErr)
+	if err != nil {
+		return errors.Join(runErr, err)
+	}
+	if err := report.WriteFile(reportPath); err != nil {
+		return err
+	}
+	report.WriteSummary(os.Stdout)
+	logger.Info(ctx, "📝 Dry-run report saved", zap.String("path", reportPath))
+	return nil
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/history"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/di"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// enablePlayerHistory включает запись истории игроков в player_events по флагу USE_EVENT_SOURCING
+func enablePlayerHistory(ctx context.Context, container *di.Container) {
+	flags, err := container.Config().FeatureFlags(ctx)
+	if err != nil {
+		logger.Warn(ctx, "Failed to load feature flags, player history disabled", zap.Error(err))
+		return
+	}
+
+	history.SetEnabled(flags.IsEventSourcingEnabled())
+	if history.Enabled() {
+		logger.Info(ctx, "📜 Player change history enabled")
+	}
+}
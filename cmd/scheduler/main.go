@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"log"
-	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -12,6 +11,8 @@ import (
 
 	// FHMoscow parser
 	fhmoscowOrchestrator "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/application/orchestrators/fhmoscow"
+	// FHMoscow calendar
+	fhmoscowCalendarOrch "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/application/orchestrators/fhmoscow/calendar"
 	// FHSPB calendar
 	fhspbCalendarOrch "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/application/orchestrators/fhspb/calendar"
 	// FHSPB parser
@@ -29,6 +30,7 @@ import (
 	// MIHF calendar
 	mihfCalendarOrch "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/application/orchestrators/mihf/calendar"
 	// Repositories
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories"
 	fhspbrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhspb"
 	mihfrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/mihf"
@@ -46,9 +48,12 @@ import (
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/mihf"
 	// Scheduler
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/application"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/infrastructure"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/config/modules"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/di"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/governor"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	_ "github.com/lib/pq"
 	"go.uber.org/zap"
@@ -74,8 +79,24 @@ func main() {
 		logger.Fatal(ctx, "Failed to load scheduler config", zap.Error(err))
 	}
 
+	// Общий регулятор запросов к источникам, создаётся до клиентов
+	governorConfig, err := modules.LoadHTTPGovernorConfig("config/http_governor.yaml")
+	if err != nil {
+		logger.Fatal(ctx, "Failed to load http governor config", zap.Error(err))
+	}
+	governor.SetDefault(governor.New(governorConfig))
+
+	// Режим dry-run: одна задача без записи в базу
+	if *dryRunJob != "" {
+		if err := runDryRun(ctx, config, *dryRunJob, *dryRunReport); err != nil {
+			logger.Fatal(ctx, "Dry run failed", zap.Error(err))
+		}
+		return
+	}
+
 	container := di.NewContainer()
 	defer func() { _ = container.Close() }()
+	enablePlayerHistory(ctx, container)
 
 	db, err := container.DB(ctx)
 	if err != nil {
@@ -83,6 +104,9 @@ func main() {
 	}
 
 	lockRepo := infrastructure.NewLockRepository(db.DB)
+	checkpointRepo := infrastructure.NewCheckpointRepository(db.DB)
+	jobRunRepo := infrastructure.NewJobRunRepository(db.DB)
+	controlRepo := infrastructure.NewControlRepository(db.DB)
 
 	// Инициализируем метрики
 	metrics, err := infrastructure.NewSchedulerMetrics()
@@ -90,14 +114,14 @@ func main() {
 		logger.Warn(ctx, "Failed to initialize metrics: "+err.Error())
 	}
 
-	scheduler := application.NewSchedulerService(config, lockRepo, metrics)
+	scheduler := application.NewSchedulerService(config, lockRepo, checkpointRepo, jobRunRepo, controlRepo, metrics)
+	enableQualityGate(ctx, scheduler, container, config)
 
 	// Создаём репозитории
 	tournamentRepo := repositories.NewTournamentPostgres(db)
-	failedJobRepo := repositories.NewFailedJobRepository(db)
 
 	// Регистрируем handlers
-	registerHandlers(ctx, scheduler, container, config, tournamentRepo, failedJobRepo)
+	registerHandlers(ctx, scheduler, container, config, tournamentRepo)
 
 	// Обработка сигналов
 	sigCh := make(chan os.Signal, 1)
@@ -109,10 +133,15 @@ func main() {
 		cancel()
 	}()
 
+	relay := newOutboxRelay(ctx, container)
+
 	// Режим run-once или run_immediately
 	if *runOnce || config.RunImmediately {
 		logger.Info(ctx, "🚀 Running all jobs once...")
-		runAllJobsOnce(ctx, scheduler, config)
+		runAllJobsOnce(ctx, scheduler)
+		if relay != nil {
+			relay.Drain(ctx)
+		}
 		logger.Info(ctx, "✅ All jobs completed")
 		return
 	}
@@ -127,7 +156,11 @@ func main() {
 		return
 	}
 
-	// Обычный режим - запускаем scheduler
+	// Обычный режим - запускаем scheduler и relay событий
+	if relay != nil {
+		go relay.Run(ctx)
+	}
+
 	logger.Info(ctx, "🚀 Starting scheduler...")
 	if err := scheduler.Start(ctx); err != nil {
 		logger.Fatal(ctx, "Failed to start scheduler", zap.Error(err))
@@ -149,82 +182,102 @@ func registerHandlers(
 	container *di.Container,
 	config *modules.SchedulerConfig,
 	tournamentRepo *repositories.TournamentPostgres,
-	failedJobRepo *repositories.FailedJobRepository,
 ) {
 	metrics := scheduler.GetMetrics()
+	planner := application.NewCadencePlanner(config, tournamentRepo)
 
 	// Junior Stats handler
-	scheduler.RegisterHandler("junior_stats", func() error {
-		return runJuniorStats(ctx, container, config, tournamentRepo, metrics)
-	})
+	scheduler.RegisterHandler("junior_stats", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runJuniorStats(ctx, run, container, planner, tournamentRepo, metrics)
+	}))
 
 	// FHSPB Stats handler
-	scheduler.RegisterHandler("fhspb_stats", func() error {
-		return runFHSPBStats(ctx, container, config, tournamentRepo, metrics)
-	})
+	scheduler.RegisterHandler("fhspb_stats", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runFHSPBStats(ctx, run, container, planner, tournamentRepo, metrics)
+	}))
 
 	// Retry worker
-	retryWorker := application.NewRetryWorker(failedJobRepo)
-	scheduler.RegisterHandler("retry_worker", retryWorker.Run)
+	scheduler.RegisterHandler("retry_worker", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runRetryWorker(ctx, run, container, config)
+	}))
 
 	// Junior parser handler
-	scheduler.RegisterHandler("junior_parser", func() error {
-		return runJuniorParser(ctx, container, config)
-	})
+	scheduler.RegisterHandler("junior_parser", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runJuniorParser(ctx, run, container, config)
+	}))
 
 	// FHSPB parser handler
-	scheduler.RegisterHandler("fhspb_parser", func() error {
-		return runFHSPBParser(ctx, container)
-	})
+	scheduler.RegisterHandler("fhspb_parser", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runFHSPBParser(ctx, run, container)
+	}))
 
 	// MIHF parser handler
-	scheduler.RegisterHandler("mihf_parser", func() error {
-		return runMIHFParser(ctx, container, config)
-	})
+	scheduler.RegisterHandler("mihf_parser", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runMIHFParser(ctx, run, container)
+	}))
 
 	// FHMoscow parser handler
-	scheduler.RegisterHandler("fhmoscow_parser", func() error {
-		return runFHMoscowParser(ctx, container)
-	})
+	scheduler.RegisterHandler("fhmoscow_parser", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runFHMoscowParser(ctx, run, container)
+	}))
 
 	// Junior calendar handler
-	scheduler.RegisterHandler("junior_calendar", func() error {
-		return runJuniorCalendar(ctx, container, config)
-	})
+	scheduler.RegisterHandler("junior_calendar", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runJuniorCalendar(ctx, run, container, config)
+	}))
 
 	// FHSPB calendar handler
-	scheduler.RegisterHandler("fhspb_calendar", func() error {
-		return runFHSPBCalendar(ctx, container)
-	})
+	scheduler.RegisterHandler("fhspb_calendar", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runFHSPBCalendar(ctx, run, container)
+	}))
 
 	// MIHF calendar handler
-	scheduler.RegisterHandler("mihf_calendar", func() error {
-		return runMIHFCalendar(ctx, container)
-	})
+	scheduler.RegisterHandler("mihf_calendar", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runMIHFCalendar(ctx, run, container)
+	}))
+
+	// FHMoscow calendar handler
+	scheduler.RegisterHandler("fhmoscow_calendar", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runFHMoscowCalendar(ctx, run, container)
+	}))
+
+	// Club matcher handler
+	scheduler.RegisterHandler("club_matcher", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runClubMatcher(ctx, run, container)
+	}))
+
+	// Venue linker handler
+	scheduler.RegisterHandler("venue_linker", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runVenueLinker(ctx, run, container)
+	}))
+
+	// League strength handler
+	scheduler.RegisterHandler("league_strength", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return runLeagueStrength(ctx, run, container)
+	}))
 
 	logger.Info(ctx, "📋 Handlers registered")
 }
 
 func runJuniorStats(
 	ctx context.Context,
+	run domain.JobRun,
 	container *di.Container,
-	config *modules.SchedulerConfig,
+	planner *application.CadencePlanner,
 	tournamentRepo *repositories.TournamentPostgres,
 	metrics *infrastructure.SchedulerMetrics,
 ) error {
 	logger.Info(ctx, "📊 Starting Junior Stats...")
 
-	// Получаем турниры по приоритету
-	tournaments, err := tournamentRepo.GetAllForParsing(ctx, true, "junior")
+	// Турниры, которым подошёл срок обновления, в пределах бюджета запуска
+	tournaments, err := plannedTournaments(ctx, planner, tournamentRepo, "junior")
 	if err != nil {
 		return err
 	}
 
-	// Применяем лимит
-	jobCfg, _ := config.GetJob("junior_stats")
-	if jobCfg.MaxTournaments > 0 && len(tournaments) > jobCfg.MaxTournaments {
-		tournaments = tournaments[:jobCfg.MaxTournaments]
-	}
+	// Пропускаем турниры, обработанные прерванным запуском
+	progress := newTournamentProgress(ctx, run)
+	tournaments = progress.Pending(tournaments)
 
 	if len(tournaments) == 0 {
 		logger.Info(ctx, "✅ No tournaments need parsing")
@@ -241,7 +294,7 @@ func runJuniorStats(
 		return err
 	}
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
+	httpClient := governor.NewHTTPClient(30 * time.Second)
 	statsParser := stats.NewParser(httpClient)
 	zapLogger := zap.NewNop()
 	statsService := juniorStats.NewStatsParserService(statsParser, playerStatsRepo, zapLogger)
@@ -254,8 +307,13 @@ func runJuniorStats(
 		stdLogger,
 	)
 
-	// Парсим
-	if err := orch.RunForTournaments(ctx, tournaments); err != nil {
+	// Парсим пачками, сохраняя контрольную точку и last_stats_parsed_at после каждой
+	err = processInBatches(ctx, tournaments, progress, orch.RunForTournaments, func(ctx context.Context, t *entities.Tournament) {
+		if err := tournamentRepo.UpdateLastStatsParsed(ctx, t.ID); err != nil {
+			logger.Error(ctx, "Failed to update last_stats_parsed_at: "+err.Error())
+		}
+	})
+	if err != nil {
 		return err
 	}
 
@@ -264,37 +322,29 @@ func runJuniorStats(
 		metrics.RecordTournamentsParsed(ctx, "junior", int64(len(tournaments)))
 	}
 
-	// Обновляем last_stats_parsed_at
-	for _, t := range tournaments {
-		if err := tournamentRepo.UpdateLastStatsParsed(ctx, t.ID); err != nil {
-			logger.Error(ctx, "Failed to update last_stats_parsed_at: "+err.Error())
-		}
-	}
-
 	logger.Info(ctx, "✅ Junior Stats completed")
 	return nil
 }
 
 func runFHSPBStats(
 	ctx context.Context,
+	run domain.JobRun,
 	container *di.Container,
-	config *modules.SchedulerConfig,
+	planner *application.CadencePlanner,
 	tournamentRepo *repositories.TournamentPostgres,
 	metrics *infrastructure.SchedulerMetrics,
 ) error {
 	logger.Info(ctx, "📊 Starting FHSPB Stats...")
 
-	// Получаем турниры по приоритету
-	tournaments, err := tournamentRepo.GetAllForParsing(ctx, true, "fhspb")
+	// Турниры, которым подошёл срок обновления, в пределах бюджета запуска
+	tournaments, err := plannedTournaments(ctx, planner, tournamentRepo, "fhspb")
 	if err != nil {
 		return err
 	}
 
-	// Применяем лимит
-	jobCfg, _ := config.GetJob("fhspb_stats")
-	if jobCfg.MaxTournaments > 0 && len(tournaments) > jobCfg.MaxTournaments {
-		tournaments = tournaments[:jobCfg.MaxTournaments]
-	}
+	// Пропускаем турниры, обработанные прерванным запуском
+	progress := newTournamentProgress(ctx, run)
+	tournaments = progress.Pending(tournaments)
 
 	if len(tournaments) == 0 {
 		logger.Info(ctx, "✅ No FHSPB tournaments need parsing")
@@ -306,20 +356,6 @@ func runFHSPBStats(
 		return err
 	}
 
-	// Конвертируем в FHSPB Tournament тип
-	fhspbTournaments := make([]*fhspbrepo.Tournament, len(tournaments))
-	for i, t := range tournaments {
-		externalID := ""
-		if t.ExternalID != nil {
-			externalID = *t.ExternalID
-		}
-		fhspbTournaments[i] = &fhspbrepo.Tournament{
-			ID:         t.ID,
-			ExternalID: externalID,
-			Name:       t.Name,
-		}
-	}
-
 	// Создаём orchestrator
 	fhspbClient := fhspb.NewClient()
 
@@ -334,8 +370,16 @@ func runFHSPBStats(
 
 	orch := fhspbStats.NewOrchestrator(deps)
 
-	// Парсим
-	if err := orch.RunForTournaments(ctx, fhspbTournaments); err != nil {
+	// Парсим пачками, сохраняя контрольную точку и last_stats_parsed_at после каждой
+	process := func(ctx context.Context, batch []*entities.Tournament) error {
+		return orch.RunForTournaments(ctx, toFHSPBTournaments(batch))
+	}
+	err = processInBatches(ctx, tournaments, progress, process, func(ctx context.Context, t *entities.Tournament) {
+		if err := tournamentRepo.UpdateLastStatsParsed(ctx, t.ID); err != nil {
+			logger.Error(ctx, "Failed to update last_stats_parsed_at: "+err.Error())
+		}
+	})
+	if err != nil {
 		return err
 	}
 
@@ -344,57 +388,76 @@ func runFHSPBStats(
 		metrics.RecordTournamentsParsed(ctx, "fhspb", int64(len(tournaments)))
 	}
 
-	// Обновляем last_stats_parsed_at
-	for _, t := range tournaments {
-		if err := tournamentRepo.UpdateLastStatsParsed(ctx, t.ID); err != nil {
-			logger.Error(ctx, "Failed to update last_stats_parsed_at: "+err.Error())
-		}
-	}
-
 	logger.Info(ctx, "✅ FHSPB Stats completed")
 	return nil
 }
 
-func runMIHFParser(
-	ctx context.Context,
-	container *di.Container,
-	config *modules.SchedulerConfig,
-) error {
+// toFHSPBTournaments конвертирует турниры в тип FHSPB
+func toFHSPBTournaments(tournaments []*entities.Tournament) []*fhspbrepo.Tournament {
+	result := make([]*fhspbrepo.Tournament, len(tournaments))
+	for i, t := range tournaments {
+		externalID := ""
+		if t.ExternalID != nil {
+			externalID = *t.ExternalID
+		}
+		result[i] = &fhspbrepo.Tournament{
+			ID:         t.ID,
+			ExternalID: externalID,
+			Name:       t.Name,
+		}
+	}
+	return result
+}
+
+func runMIHFParser(ctx context.Context, run domain.JobRun, container *di.Container) error {
 	logger.Info(ctx, "Starting MIHF Parser...")
 
-	db, err := container.DB(ctx)
+	orch, err := newMIHFParser(ctx, container)
 	if err != nil {
 		return err
 	}
+	if err := orch.WithProgress(newTournamentProgress(ctx, run)).Run(ctx); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "MIHF Parser completed")
+	return nil
+}
+
+func newMIHFParser(ctx context.Context, container *di.Container) (*mihfOrchestrator.Orchestrator, error) {
+	db, err := container.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	tournamentRepo, err := container.MIHFTournamentRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	teamRepo, err := container.MIHFTeamRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	playerRepo, err := container.MIHFPlayerRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	playerTeamRepo, err := container.MIHFPlayerTeamRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	playerStatisticsRepo, err := container.MIHFPlayerStatisticsRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	goalieStatisticsRepo, err := container.MIHFGoalieStatisticsRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	parsingConfig, err := container.Config().Parsing(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	client := mihf.NewClient()
@@ -413,13 +476,7 @@ func runMIHFParser(
 
 	configAdapter := &mihfConfigAdapter{cfg: parsingConfig.MIHF}
 
-	orch := mihfOrchestrator.New(deps, configAdapter)
-	if err := orch.Run(ctx); err != nil {
-		return err
-	}
-
-	logger.Info(ctx, "MIHF Parser completed")
-	return nil
+	return mihfOrchestrator.New(deps, configAdapter), nil
 }
 
 type mihfConfigAdapter struct {
@@ -442,29 +499,59 @@ func (a *mihfConfigAdapter) TestSeason() string        { return a.cfg.TestSeason
 // Junior Parser
 // ============================================================================
 
-func runJuniorParser(ctx context.Context, container *di.Container, schedulerConfig *modules.SchedulerConfig) error {
+func runJuniorParser(ctx context.Context, run domain.JobRun, container *di.Container, schedulerConfig *modules.SchedulerConfig) error {
 	logger.Info(ctx, "🏒 Starting Junior Parser...")
 
-	parsingConfig, err := container.Config().Parsing(ctx)
+	orch, err := newJuniorParser(ctx, container, schedulerConfig, newTournamentProgress(ctx, run))
 	if err != nil {
 		return err
 	}
+	if err := orch.Run(ctx); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "✅ Junior Parser completed")
+	return nil
+}
+
+// juniorParserOrchestrator оркестратор парсера Junior, собранный для запуска или повторов
+type juniorParserOrchestrator interface {
+	Run(ctx context.Context) error
+	RegisterRetryHandlers(w *retry.Worker)
+}
+
+// newJuniorParser собирает оркестратор Junior; progress может быть nil
+func newJuniorParser(
+	ctx context.Context,
+	container *di.Container,
+	schedulerConfig *modules.SchedulerConfig,
+	progress juniorParser.TournamentProgress,
+) (juniorParserOrchestrator, error) {
+	db, err := container.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsingConfig, err := container.Config().Parsing(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	playerRepo, err := container.ParsingPlayerRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	teamRepo, err := container.ParsingTeamRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	tournamentRepo, err := container.ParsingTournamentRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	playerTeamRepo, err := container.ParsingPlayerTeamRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Получаем max_tournaments из scheduler config
@@ -484,14 +571,13 @@ func runJuniorParser(ctx context.Context, container *di.Container, schedulerConf
 		tournamentRepo,
 		playerTeamRepo,
 		configAdapter,
-	)
+	).WithProgress(progress)
 
-	if err := orch.Run(ctx); err != nil {
-		return err
+	if parsingConfig.Junior.RetryEnabled {
+		orch.WithRetry(retry.NewManager(db, parsingConfig.Junior.RetryMaxAttempts, parsingConfig.Junior.RetryDelay))
 	}
 
-	logger.Info(ctx, "✅ Junior Parser completed")
-	return nil
+	return orch, nil
 }
 
 type juniorConfigAdapter struct {
@@ -508,34 +594,47 @@ func (a *juniorConfigAdapter) MaxTournaments() int { return a.maxTournaments }
 // FHSPB Parser
 // ============================================================================
 
-func runFHSPBParser(ctx context.Context, container *di.Container) error {
+func runFHSPBParser(ctx context.Context, run domain.JobRun, container *di.Container) error {
 	logger.Info(ctx, "🏒 Starting FHSPB Parser...")
 
-	db, err := container.DB(ctx)
+	orch, err := newFHSPBParser(ctx, container)
 	if err != nil {
 		return err
 	}
+	if err := orch.WithProgress(newTournamentProgress(ctx, run)).Run(ctx); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "✅ FHSPB Parser completed")
+	return nil
+}
+
+func newFHSPBParser(ctx context.Context, container *di.Container) (*fhspbParserOrch.Orchestrator, error) {
+	db, err := container.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	tournamentRepo, err := container.FHSPBTournamentRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	teamRepo, err := container.FHSPBTeamRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	playerRepo, err := container.FHSPBPlayerRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	playerTeamRepo, err := container.FHSPBPlayerTeamRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	parsingConfig, err := container.Config().Parsing(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	client := fhspb.NewClient()
@@ -552,13 +651,7 @@ func runFHSPBParser(ctx context.Context, container *di.Container) error {
 
 	configAdapter := &fhspbConfigAdapter{cfg: parsingConfig.FHSPB}
 
-	orch := fhspbParserOrch.New(deps, configAdapter)
-	if err := orch.Run(ctx); err != nil {
-		return err
-	}
-
-	logger.Info(ctx, "✅ FHSPB Parser completed")
-	return nil
+	return fhspbParserOrch.New(deps, configAdapter), nil
 }
 
 type fhspbConfigAdapter struct {
@@ -578,42 +671,59 @@ func (a *fhspbConfigAdapter) RetryDelay() time.Duration { return a.cfg.RetryDela
 // FHMoscow Parser
 // ============================================================================
 
-func runFHMoscowParser(ctx context.Context, container *di.Container) error {
+func runFHMoscowParser(ctx context.Context, run domain.JobRun, container *di.Container) error {
 	logger.Info(ctx, "🏒 Starting FHMoscow Parser...")
 
-	db, err := container.DB(ctx)
+	orch, err := newFHMoscowParser(ctx, container)
 	if err != nil {
 		return err
 	}
+	if err := orch.WithProgress(newTournamentProgress(ctx, run)).Run(ctx); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "✅ FHMoscow Parser completed")
+	return nil
+}
+
+func newFHMoscowParser(ctx context.Context, container *di.Container) (*fhmoscowOrchestrator.Orchestrator, error) {
+	db, err := container.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	tournamentRepo, err := container.FHMoscowTournamentRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	teamRepo, err := container.FHMoscowTeamRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	playerRepo, err := container.FHMoscowPlayerRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	playerTeamRepo, err := container.FHMoscowPlayerTeamRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	playerStatisticsRepo, err := container.FHMoscowPlayerStatisticsRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	goalieStatisticsRepo, err := container.FHMoscowGoalieStatisticsRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	playerFrontierRepo, err := container.FHMoscowPlayerFrontierRepository(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	parsingConfig, err := container.Config().Parsing(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	client := fhmoscow.NewClient()
@@ -628,17 +738,12 @@ func runFHMoscowParser(ctx context.Context, container *di.Container) error {
 		PlayerTeamRepo:       playerTeamRepo,
 		PlayerStatisticsRepo: playerStatisticsRepo,
 		GoalieStatisticsRepo: goalieStatisticsRepo,
+		PlayerFrontierRepo:   playerFrontierRepo,
 	}
 
 	configAdapter := &fhmoscowConfigAdapter{cfg: parsingConfig.FHMoscow}
 
-	orch := fhmoscowOrchestrator.New(deps, configAdapter)
-	if err := orch.Run(ctx); err != nil {
-		return err
-	}
-
-	logger.Info(ctx, "✅ FHMoscow Parser completed")
-	return nil
+	return fhmoscowOrchestrator.New(deps, configAdapter), nil
 }
 
 type fhmoscowConfigAdapter struct {
@@ -657,41 +762,68 @@ func (a *fhmoscowConfigAdapter) MaxSeasons() int           { return a.cfg.MaxSea
 func (a *fhmoscowConfigAdapter) TestSeason() string        { return a.cfg.TestSeason }
 func (a *fhmoscowConfigAdapter) ScanPlayers() bool         { return a.cfg.ScanPlayers }
 func (a *fhmoscowConfigAdapter) MaxPlayerID() int          { return a.cfg.MaxPlayerID }
+func (a *fhmoscowConfigAdapter) ProbeMissLimit() int       { return a.cfg.ProbeMissLimit }
+func (a *fhmoscowConfigAdapter) PlayerRefreshAfter() time.Duration {
+	return a.cfg.PlayerRefreshAfter
+}
 
 // ============================================================================
 // Junior Calendar
 // ============================================================================
 
-func runJuniorCalendar(ctx context.Context, container *di.Container, schedulerConfig *modules.SchedulerConfig) error {
+func runJuniorCalendar(ctx context.Context, run domain.JobRun, container *di.Container, schedulerConfig *modules.SchedulerConfig) error {
 	logger.Info(ctx, "🗓️ Starting Junior Calendar Parser...")
 
-	matchRepo, err := container.MatchRepository(ctx)
+	orch, err := newJuniorCalendar(ctx, container, schedulerConfig)
 	if err != nil {
 		return err
 	}
+	if err := orch.WithProgress(newTournamentProgress(ctx, run)).Run(ctx); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "✅ Junior Calendar Parser completed")
+	return nil
+}
+
+func newJuniorCalendar(ctx context.Context, container *di.Container, schedulerConfig *modules.SchedulerConfig) (*juniorCalendar.Orchestrator, error) {
+	db, err := container.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsingConfig, err := container.Config().Parsing(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matchRepo, err := container.MatchRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
 	matchEventRepo, err := container.MatchEventRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	matchLineupRepo, err := container.MatchLineupRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	standingRepo, err := container.StandingRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	tournamentRepo, err := container.ParsingTournamentRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	teamRepo, err := container.ParsingTeamRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	playerRepo, err := container.ParsingPlayerRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Получаем max_tournaments из scheduler config
@@ -723,12 +855,12 @@ func runJuniorCalendar(ctx context.Context, container *di.Container, schedulerCo
 		configAdapter,
 	)
 
-	if err := orch.Run(ctx); err != nil {
-		return err
+	// Неудачные матчи и протоколы повторяются по политике парсера Junior
+	if parsingConfig.Junior.RetryEnabled {
+		orch.WithRetry(retry.NewManager(db, parsingConfig.Junior.RetryMaxAttempts, parsingConfig.Junior.RetryDelay))
 	}
 
-	logger.Info(ctx, "✅ Junior Calendar Parser completed")
-	return nil
+	return orch, nil
 }
 
 type juniorCalendarConfigAdapter struct {
@@ -747,41 +879,64 @@ func (a *juniorCalendarConfigAdapter) MaxTournaments() int    { return a.maxTour
 // FHSPB Calendar
 // ============================================================================
 
-func runFHSPBCalendar(ctx context.Context, container *di.Container) error {
+func runFHSPBCalendar(ctx context.Context, run domain.JobRun, container *di.Container) error {
 	logger.Info(ctx, "Starting FHSPB Calendar Parser...")
 
+	orch, err := newFHSPBCalendar(ctx, container)
+	if err != nil {
+		return err
+	}
+	if err := orch.WithProgress(newTournamentProgress(ctx, run)).Run(ctx); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "FHSPB Calendar Parser completed")
+	return nil
+}
+
+func newFHSPBCalendar(ctx context.Context, container *di.Container) (*fhspbCalendarOrch.Orchestrator, error) {
+	db, err := container.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsingConfig, err := container.Config().Parsing(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Репозитории
 	matchRepo, err := container.MatchRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	matchEventRepo, err := container.MatchEventRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	matchLineupRepo, err := container.MatchLineupRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	standingRepo, err := container.StandingRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	matchTeamStatsRepo, err := container.MatchTeamStatsRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	tournamentRepo, err := container.FHSPBTournamentRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	teamRepo, err := container.FHSPBTeamRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	playerRepo, err := container.FHSPBPlayerRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Client и парсеры
@@ -810,12 +965,12 @@ func runFHSPBCalendar(ctx context.Context, container *di.Container) error {
 		configAdapter,
 	)
 
-	if err := orch.Run(ctx); err != nil {
-		return err
+	// Неудачные протоколы повторяются по политике парсера FHSPB
+	if parsingConfig.FHSPB.RetryEnabled {
+		orch.WithRetry(retry.NewManager(db, parsingConfig.FHSPB.RetryMaxAttempts, parsingConfig.FHSPB.RetryDelay))
 	}
 
-	logger.Info(ctx, "FHSPB Calendar Parser completed")
-	return nil
+	return orch, nil
 }
 
 type fhspbCalendarConfigAdapter struct{}
@@ -830,31 +985,44 @@ func (a *fhspbCalendarConfigAdapter) SkipExisting() bool  { return true }
 // MIHF Calendar
 // ============================================================================
 
-func runMIHFCalendar(ctx context.Context, container *di.Container) error {
+func runMIHFCalendar(ctx context.Context, run domain.JobRun, container *di.Container) error {
 	logger.Info(ctx, "Starting MIHF Calendar Parser...")
 
-	db, err := container.DB(ctx)
+	orch, err := newMIHFCalendar(ctx, container)
 	if err != nil {
 		return err
 	}
+	if err := orch.WithProgress(newTournamentProgress(ctx, run)).Run(ctx); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "MIHF Calendar Parser completed")
+	return nil
+}
+
+func newMIHFCalendar(ctx context.Context, container *di.Container) (*mihfCalendarOrch.Orchestrator, error) {
+	db, err := container.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	parsingConfig, err := container.Config().Parsing(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Репозитории
 	matchRepo, err := container.MatchRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	matchEventRepo, err := container.MatchEventRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	matchLineupRepo, err := container.MatchLineupRepository(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// MIHF репозитории
@@ -871,7 +1039,7 @@ func runMIHFCalendar(ctx context.Context, container *di.Container) error {
 	configAdapter := &mihfCalendarConfigAdapter{cfg: parsingConfig.MIHF}
 
 	// Orchestrator
-	orch := mihfCalendarOrch.NewOrchestrator(
+	return mihfCalendarOrch.NewOrchestrator(
 		db,
 		client,
 		tournamentRepo,
@@ -882,14 +1050,7 @@ func runMIHFCalendar(ctx context.Context, container *di.Container) error {
 		matchEventRepo,
 		matchLineupRepo,
 		configAdapter,
-	)
-
-	if err := orch.Run(ctx); err != nil {
-		return err
-	}
-
-	logger.Info(ctx, "MIHF Calendar Parser completed")
-	return nil
+	), nil
 }
 
 type mihfCalendarConfigAdapter struct {
@@ -909,27 +1070,173 @@ func (a *mihfCalendarConfigAdapter) RetryMaxAttempts() int     { return a.cfg.Re
 func (a *mihfCalendarConfigAdapter) RetryDelay() time.Duration { return a.cfg.RetryDelay }
 
 // ============================================================================
-// Utilities
+// FHMoscow Calendar
 // ============================================================================
 
-func runAllJobsOnce(ctx context.Context, scheduler *application.SchedulerService, config *modules.SchedulerConfig) {
-	handlers := scheduler.GetHandlers()
+func runFHMoscowCalendar(ctx context.Context, run domain.JobRun, container *di.Container) error {
+	logger.Info(ctx, "Starting FHMoscow Calendar Parser...")
+
+	orch, err := newFHMoscowCalendar(ctx, container)
+	if err != nil {
+		return err
+	}
+	if err := orch.WithProgress(newTournamentProgress(ctx, run)).Run(ctx); err != nil {
+		return err
+	}
 
-	// Используем отсортированный список jobs (по полю order)
-	for _, jobWithName := range config.EnabledJobsOrdered() {
-		name := jobWithName.Name
-		logger.Info(ctx, "▶️ Running job: "+name)
+	logger.Info(ctx, "FHMoscow Calendar Parser completed")
+	return nil
+}
 
-		handler, ok := handlers[name]
-		if !ok {
-			logger.Warn(ctx, "No handler for job: "+name)
-			continue
-		}
+func newFHMoscowCalendar(ctx context.Context, container *di.Container) (*fhmoscowCalendarOrch.Orchestrator, error) {
+	db, err := container.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsingConfig, err := container.Config().Parsing(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Репозитории
+	matchRepo, err := container.MatchRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matchEventRepo, err := container.MatchEventRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matchLineupRepo, err := container.MatchLineupRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+	standingRepo, err := container.StandingRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tournamentRepo, err := container.FHMoscowTournamentRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+	teamRepo, err := container.FHMoscowTeamRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+	playerRepo, err := container.FHMoscowPlayerRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Client
+	client := fhmoscow.NewClient()
+	client.SetDelay(parsingConfig.FHMoscow.RequestDelay)
+
+	// Config adapter (использует parsingConfig.FHMoscow для общих параметров)
+	configAdapter := &fhmoscowCalendarConfigAdapter{cfg: parsingConfig.FHMoscow}
+
+	// Orchestrator
+	return fhmoscowCalendarOrch.NewOrchestrator(
+		db,
+		client,
+		tournamentRepo,
+		teamRepo,
+		playerRepo,
+		matchRepo,
+		matchEventRepo,
+		matchLineupRepo,
+		standingRepo,
+		configAdapter,
+	), nil
+}
+
+type fhmoscowCalendarConfigAdapter struct {
+	cfg modules.FHMoscowConfig
+}
+
+func (a *fhmoscowCalendarConfigAdapter) MinBirthYear() int         { return a.cfg.MinBirthYear }
+func (a *fhmoscowCalendarConfigAdapter) TestSeason() string        { return a.cfg.TestSeason }
+func (a *fhmoscowCalendarConfigAdapter) ParseProtocol() bool       { return true }
+func (a *fhmoscowCalendarConfigAdapter) ParseStandings() bool      { return true }
+func (a *fhmoscowCalendarConfigAdapter) SkipExisting() bool        { return true }
+func (a *fhmoscowCalendarConfigAdapter) RetryEnabled() bool        { return a.cfg.RetryEnabled }
+func (a *fhmoscowCalendarConfigAdapter) RetryMaxAttempts() int     { return a.cfg.RetryMaxAttempts }
+func (a *fhmoscowCalendarConfigAdapter) RetryDelay() time.Duration { return a.cfg.RetryDelay }
+
+func runLeagueStrength(ctx context.Context, run domain.JobRun, container *di.Container) error {
+	logger.Info(ctx, "⚖️ Starting League Strength estimation...")
+
+	strengthService, err := container.AnalyticsStrengthService(ctx)
+	if err != nil {
+		return err
+	}
+
+	saved, err := strengthService.Recompute(ctx)
+	if err != nil {
+		return err
+	}
+	run.Track("coefficients", saved)
+
+	logger.Info(ctx, "✅ League Strength completed")
+	return nil
+}
+
+func runClubMatcher(ctx context.Context, run domain.JobRun, container *di.Container) error {
+	logger.Info(ctx, "🏒 Starting Club matcher...")
+
+	clubService, err := container.AnalyticsClubService(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := clubService.MatchTeams(ctx)
+	run.Track("teams_linked", result.Linked)
+	run.Track("clubs_created", result.Created)
+	run.Track("reviews_queued", result.Queued)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "✅ Club matcher completed")
+	return nil
+}
+
+func runVenueLinker(ctx context.Context, run domain.JobRun, container *di.Container) error {
+	logger.Info(ctx, "🏟️ Starting Venue linker...")
+
+	venueService, err := container.AnalyticsVenueService(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := venueService.LinkVenues(ctx)
+	run.Track("venues_created", result.Created)
+	run.Track("venues_linked", result.Linked)
+	run.Track("matches_linked", result.Matches)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "✅ Venue linker completed")
+	return nil
+}
+
+// ============================================================================
+// Utilities
+// ============================================================================
 
-		if err := handler(); err != nil {
-			logger.Error(ctx, "Job failed: "+name+": "+err.Error())
-		} else {
-			logger.Info(ctx, "✅ Job completed: "+name)
+func runAllJobsOnce(ctx context.Context, scheduler *application.SchedulerService) {
+	// Задачи выполняются в порядке зависимостей (depends_on), при равенстве - по полю order
+	pipeline := scheduler.RunAllOnce(ctx)
+	for _, job := range pipeline.Jobs {
+		switch job.Status {
+		case domain.JobStatusSucceeded:
+			logger.Info(ctx, "✅ Job completed: "+job.JobName)
+		case domain.JobStatusFailed:
+			logger.Error(ctx, "Job failed: "+job.JobName+": "+job.Error)
+		case domain.JobStatusSkipped:
+			logger.Warn(ctx, "Job skipped: "+job.JobName+": "+job.SkipReason)
 		}
 	}
 }
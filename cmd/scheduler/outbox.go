@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/di"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/outbox"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// newOutboxRelay создаёт relay событий outbox: задачи планировщика пишут события
+// о матчах и статистике, relay раскладывает их подписчикам других процессов.
+// Сейчас подписан только API; бот читает данные из базы напрямую и событий не получает
+func newOutboxRelay(ctx context.Context, container *di.Container) *outbox.Relay {
+	store, err := container.EventOutboxStore(ctx)
+	if err != nil {
+		logger.Warn(ctx, "Outbox relay disabled", zap.Error(err))
+		return nil
+	}
+
+	dsn := ""
+	if dbConfig, err := container.Config().Database(ctx); err == nil {
+		dsn = dbConfig.URI()
+	}
+	return outbox.NewRelay(store, dsn, outbox.DefaultOptions())
+}
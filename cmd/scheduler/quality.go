@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/quality"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/application"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/config/modules"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/di"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// enableQualityGate включает проверки качества данных после каждой успешной задачи и
+// регистрирует задачу data_quality с полной проверкой таблиц
+func enableQualityGate(ctx context.Context, scheduler *application.SchedulerService, container *di.Container, config *modules.SchedulerConfig) {
+	if !config.Quality.Enabled {
+		return
+	}
+
+	db, err := container.DB(ctx)
+	if err != nil {
+		logger.Warn(ctx, "Failed to connect to database, data quality checks disabled", zap.Error(err))
+		return
+	}
+
+	registry := quality.NewRegistry()
+	for _, rule := range quality.DefaultRules() {
+		if err := registry.Register(rule); err != nil {
+			logger.Fatal(ctx, "Invalid data quality rule", zap.Error(err))
+		}
+	}
+
+	engine := quality.NewEngine(db, registry)
+	scheduler.UseQualityGate(engine)
+	scheduler.RegisterHandler("data_quality", domain.JobFunc(func(ctx context.Context, run domain.JobRun) error {
+		return engine.Scan(ctx, run.ID, "data_quality")
+	}))
+	logger.Info(ctx, "🧪 Data quality checks enabled", zap.Int("rules", len(registry.Rules())), zap.Bool("block_on_critical", config.Quality.BlockOnCritical))
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+
+	fhmoscowOrchestrator "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/application/orchestrators/fhmoscow"
+	fhspbParserOrch "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/application/orchestrators/fhspb/parser/orchestrator"
+	juniorParser "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/application/orchestrators/junior/parser"
+	mihfOrchestrator "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/application/orchestrators/mihf"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/application"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/config/modules"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/di"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// retryRegistrar оркестратор, умеющий повторять свои сущности
+type retryRegistrar interface {
+	RegisterRetryHandlers(w *retry.Worker)
+}
+
+func runRetryWorker(ctx context.Context, run domain.JobRun, container *di.Container, schedulerConfig *modules.SchedulerConfig) error {
+	logger.Info(ctx, "🔁 Starting Retry Worker...")
+
+	worker, err := newRetryWorker(ctx, container, schedulerConfig)
+	if err != nil {
+		return err
+	}
+	if err := worker.Run(ctx, run); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "✅ Retry Worker completed")
+	return nil
+}
+
+// newRetryWorker собирает очередь повторов с политиками и обработчиками источников
+func newRetryWorker(ctx context.Context, container *di.Container, schedulerConfig *modules.SchedulerConfig) (*application.RetryWorker, error) {
+	db, err := container.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsingConfig, err := container.Config().Parsing(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	store := retry.NewStore(db)
+	worker := retry.NewWorker(store)
+
+	// Политики повторов берутся из настроек парсеров источников
+	worker.SetPolicy(mihfOrchestrator.Source, retry.Policy{
+		MaxRetries: parsingConfig.MIHF.RetryMaxAttempts,
+		BaseDelay:  parsingConfig.MIHF.RetryDelay,
+	})
+	worker.SetPolicy(fhspbParserOrch.Source, retry.Policy{
+		MaxRetries: parsingConfig.FHSPB.RetryMaxAttempts,
+		BaseDelay:  parsingConfig.FHSPB.RetryDelay,
+	})
+	worker.SetPolicy(fhmoscowOrchestrator.Source, retry.Policy{
+		MaxRetries: parsingConfig.FHMoscow.RetryMaxAttempts,
+		BaseDelay:  parsingConfig.FHMoscow.RetryDelay,
+	})
+	worker.SetPolicy(juniorParser.Source, retry.Policy{
+		MaxRetries: parsingConfig.Junior.RetryMaxAttempts,
+		BaseDelay:  parsingConfig.Junior.RetryDelay,
+	})
+
+	juniorParserOrch, err := newJuniorParser(ctx, container, schedulerConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+	juniorCalendarOrch, err := newJuniorCalendar(ctx, container, schedulerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	mihfParser, err := newMIHFParser(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+	mihfCalendar, err := newMIHFCalendar(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+	fhspbParser, err := newFHSPBParser(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+	fhspbCalendar, err := newFHSPBCalendar(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+	fhmoscowParser, err := newFHMoscowParser(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+	fhmoscowCalendar, err := newFHMoscowCalendar(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+
+	registrars := []retryRegistrar{
+		juniorParserOrch, juniorCalendarOrch,
+		mihfParser, mihfCalendar,
+		fhspbParser, fhspbCalendar,
+		fhmoscowParser, fhmoscowCalendar,
+	}
+	for _, orch := range registrars {
+		orch.RegisterRetryHandlers(worker)
+	}
+
+	return application.NewRetryWorker(worker, store), nil
+}
@@ -20,6 +20,7 @@ import { useCountUp } from '@/shared/hooks'
 import { usePlayerProfile } from '@/shared/api/useExploreQueries'
 import { PlayerStatsHistory } from './PlayerStatsHistory'
 import { PlayerChartsSection } from './charts/PlayerChartsSection'
+import { DisciplineCard } from './discipline'
 
 const POSITION_LABELS: Record<string, string> = {
   forward: 'Нападающий',
@@ -221,6 +222,9 @@ export const PlayerProfilePage = memo(function PlayerProfilePage() {
       {/* Charts */}
       <PlayerChartsSection playerId={id ?? ''} />
 
+      {/* Discipline */}
+      <DisciplineCard scope="players" id={id ?? ''} />
+
       {/* Detailed stats history */}
       <PlayerStatsHistory playerId={id ?? ''} />
     </div>
@@ -15,6 +15,8 @@ import {
 import { GlassCard } from '@/shared/ui'
 import { cn } from '@/shared/lib/utils'
 import { useTeamProfile } from '@/shared/api/useExploreQueries'
+import { TeamLinesCard } from './team'
+import { DisciplineCard } from './discipline'
 
 function PlayerPhoto({ url, name }: { url?: string; name: string }) {
   const [hasError, setHasError] = useState(false)
@@ -198,6 +200,12 @@ export const TeamProfilePage = memo(function TeamProfilePage() {
         </GlassCard>
       </motion.div>
 
+      {/* Lines */}
+      <TeamLinesCard teamId={team.id} />
+
+      {/* Discipline */}
+      <DisciplineCard scope="teams" id={team.id} delay={0.34} />
+
       {/* Recent matches */}
       {team.recentMatches && team.recentMatches.length > 0 && (
         <motion.div
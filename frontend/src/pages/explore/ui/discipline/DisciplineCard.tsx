@@ -0,0 +1,107 @@
+import { memo } from 'react'
+import { Link } from 'react-router-dom'
+import { motion } from 'framer-motion'
+import { Gavel } from 'lucide-react'
+import { GlassCard } from '@/shared/ui'
+import { useDiscipline } from '@/shared/api/useExploreQueries'
+
+const MAX_INFRACTIONS = 5
+
+interface DisciplineCardProps {
+  scope: 'players' | 'teams'
+  id: string
+  delay?: number
+}
+
+export const DisciplineCard = memo(function DisciplineCard({ scope, id, delay = 0.3 }: DisciplineCardProps) {
+  const { data } = useDiscipline(scope, id)
+
+  if (!data || data.penalties === 0) {
+    return null
+  }
+
+  const maxPeriod = Math.max(...data.periods.map((p) => p.penalties), 1)
+
+  return (
+    <motion.div
+      initial={{ opacity: 0, y: 20 }}
+      animate={{ opacity: 1, y: 0 }}
+      transition={{ delay }}
+    >
+      <GlassCard className="p-6">
+        <h3 className="text-lg font-semibold text-white mb-4 flex items-center gap-2">
+          <Gavel size={20} className="text-[#f59e0b]" />
+          Дисциплина
+        </h3>
+
+        <div className="grid grid-cols-2 gap-3 md:grid-cols-4 mb-6">
+          {[
+            { label: 'Штраф. минут', value: data.minutes },
+            { label: 'Минут за игру', value: data.minutesPerGame.toFixed(2) },
+            { label: 'Больших / дисц.', value: `${data.majors} / ${data.misconducts + data.gameMisconducts}` },
+            {
+              label: 'Реализовано соперником',
+              value: `${data.costlyPenalties} из ${data.powerPlayPenalties}`,
+            },
+          ].map((s) => (
+            <div key={s.label} className="rounded-lg bg-white/5 p-3 text-center">
+              <p className="text-xl font-bold text-white">{s.value}</p>
+              <p className="text-[10px] text-gray-500 mt-0.5">{s.label}</p>
+            </div>
+          ))}
+        </div>
+
+        <div className="grid gap-6 md:grid-cols-2">
+          <div>
+            <p className="text-xs uppercase tracking-wide text-gray-500 mb-2">Частые нарушения</p>
+            <div className="space-y-2">
+              {data.infractions.slice(0, MAX_INFRACTIONS).map((inf) => (
+                <div key={`${inf.code}-${inf.reason}`} className="flex items-center justify-between text-sm">
+                  <span className="text-gray-300 truncate mr-2">{inf.reason || inf.code}</span>
+                  <span className="text-gray-400 flex-shrink-0">
+                    {inf.count} × · {inf.minutes} мин
+                  </span>
+                </div>
+              ))}
+            </div>
+          </div>
+
+          <div>
+            <p className="text-xs uppercase tracking-wide text-gray-500 mb-2">По периодам</p>
+            <div className="space-y-2">
+              {data.periods.map((p) => (
+                <div key={p.period} className="flex items-center gap-3 text-sm">
+                  <span className="w-8 text-gray-400">{p.period === 'OT' ? 'ОТ' : `${p.period}п`}</span>
+                  <div className="flex-1 h-2 rounded-full bg-white/5 overflow-hidden">
+                    <div
+                      className="h-full bg-[#f59e0b]"
+                      style={{ width: `${(p.penalties / maxPeriod) * 100}%` }}
+                    />
+                  </div>
+                  <span className="w-10 text-right text-gray-300">{p.penalties}</span>
+                </div>
+              ))}
+            </div>
+          </div>
+        </div>
+
+        {data.topPlayers && data.topPlayers.length > 0 && (
+          <div className="mt-6">
+            <p className="text-xs uppercase tracking-wide text-gray-500 mb-2">Больше всех штрафовались</p>
+            <div className="flex flex-wrap gap-2">
+              {data.topPlayers.slice(0, MAX_INFRACTIONS).map((o) => (
+                <Link
+                  key={o.id}
+                  to={`/explore/players/${o.id}`}
+                  className="px-2 py-1 rounded-lg bg-white/5 text-xs text-gray-300 hover:bg-white/[0.08] transition-colors"
+                >
+                  {o.name || o.id} · {o.minutes} мин
+                </Link>
+              ))}
+            </div>
+          </div>
+        )}
+      </GlassCard>
+    </motion.div>
+  )
+})
@@ -0,0 +1 @@
+export { DisciplineCard } from './DisciplineCard'
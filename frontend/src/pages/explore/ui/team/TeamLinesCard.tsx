@@ -0,0 +1,85 @@
+import { memo } from 'react'
+import { Link } from 'react-router-dom'
+import { motion } from 'framer-motion'
+import { Layers } from 'lucide-react'
+import { GlassCard } from '@/shared/ui'
+import { cn } from '@/shared/lib/utils'
+import { useTeamLines } from '@/shared/api/useExploreQueries'
+import type { LineCombination } from '@/shared/api/exploreTypes'
+
+const MAX_LINES = 5
+
+function LineRow({ line }: { line: LineCombination }) {
+  return (
+    <div className="flex items-center gap-3 rounded-lg bg-white/5 p-3">
+      <div className="flex-1 min-w-0 flex flex-wrap gap-x-2 gap-y-1">
+        {line.players.map((p, i) => (
+          <span key={p.id} className="text-sm text-white">
+            <Link to={`/explore/players/${p.id}`} className="hover:text-[#00d4ff] transition-colors">
+              {p.name || p.id}
+            </Link>
+            {i < line.players.length - 1 && <span className="text-gray-600"> –</span>}
+          </span>
+        ))}
+      </div>
+      <div className="text-right flex-shrink-0">
+        <span className="text-xs text-gray-400 block">
+          {line.goalsFor}:{line.goalsAgainst}
+        </span>
+        <span className="text-[10px] text-gray-500">{line.matches} игр</span>
+      </div>
+      <span
+        className={cn(
+          'text-sm font-bold w-10 text-right',
+          line.goalDiff > 0 ? 'text-[#10b981]' : line.goalDiff < 0 ? 'text-[#ef4444]' : 'text-gray-400'
+        )}
+      >
+        {line.goalDiff > 0 ? `+${line.goalDiff}` : line.goalDiff}
+      </span>
+    </div>
+  )
+}
+
+function LinesBlock({ title, lines }: { title: string; lines: LineCombination[] }) {
+  return (
+    <div>
+      <p className="text-xs uppercase tracking-wide text-gray-500 mb-2">{title}</p>
+      {lines.length > 0 ? (
+        <div className="space-y-2">
+          {lines.slice(0, MAX_LINES).map((line) => (
+            <LineRow key={line.players.map((p) => p.id).join('-')} line={line} />
+          ))}
+        </div>
+      ) : (
+        <p className="text-sm text-gray-500">Недостаточно данных</p>
+      )}
+    </div>
+  )
+}
+
+export const TeamLinesCard = memo(function TeamLinesCard({ teamId }: { teamId: string }) {
+  const { data } = useTeamLines(teamId)
+
+  if (!data || (data.forwards.length === 0 && data.defence.length === 0)) {
+    return null
+  }
+
+  return (
+    <motion.div
+      initial={{ opacity: 0, y: 20 }}
+      animate={{ opacity: 1, y: 0 }}
+      transition={{ delay: 0.33 }}
+    >
+      <GlassCard className="p-6" glowColor="purple">
+        <h3 className="text-lg font-semibold text-white mb-4 flex items-center gap-2">
+          <Layers size={20} className="text-[#8b5cf6]" />
+          Звенья и пары
+        </h3>
+        <div className="grid gap-6 md:grid-cols-2">
+          <LinesBlock title="Тройки нападающих" lines={data.forwards} />
+          <LinesBlock title="Пары защитников" lines={data.defence} />
+        </div>
+      </GlassCard>
+    </motion.div>
+  )
+})
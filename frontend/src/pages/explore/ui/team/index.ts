@@ -0,0 +1 @@
+export { TeamLinesCard } from './TeamLinesCard'
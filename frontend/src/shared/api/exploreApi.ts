@@ -14,6 +14,9 @@ import type {
   RankingsFilters,
   MatchDetail,
   TeamRosterResponse,
+  TeamLinesResponse,
+  PlayerLinematesResponse,
+  DisciplineReport,
 } from './exploreTypes'
 
 export async function getExploreOverview(): Promise<ExploreOverview> {
@@ -168,3 +171,31 @@ export async function getTeamRoster(
   const { data } = await apiClient.get(`/explore/teams/${teamId}/roster/${tournamentId}`, { params })
   return data
 }
+
+export async function getTeamLines(teamId: string, tournamentId?: string): Promise<TeamLinesResponse> {
+  const params: Record<string, string> = {}
+  if (tournamentId) params.tournamentId = tournamentId
+  const { data } = await apiClient.get(`/explore/teams/${teamId}/lines`, { params })
+  return data
+}
+
+export async function getPlayerLinemates(
+  playerId: string,
+  tournamentId?: string
+): Promise<PlayerLinematesResponse> {
+  const params: Record<string, string> = {}
+  if (tournamentId) params.tournamentId = tournamentId
+  const { data } = await apiClient.get(`/explore/players/${playerId}/linemates`, { params })
+  return data
+}
+
+export async function getDiscipline(
+  scope: 'players' | 'teams' | 'tournaments',
+  id: string,
+  tournamentId?: string
+): Promise<DisciplineReport> {
+  const params: Record<string, string> = {}
+  if (tournamentId) params.tournamentId = tournamentId
+  const { data } = await apiClient.get(`/explore/${scope}/${id}/discipline`, { params })
+  return data
+}
@@ -313,3 +313,82 @@ export interface TeamRosterResponse {
   team: TeamInfo
   players: RosterPlayer[]
 }
+
+// Line Combination Types
+export interface LinePlayer {
+  id: string
+  name: string
+  position: string
+}
+
+export interface LineCombination {
+  kind: 'forwards' | 'defence'
+  players: LinePlayer[]
+  goalsFor: number
+  goalsAgainst: number
+  goalDiff: number
+  matches: number
+}
+
+export interface TeamLinesResponse {
+  teamId: string
+  forwards: LineCombination[]
+  defence: LineCombination[]
+}
+
+export interface Linemate {
+  player: LinePlayer
+  goalsFor: number
+  goalsAgainst: number
+  goalDiff: number
+  matches: number
+}
+
+export interface PlayerLinematesResponse {
+  playerId: string
+  linemates: Linemate[]
+}
+
+// Discipline Types
+export interface Infraction {
+  code?: string
+  reason: string
+  count: number
+  minutes: number
+}
+
+export interface PeriodDiscipline {
+  period: string
+  penalties: number
+  minutes: number
+  share: number
+  perGame: number
+  goalsConceded: number
+}
+
+export interface Offender {
+  id: string
+  name: string
+  penalties: number
+  minutes: number
+}
+
+export interface DisciplineReport {
+  scope: 'player' | 'team' | 'tournament'
+  id: string
+  games: number
+  penalties: number
+  minutes: number
+  minutesPerGame: number
+  minors: number
+  majors: number
+  misconducts: number
+  gameMisconducts: number
+  powerPlayPenalties: number
+  costlyPenalties: number
+  costlyRate: number
+  infractions: Infraction[]
+  periods: PeriodDiscipline[]
+  topPlayers?: Offender[]
+  topTeams?: Offender[]
+}
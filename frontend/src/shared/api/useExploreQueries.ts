@@ -17,6 +17,9 @@ import {
   getRankingsFilters,
   getMatchDetail,
   getTeamRoster,
+  getTeamLines,
+  getPlayerLinemates,
+  getDiscipline,
 } from './exploreApi'
 import type { RankingsParams } from './exploreApi'
 
@@ -167,3 +170,31 @@ export function useTeamRoster(
     enabled: !!teamId && !!tournamentId,
   })
 }
+
+export function useTeamLines(teamId: string, tournamentId?: string) {
+  return useQuery({
+    queryKey: ['explore', 'teams', teamId, 'lines', tournamentId],
+    queryFn: () => getTeamLines(teamId, tournamentId),
+    enabled: !!teamId,
+  })
+}
+
+export function usePlayerLinemates(playerId: string, tournamentId?: string) {
+  return useQuery({
+    queryKey: ['explore', 'players', playerId, 'linemates', tournamentId],
+    queryFn: () => getPlayerLinemates(playerId, tournamentId),
+    enabled: !!playerId,
+  })
+}
+
+export function useDiscipline(
+  scope: 'players' | 'teams' | 'tournaments',
+  id: string,
+  tournamentId?: string
+) {
+  return useQuery({
+    queryKey: ['explore', scope, id, 'discipline', tournamentId],
+    queryFn: () => getDiscipline(scope, id, tournamentId),
+    enabled: !!id,
+  })
+}
@@ -0,0 +1,80 @@
+package application
+
+import (
+	"slices"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+// clubIndex клубы с нормализованными названиями для поиска кандидата команде
+type clubIndex struct {
+	clubs []indexedClub
+	byKey map[string][]int // нормализованное название -> клубы
+}
+
+type indexedClub struct {
+	club domain.Club
+	keys []string
+}
+
+func newClubIndex(clubs []domain.Club) *clubIndex {
+	ix := &clubIndex{byKey: make(map[string][]int)}
+	for _, c := range clubs {
+		ix.add(c)
+	}
+	return ix
+}
+
+// add добавляет клуб или новые названия уже добавленного клуба
+func (ix *clubIndex) add(club domain.Club) {
+	names := append([]string{club.Name}, club.Aliases...)
+	for i := range ix.clubs {
+		if ix.clubs[i].club.ID == club.ID {
+			ix.clubs[i].club.Aliases = append(ix.clubs[i].club.Aliases, club.Aliases...)
+			ix.addKeys(i, names)
+			return
+		}
+	}
+	ix.clubs = append(ix.clubs, indexedClub{club: club})
+	ix.addKeys(len(ix.clubs)-1, names)
+}
+
+func (ix *clubIndex) addKeys(i int, names []string) {
+	for _, name := range names {
+		key := NormalizeClubName(name)
+		if key == "" || slices.Contains(ix.clubs[i].keys, key) {
+			continue
+		}
+		ix.clubs[i].keys = append(ix.clubs[i].keys, key)
+		ix.byKey[key] = append(ix.byKey[key], i)
+	}
+}
+
+// best возвращает наиболее похожий на команду клуб и сходство, false если клубов нет.
+// Совпадение названия с клубом того же города находится без перебора
+func (ix *clubIndex) best(team domain.ClubTeam) (domain.Club, float64, bool) {
+	key := NormalizeClubName(team.Name)
+	bestIdx, bestScore := -1, 0.0
+	for _, i := range ix.byKey[key] {
+		if score := locationFactor(team, ix.clubs[i].club); score > bestScore {
+			bestIdx, bestScore = i, score
+		}
+	}
+	if bestScore < 1 {
+		for i, c := range ix.clubs {
+			factor := locationFactor(team, c.club)
+			if factor <= bestScore {
+				continue
+			}
+			for _, k := range c.keys {
+				if score := nameSimilarity(key, k) * factor; score > bestScore {
+					bestIdx, bestScore = i, score
+				}
+			}
+		}
+	}
+	if bestIdx < 0 {
+		return domain.Club{}, 0, false
+	}
+	return ix.clubs[bestIdx].club, bestScore, true
+}
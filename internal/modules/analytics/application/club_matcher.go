@@ -0,0 +1,114 @@
+package application
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+const (
+	// clubAutoLinkScore сходство, с которого команда привязывается к клубу без проверки
+	clubAutoLinkScore = 0.9
+	// clubReviewScore сходство, с которого сопоставление отправляется на проверку;
+	// ниже - для команды создаётся новый клуб
+	clubReviewScore = 0.75
+)
+
+var (
+	clubYearRe   = regexp.MustCompile(`(19|20)\d{2}(\s*г\.?\s*р\.?)?`)
+	clubParensRe = regexp.MustCompile(`\([^)]*\)`)
+	// clubNoiseWords слова, не отличающие один клуб от другого
+	clubNoiseWords = map[string]bool{"хк": true, "hc": true, "г": true, "гр": true, "команда": true}
+)
+
+// NormalizeClubName ключ названия клуба: без года рождения, уточнений в скобках, кавычек и
+// регистра. "ХК СКА-Стрельна 2012 г.р." и "СКА-Стрельна (мол.)" дают "ска стрельна"
+func NormalizeClubName(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "ё", "е")
+	name = clubParensRe.ReplaceAllString(name, " ")
+	name = clubYearRe.ReplaceAllString(name, " ")
+
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	result := words[:0]
+	for _, w := range words {
+		if !clubNoiseWords[w] {
+			result = append(result, w)
+		}
+	}
+	return strings.Join(result, " ")
+}
+
+// nameSimilarity сходство нормализованных названий от 0 до 1: лучшее из посимвольного
+// (расстояние Левенштейна) и пословного (переставленные слова)
+func nameSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	if a == "" || b == "" {
+		return 0
+	}
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	chars := 1 - float64(levenshtein(ra, rb))/float64(longest)
+	return max(chars, tokenSimilarity(a, b))
+}
+
+// tokenSimilarity доля общих слов (коэффициент Жаккара)
+func tokenSimilarity(a, b string) float64 {
+	wa, wb := strings.Fields(a), strings.Fields(b)
+	set := make(map[string]bool, len(wa))
+	for _, w := range wa {
+		set[w] = true
+	}
+	common := 0
+	union := len(set)
+	seen := make(map[string]bool, len(wb))
+	for _, w := range wb {
+		if seen[w] {
+			continue
+		}
+		seen[w] = true
+		if set[w] {
+			common++
+		} else {
+			union++
+		}
+	}
+	return float64(common) / float64(union)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// locationFactor снижает сходство команд из разных регионов или городов:
+// одноимённые клубы разных городов - разные клубы
+func locationFactor(team domain.ClubTeam, club domain.Club) float64 {
+	if team.Region != "" && club.Region != "" && !strings.EqualFold(team.Region, club.Region) {
+		return 0.7
+	}
+	if team.City != "" && club.City != "" && NormalizeClubName(team.City) != NormalizeClubName(club.City) {
+		return 0.8
+	}
+	return 1
+}
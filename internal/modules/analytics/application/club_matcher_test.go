@@ -0,0 +1,127 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+func TestNormalizeClubName(t *testing.T) {
+	tests := map[string]string{
+		"СКА-Стрельна 2012":         "ска стрельна",
+		"ХК СКА-Стрельна 2012 г.р.": "ска стрельна",
+		"СКА-Стрельна (мол.)":       "ска стрельна",
+		"«Динамо» Санкт-Петербург":  "динамо санкт петербург",
+		"Лёд 2013": "лед",
+		"Динамо-2": "динамо 2",
+	}
+	for name, want := range tests {
+		if got := NormalizeClubName(name); got != want {
+			t.Errorf("NormalizeClubName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestNameSimilarity(t *testing.T) {
+	if got := nameSimilarity("ска стрельна", "стрельна ска"); got != 1 {
+		t.Errorf("reordered words similarity = %v, want 1", got)
+	}
+	if got := nameSimilarity("ска стрельна", "ска стрельнa"); got < clubAutoLinkScore {
+		t.Errorf("one typo similarity = %v, want >= %v", got, clubAutoLinkScore)
+	}
+	if got := nameSimilarity("динамо", "спартак"); got >= clubReviewScore {
+		t.Errorf("different clubs similarity = %v, want < %v", got, clubReviewScore)
+	}
+}
+
+func TestClubIndexBest(t *testing.T) {
+	index := newClubIndex([]domain.Club{
+		{ID: "spb", Name: "Динамо", City: "Санкт-Петербург", Region: "spb"},
+		{ID: "msk", Name: "Динамо", City: "Москва", Region: "msk"},
+	})
+
+	club, score, ok := index.best(domain.ClubTeam{Name: "Динамо 2012", City: "Москва", Region: "msk"})
+	if !ok || club.ID != "msk" || score != 1 {
+		t.Errorf("best = %s (%v), want msk (1)", club.ID, score)
+	}
+
+	// Одноимённый клуб другого региона не привязывается автоматически
+	_, score, _ = index.best(domain.ClubTeam{Name: "Динамо", City: "Казань", Region: "kzn"})
+	if score >= clubAutoLinkScore {
+		t.Errorf("other region score = %v, want < %v", score, clubAutoLinkScore)
+	}
+}
+
+// fakeClubRepo хранит клубы и проверки в памяти
+type fakeClubRepo struct {
+	ClubRepository
+	clubs   []domain.Club
+	teams   []domain.ClubTeam
+	links   map[string]string
+	reviews map[string]string
+}
+
+func (r *fakeClubRepo) LoadClubs(context.Context) ([]domain.Club, error) { return r.clubs, nil }
+
+func (r *fakeClubRepo) LoadUnmatchedTeams(context.Context) ([]domain.ClubTeam, error) {
+	return r.teams, nil
+}
+
+func (r *fakeClubRepo) CreateClub(_ context.Context, club domain.Club, team domain.ClubTeam) (domain.Club, error) {
+	club.ID = fmt.Sprintf("new:%d", len(r.clubs))
+	club.Aliases = []string{team.Name}
+	r.clubs = append(r.clubs, club)
+	r.links[team.ID] = club.ID
+	return club, nil
+}
+
+func (r *fakeClubRepo) LinkTeam(_ context.Context, team domain.ClubTeam, clubID string) error {
+	r.links[team.ID] = clubID
+	return nil
+}
+
+func (r *fakeClubRepo) QueueReview(_ context.Context, team domain.ClubTeam, clubID string, _ float64) error {
+	r.reviews[team.ID] = clubID
+	return nil
+}
+
+func TestMatchTeams(t *testing.T) {
+	repo := &fakeClubRepo{
+		clubs: []domain.Club{{ID: "ska", Name: "СКА-Стрельна", City: "Санкт-Петербург", Region: "spb"}},
+		teams: []domain.ClubTeam{
+			{ID: "j1", Name: "СКА-Стрельна 2012", City: "Санкт-Петербург", Region: "spb"},
+			{ID: "m1", Name: "Крылья Советов 2013", Region: "msk"},
+			{ID: "m2", Name: "Крылья Советов 2014", Region: "msk"},
+			{ID: "s1", Name: "СКА-Стрельна", City: "Стрельна", Region: "spb"},
+			{ID: "s2", Name: "СКА-Стрельна 2011", City: "Стрельна", Region: "spb"},
+		},
+		links:   make(map[string]string),
+		reviews: make(map[string]string),
+	}
+
+	result, err := NewClubService(repo).MatchTeams(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if repo.links["j1"] != "ska" {
+		t.Errorf("j1 linked to %q, want ska", repo.links["j1"])
+	}
+	// Вторая команда нового клуба привязывается к клубу, созданному по первой
+	if repo.links["m1"] == "" || repo.links["m1"] != repo.links["m2"] {
+		t.Errorf("m1/m2 linked to %q/%q, want the same new club", repo.links["m1"], repo.links["m2"])
+	}
+	if repo.clubs[1].Name != "Крылья Советов" {
+		t.Errorf("new club name = %q", repo.clubs[1].Name)
+	}
+	// Другой город - на проверку, одноимённая команда того же города ждёт решения
+	if repo.reviews["s1"] != "ska" || repo.reviews["s2"] != "" || repo.links["s2"] != "" {
+		t.Errorf("reviews = %v, links = %v", repo.reviews, repo.links)
+	}
+	want := domain.ClubMatchResult{Linked: 2, Created: 1, Queued: 1}
+	if result != want {
+		t.Errorf("result = %+v, want %+v", result, want)
+	}
+}
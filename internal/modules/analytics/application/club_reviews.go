@@ -0,0 +1,80 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+// Reviews возвращает проверки сопоставления со статусом и их общее количество
+func (s *ClubService) Reviews(ctx context.Context, status string, limit, offset int) ([]domain.ClubReview, int, error) {
+	return s.repo.Reviews(ctx, status, limit, offset)
+}
+
+// ApproveReview привязывает команду к предложенному клубу
+func (s *ClubService) ApproveReview(ctx context.Context, id int64) (*domain.ClubReview, error) {
+	review, err := s.pendingReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.ResolveReview(ctx, id, review.ClubID, domain.ClubReviewApproved); err != nil {
+		return nil, fmt.Errorf("approve club review: %w", err)
+	}
+	review.Status = domain.ClubReviewApproved
+	return review, nil
+}
+
+// RejectReview создаёт для команды отдельный клуб
+func (s *ClubService) RejectReview(ctx context.Context, id int64) (*domain.ClubReview, error) {
+	review, err := s.pendingReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	club, err := s.repo.CreateClub(ctx, clubForTeam(review.Team), review.Team)
+	if err != nil {
+		return nil, fmt.Errorf("create club: %w", err)
+	}
+	if err := s.repo.ResolveReview(ctx, id, club.ID, domain.ClubReviewRejected); err != nil {
+		return nil, fmt.Errorf("reject club review: %w", err)
+	}
+	review.Status = domain.ClubReviewRejected
+	review.ClubID, review.ClubName = club.ID, club.Name
+	return review, nil
+}
+
+func (s *ClubService) pendingReview(ctx context.Context, id int64) (*domain.ClubReview, error) {
+	review, err := s.repo.GetReview(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get club review: %w", err)
+	}
+	if review == nil {
+		return nil, ErrClubReviewNotFound
+	}
+	if review.Status != domain.ClubReviewPending {
+		return nil, ErrClubReviewResolved
+	}
+	return review, nil
+}
+
+// SearchClubs ищет клубы по названию или названию команды
+func (s *ClubService) SearchClubs(ctx context.Context, query string, limit int) ([]domain.Club, error) {
+	return s.repo.SearchClubs(ctx, query, limit)
+}
+
+// ClubHistory возвращает клуб с командами и выступлениями по сезонам, nil если клуба нет
+func (s *ClubService) ClubHistory(ctx context.Context, id string) (*domain.ClubHistory, error) {
+	club, err := s.repo.GetClub(ctx, id)
+	if err != nil || club == nil {
+		return nil, err
+	}
+	teams, err := s.repo.ClubTeams(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("load club teams: %w", err)
+	}
+	seasons, err := s.repo.ClubSeasons(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("load club seasons: %w", err)
+	}
+	return &domain.ClubHistory{Club: *club, Teams: teams, Seasons: seasons}, nil
+}
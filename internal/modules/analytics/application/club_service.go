@@ -0,0 +1,127 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrClubReviewNotFound проверка не найдена
+	ErrClubReviewNotFound = errors.New("club review not found")
+	// ErrClubReviewResolved по проверке уже принято решение
+	ErrClubReviewResolved = errors.New("club review already resolved")
+)
+
+// ClubRepository хранилище клубов, их названий и проверок сопоставления
+type ClubRepository interface {
+	LoadClubs(ctx context.Context) ([]domain.Club, error)
+	// LoadUnmatchedTeams команды без клуба и без ожидающей проверки
+	LoadUnmatchedTeams(ctx context.Context) ([]domain.ClubTeam, error)
+	// CreateClub создаёт клуб и привязывает к нему команду
+	CreateClub(ctx context.Context, club domain.Club, team domain.ClubTeam) (domain.Club, error)
+	// LinkTeam привязывает команду к клубу и добавляет её название в названия клуба
+	LinkTeam(ctx context.Context, team domain.ClubTeam, clubID string) error
+	QueueReview(ctx context.Context, team domain.ClubTeam, clubID string, score float64) error
+
+	Reviews(ctx context.Context, status string, limit, offset int) ([]domain.ClubReview, int, error)
+	GetReview(ctx context.Context, id int64) (*domain.ClubReview, error)
+	// ResolveReview привязывает команду проверки к клубу и закрывает проверку со статусом
+	ResolveReview(ctx context.Context, id int64, clubID, status string) error
+
+	SearchClubs(ctx context.Context, query string, limit int) ([]domain.Club, error)
+	GetClub(ctx context.Context, id string) (*domain.Club, error)
+	ClubTeams(ctx context.Context, clubID string) ([]domain.ClubTeam, error)
+	ClubSeasons(ctx context.Context, clubID string) ([]domain.ClubSeason, error)
+}
+
+// ClubService сопоставление команд источников с клубами и история клубов
+type ClubService struct {
+	repo ClubRepository
+}
+
+// NewClubService создаёт сервис клубов
+func NewClubService(repo ClubRepository) *ClubService {
+	return &ClubService{repo: repo}
+}
+
+// MatchTeams сопоставляет команды без клуба: похожие привязывает к клубу, сомнительные
+// отправляет на проверку, для остальных создаёт клубы.
+//
+// На проверку уходит одна команда из одноимённых команд одного города; остальные
+// сопоставляются следующим запуском по названию, добавленному решением проверки
+func (s *ClubService) MatchTeams(ctx context.Context) (domain.ClubMatchResult, error) {
+	var result domain.ClubMatchResult
+
+	clubs, err := s.repo.LoadClubs(ctx)
+	if err != nil {
+		return result, fmt.Errorf("load clubs: %w", err)
+	}
+	teams, err := s.repo.LoadUnmatchedTeams(ctx)
+	if err != nil {
+		return result, fmt.Errorf("load unmatched teams: %w", err)
+	}
+
+	index := newClubIndex(clubs)
+	queued := make(map[string]bool)
+	for _, team := range teams {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		if queued[sameTeamKey(team)] {
+			continue
+		}
+
+		club, score, ok := index.best(team)
+		switch {
+		case ok && score >= clubAutoLinkScore:
+			if err := s.repo.LinkTeam(ctx, team, club.ID); err != nil {
+				return result, fmt.Errorf("link team %s: %w", team.ID, err)
+			}
+			index.add(domain.Club{ID: club.ID, Aliases: []string{team.Name}})
+			result.Linked++
+		case ok && score >= clubReviewScore:
+			if err := s.repo.QueueReview(ctx, team, club.ID, score); err != nil {
+				return result, fmt.Errorf("queue review for team %s: %w", team.ID, err)
+			}
+			queued[sameTeamKey(team)] = true
+			result.Queued++
+		default:
+			created, err := s.repo.CreateClub(ctx, clubForTeam(team), team)
+			if err != nil {
+				return result, fmt.Errorf("create club for team %s: %w", team.ID, err)
+			}
+			index.add(created)
+			result.Created++
+		}
+	}
+
+	logger.Info(ctx, "🏒 Teams matched to clubs",
+		zap.Int("teams", len(teams)),
+		zap.Int("linked", result.Linked),
+		zap.Int("created", result.Created),
+		zap.Int("queued", result.Queued),
+	)
+	return result, nil
+}
+
+// sameTeamKey команды с одним ключом - одна команда клуба в разных турнирах и годах рождения
+func sameTeamKey(team domain.ClubTeam) string {
+	return NormalizeClubName(team.Name) + "|" + NormalizeClubName(team.City) + "|" + team.Region
+}
+
+// clubForTeam новый клуб по команде: название без года рождения и уточнений
+func clubForTeam(team domain.ClubTeam) domain.Club {
+	name := clubParensRe.ReplaceAllString(team.Name, " ")
+	name = clubYearRe.ReplaceAllString(name, " ")
+	name = strings.Trim(strings.Join(strings.Fields(name), " "), " -")
+	if name == "" {
+		name = team.Name
+	}
+	return domain.Club{Name: name, City: team.City, Region: team.Region}
+}
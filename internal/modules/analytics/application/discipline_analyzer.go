@@ -0,0 +1,197 @@
+package application
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+// ClassifyPenalty относит штраф к категории по количеству минут
+func ClassifyPenalty(minutes int) string {
+	switch {
+	case minutes >= 25:
+		return domain.PenaltyClassMajor // матч-штраф
+	case minutes >= 20:
+		return domain.PenaltyClassGameMisconduct
+	case minutes >= 10:
+		return domain.PenaltyClassMisconduct
+	case minutes >= 5:
+		return domain.PenaltyClassMajor
+	default:
+		return domain.PenaltyClassMinor
+	}
+}
+
+// powerPlaySeconds возвращает длительность большинства соперника после штрафа, 0 если его нет
+func powerPlaySeconds(minutes int) int {
+	switch minutes {
+	case 2, 4, 5:
+		return minutes * 60
+	case 25:
+		return 5 * 60
+	default:
+		return 0
+	}
+}
+
+// isCoincidental проверяет, получил ли соперник такой же штраф в ту же секунду
+func isCoincidental(p domain.PenaltyEvent, matchPenalties []domain.PenaltyEvent) bool {
+	for _, o := range matchPenalties {
+		if o.TeamID != p.TeamID && o.Second == p.Second && powerPlaySeconds(o.Minutes) == powerPlaySeconds(p.Minutes) {
+			return true
+		}
+	}
+	return false
+}
+
+// concededInWindow проверяет, забил ли соперник за время большинства после штрафа
+func concededInWindow(p domain.PenaltyEvent, window int, matchGoals []domain.MatchGoal) bool {
+	for _, g := range matchGoals {
+		if g.TeamID != "" && g.TeamID != p.TeamID && g.Second > p.Second && g.Second <= p.Second+window {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildDisciplineReport строит отчёт о дисциплине по штрафам и голам в тех же матчах.
+//
+// matchPenalties - все штрафы матчей выборки (обеих команд), нужны для взаимных удалений;
+// goals - голы этих матчей для подсчёта реализованного большинства.
+func BuildDisciplineReport(scope, id string, games int, penalties, matchPenalties []domain.PenaltyEvent, goals []domain.MatchGoal, topLimit int) domain.DisciplineReport {
+	report := domain.DisciplineReport{Scope: scope, ID: id}
+
+	goalsByMatch := make(map[string][]domain.MatchGoal)
+	for _, g := range goals {
+		goalsByMatch[g.MatchID] = append(goalsByMatch[g.MatchID], g)
+	}
+	penaltiesByMatch := make(map[string][]domain.PenaltyEvent)
+	for _, p := range matchPenalties {
+		penaltiesByMatch[p.MatchID] = append(penaltiesByMatch[p.MatchID], p)
+	}
+
+	infractions := make(map[string]*domain.Infraction)
+	players := make(map[string]*domain.Offender)
+	teams := make(map[string]*domain.Offender)
+	matches := make(map[string]bool)
+
+	for _, p := range penalties {
+		matches[p.MatchID] = true
+		report.Penalties++
+		report.Minutes += p.Minutes
+
+		switch ClassifyPenalty(p.Minutes) {
+		case domain.PenaltyClassMinor:
+			report.Minors++
+		case domain.PenaltyClassMajor:
+			report.Majors++
+		case domain.PenaltyClassMisconduct:
+			report.Misconducts++
+		case domain.PenaltyClassGameMisconduct:
+			report.GameMisconducts++
+		}
+
+		key := infractionKey(p)
+		inf, ok := infractions[key]
+		if !ok {
+			inf = &domain.Infraction{Code: p.ReasonCode, Reason: p.Reason}
+			infractions[key] = inf
+		}
+		inf.Count++
+		inf.Minutes += p.Minutes
+
+		period := &report.Periods[periodIndex(p.Period)]
+		period.Penalties++
+		period.Minutes += p.Minutes
+
+		if window := powerPlaySeconds(p.Minutes); window > 0 && !isCoincidental(p, penaltiesByMatch[p.MatchID]) {
+			report.PowerPlayPenalties++
+			if concededInWindow(p, window, goalsByMatch[p.MatchID]) {
+				report.CostlyPenalties++
+				period.GoalsConceded++
+			}
+		}
+
+		if p.PlayerID != "" {
+			addOffender(players, p.PlayerID, p.PlayerName, p.Minutes)
+		}
+		if p.TeamID != "" {
+			addOffender(teams, p.TeamID, p.TeamName, p.Minutes)
+		}
+	}
+
+	report.Games = games
+	if report.Games < len(matches) {
+		report.Games = len(matches)
+	}
+	if report.Games > 0 {
+		report.MinutesPerGame = float64(report.Minutes) / float64(report.Games)
+	}
+	if report.PowerPlayPenalties > 0 {
+		report.CostlyRate = float64(report.CostlyPenalties) / float64(report.PowerPlayPenalties)
+	}
+	for i := range report.Periods {
+		p := &report.Periods[i]
+		if report.Penalties > 0 {
+			p.Share = float64(p.Penalties) / float64(report.Penalties)
+		}
+		if report.Games > 0 {
+			p.PerGame = float64(p.Penalties) / float64(report.Games)
+		}
+	}
+
+	for _, inf := range infractions {
+		report.Infractions = append(report.Infractions, *inf)
+	}
+	sort.Slice(report.Infractions, func(i, j int) bool {
+		a, b := report.Infractions[i], report.Infractions[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Code+a.Reason < b.Code+b.Reason
+	})
+
+	if scope != domain.DisciplineScopePlayer {
+		report.TopPlayers = topOffenders(players, topLimit)
+	}
+	if scope == domain.DisciplineScopeTournament {
+		report.TopTeams = topOffenders(teams, topLimit)
+	}
+	return report
+}
+
+// infractionKey группирует штрафы по коду нарушения, а без кода - по тексту
+func infractionKey(p domain.PenaltyEvent) string {
+	if p.ReasonCode != "" {
+		return "code:" + strings.ToUpper(p.ReasonCode)
+	}
+	return "reason:" + strings.ToLower(strings.TrimSpace(p.Reason))
+}
+
+func addOffender(set map[string]*domain.Offender, id, name string, minutes int) {
+	o, ok := set[id]
+	if !ok {
+		o = &domain.Offender{ID: id, Name: name}
+		set[id] = o
+	}
+	o.Penalties++
+	o.Minutes += minutes
+}
+
+func topOffenders(set map[string]*domain.Offender, limit int) []domain.Offender {
+	result := make([]domain.Offender, 0, len(set))
+	for _, o := range set {
+		result = append(result, *o)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Minutes != result[j].Minutes {
+			return result[i].Minutes > result[j].Minutes
+		}
+		return result[i].ID < result[j].ID
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
@@ -0,0 +1,62 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+func TestClassifyPenalty(t *testing.T) {
+	tests := map[int]string{
+		2:  domain.PenaltyClassMinor,
+		4:  domain.PenaltyClassMinor,
+		5:  domain.PenaltyClassMajor,
+		10: domain.PenaltyClassMisconduct,
+		20: domain.PenaltyClassGameMisconduct,
+		25: domain.PenaltyClassMajor,
+	}
+	for minutes, want := range tests {
+		if got := ClassifyPenalty(minutes); got != want {
+			t.Errorf("ClassifyPenalty(%d) = %q, want %q", minutes, got, want)
+		}
+	}
+}
+
+func TestBuildDisciplineReport(t *testing.T) {
+	penalties := []domain.PenaltyEvent{
+		{MatchID: "m1", TeamID: "us", PlayerID: "p1", Period: 1, Second: 300, Minutes: 2, ReasonCode: "ПОДН"},
+		{MatchID: "m1", TeamID: "us", PlayerID: "p1", Period: 2, Second: 1500, Minutes: 2, ReasonCode: "подн"},
+		{MatchID: "m1", TeamID: "us", PlayerID: "p2", Period: 3, Second: 2500, Minutes: 10, Reason: "Грубость"},
+		{MatchID: "m2", TeamID: "us", PlayerID: "p2", Period: 3, Second: 3000, Minutes: 2, ReasonCode: "ЗД"},
+	}
+	matchPenalties := append([]domain.PenaltyEvent{
+		// Взаимное удаление: большинства нет
+		{MatchID: "m2", TeamID: "opp", Second: 3000, Minutes: 2, ReasonCode: "ГРУБ"},
+	}, penalties...)
+	goals := []domain.MatchGoal{
+		{MatchID: "m1", TeamID: "opp", Second: 360},  // реализовали большинство
+		{MatchID: "m1", TeamID: "opp", Second: 1700}, // через 200 секунд - уже в равных
+		{MatchID: "m2", TeamID: "opp", Second: 3050},
+	}
+
+	got := BuildDisciplineReport(domain.DisciplineScopeTeam, "us", 4, penalties, matchPenalties, goals, 5)
+
+	if got.Penalties != 4 || got.Minutes != 16 || got.MinutesPerGame != 4 {
+		t.Errorf("totals = %d pen, %d min, %.1f/game; want 4, 16, 4.0", got.Penalties, got.Minutes, got.MinutesPerGame)
+	}
+	if got.Minors != 3 || got.Misconducts != 1 {
+		t.Errorf("classes = %d minors, %d misconducts; want 3, 1", got.Minors, got.Misconducts)
+	}
+	if got.PowerPlayPenalties != 2 || got.CostlyPenalties != 1 || got.CostlyRate != 0.5 {
+		t.Errorf("power play = %d pen, %d costly, rate %.2f; want 2, 1, 0.50", got.PowerPlayPenalties, got.CostlyPenalties, got.CostlyRate)
+	}
+	if len(got.Infractions) != 3 || got.Infractions[0].Count != 2 {
+		t.Errorf("infractions = %+v, want 3 kinds with ПОДН x2 first", got.Infractions)
+	}
+	if got.Periods[2].Penalties != 2 || got.Periods[0].GoalsConceded != 1 {
+		t.Errorf("periods = %+v, want 2 penalties in 3rd and costly one in 1st", got.Periods)
+	}
+	if len(got.TopPlayers) != 2 || got.TopPlayers[0].ID != "p2" || len(got.TopTeams) != 0 {
+		t.Errorf("offenders = %+v / %+v, want p2 first and no teams for team scope", got.TopPlayers, got.TopTeams)
+	}
+}
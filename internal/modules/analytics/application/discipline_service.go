@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+// disciplineTopLimit количество игроков и команд в рейтинге нарушителей
+const disciplineTopLimit = 10
+
+// DisciplineFilter область отчёта о дисциплине
+type DisciplineFilter struct {
+	Scope        string // domain.DisciplineScope*
+	ID           string
+	TournamentID string // дополнительный фильтр для игрока и команды
+}
+
+// DisciplineRepository источник штрафов и голов
+type DisciplineRepository interface {
+	LoadPenalties(ctx context.Context, filter DisciplineFilter) ([]domain.PenaltyEvent, error)
+	LoadMatchEvents(ctx context.Context, matchIDs []string) ([]domain.PenaltyEvent, []domain.MatchGoal, error)
+	CountGames(ctx context.Context, filter DisciplineFilter) (int, error)
+}
+
+// DisciplineService отчёты о штрафах и дисциплине
+type DisciplineService struct {
+	repo DisciplineRepository
+}
+
+// NewDisciplineService создаёт сервис отчётов о дисциплине
+func NewDisciplineService(repo DisciplineRepository) *DisciplineService {
+	return &DisciplineService{repo: repo}
+}
+
+// Report строит отчёт о дисциплине игрока, команды или турнира
+func (s *DisciplineService) Report(ctx context.Context, filter DisciplineFilter) (*domain.DisciplineReport, error) {
+	penalties, err := s.repo.LoadPenalties(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("load penalties: %w", err)
+	}
+	games, err := s.repo.CountGames(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("count games: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var matchIDs []string
+	for _, p := range penalties {
+		if !seen[p.MatchID] {
+			seen[p.MatchID] = true
+			matchIDs = append(matchIDs, p.MatchID)
+		}
+	}
+	matchPenalties, goals, err := s.repo.LoadMatchEvents(ctx, matchIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load match events: %w", err)
+	}
+
+	report := BuildDisciplineReport(filter.Scope, filter.ID, games, penalties, matchPenalties, goals, disciplineTopLimit)
+	return &report, nil
+}
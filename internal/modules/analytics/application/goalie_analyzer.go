@@ -0,0 +1,298 @@
+package application
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+const (
+	periodSeconds     = 20 * 60
+	regulationSeconds = 3 * periodSeconds
+
+	// qualityStartFloor минимальный процент отражённых при 2 и менее пропущенных
+	qualityStartFloor = 0.885
+	// qualityStartMaxGoals максимум пропущенных для старта по второму правилу
+	qualityStartMaxGoals = 2
+	// qualityStartMinTOI минимум игрового времени, чтобы считать выход стартом (если время известно)
+	qualityStartMinTOI = 40 * 60
+)
+
+// GameState приводит тип гола из протокола к игровой ситуации.
+//
+// junior.fhr.ru пишет en/home/away, fhspb - PP1, SH2, EN, PS, GWG.
+func GameState(goalType string) string {
+	t := strings.ToUpper(strings.TrimSpace(goalType))
+	switch {
+	case strings.HasPrefix(t, "PP"):
+		return domain.GameStatePowerPlay
+	case strings.HasPrefix(t, "SH"):
+		return domain.GameStateShortHand
+	case strings.HasPrefix(t, "EN"):
+		return domain.GameStateEmptyNet
+	default:
+		return domain.GameStateEven
+	}
+}
+
+// GameSecond переводит время события в секунду матча.
+//
+// Источники пишут время либо от начала матча, либо от начала периода.
+func GameSecond(period, minutes, seconds int) int {
+	if period < 1 {
+		period = 1
+	}
+	offset := (period - 1) * periodSeconds
+	t := minutes*60 + seconds
+	if t < offset {
+		t += offset
+	}
+	return t
+}
+
+// periodIndex возвращает индекс периода в массиве статистики (овертаймы объединены)
+func periodIndex(period int) int {
+	switch {
+	case period < 1:
+		return 0
+	case period > domain.PeriodCount:
+		return domain.PeriodCount - 1
+	default:
+		return period - 1
+	}
+}
+
+// AttachGoals распределяет пропущенные голы по игровым строкам вратарей
+func AttachGoals(games []domain.GoalieGame, goals []domain.GoalAgainst) {
+	index := make(map[string]int, len(games))
+	for i := range games {
+		index[games[i].MatchID+"|"+games[i].PlayerID] = i
+	}
+	for _, g := range goals {
+		i, ok := index[g.MatchID+"|"+g.GoalieID]
+		if !ok {
+			continue
+		}
+		if games[i].GoalsState == nil {
+			games[i].GoalsState = make(map[string]int)
+		}
+		games[i].GoalsPeriod[periodIndex(g.Period)]++
+		games[i].GoalsState[g.State]++
+	}
+}
+
+// LeagueSavePct считает средний процент отражённых бросков по турнирам
+func LeagueSavePct(games []domain.GoalieGame) map[string]float64 {
+	type totals struct{ saves, shots int }
+	league := make(map[string]*totals)
+	for _, g := range games {
+		t, ok := league[g.TournamentID]
+		if !ok {
+			t = &totals{}
+			league[g.TournamentID] = t
+		}
+		t.saves += g.Saves
+		t.shots += g.Shots()
+	}
+
+	result := make(map[string]float64, len(league))
+	for id, t := range league {
+		if t.shots > 0 {
+			result[id] = float64(t.saves) / float64(t.shots)
+		}
+	}
+	return result
+}
+
+// MarkQualityStarts отмечает качественные старты.
+//
+// Старт качественный, если процент отражённых не ниже среднего по турниру,
+// либо вратарь пропустил не больше двух шайб при проценте не ниже 88,5.
+func MarkQualityStarts(games []domain.GoalieGame, leagueAvg map[string]float64) {
+	for i := range games {
+		g := &games[i]
+		g.QualityStart = false
+		if g.Shots() == 0 || (g.TimeOnIce > 0 && g.TimeOnIce < qualityStartMinTOI) {
+			continue
+		}
+		pct := g.SavePct()
+		avg, ok := leagueAvg[g.TournamentID]
+		g.QualityStart = (ok && pct >= avg) || (g.GoalsAgainst <= qualityStartMaxGoals && pct >= qualityStartFloor)
+	}
+}
+
+// SummarizeGoalie собирает сводную статистику по игровым строкам одного вратаря
+func SummarizeGoalie(games []domain.GoalieGame) domain.GoalieSummary {
+	s := domain.GoalieSummary{GoalsByState: make(map[string]int)}
+	starts := 0
+	var sampleGoals [domain.PeriodCount]int
+	for _, g := range games {
+		if s.PlayerID == "" {
+			s.PlayerID, s.Name = g.PlayerID, g.Name
+		}
+		s.Games++
+		s.Saves += g.Saves
+		s.GoalsAgainst += g.GoalsAgainst
+		s.TimeOnIce += g.TimeOnIce
+		if g.Shots() > 0 {
+			starts++
+		}
+		if g.QualityStart {
+			s.QualityStarts++
+		}
+		for state, n := range g.GoalsState {
+			s.GoalsByState[state] += n
+		}
+		for p := 0; p < domain.PeriodCount; p++ {
+			s.PeriodGoals[p] += g.GoalsPeriod[p]
+		}
+		if g.ShotsAgainst != nil {
+			s.PeriodSampleGames++
+			for p := 0; p < domain.PeriodCount; p++ {
+				s.PeriodShots[p] += g.ShotsAgainst[p]
+				sampleGoals[p] += g.GoalsPeriod[p]
+			}
+		}
+	}
+
+	if shots := s.Saves + s.GoalsAgainst; shots > 0 {
+		s.SavePct = float64(s.Saves) / float64(shots)
+	}
+	if s.TimeOnIce > 0 {
+		s.GoalsAgainstAvg = float64(s.GoalsAgainst) * float64(regulationSeconds) / float64(s.TimeOnIce)
+	}
+	if starts > 0 {
+		s.QualityStartPct = float64(s.QualityStarts) / float64(starts)
+	}
+	for p := 0; p < domain.PeriodCount; p++ {
+		if s.PeriodShots[p] > 0 {
+			pct := float64(s.PeriodShots[p]-sampleGoals[p]) / float64(s.PeriodShots[p])
+			s.PeriodSavePct[p] = &pct
+		}
+	}
+	return s
+}
+
+// BuildGoalieLeaderboard строит рейтинг вратарей по проценту отражённых бросков
+func BuildGoalieLeaderboard(games []domain.GoalieGame, minGames int) []domain.GoalieSummary {
+	byPlayer := make(map[string][]domain.GoalieGame)
+	for _, g := range games {
+		byPlayer[g.PlayerID] = append(byPlayer[g.PlayerID], g)
+	}
+
+	var result []domain.GoalieSummary
+	for _, pg := range byPlayer {
+		if len(pg) < minGames {
+			continue
+		}
+		result = append(result, SummarizeGoalie(pg))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].SavePct != result[j].SavePct {
+			return result[i].SavePct > result[j].SavePct
+		}
+		if result[i].GoalsAgainstAvg != result[j].GoalsAgainstAvg {
+			return result[i].GoalsAgainstAvg < result[j].GoalsAgainstAvg
+		}
+		return result[i].PlayerID < result[j].PlayerID
+	})
+	return result
+}
+
+// PullOutcome определяет исход снятия вратаря по первому голу после снятия
+func PullOutcome(pull domain.EmptyNetPull, goals []domain.MatchGoal) string {
+	var first *domain.MatchGoal
+	for i := range goals {
+		g := &goals[i]
+		if g.MatchID != pull.MatchID || g.Second < pull.Second {
+			continue
+		}
+		if first == nil || g.Second < first.Second {
+			first = g
+		}
+	}
+	switch {
+	case first == nil:
+		return domain.PullOutcomeNoGoal
+	case first.TeamID == pull.TeamID:
+		return domain.PullOutcomeGoalFor
+	default:
+		return domain.PullOutcomeGoalAgainst
+	}
+}
+
+// scoreAt возвращает счёт команды и соперника до указанной секунды матча
+func scoreAt(goals []domain.MatchGoal, teamID string, second int) (int, int) {
+	var own, opp int
+	for _, g := range goals {
+		if g.Second >= second {
+			continue
+		}
+		if g.TeamID == teamID {
+			own++
+		} else {
+			opp++
+		}
+	}
+	return own, opp
+}
+
+// AnalyzePulls сводит снятия вратаря по командам: когда снимают и чем заканчивается
+func AnalyzePulls(pulls []domain.EmptyNetPull, goals []domain.MatchGoal) []domain.PullSummary {
+	byMatch := make(map[string][]domain.MatchGoal)
+	for _, g := range goals {
+		byMatch[g.MatchID] = append(byMatch[g.MatchID], g)
+	}
+
+	type acc struct {
+		summary domain.PullSummary
+		timed   int
+		leftSum int
+	}
+	teams := make(map[string]*acc)
+	for _, p := range pulls {
+		p.ScoreFor, p.ScoreAgainst = scoreAt(byMatch[p.MatchID], p.TeamID, p.Second)
+		a, ok := teams[p.TeamID]
+		if !ok {
+			a = &acc{summary: domain.PullSummary{
+				TeamID:          p.TeamID,
+				ByDeficit:       make(map[int]int),
+				GoalsForByDelta: make(map[int]int),
+			}}
+			teams[p.TeamID] = a
+		}
+		a.summary.Pulls++
+		a.summary.ByDeficit[p.Deficit()]++
+		if p.Second <= regulationSeconds {
+			a.timed++
+			a.leftSum += regulationSeconds - p.Second
+		}
+
+		switch PullOutcome(p, byMatch[p.MatchID]) {
+		case domain.PullOutcomeGoalFor:
+			a.summary.GoalsFor++
+			a.summary.GoalsForByDelta[p.Deficit()]++
+		case domain.PullOutcomeGoalAgainst:
+			a.summary.GoalsAgainst++
+		default:
+			a.summary.NoGoal++
+		}
+	}
+
+	result := make([]domain.PullSummary, 0, len(teams))
+	for _, a := range teams {
+		if a.timed > 0 {
+			a.summary.AvgSecondsLeft = float64(a.leftSum) / float64(a.timed)
+		}
+		result = append(result, a.summary)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Pulls != result[j].Pulls {
+			return result[i].Pulls > result[j].Pulls
+		}
+		return result[i].TeamID < result[j].TeamID
+	})
+	return result
+}
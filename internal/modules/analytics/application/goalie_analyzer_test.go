@@ -0,0 +1,108 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+func TestGameState(t *testing.T) {
+	tests := map[string]string{
+		"PP1": domain.GameStatePowerPlay,
+		"sh2": domain.GameStateShortHand,
+		"EN":  domain.GameStateEmptyNet,
+		"en":  domain.GameStateEmptyNet,
+		"GWG": domain.GameStateEven,
+		"":    domain.GameStateEven,
+	}
+	for goalType, want := range tests {
+		if got := GameState(goalType); got != want {
+			t.Errorf("GameState(%q) = %q, want %q", goalType, got, want)
+		}
+	}
+}
+
+func TestGameSecond(t *testing.T) {
+	// Время от начала матча и от начала периода дают одинаковый результат
+	if got := GameSecond(3, 45, 30); got != 45*60+30 {
+		t.Errorf("GameSecond(3, 45, 30) = %d, want %d", got, 45*60+30)
+	}
+	if got := GameSecond(3, 5, 30); got != 45*60+30 {
+		t.Errorf("GameSecond(3, 5, 30) = %d, want %d", got, 45*60+30)
+	}
+}
+
+func TestMarkQualityStarts(t *testing.T) {
+	games := []domain.GoalieGame{
+		{TournamentID: "t", Saves: 28, GoalsAgainst: 2}, // 93.3% - выше среднего
+		{TournamentID: "t", Saves: 18, GoalsAgainst: 2}, // 90% - ниже среднего, но 2 гола и >88.5%
+		{TournamentID: "t", Saves: 20, GoalsAgainst: 5}, // 80% - провал
+		{TournamentID: "t", Saves: 10, GoalsAgainst: 0, TimeOnIce: 15 * 60},
+	}
+	MarkQualityStarts(games, map[string]float64{"t": 0.91})
+
+	want := []bool{true, true, false, false}
+	for i, g := range games {
+		if g.QualityStart != want[i] {
+			t.Errorf("game %d QualityStart = %v, want %v", i, g.QualityStart, want[i])
+		}
+	}
+}
+
+func TestSummarizeGoalie(t *testing.T) {
+	games := []domain.GoalieGame{
+		{MatchID: "m1", PlayerID: "g", Saves: 27, GoalsAgainst: 3, TimeOnIce: 3600,
+			ShotsAgainst: &[domain.PeriodCount]int{10, 10, 10, 0}},
+		{MatchID: "m2", PlayerID: "g", Saves: 19, GoalsAgainst: 1, TimeOnIce: 3600},
+	}
+	AttachGoals(games, []domain.GoalAgainst{
+		{MatchID: "m1", GoalieID: "g", Period: 1, State: domain.GameStateEven},
+		{MatchID: "m1", GoalieID: "g", Period: 3, State: domain.GameStatePowerPlay},
+		{MatchID: "m1", GoalieID: "g", Period: 3, State: domain.GameStateEven},
+		{MatchID: "m2", GoalieID: "g", Period: 2, State: domain.GameStateEven},
+		{MatchID: "m2", GoalieID: "other", Period: 2, State: domain.GameStateEven},
+	})
+
+	s := SummarizeGoalie(games)
+	if s.Games != 2 || s.SavePct != 46.0/50.0 || s.GoalsAgainstAvg != 2 {
+		t.Errorf("summary = %d games, %.3f sv, %.2f gaa; want 2, 0.920, 2.00", s.Games, s.SavePct, s.GoalsAgainstAvg)
+	}
+	if s.GoalsByState[domain.GameStateEven] != 3 || s.GoalsByState[domain.GameStatePowerPlay] != 1 {
+		t.Errorf("goals by state = %v, want even 3, pp 1", s.GoalsByState)
+	}
+	if s.PeriodSampleGames != 1 || s.PeriodSavePct[2] == nil || *s.PeriodSavePct[2] != 0.8 {
+		t.Errorf("period save pct = %v over %d games, want 0.8 in 3rd period over 1 game", s.PeriodSavePct, s.PeriodSampleGames)
+	}
+	if s.PeriodSavePct[3] != nil {
+		t.Errorf("overtime save pct = %v, want nil without shots", *s.PeriodSavePct[3])
+	}
+}
+
+func TestAnalyzePulls(t *testing.T) {
+	goals := []domain.MatchGoal{
+		{MatchID: "m1", TeamID: "opp", Second: 600},
+		{MatchID: "m1", TeamID: "us", Second: 3570}, // забили после снятия
+		{MatchID: "m2", TeamID: "opp", Second: 1000},
+		{MatchID: "m2", TeamID: "opp", Second: 2000},
+		{MatchID: "m2", TeamID: "opp", Second: 3550}, // пропустили в пустые
+	}
+	pulls := []domain.EmptyNetPull{
+		{MatchID: "m1", TeamID: "us", Second: 3520, Period: 3},
+		{MatchID: "m2", TeamID: "us", Second: 3480, Period: 3},
+	}
+
+	got := AnalyzePulls(pulls, goals)
+	if len(got) != 1 {
+		t.Fatalf("AnalyzePulls() returned %d summaries, want 1", len(got))
+	}
+	s := got[0]
+	if s.Pulls != 2 || s.GoalsFor != 1 || s.GoalsAgainst != 1 || s.NoGoal != 0 {
+		t.Errorf("summary = %+v, want 2 pulls, 1 for, 1 against", s)
+	}
+	if s.AvgSecondsLeft != 100 {
+		t.Errorf("AvgSecondsLeft = %v, want 100", s.AvgSecondsLeft)
+	}
+	if s.ByDeficit[1] != 1 || s.ByDeficit[2] != 1 || s.GoalsForByDelta[1] != 1 {
+		t.Errorf("deficits = %v / %v, want one pull at -1 and -2, goal at -1", s.ByDeficit, s.GoalsForByDelta)
+	}
+}
@@ -0,0 +1,112 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+// defaultLeaderboardMinGames минимум матчей для попадания в рейтинг вратарей
+const defaultLeaderboardMinGames = 3
+
+// GoalieFilter фильтр выборки вратарских данных
+type GoalieFilter struct {
+	PlayerID     string
+	TournamentID string
+}
+
+// GoalieRepository источник игровых строк вратарей и событий
+type GoalieRepository interface {
+	LoadGoalieGames(ctx context.Context, filter GoalieFilter) ([]domain.GoalieGame, error)
+	LoadGoalsAgainst(ctx context.Context, filter GoalieFilter) ([]domain.GoalAgainst, error)
+	LoadLeagueSavePct(ctx context.Context, tournamentIDs []string) (map[string]float64, error)
+	LoadPulls(ctx context.Context, teamID, tournamentID string) ([]domain.EmptyNetPull, []domain.MatchGoal, error)
+}
+
+// GoalieService вратарская аналитика по протоколам матчей
+type GoalieService struct {
+	repo GoalieRepository
+}
+
+// NewGoalieService создаёт сервис вратарской аналитики
+func NewGoalieService(repo GoalieRepository) *GoalieService {
+	return &GoalieService{repo: repo}
+}
+
+// GoalieProfile возвращает игровые строки и сводку вратаря, nil если матчей нет
+func (s *GoalieService) GoalieProfile(ctx context.Context, playerID, tournamentID string) (*domain.GoalieProfile, error) {
+	filter := GoalieFilter{PlayerID: playerID, TournamentID: tournamentID}
+	games, err := s.loadGames(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(games) == 0 {
+		return nil, nil
+	}
+
+	leagueAvg, err := s.repo.LoadLeagueSavePct(ctx, tournamentIDs(games))
+	if err != nil {
+		return nil, fmt.Errorf("load league save pct: %w", err)
+	}
+	MarkQualityStarts(games, leagueAvg)
+
+	return &domain.GoalieProfile{Summary: SummarizeGoalie(games), Games: games}, nil
+}
+
+// Leaderboard возвращает рейтинг вратарей турнира (или всех турниров)
+func (s *GoalieService) Leaderboard(ctx context.Context, tournamentID string, minGames, limit int) ([]domain.GoalieSummary, error) {
+	if minGames <= 0 {
+		minGames = defaultLeaderboardMinGames
+	}
+	games, err := s.loadGames(ctx, GoalieFilter{TournamentID: tournamentID})
+	if err != nil {
+		return nil, err
+	}
+	MarkQualityStarts(games, LeagueSavePct(games))
+
+	result := BuildGoalieLeaderboard(games, minGames)
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// TeamPulls возвращает сводку по снятиям вратаря команды, nil если снятий не было
+func (s *GoalieService) TeamPulls(ctx context.Context, teamID, tournamentID string) (*domain.PullSummary, error) {
+	pulls, goals, err := s.repo.LoadPulls(ctx, teamID, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("load pulls: %w", err)
+	}
+	for _, summary := range AnalyzePulls(pulls, goals) {
+		if summary.TeamID == teamID {
+			return &summary, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *GoalieService) loadGames(ctx context.Context, filter GoalieFilter) ([]domain.GoalieGame, error) {
+	games, err := s.repo.LoadGoalieGames(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("load goalie games: %w", err)
+	}
+	goals, err := s.repo.LoadGoalsAgainst(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("load goals against: %w", err)
+	}
+	AttachGoals(games, goals)
+	return games, nil
+}
+
+func tournamentIDs(games []domain.GoalieGame) []string {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, g := range games {
+		if g.TournamentID != "" && !seen[g.TournamentID] {
+			seen[g.TournamentID] = true
+			ids = append(ids, g.TournamentID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,139 @@
+package application
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+type comboStats struct {
+	kind    string
+	ids     []string
+	gf, ga  int
+	matches map[string]bool
+}
+
+func (c *comboStats) add(goal domain.OnIceGoal) {
+	if goal.For {
+		c.gf++
+	} else {
+		c.ga++
+	}
+	c.matches[goal.MatchID] = true
+}
+
+// BuildLines выделяет тройки нападающих и пары защитников по совместному пребыванию на льду.
+//
+// Тройка учитывается, когда на льду ровно три нападающих команды, пара - ровно два
+// защитника; так отсекаются составы при большинстве, меньшинстве и пустых воротах.
+func BuildLines(goals []domain.OnIceGoal, players map[string]domain.LinePlayer, minGoals int) []domain.LineCombination {
+	combos := make(map[string]*comboStats)
+
+	track := func(kind string, ids []string, goal domain.OnIceGoal) {
+		sort.Strings(ids)
+		key := kind + ":" + strings.Join(ids, ",")
+		c, ok := combos[key]
+		if !ok {
+			c = &comboStats{kind: kind, ids: ids, matches: make(map[string]bool)}
+			combos[key] = c
+		}
+		c.add(goal)
+	}
+
+	for _, goal := range goals {
+		var forwards, defence []string
+		for _, id := range goal.Players {
+			switch players[id].Position {
+			case domain.PositionForward:
+				forwards = append(forwards, id)
+			case domain.PositionDefender:
+				defence = append(defence, id)
+			}
+		}
+		if len(forwards) == 3 {
+			track(domain.LineKindForwards, forwards, goal)
+		}
+		if len(defence) == 2 {
+			track(domain.LineKindDefence, defence, goal)
+		}
+	}
+
+	var result []domain.LineCombination
+	for _, c := range combos {
+		if c.gf+c.ga < minGoals {
+			continue
+		}
+		line := domain.LineCombination{
+			Kind:         c.kind,
+			GoalsFor:     c.gf,
+			GoalsAgainst: c.ga,
+			Matches:      len(c.matches),
+		}
+		for _, id := range c.ids {
+			line.Players = append(line.Players, lookupPlayer(players, id))
+		}
+		result = append(result, line)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Kind != b.Kind {
+			return a.Kind == domain.LineKindForwards
+		}
+		if a.GoalsFor+a.GoalsAgainst != b.GoalsFor+b.GoalsAgainst {
+			return a.GoalsFor+a.GoalsAgainst > b.GoalsFor+b.GoalsAgainst
+		}
+		return a.GoalDiff() > b.GoalDiff()
+	})
+	return result
+}
+
+// BuildLinemates считает совместную результативность игрока с каждым партнёром по команде
+func BuildLinemates(goals []domain.OnIceGoal, playerID string, players map[string]domain.LinePlayer, minGoals int) []domain.Linemate {
+	mates := make(map[string]*comboStats)
+	for _, goal := range goals {
+		for _, id := range goal.Players {
+			if id == playerID || players[id].Position == domain.PositionGoalie {
+				continue
+			}
+			c, ok := mates[id]
+			if !ok {
+				c = &comboStats{ids: []string{id}, matches: make(map[string]bool)}
+				mates[id] = c
+			}
+			c.add(goal)
+		}
+	}
+
+	var result []domain.Linemate
+	for id, c := range mates {
+		if c.gf+c.ga < minGoals {
+			continue
+		}
+		result = append(result, domain.Linemate{
+			Player:       lookupPlayer(players, id),
+			GoalsFor:     c.gf,
+			GoalsAgainst: c.ga,
+			Matches:      len(c.matches),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].GoalDiff() != result[j].GoalDiff() {
+			return result[i].GoalDiff() > result[j].GoalDiff()
+		}
+		if result[i].GoalsFor != result[j].GoalsFor {
+			return result[i].GoalsFor > result[j].GoalsFor
+		}
+		return result[i].Player.ID < result[j].Player.ID
+	})
+	return result
+}
+
+func lookupPlayer(players map[string]domain.LinePlayer, id string) domain.LinePlayer {
+	if p, ok := players[id]; ok {
+		return p
+	}
+	return domain.LinePlayer{ID: id}
+}
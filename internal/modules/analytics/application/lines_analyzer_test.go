@@ -0,0 +1,68 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+func linePlayers() map[string]domain.LinePlayer {
+	return map[string]domain.LinePlayer{
+		"f1": {ID: "f1", Position: domain.PositionForward},
+		"f2": {ID: "f2", Position: domain.PositionForward},
+		"f3": {ID: "f3", Position: domain.PositionForward},
+		"f4": {ID: "f4", Position: domain.PositionForward},
+		"d1": {ID: "d1", Position: domain.PositionDefender},
+		"d2": {ID: "d2", Position: domain.PositionDefender},
+		"g1": {ID: "g1", Position: domain.PositionGoalie},
+	}
+}
+
+func TestBuildLines(t *testing.T) {
+	goals := []domain.OnIceGoal{
+		{MatchID: "m1", For: true, Players: []string{"f1", "f2", "f3", "d1", "d2", "g1"}},
+		{MatchID: "m2", For: true, Players: []string{"f3", "f2", "f1", "d2", "d1", "g1"}},
+		{MatchID: "m2", For: false, Players: []string{"f1", "f2", "f3", "d1", "d2", "g1"}},
+		// Большинство: четыре нападающих, тройка не учитывается
+		{MatchID: "m3", For: true, Players: []string{"f1", "f2", "f3", "f4", "d1"}},
+	}
+
+	lines := BuildLines(goals, linePlayers(), 2)
+	if len(lines) != 2 {
+		t.Fatalf("BuildLines() returned %d lines, want 2", len(lines))
+	}
+
+	trio := lines[0]
+	if trio.Kind != domain.LineKindForwards || len(trio.Players) != 3 {
+		t.Fatalf("first line = %+v, want forward trio", trio)
+	}
+	if trio.GoalsFor != 2 || trio.GoalsAgainst != 1 || trio.Matches != 2 {
+		t.Errorf("trio stats = %d-%d in %d matches, want 2-1 in 2", trio.GoalsFor, trio.GoalsAgainst, trio.Matches)
+	}
+
+	pair := lines[1]
+	if pair.Kind != domain.LineKindDefence || pair.GoalDiff() != 1 {
+		t.Errorf("second line = %+v, want defence pair with +1", pair)
+	}
+}
+
+func TestBuildLinemates(t *testing.T) {
+	goals := []domain.OnIceGoal{
+		{MatchID: "m1", For: true, Players: []string{"f1", "f2", "d1", "g1"}},
+		{MatchID: "m1", For: true, Players: []string{"f1", "f2", "d2", "g1"}},
+		{MatchID: "m2", For: false, Players: []string{"f1", "d1", "g1"}},
+	}
+
+	mates := BuildLinemates(goals, "f1", linePlayers(), 1)
+	if len(mates) != 3 {
+		t.Fatalf("BuildLinemates() returned %d linemates, want 3 (goalie excluded)", len(mates))
+	}
+	if mates[0].Player.ID != "f2" || mates[0].GoalDiff() != 2 {
+		t.Errorf("top linemate = %+v, want f2 with +2", mates[0])
+	}
+	for _, m := range mates {
+		if m.Player.ID == "d1" && (m.GoalsFor != 1 || m.GoalsAgainst != 1 || m.Matches != 2) {
+			t.Errorf("d1 stats = %+v, want 1-1 in 2 matches", m)
+		}
+	}
+}
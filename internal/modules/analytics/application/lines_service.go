@@ -0,0 +1,72 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+const (
+	// minLineGoals минимум голов при сочетании на льду, чтобы показать его
+	minLineGoals = 2
+	// minLinemateGoals минимум совместных голов для партнёра
+	minLinemateGoals = 1
+)
+
+// LinesRepository источник голов с составами на льду
+type LinesRepository interface {
+	LoadTeamGoals(ctx context.Context, teamID, tournamentID string) ([]domain.OnIceGoal, error)
+	LoadPlayerGoals(ctx context.Context, playerID, tournamentID string) ([]domain.OnIceGoal, error)
+	LoadPlayers(ctx context.Context, ids []string) (map[string]domain.LinePlayer, error)
+}
+
+// LinesService анализ сочетаний игроков (звенья, пары, партнёры)
+type LinesService struct {
+	repo LinesRepository
+}
+
+// NewLinesService создаёт сервис анализа сочетаний
+func NewLinesService(repo LinesRepository) *LinesService {
+	return &LinesService{repo: repo}
+}
+
+// TeamLines возвращает тройки нападающих и пары защитников команды
+func (s *LinesService) TeamLines(ctx context.Context, teamID, tournamentID string) ([]domain.LineCombination, error) {
+	goals, err := s.repo.LoadTeamGoals(ctx, teamID, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("load team goals: %w", err)
+	}
+	players, err := s.repo.LoadPlayers(ctx, collectPlayerIDs(goals))
+	if err != nil {
+		return nil, fmt.Errorf("load players: %w", err)
+	}
+	return BuildLines(goals, players, minLineGoals), nil
+}
+
+// PlayerLinemates возвращает партнёров игрока, отсортированных по совместной разнице шайб
+func (s *LinesService) PlayerLinemates(ctx context.Context, playerID, tournamentID string) ([]domain.Linemate, error) {
+	goals, err := s.repo.LoadPlayerGoals(ctx, playerID, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("load player goals: %w", err)
+	}
+	players, err := s.repo.LoadPlayers(ctx, collectPlayerIDs(goals))
+	if err != nil {
+		return nil, fmt.Errorf("load players: %w", err)
+	}
+	return BuildLinemates(goals, playerID, players, minLinemateGoals), nil
+}
+
+func collectPlayerIDs(goals []domain.OnIceGoal) []string {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, g := range goals {
+		for _, id := range g.Players {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
@@ -0,0 +1,164 @@
+package application
+
+import (
+	"math"
+	"sort"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+const (
+	// strengthMaxIterations ограничение итераций попеременного МНК
+	strengthMaxIterations = 200
+	// strengthTolerance порог сходимости по изменению силы турнира
+	strengthTolerance = 1e-6
+	// strengthShrinkage сжатие к среднему для турниров с малым числом связей
+	strengthShrinkage = 5.0
+)
+
+type unitKey struct {
+	tournamentID string
+	birthYear    int
+}
+
+type observation struct {
+	entity int
+	unit   int
+	y      float64 // log результативности за игру
+	w      float64 // вес = число игр
+}
+
+// EstimateStrength оценивает коэффициенты силы турниров по каждому сезону отдельно.
+//
+// Модель: log(результативность) = способность - сила турнира. Параметры находятся
+// попеременным взвешенным МНК по игрокам и командам, выступавшим хотя бы в двух
+// турнирах сезона. Сила центрируется к нулю внутри сезона, коэффициент = exp(силы).
+func EstimateStrength(observations []domain.StrengthObservation) []domain.StrengthCoefficient {
+	bySeason := make(map[string][]domain.StrengthObservation)
+	for _, o := range observations {
+		if o.Games > 0 && o.Season != "" {
+			bySeason[o.Season] = append(bySeason[o.Season], o)
+		}
+	}
+
+	var result []domain.StrengthCoefficient
+	for season, obs := range bySeason {
+		result = append(result, estimateSeason(season, obs)...)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Season != result[j].Season {
+			return result[i].Season < result[j].Season
+		}
+		if result[i].TournamentID != result[j].TournamentID {
+			return result[i].TournamentID < result[j].TournamentID
+		}
+		return result[i].BirthYear < result[j].BirthYear
+	})
+	return result
+}
+
+func estimateSeason(season string, raw []domain.StrengthObservation) []domain.StrengthCoefficient {
+	entityUnits := make(map[string]map[unitKey]bool)
+	for _, o := range raw {
+		if entityUnits[o.EntityID] == nil {
+			entityUnits[o.EntityID] = make(map[unitKey]bool)
+		}
+		entityUnits[o.EntityID][unitKey{o.TournamentID, o.BirthYear}] = true
+	}
+
+	entities := make(map[string]int)
+	units := make(map[unitKey]int)
+	var unitList []unitKey
+	var obs []observation
+	for _, o := range raw {
+		if len(entityUnits[o.EntityID]) < 2 {
+			continue
+		}
+		key := unitKey{o.TournamentID, o.BirthYear}
+		if _, ok := units[key]; !ok {
+			units[key] = len(unitList)
+			unitList = append(unitList, key)
+		}
+		if _, ok := entities[o.EntityID]; !ok {
+			entities[o.EntityID] = len(entities)
+		}
+		obs = append(obs, observation{
+			entity: entities[o.EntityID],
+			unit:   units[key],
+			y:      math.Log((float64(o.Scored) + 0.5) / float64(o.Games)),
+			w:      float64(o.Games),
+		})
+	}
+	if len(unitList) < 2 {
+		return nil
+	}
+
+	strength := fitStrength(obs, len(entities), len(unitList))
+
+	shared := make([]map[int]bool, len(unitList))
+	games := make([]int, len(unitList))
+	for _, o := range obs {
+		if shared[o.unit] == nil {
+			shared[o.unit] = make(map[int]bool)
+		}
+		shared[o.unit][o.entity] = true
+		games[o.unit] += int(o.w)
+	}
+
+	result := make([]domain.StrengthCoefficient, len(unitList))
+	for i, key := range unitList {
+		n := float64(len(shared[i]))
+		s := strength[i] * n / (n + strengthShrinkage)
+		result[i] = domain.StrengthCoefficient{
+			TournamentID:   key.tournamentID,
+			BirthYear:      key.birthYear,
+			Season:         season,
+			Coefficient:    math.Round(math.Exp(s)*10000) / 10000,
+			SharedEntities: len(shared[i]),
+			Games:          games[i],
+		}
+	}
+	return result
+}
+
+// fitStrength решает модель попеременным МНК и возвращает центрированную силу турниров.
+func fitStrength(obs []observation, entityCount, unitCount int) []float64 {
+	ability := make([]float64, entityCount)
+	strength := make([]float64, unitCount)
+
+	for iter := 0; iter < strengthMaxIterations; iter++ {
+		sum, weight := make([]float64, entityCount), make([]float64, entityCount)
+		for _, o := range obs {
+			sum[o.entity] += o.w * (o.y + strength[o.unit])
+			weight[o.entity] += o.w
+		}
+		for e := range ability {
+			ability[e] = sum[e] / weight[e]
+		}
+
+		uSum, uWeight := make([]float64, unitCount), make([]float64, unitCount)
+		for _, o := range obs {
+			uSum[o.unit] += o.w * (ability[o.entity] - o.y)
+			uWeight[o.unit] += o.w
+		}
+
+		var mean, total, delta float64
+		next := make([]float64, unitCount)
+		for u := range next {
+			next[u] = uSum[u] / uWeight[u]
+			mean += next[u] * uWeight[u]
+			total += uWeight[u]
+		}
+		mean /= total
+		for u := range next {
+			next[u] -= mean
+			delta = math.Max(delta, math.Abs(next[u]-strength[u]))
+		}
+		strength = next
+
+		if delta < strengthTolerance {
+			break
+		}
+	}
+	return strength
+}
@@ -0,0 +1,52 @@
+package application
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+func TestEstimateStrength(t *testing.T) {
+	var obs []domain.StrengthObservation
+	for i := 0; i < 10; i++ {
+		player := fmt.Sprintf("player:%d", i)
+		// В сильном турнире игроки набирают вдвое меньше очков за игру
+		obs = append(obs,
+			domain.StrengthObservation{Season: "2024-2025", EntityID: player, TournamentID: "msk:1", BirthYear: 2012, Games: 10, Scored: 5 + i},
+			domain.StrengthObservation{Season: "2024-2025", EntityID: player, TournamentID: "cfo:2", BirthYear: 2012, Games: 10, Scored: 2 * (5 + i)},
+		)
+	}
+	// Игрок только из одного турнира не влияет на оценку
+	obs = append(obs, domain.StrengthObservation{Season: "2024-2025", EntityID: "player:solo", TournamentID: "cfo:2", BirthYear: 2012, Games: 10, Scored: 100})
+
+	got := EstimateStrength(obs)
+	if len(got) != 2 {
+		t.Fatalf("EstimateStrength() returned %d coefficients, want 2", len(got))
+	}
+
+	coef := make(map[string]domain.StrengthCoefficient)
+	for _, c := range got {
+		coef[c.TournamentID] = c
+	}
+	strong, weak := coef["msk:1"], coef["cfo:2"]
+	if strong.Coefficient <= 1 || weak.Coefficient >= 1 {
+		t.Errorf("coefficients = strong %v, weak %v; want strong > 1 > weak", strong.Coefficient, weak.Coefficient)
+	}
+	if strong.SharedEntities != 10 || weak.SharedEntities != 10 {
+		t.Errorf("shared entities = %d/%d, want 10/10", strong.SharedEntities, weak.SharedEntities)
+	}
+	if ratio := strong.Coefficient / weak.Coefficient; ratio < 1.3 || ratio > 2.1 {
+		t.Errorf("strength ratio = %v, want close to 2 after shrinkage", ratio)
+	}
+}
+
+func TestEstimateStrength_NoLinks(t *testing.T) {
+	obs := []domain.StrengthObservation{
+		{Season: "2024-2025", EntityID: "player:1", TournamentID: "a", BirthYear: 2012, Games: 10, Scored: 5},
+		{Season: "2024-2025", EntityID: "player:2", TournamentID: "b", BirthYear: 2012, Games: 10, Scored: 5},
+	}
+	if got := EstimateStrength(obs); len(got) != 0 {
+		t.Errorf("EstimateStrength() = %v, want no coefficients without shared entities", got)
+	}
+}
@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// strengthMinGames минимум игр в турнире, чтобы наблюдение участвовало в оценке
+const strengthMinGames = 3
+
+// StrengthRepository хранилище наблюдений и коэффициентов силы
+type StrengthRepository interface {
+	LoadObservations(ctx context.Context, minGames int) ([]domain.StrengthObservation, error)
+	ReplaceSeasons(ctx context.Context, seasons []string, coefficients []domain.StrengthCoefficient) error
+}
+
+// StrengthService пересчитывает коэффициенты силы турниров
+type StrengthService struct {
+	repo StrengthRepository
+}
+
+// NewStrengthService создаёт сервис пересчёта коэффициентов силы
+func NewStrengthService(repo StrengthRepository) *StrengthService {
+	return &StrengthService{repo: repo}
+}
+
+// Recompute пересчитывает коэффициенты по всем сезонам и сохраняет их
+func (s *StrengthService) Recompute(ctx context.Context) (int, error) {
+	observations, err := s.repo.LoadObservations(ctx, strengthMinGames)
+	if err != nil {
+		return 0, err
+	}
+
+	coefficients := EstimateStrength(observations)
+	if len(coefficients) == 0 {
+		logger.Info(ctx, "⚖️ No linked tournaments for strength estimation")
+		return 0, nil
+	}
+
+	seen := make(map[string]bool)
+	var seasons []string
+	for _, c := range coefficients {
+		if !seen[c.Season] {
+			seen[c.Season] = true
+			seasons = append(seasons, c.Season)
+		}
+	}
+
+	if err := s.repo.ReplaceSeasons(ctx, seasons, coefficients); err != nil {
+		return 0, fmt.Errorf("save strength coefficients: %w", err)
+	}
+
+	logger.Info(ctx, "⚖️ Tournament strength recomputed",
+		zap.Int("observations", len(observations)),
+		zap.Int("coefficients", len(coefficients)),
+		zap.Int("seasons", len(seasons)),
+	)
+	return len(coefficients), nil
+}
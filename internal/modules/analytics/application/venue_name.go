@@ -0,0 +1,59 @@
+package application
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+// earthRadiusKm средний радиус Земли
+const earthRadiusKm = 6371.0
+
+// venueNoiseWords слова, не отличающие одну арену от другой
+var venueNoiseWords = map[string]bool{"г": true, "гор": true, "город": true}
+
+// NormalizeVenueName ключ названия арены или города: без кавычек, знаков и регистра.
+// "ЛД «Кристалл», г. Электросталь" даёт "лд кристалл электросталь"
+func NormalizeVenueName(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "ё", "е")
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	result := words[:0]
+	for _, w := range words {
+		if !venueNoiseWords[w] {
+			result = append(result, w)
+		}
+	}
+	return strings.Join(result, " ")
+}
+
+// ParseVenue делит название арены из матча на арену и город. Источники пишут
+// "Стадион, Город"; без запятой город арены - город команды хозяев
+func ParseVenue(text domain.VenueText) (name, city string) {
+	name = strings.TrimSpace(text.Text)
+	city = strings.TrimSpace(text.HomeCity)
+	if i := strings.LastIndex(name, ","); i > 0 {
+		if suffix := strings.TrimSpace(name[i+1:]); NormalizeVenueName(suffix) != "" {
+			name, city = strings.TrimSpace(name[:i]), suffix
+		}
+	}
+	city = strings.TrimSpace(strings.TrimPrefix(city, "г."))
+	return name, city
+}
+
+// venueKey ключ арены: одинаковое название в одном городе - одна арена
+func venueKey(name, city string) string {
+	return NormalizeVenueName(name) + "|" + NormalizeVenueName(city)
+}
+
+// distanceKm расстояние по большому кругу (формула гаверсинусов)
+func distanceKm(a, b domain.GeoPoint) float64 {
+	lat1, lat2 := a.Lat*math.Pi/180, b.Lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Lon - a.Lon) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
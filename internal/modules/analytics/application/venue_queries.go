@@ -0,0 +1,71 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+// SearchVenues ищет арены по названию и городу
+func (s *VenueService) SearchVenues(ctx context.Context, query, city string, limit int) ([]domain.Venue, error) {
+	return s.repo.SearchVenues(ctx, query, city, limit)
+}
+
+// VenueSchedule возвращает арену с матчами, nil если арены нет
+func (s *VenueService) VenueSchedule(ctx context.Context, id string, limit int) (*domain.VenueSchedule, error) {
+	venue, err := s.repo.GetVenue(ctx, id)
+	if err != nil || venue == nil {
+		return nil, err
+	}
+	games, err := s.repo.VenueGames(ctx, id, limit)
+	if err != nil {
+		return nil, fmt.Errorf("load venue games: %w", err)
+	}
+	return &domain.VenueSchedule{Venue: *venue, Games: games}, nil
+}
+
+// TeamTravel считает выезды команды за сезон; пустой сезон - последний сезон команды
+func (s *VenueService) TeamTravel(ctx context.Context, teamID, season string) (*domain.TeamTravel, error) {
+	season, games, err := s.repo.TeamSeasonGames(ctx, teamID, season)
+	if err != nil {
+		return nil, fmt.Errorf("load team games: %w", err)
+	}
+	travel := teamTravel(games)
+	travel.TeamID, travel.Season = teamID, season
+	return &travel, nil
+}
+
+// teamTravel считает расстояния выездов от домашней арены - арены большинства
+// домашних матчей с координатами
+func teamTravel(games []domain.TeamVenueGame) domain.TeamTravel {
+	var travel domain.TeamTravel
+
+	counts := make(map[string]int)
+	for _, g := range games {
+		if g.Home && g.Venue != nil && g.Venue.Location != nil {
+			counts[g.Venue.ID]++
+			if travel.Home == nil || counts[g.Venue.ID] > counts[travel.Home.ID] {
+				travel.Home = g.Venue
+			}
+		}
+	}
+
+	for _, g := range games {
+		if g.Home {
+			continue
+		}
+		if travel.Home == nil || g.Venue == nil || g.Venue.Location == nil {
+			travel.Unlocated++
+			continue
+		}
+		km := math.Round(distanceKm(*travel.Home.Location, *g.Venue.Location)*10) / 10
+		travel.Trips = append(travel.Trips, domain.TeamTrip{
+			MatchID: g.MatchID, ScheduledAt: g.ScheduledAt, Venue: *g.Venue, DistanceKm: km,
+		})
+		travel.TotalKm += 2 * km
+	}
+	travel.TotalKm = math.Round(travel.TotalKm*10) / 10
+	return travel
+}
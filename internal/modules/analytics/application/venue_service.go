@@ -0,0 +1,122 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Gazetteer офлайн-справочник координат арен и городов
+type Gazetteer interface {
+	// Locate ищет арену в городе, затем сам город; возвращает точность найденных координат
+	Locate(name, city string) (point domain.GeoPoint, precision string, ok bool)
+}
+
+// VenueRepository хранилище арен и привязки к ним матчей
+type VenueRepository interface {
+	LoadVenues(ctx context.Context) ([]domain.Venue, error)
+	// LoadUnlinkedVenueTexts названия арен матчей без арены
+	LoadUnlinkedVenueTexts(ctx context.Context) ([]domain.VenueText, error)
+	// CreateVenue создаёт арену и привязывает к ней матчи с названием text, возвращает число матчей
+	CreateVenue(ctx context.Context, venue *domain.Venue, text string) (int, error)
+	// LinkVenue добавляет название арене и привязывает к ней матчи, возвращает число матчей
+	LinkVenue(ctx context.Context, venueID, text string) (int, error)
+
+	SearchVenues(ctx context.Context, query, city string, limit int) ([]domain.Venue, error)
+	GetVenue(ctx context.Context, id string) (*domain.Venue, error)
+	VenueGames(ctx context.Context, venueID string, limit int) ([]domain.VenueGame, error)
+	// TeamSeasonGames матчи команды в сезоне; пустой сезон - последний сезон команды
+	TeamSeasonGames(ctx context.Context, teamID, season string) (string, []domain.TeamVenueGame, error)
+}
+
+// VenueService справочник арен, расписание арен и выезды команд
+type VenueService struct {
+	repo      VenueRepository
+	gazetteer Gazetteer
+}
+
+// NewVenueService создаёт сервис арен
+func NewVenueService(repo VenueRepository, gazetteer Gazetteer) *VenueService {
+	return &VenueService{repo: repo, gazetteer: gazetteer}
+}
+
+// LinkVenues привязывает матчи к аренам: название арены из матча сравнивается с
+// известными по нормализованному названию и городу, для новых создаётся арена
+// с координатами из справочника
+func (s *VenueService) LinkVenues(ctx context.Context) (domain.VenueLinkResult, error) {
+	var result domain.VenueLinkResult
+
+	venues, err := s.repo.LoadVenues(ctx)
+	if err != nil {
+		return result, fmt.Errorf("load venues: %w", err)
+	}
+	texts, err := s.repo.LoadUnlinkedVenueTexts(ctx)
+	if err != nil {
+		return result, fmt.Errorf("load venue texts: %w", err)
+	}
+
+	// Матчи с уже известным названием привязываются по нему, остальные - по ключу арены
+	aliases := make(map[string]string)
+	index := make(map[string]string, len(venues))
+	for _, v := range venues {
+		index[venueKey(v.Name, v.City)] = v.ID
+		for _, alias := range v.Aliases {
+			aliases[alias] = v.ID
+			index[venueKey(ParseVenue(domain.VenueText{Text: alias, HomeCity: v.City}))] = v.ID
+		}
+	}
+
+	for _, text := range texts {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		name, city := ParseVenue(text)
+		if NormalizeVenueName(name) == "" {
+			continue
+		}
+		key := venueKey(name, city)
+
+		id, ok := aliases[text.Text]
+		if !ok {
+			id, ok = index[key]
+		}
+		if ok {
+			n, err := s.repo.LinkVenue(ctx, id, text.Text)
+			if err != nil {
+				return result, fmt.Errorf("link venue %q: %w", text.Text, err)
+			}
+			result.Linked++
+			result.Matches += n
+			continue
+		}
+
+		venue := s.newVenue(name, city, text.Region)
+		n, err := s.repo.CreateVenue(ctx, &venue, text.Text)
+		if err != nil {
+			return result, fmt.Errorf("create venue %q: %w", text.Text, err)
+		}
+		index[key] = venue.ID
+		result.Created++
+		result.Matches += n
+	}
+
+	logger.Info(ctx, "🏟️ Matches linked to venues",
+		zap.Int("venue_texts", len(texts)),
+		zap.Int("linked", result.Linked),
+		zap.Int("created", result.Created),
+		zap.Int("matches", result.Matches),
+	)
+	return result, nil
+}
+
+func (s *VenueService) newVenue(name, city, region string) domain.Venue {
+	venue := domain.Venue{Name: name, City: city, Region: region}
+	if point, precision, ok := s.gazetteer.Locate(name, city); ok {
+		venue.Location = &point
+		venue.Precision = precision
+	}
+	return venue
+}
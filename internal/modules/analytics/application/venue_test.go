@@ -0,0 +1,129 @@
+package application
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+func TestParseVenue(t *testing.T) {
+	tests := []struct {
+		text       domain.VenueText
+		name, city string
+	}{
+		{domain.VenueText{Text: "СК Локомотив, Ярославль", HomeCity: "Москва"}, "СК Локомотив", "Ярославль"},
+		{domain.VenueText{Text: "ЛД Кристалл, г. Электросталь"}, "ЛД Кристалл", "Электросталь"},
+		{domain.VenueText{Text: "Ледовый дворец", HomeCity: "Санкт-Петербург"}, "Ледовый дворец", "Санкт-Петербург"},
+		{domain.VenueText{Text: "Арена,", HomeCity: "Тверь"}, "Арена,", "Тверь"},
+	}
+	for _, tt := range tests {
+		name, city := ParseVenue(tt.text)
+		if name != tt.name || city != tt.city {
+			t.Errorf("ParseVenue(%q) = %q, %q, want %q, %q", tt.text.Text, name, city, tt.name, tt.city)
+		}
+	}
+	if got := NormalizeVenueName("ЛД «Кристалл», г. Электросталь"); got != "лд кристалл электросталь" {
+		t.Errorf("NormalizeVenueName = %q", got)
+	}
+}
+
+func TestDistanceKm(t *testing.T) {
+	moscow := domain.GeoPoint{Lat: 55.7558, Lon: 37.6173}
+	spb := domain.GeoPoint{Lat: 59.9343, Lon: 30.3351}
+	if got := distanceKm(moscow, spb); math.Abs(got-634) > 5 {
+		t.Errorf("Moscow - Saint Petersburg = %.0f km, want about 634", got)
+	}
+}
+
+func TestTeamTravel(t *testing.T) {
+	home := &domain.Venue{ID: "home", Location: &domain.GeoPoint{Lat: 55.7558, Lon: 37.6173}}
+	away := &domain.Venue{ID: "spb", Location: &domain.GeoPoint{Lat: 59.9343, Lon: 30.3351}}
+	games := []domain.TeamVenueGame{
+		{MatchID: "1", Home: true, Venue: home},
+		{MatchID: "2", Home: false, Venue: away},
+		{MatchID: "3", Home: false, Venue: home},
+		{MatchID: "4", Home: false, Venue: &domain.Venue{ID: "unknown"}},
+		{MatchID: "5", Home: false},
+	}
+
+	travel := teamTravel(games)
+	if travel.Home == nil || travel.Home.ID != "home" {
+		t.Fatalf("home venue = %+v", travel.Home)
+	}
+	if len(travel.Trips) != 2 || travel.Unlocated != 2 {
+		t.Errorf("trips = %d, unlocated = %d, want 2 and 2", len(travel.Trips), travel.Unlocated)
+	}
+	if want := 2 * travel.Trips[0].DistanceKm; travel.TotalKm != want {
+		t.Errorf("total = %v, want %v", travel.TotalKm, want)
+	}
+}
+
+// fakeVenueRepo хранит арены и привязки матчей в памяти
+type fakeVenueRepo struct {
+	VenueRepository
+	venues []domain.Venue
+	texts  []domain.VenueText
+	links  map[string]string
+}
+
+func (r *fakeVenueRepo) LoadVenues(context.Context) ([]domain.Venue, error) { return r.venues, nil }
+
+func (r *fakeVenueRepo) LoadUnlinkedVenueTexts(context.Context) ([]domain.VenueText, error) {
+	return r.texts, nil
+}
+
+func (r *fakeVenueRepo) CreateVenue(_ context.Context, venue *domain.Venue, text string) (int, error) {
+	venue.ID = "new:" + venue.Name
+	r.venues = append(r.venues, *venue)
+	r.links[text] = venue.ID
+	return 1, nil
+}
+
+func (r *fakeVenueRepo) LinkVenue(_ context.Context, venueID, text string) (int, error) {
+	r.links[text] = venueID
+	return 1, nil
+}
+
+type fakeGazetteer struct{}
+
+func (fakeGazetteer) Locate(_, city string) (domain.GeoPoint, string, bool) {
+	if city == "Ярославль" {
+		return domain.GeoPoint{Lat: 57.6, Lon: 39.9}, domain.VenuePrecisionCity, true
+	}
+	return domain.GeoPoint{}, "", false
+}
+
+func TestLinkVenues(t *testing.T) {
+	repo := &fakeVenueRepo{
+		venues: []domain.Venue{{ID: "ld", Name: "Ледовый дворец", City: "Санкт-Петербург", Aliases: []string{"ЛД-1"}}},
+		texts: []domain.VenueText{
+			{Text: "Ледовый дворец, Санкт-Петербург"},
+			{Text: "ЛД-1", HomeCity: "Колпино"},
+			{Text: "Арена 2000, Ярославль"},
+			{Text: "Арена 2000", HomeCity: "Ярославль"},
+			{Text: "Арена 2000", HomeCity: "Казань"},
+		},
+		links: make(map[string]string),
+	}
+
+	result, err := NewVenueService(repo, fakeGazetteer{}).LinkVenues(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.links["Ледовый дворец, Санкт-Петербург"] != "ld" || repo.links["ЛД-1"] != "ld" {
+		t.Errorf("links = %v", repo.links)
+	}
+	if repo.links["Арена 2000"] == "" || repo.venues[1].Location == nil || repo.venues[1].City != "Ярославль" {
+		t.Errorf("venues = %+v, links = %v", repo.venues, repo.links)
+	}
+	// Одноимённая арена другого города - другая арена, без координат вне справочника
+	if len(repo.venues) != 3 || repo.venues[2].Location != nil {
+		t.Errorf("venues = %+v", repo.venues)
+	}
+	want := domain.VenueLinkResult{Linked: 3, Created: 2, Matches: 5}
+	if result != want {
+		t.Errorf("result = %+v, want %+v", result, want)
+	}
+}
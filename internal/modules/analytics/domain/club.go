@@ -0,0 +1,69 @@
+package domain
+
+import "time"
+
+// Статусы проверки сопоставления команды с клубом
+const (
+	ClubReviewPending  = "pending"
+	ClubReviewApproved = "approved" // команда привязана к предложенному клубу
+	ClubReviewRejected = "rejected" // для команды создан отдельный клуб
+)
+
+// Club клуб: одна организация за строками команд разных источников, турниров и годов рождения
+type Club struct {
+	ID      string
+	Name    string
+	City    string
+	Region  string
+	Aliases []string // названия команд клуба в источниках
+}
+
+// ClubTeam строка команды источника
+type ClubTeam struct {
+	ID     string
+	Name   string
+	City   string
+	Region string
+	Source string
+	ClubID string // пусто, если команда ещё не сопоставлена
+}
+
+// ClubReview сопоставление команды с клубом, ожидающее решения администратора
+type ClubReview struct {
+	ID         int64
+	Team       ClubTeam
+	ClubID     string
+	ClubName   string
+	Score      float64
+	Status     string
+	CreatedAt  time.Time
+	ResolvedAt *time.Time
+}
+
+// ClubSeason выступления команд клуба в сезоне по источнику (по турнирным таблицам)
+type ClubSeason struct {
+	Season       string
+	Source       string
+	Teams        int // строки команд, например разные годы рождения
+	Tournaments  int
+	Games        int
+	Wins         int
+	Losses       int
+	Points       int
+	GoalsFor     int
+	GoalsAgainst int
+}
+
+// ClubHistory клуб с командами источников и выступлениями по сезонам, новые сезоны первыми
+type ClubHistory struct {
+	Club    Club
+	Teams   []ClubTeam
+	Seasons []ClubSeason
+}
+
+// ClubMatchResult итог сопоставления несвязанных команд
+type ClubMatchResult struct {
+	Linked  int // привязаны к существующему клубу
+	Created int // создан новый клуб
+	Queued  int // отправлены на проверку
+}
@@ -0,0 +1,80 @@
+package domain
+
+// Области отчёта о дисциплине
+const (
+	DisciplineScopePlayer     = "player"
+	DisciplineScopeTeam       = "team"
+	DisciplineScopeTournament = "tournament"
+)
+
+// Категории штрафов по длительности
+const (
+	PenaltyClassMinor          = "minor"           // 2 и 2+2 минуты
+	PenaltyClassMajor          = "major"           // 5 минут, матч-штраф
+	PenaltyClassMisconduct     = "misconduct"      // 10 минут
+	PenaltyClassGameMisconduct = "game_misconduct" // 20 минут, до конца игры
+)
+
+// PenaltyEvent штраф из протокола матча
+type PenaltyEvent struct {
+	MatchID      string
+	TournamentID string
+	TeamID       string
+	TeamName     string
+	PlayerID     string
+	PlayerName   string
+	Period       int
+	Second       int // секунда матча
+	Minutes      int
+	ReasonCode   string
+	Reason       string
+}
+
+// Infraction нарушение и его частота
+type Infraction struct {
+	Code    string
+	Reason  string
+	Count   int
+	Minutes int
+}
+
+// PeriodDiscipline штрафы за период
+type PeriodDiscipline struct {
+	Penalties     int
+	Minutes       int
+	Share         float64 // доля от всех штрафов
+	PerGame       float64 // штрафов за период в среднем за игру
+	GoalsConceded int     // голы соперника в большинстве после штрафов периода
+}
+
+// Offender участник отчёта (игрок или команда) с суммарными штрафами
+type Offender struct {
+	ID        string
+	Name      string
+	Penalties int
+	Minutes   int
+}
+
+// DisciplineReport отчёт о дисциплине игрока, команды или турнира
+type DisciplineReport struct {
+	Scope           string
+	ID              string
+	Games           int
+	Penalties       int
+	Minutes         int
+	MinutesPerGame  float64
+	Minors          int
+	Majors          int
+	Misconducts     int
+	GameMisconducts int
+
+	// Штрафы, дающие сопернику большинство, и голы в это время
+	PowerPlayPenalties int
+	CostlyPenalties    int     // после штрафа соперник забил в большинстве
+	CostlyRate         float64 // доля штрафов с пропущенной шайбой
+
+	Infractions []Infraction
+	Periods     [PeriodCount]PeriodDiscipline
+	TopPlayers  []Offender // для команды и турнира
+	TopTeams    []Offender // для турнира
+}
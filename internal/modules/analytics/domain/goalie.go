@@ -0,0 +1,123 @@
+package domain
+
+import "time"
+
+// Игровые ситуации при голе.
+//
+// Протоколы дают броски только по периодам, без времени и игровой ситуации,
+// поэтому процент отражённых по ситуациям не считается: по ним есть только пропущенные.
+// TODO: процент отражённых по ситуациям (PP/SH/EN) - когда источник начнёт отдавать
+// броски по игровым ситуациям
+const (
+	GameStateEven      = "even" // в равных составах
+	GameStatePowerPlay = "pp"   // в большинстве
+	GameStateShortHand = "sh"   // в меньшинстве
+	GameStateEmptyNet  = "en"   // в пустые ворота
+)
+
+// Количество периодов в статистике (3 основных + овертайм)
+const PeriodCount = 4
+
+// GoalieGame игровая строка вратаря из протокола
+type GoalieGame struct {
+	MatchID      string
+	TournamentID string
+	PlayerID     string
+	Name         string
+	TeamID       string
+	PlayedAt     *time.Time
+	Saves        int
+	GoalsAgainst int
+	TimeOnIce    int               // секунды, 0 если неизвестно
+	ShotsAgainst *[PeriodCount]int // броски соперника по периодам, если вратарь отыграл матч один
+	GoalsPeriod  [PeriodCount]int  // пропущенные по периодам
+	GoalsState   map[string]int    // пропущенные по игровой ситуации
+	QualityStart bool
+}
+
+// Shots возвращает количество бросков в створ
+func (g GoalieGame) Shots() int {
+	return g.Saves + g.GoalsAgainst
+}
+
+// SavePct возвращает процент отражённых бросков
+func (g GoalieGame) SavePct() float64 {
+	if g.Shots() == 0 {
+		return 0
+	}
+	return float64(g.Saves) / float64(g.Shots())
+}
+
+// GoalAgainst пропущенный гол с привязкой к вратарю
+type GoalAgainst struct {
+	MatchID  string
+	GoalieID string
+	Period   int
+	State    string
+}
+
+// GoalieSummary сводная статистика вратаря
+type GoalieSummary struct {
+	PlayerID          string
+	Name              string
+	Games             int
+	Saves             int
+	GoalsAgainst      int
+	TimeOnIce         int
+	QualityStarts     int
+	SavePct           float64
+	GoalsAgainstAvg   float64 // за 60 минут, 0 если время неизвестно
+	QualityStartPct   float64
+	PeriodShots       [PeriodCount]int      // броски по периодам в матчах с известной разбивкой
+	PeriodGoals       [PeriodCount]int      // пропущенные по периодам во всех матчах
+	PeriodSavePct     [PeriodCount]*float64 // nil, если бросков за период нет
+	GoalsByState      map[string]int        // пропущенные по игровой ситуации, процент отражённых не считается
+	PeriodSampleGames int                   // матчи, по которым известны броски по периодам
+}
+
+// GoalieProfile вратарская аналитика игрока: сводка и игровые строки
+type GoalieProfile struct {
+	Summary GoalieSummary
+	Games   []GoalieGame
+}
+
+// EmptyNetPull снятие вратаря командой
+type EmptyNetPull struct {
+	MatchID      string
+	TeamID       string
+	Second       int // секунда матча
+	Period       int
+	ScoreFor     int // счёт команды в момент снятия (заполняется при анализе)
+	ScoreAgainst int // счёт соперника в момент снятия (заполняется при анализе)
+}
+
+// MatchGoal гол в матче для анализа снятий вратаря
+type MatchGoal struct {
+	MatchID string
+	TeamID  string // забившая команда
+	Second  int
+}
+
+// Исходы снятия вратаря
+const (
+	PullOutcomeGoalFor     = "goal_for"     // команда забила в большинстве
+	PullOutcomeGoalAgainst = "goal_against" // команда пропустила
+	PullOutcomeNoGoal      = "no_goal"      // голов не было
+)
+
+// PullSummary сводка по снятиям вратаря команды
+type PullSummary struct {
+	TeamID          string
+	Pulls           int
+	AvgSecondsLeft  float64
+	GoalsFor        int
+	GoalsAgainst    int
+	NoGoal          int
+	ByDeficit       map[int]int // отставание в момент снятия -> количество снятий
+	GoalsForByDelta map[int]int // отставание -> забитые при снятом вратаре
+}
+
+// Deficit возвращает отставание команды в момент снятия вратаря
+func (p EmptyNetPull) Deficit() int {
+	return p.ScoreAgainst - p.ScoreFor
+}
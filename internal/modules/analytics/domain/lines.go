@@ -0,0 +1,56 @@
+package domain
+
+// Типы сочетаний игроков
+const (
+	LineKindForwards = "forwards" // тройка нападающих
+	LineKindDefence  = "defence"  // пара защитников
+)
+
+// Позиции игроков в составе
+const (
+	PositionForward  = "F"
+	PositionDefender = "D"
+	PositionGoalie   = "G"
+)
+
+// OnIceGoal гол с точки зрения команды: кто из её игроков был на льду
+type OnIceGoal struct {
+	MatchID string
+	TeamID  string
+	For     bool     // true - гол забила команда, false - пропустила
+	Players []string // игроки команды на льду
+}
+
+// LinePlayer игрок в сочетании
+type LinePlayer struct {
+	ID       string
+	Name     string
+	Position string
+}
+
+// LineCombination сочетание игроков (тройка или пара) и его результативность
+type LineCombination struct {
+	Kind         string
+	Players      []LinePlayer
+	GoalsFor     int
+	GoalsAgainst int
+	Matches      int
+}
+
+// GoalDiff возвращает разницу забитых и пропущенных
+func (l LineCombination) GoalDiff() int {
+	return l.GoalsFor - l.GoalsAgainst
+}
+
+// Linemate партнёр игрока и их совместная результативность
+type Linemate struct {
+	Player       LinePlayer
+	GoalsFor     int
+	GoalsAgainst int
+	Matches      int
+}
+
+// GoalDiff возвращает разницу забитых и пропущенных вместе
+func (l Linemate) GoalDiff() int {
+	return l.GoalsFor - l.GoalsAgainst
+}
@@ -0,0 +1,21 @@
+package domain
+
+// StrengthObservation результативность игрока или команды в турнире (возрастной группе)
+type StrengthObservation struct {
+	Season       string
+	EntityID     string // "player:<id>" или "team:<id>"
+	TournamentID string
+	BirthYear    int
+	Games        int
+	Scored       int // очки игрока или заброшенные шайбы команды
+}
+
+// StrengthCoefficient коэффициент силы турнира (возрастной группы)
+type StrengthCoefficient struct {
+	TournamentID   string
+	BirthYear      int
+	Season         string
+	Coefficient    float64 // >1 - турнир сильнее среднего по сезону
+	SharedEntities int     // игроки и команды, связывающие турнир с другими
+	Games          int
+}
@@ -0,0 +1,88 @@
+package domain
+
+import "time"
+
+// Точность координат арены
+const (
+	VenuePrecisionArena = "arena" // арена найдена в справочнике
+	VenuePrecisionCity  = "city"  // координаты центра города
+)
+
+// GeoPoint координаты в градусах WGS 84
+type GeoPoint struct {
+	Lat float64
+	Lon float64
+}
+
+// Venue арена: каноническое название, город и координаты из справочника
+type Venue struct {
+	ID        string
+	Name      string
+	City      string
+	Region    string
+	Location  *GeoPoint // nil, если ни арены, ни города нет в справочнике
+	Precision string
+	Aliases   []string // названия арены в матчах источников
+}
+
+// VenueText название арены из матча с городом хозяев, которым уточняется город арены
+type VenueText struct {
+	Text     string
+	HomeCity string
+	Region   string
+	Matches  int
+}
+
+// VenueGame матч на арене
+type VenueGame struct {
+	MatchID     string
+	ScheduledAt *time.Time
+	HomeTeamID  string
+	AwayTeamID  string
+	HomeTeam    string
+	AwayTeam    string
+	HomeScore   *int
+	AwayScore   *int
+	Tournament  string
+	Status      string
+}
+
+// VenueSchedule арена с матчами
+type VenueSchedule struct {
+	Venue Venue
+	Games []VenueGame
+}
+
+// TeamVenueGame матч команды с ареной, nil если матч не привязан к арене
+type TeamVenueGame struct {
+	MatchID     string
+	ScheduledAt *time.Time
+	Home        bool
+	Venue       *Venue
+}
+
+// TeamTrip выезд команды на матч
+type TeamTrip struct {
+	MatchID     string
+	ScheduledAt *time.Time
+	Venue       Venue
+	DistanceKm  float64 // от домашней арены, в одну сторону
+}
+
+// TeamTravel выезды команды за сезон. Домашняя арена - арена большинства домашних матчей,
+// TotalKm - сумма поездок туда и обратно
+type TeamTravel struct {
+	TeamID    string
+	Season    string
+	Home      *Venue
+	Trips     []TeamTrip
+	Unlocated int // выездные матчи на аренах без координат
+	TotalKm   float64
+}
+
+// VenueLinkResult итог привязки матчей к аренам
+type VenueLinkResult struct {
+	Linked  int // названия, привязанные к существующей арене
+	Created int // созданные арены
+	Matches int // матчи, получившие арену
+}
@@ -0,0 +1,104 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+// SearchClubs ищет клубы по подстроке названия клуба или названия его команды
+func (r *ClubRepository) SearchClubs(ctx context.Context, query string, limit int) ([]domain.Club, error) {
+	var rows []clubRow
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT `+clubColumns+`
+		FROM clubs c
+		LEFT JOIN club_aliases a ON a.club_id = c.id
+		WHERE $1 = '' OR c.name ILIKE '%' || $1 || '%'
+		   OR EXISTS (SELECT 1 FROM club_aliases s WHERE s.club_id = c.id AND s.alias ILIKE '%' || $1 || '%')
+		GROUP BY c.id
+		ORDER BY c.name, c.id
+		LIMIT $2`, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("search clubs: %w", err)
+	}
+	result := make([]domain.Club, len(rows))
+	for i, c := range rows {
+		result[i] = c.toDomain()
+	}
+	return result, nil
+}
+
+// GetClub возвращает клуб с названиями команд, nil если его нет
+func (r *ClubRepository) GetClub(ctx context.Context, id string) (*domain.Club, error) {
+	var row clubRow
+	err := r.db.GetContext(ctx, &row, `
+		SELECT `+clubColumns+`
+		FROM clubs c
+		LEFT JOIN club_aliases a ON a.club_id = c.id
+		WHERE c.id = $1
+		GROUP BY c.id`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get club: %w", err)
+	}
+	club := row.toDomain()
+	return &club, nil
+}
+
+// ClubTeams возвращает команды источников, привязанные к клубу
+func (r *ClubRepository) ClubTeams(ctx context.Context, clubID string) ([]domain.ClubTeam, error) {
+	var rows []teamRow
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT `+teamColumns+`
+		FROM teams t
+		WHERE t.club_id = $1
+		ORDER BY t.source, t.name, t.id`, clubID)
+	if err != nil {
+		return nil, fmt.Errorf("load club teams: %w", err)
+	}
+	result := make([]domain.ClubTeam, len(rows))
+	for i, t := range rows {
+		result[i] = t.toDomain()
+	}
+	return result, nil
+}
+
+// ClubSeasons суммирует турнирные таблицы команд клуба по сезонам и источникам.
+// Победы и поражения учитывают овертаймы и буллиты
+func (r *ClubRepository) ClubSeasons(ctx context.Context, clubID string) ([]domain.ClubSeason, error) {
+	rows, err := r.db.QueryxContext(ctx, `
+		SELECT COALESCE(tr.season, '') AS season, ts.source,
+			COUNT(DISTINCT ts.team_id), COUNT(DISTINCT ts.tournament_id),
+			COALESCE(SUM(ts.games), 0)::int,
+			COALESCE(SUM(COALESCE(ts.wins, 0) + COALESCE(ts.wins_ot, 0) + COALESCE(ts.wins_so, 0)), 0)::int,
+			COALESCE(SUM(COALESCE(ts.losses, 0) + COALESCE(ts.losses_ot, 0) + COALESCE(ts.losses_so, 0)), 0)::int,
+			COALESCE(SUM(ts.points), 0)::int,
+			COALESCE(SUM(ts.goals_for), 0)::int,
+			COALESCE(SUM(ts.goals_against), 0)::int
+		FROM team_standings ts
+		JOIN teams t ON t.id = ts.team_id
+		JOIN tournaments tr ON tr.id = ts.tournament_id
+		WHERE t.club_id = $1
+		GROUP BY 1, 2
+		ORDER BY 1 DESC, 2`, clubID)
+	if err != nil {
+		return nil, fmt.Errorf("load club seasons: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []domain.ClubSeason
+	for rows.Next() {
+		var s domain.ClubSeason
+		if err := rows.Scan(&s.Season, &s.Source, &s.Teams, &s.Tournaments, &s.Games,
+			&s.Wins, &s.Losses, &s.Points, &s.GoalsFor, &s.GoalsAgainst); err != nil {
+			return nil, fmt.Errorf("scan club season: %w", err)
+		}
+		result = append(result, s)
+	}
+	return result, rows.Err()
+}
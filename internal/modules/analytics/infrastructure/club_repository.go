@@ -0,0 +1,140 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ClubRepository репозиторий клубов и сопоставления команд
+type ClubRepository struct {
+	db *sqlx.DB
+}
+
+// NewClubRepository создаёт новый репозиторий клубов
+func NewClubRepository(db *sqlx.DB) *ClubRepository {
+	return &ClubRepository{db: db}
+}
+
+// clubRow строка клуба с названиями команд
+type clubRow struct {
+	ID      string         `db:"id"`
+	Name    string         `db:"name"`
+	City    string         `db:"city"`
+	Region  string         `db:"region"`
+	Aliases pq.StringArray `db:"aliases"`
+}
+
+func (c clubRow) toDomain() domain.Club {
+	return domain.Club{ID: c.ID, Name: c.Name, City: c.City, Region: c.Region, Aliases: c.Aliases}
+}
+
+const clubColumns = `
+	c.id, c.name, COALESCE(c.city, '') AS city, COALESCE(c.region, '') AS region,
+	COALESCE(array_agg(a.alias ORDER BY a.alias) FILTER (WHERE a.alias IS NOT NULL), '{}') AS aliases`
+
+// LoadClubs загружает все клубы с названиями команд
+func (r *ClubRepository) LoadClubs(ctx context.Context) ([]domain.Club, error) {
+	var rows []clubRow
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT `+clubColumns+`
+		FROM clubs c
+		LEFT JOIN club_aliases a ON a.club_id = c.id
+		GROUP BY c.id`)
+	if err != nil {
+		return nil, fmt.Errorf("load clubs: %w", err)
+	}
+	result := make([]domain.Club, len(rows))
+	for i, c := range rows {
+		result[i] = c.toDomain()
+	}
+	return result, nil
+}
+
+// teamRow строка команды источника
+type teamRow struct {
+	ID     string `db:"id"`
+	Name   string `db:"name"`
+	City   string `db:"city"`
+	Region string `db:"region"`
+	Source string `db:"source"`
+	ClubID string `db:"club_id"`
+}
+
+func (t teamRow) toDomain() domain.ClubTeam {
+	return domain.ClubTeam{ID: t.ID, Name: t.Name, City: t.City, Region: t.Region, Source: t.Source, ClubID: t.ClubID}
+}
+
+const teamColumns = `
+	t.id, t.name, COALESCE(t.city, '') AS city, COALESCE(t.region, '') AS region,
+	COALESCE(t.source, '') AS source, COALESCE(t.club_id, '') AS club_id`
+
+// LoadUnmatchedTeams загружает команды без клуба и без ожидающей проверки
+func (r *ClubRepository) LoadUnmatchedTeams(ctx context.Context) ([]domain.ClubTeam, error) {
+	var rows []teamRow
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT `+teamColumns+`
+		FROM teams t
+		WHERE t.club_id IS NULL AND COALESCE(t.name, '') <> ''
+		  AND NOT EXISTS (SELECT 1 FROM club_match_reviews r WHERE r.team_id = t.id AND r.status = 'pending')
+		ORDER BY t.source, t.name, t.id`)
+	if err != nil {
+		return nil, fmt.Errorf("load unmatched teams: %w", err)
+	}
+	result := make([]domain.ClubTeam, len(rows))
+	for i, t := range rows {
+		result[i] = t.toDomain()
+	}
+	return result, nil
+}
+
+// CreateClub создаёт клуб и привязывает к нему команду
+func (r *ClubRepository) CreateClub(ctx context.Context, club domain.Club, team domain.ClubTeam) (domain.Club, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return club, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = tx.GetContext(ctx, &club.ID, `
+		INSERT INTO clubs (name, city, region) VALUES ($1, NULLIF($2, ''), NULLIF($3, ''))
+		RETURNING id`, club.Name, club.City, club.Region)
+	if err != nil {
+		return club, fmt.Errorf("insert club: %w", err)
+	}
+	if err := linkTeam(ctx, tx, team, club.ID); err != nil {
+		return club, err
+	}
+	club.Aliases = []string{team.Name}
+	return club, tx.Commit()
+}
+
+// LinkTeam привязывает команду к клубу и добавляет её название в названия клуба
+func (r *ClubRepository) LinkTeam(ctx context.Context, team domain.ClubTeam, clubID string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := linkTeam(ctx, tx, team, clubID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func linkTeam(ctx context.Context, tx *sqlx.Tx, team domain.ClubTeam, clubID string) error {
+	if _, err := tx.ExecContext(ctx, `UPDATE teams SET club_id = $1 WHERE id = $2`, clubID, team.ID); err != nil {
+		return fmt.Errorf("link team: %w", err)
+	}
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO club_aliases (club_id, alias, source) VALUES ($1, $2, NULLIF($3, ''))
+		ON CONFLICT (club_id, alias) DO NOTHING`, clubID, team.Name, team.Source)
+	if err != nil {
+		return fmt.Errorf("insert club alias: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+// QueueReview отправляет сопоставление команды с клубом на проверку
+func (r *ClubRepository) QueueReview(ctx context.Context, team domain.ClubTeam, clubID string, score float64) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO club_match_reviews (team_id, club_id, score) VALUES ($1, $2, $3)
+		ON CONFLICT (team_id) DO UPDATE SET
+			club_id = EXCLUDED.club_id, score = EXCLUDED.score,
+			status = 'pending', created_at = NOW(), resolved_at = NULL`,
+		team.ID, clubID, score)
+	if err != nil {
+		return fmt.Errorf("queue club review: %w", err)
+	}
+	return nil
+}
+
+// ResolveReview привязывает команду проверки к клубу и закрывает проверку со статусом
+func (r *ClubRepository) ResolveReview(ctx context.Context, id int64, clubID, status string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var team teamRow
+	err = tx.GetContext(ctx, &team, `
+		SELECT `+teamColumns+`
+		FROM club_match_reviews r JOIN teams t ON t.id = r.team_id
+		WHERE r.id = $1 AND r.status = 'pending'
+		FOR UPDATE OF r`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("club review %d is not pending", id)
+	}
+	if err != nil {
+		return fmt.Errorf("lock club review: %w", err)
+	}
+
+	if err := linkTeam(ctx, tx, team.toDomain(), clubID); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+		UPDATE club_match_reviews SET status = $2, club_id = $3, resolved_at = NOW() WHERE id = $1`,
+		id, status, clubID)
+	if err != nil {
+		return fmt.Errorf("resolve club review: %w", err)
+	}
+	return tx.Commit()
+}
+
+// reviewRow строка проверки с командой и предложенным клубом
+type reviewRow struct {
+	ID         int64      `db:"review_id"`
+	ClubID     string     `db:"review_club_id"`
+	ClubName   string     `db:"club_name"`
+	Score      float64    `db:"score"`
+	Status     string     `db:"status"`
+	CreatedAt  time.Time  `db:"created_at"`
+	ResolvedAt *time.Time `db:"resolved_at"`
+	teamRow
+}
+
+func (r reviewRow) toDomain() domain.ClubReview {
+	return domain.ClubReview{
+		ID: r.ID, Team: r.teamRow.toDomain(), ClubID: r.ClubID, ClubName: r.ClubName,
+		Score: r.Score, Status: r.Status, CreatedAt: r.CreatedAt, ResolvedAt: r.ResolvedAt,
+	}
+}
+
+const reviewQuery = `
+	SELECT r.id AS review_id, r.club_id AS review_club_id, c.name AS club_name, r.score, r.status,
+	       r.created_at, r.resolved_at, ` + teamColumns + `
+	FROM club_match_reviews r
+	JOIN teams t ON t.id = r.team_id
+	JOIN clubs c ON c.id = r.club_id`
+
+// Reviews возвращает проверки со статусом, новые первыми, и их общее количество
+func (r *ClubRepository) Reviews(ctx context.Context, status string, limit, offset int) ([]domain.ClubReview, int, error) {
+	var total int
+	if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM club_match_reviews WHERE status = $1`, status); err != nil {
+		return nil, 0, fmt.Errorf("count club reviews: %w", err)
+	}
+
+	var rows []reviewRow
+	err := r.db.SelectContext(ctx, &rows, reviewQuery+`
+		WHERE r.status = $1
+		ORDER BY r.created_at DESC, r.id DESC
+		LIMIT $2 OFFSET $3`, status, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list club reviews: %w", err)
+	}
+	result := make([]domain.ClubReview, len(rows))
+	for i, row := range rows {
+		result[i] = row.toDomain()
+	}
+	return result, total, nil
+}
+
+// GetReview возвращает проверку, nil если её нет
+func (r *ClubRepository) GetReview(ctx context.Context, id int64) (*domain.ClubReview, error) {
+	var row reviewRow
+	err := r.db.GetContext(ctx, &row, reviewQuery+` WHERE r.id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get club review: %w", err)
+	}
+	review := row.toDomain()
+	return &review, nil
+}
@@ -0,0 +1,182 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/application"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// penaltiesCTE штрафы с командой, определённой по team_id или признаку домашней команды
+const penaltiesCTE = `
+	penalties AS (
+		SELECT me.match_id, COALESCE(m.tournament_id, '') as tournament_id,
+			COALESCE(me.team_id,
+				CASE WHEN me.is_home THEN m.home_team_id WHEN NOT me.is_home THEN m.away_team_id END,
+				'') as team_id,
+			COALESCE(me.penalty_player_id, '') as player_id,
+			COALESCE(me.period, 0) as period,
+			COALESCE(me.time_minutes, 0) as time_minutes, COALESCE(me.time_seconds, 0) as time_seconds,
+			COALESCE(me.penalty_minutes, 0) as minutes,
+			COALESCE(me.penalty_reason_code, '') as reason_code,
+			COALESCE(me.penalty_reason, '') as reason
+		FROM match_events me
+		JOIN matches m ON m.id = me.match_id
+		WHERE me.event_type = 'penalty'
+	)`
+
+type penaltyRow struct {
+	MatchID      string `db:"match_id"`
+	TournamentID string `db:"tournament_id"`
+	TeamID       string `db:"team_id"`
+	TeamName     string `db:"team_name"`
+	PlayerID     string `db:"player_id"`
+	PlayerName   string `db:"player_name"`
+	Period       int    `db:"period"`
+	TimeMinutes  int    `db:"time_minutes"`
+	TimeSeconds  int    `db:"time_seconds"`
+	Minutes      int    `db:"minutes"`
+	ReasonCode   string `db:"reason_code"`
+	Reason       string `db:"reason"`
+}
+
+func (r penaltyRow) toDomain() domain.PenaltyEvent {
+	return domain.PenaltyEvent{
+		MatchID:      r.MatchID,
+		TournamentID: r.TournamentID,
+		TeamID:       r.TeamID,
+		TeamName:     r.TeamName,
+		PlayerID:     r.PlayerID,
+		PlayerName:   r.PlayerName,
+		Period:       r.Period,
+		Second:       application.GameSecond(r.Period, r.TimeMinutes, r.TimeSeconds),
+		Minutes:      r.Minutes,
+		ReasonCode:   r.ReasonCode,
+		Reason:       r.Reason,
+	}
+}
+
+// DisciplineRepository читает штрафы и голы из match_events
+type DisciplineRepository struct {
+	db *sqlx.DB
+}
+
+// NewDisciplineRepository создаёт репозиторий отчётов о дисциплине
+func NewDisciplineRepository(db *sqlx.DB) *DisciplineRepository {
+	return &DisciplineRepository{db: db}
+}
+
+// LoadPenalties загружает штрафы игрока, команды или турнира
+func (r *DisciplineRepository) LoadPenalties(ctx context.Context, filter application.DisciplineFilter) ([]domain.PenaltyEvent, error) {
+	var scopeColumn string
+	switch filter.Scope {
+	case domain.DisciplineScopePlayer:
+		scopeColumn = "p.player_id"
+	case domain.DisciplineScopeTeam:
+		scopeColumn = "p.team_id"
+	case domain.DisciplineScopeTournament:
+		scopeColumn = "p.tournament_id"
+	default:
+		return nil, fmt.Errorf("unknown discipline scope: %s", filter.Scope)
+	}
+
+	query := `
+		WITH ` + penaltiesCTE + `
+		SELECT p.*, COALESCE(t.name, '') as team_name, COALESCE(pl.name, '') as player_name
+		FROM penalties p
+		LEFT JOIN teams t ON t.id = p.team_id
+		LEFT JOIN players pl ON pl.id = p.player_id
+		WHERE ` + scopeColumn + ` = $1
+			AND ($2 = '' OR p.tournament_id = $2)
+	`
+	var rows []penaltyRow
+	if err := r.db.SelectContext(ctx, &rows, query, filter.ID, filter.TournamentID); err != nil {
+		return nil, fmt.Errorf("select penalties: %w", err)
+	}
+
+	result := make([]domain.PenaltyEvent, len(rows))
+	for i, row := range rows {
+		result[i] = row.toDomain()
+	}
+	return result, nil
+}
+
+// LoadMatchEvents загружает все штрафы и голы указанных матчей
+func (r *DisciplineRepository) LoadMatchEvents(ctx context.Context, matchIDs []string) ([]domain.PenaltyEvent, []domain.MatchGoal, error) {
+	if len(matchIDs) == 0 {
+		return nil, nil, nil
+	}
+
+	penaltiesQuery := `
+		WITH ` + penaltiesCTE + `
+		SELECT p.*, '' as team_name, '' as player_name
+		FROM penalties p
+		WHERE p.match_id = ANY($1)
+	`
+	var penaltyRows []penaltyRow
+	if err := r.db.SelectContext(ctx, &penaltyRows, penaltiesQuery, pq.Array(matchIDs)); err != nil {
+		return nil, nil, fmt.Errorf("select match penalties: %w", err)
+	}
+
+	goalsQuery := `
+		SELECT me.match_id,
+			COALESCE(me.team_id, CASE WHEN me.is_home THEN m.home_team_id ELSE m.away_team_id END, '') as team_id,
+			COALESCE(me.period, 0) as period,
+			COALESCE(me.time_minutes, 0) as time_minutes, COALESCE(me.time_seconds, 0) as time_seconds
+		FROM match_events me
+		JOIN matches m ON m.id = me.match_id
+		WHERE me.event_type = 'goal' AND me.match_id = ANY($1)
+	`
+	var goalRows []timedEventRow
+	if err := r.db.SelectContext(ctx, &goalRows, goalsQuery, pq.Array(matchIDs)); err != nil {
+		return nil, nil, fmt.Errorf("select match goals: %w", err)
+	}
+
+	penalties := make([]domain.PenaltyEvent, len(penaltyRows))
+	for i, row := range penaltyRows {
+		penalties[i] = row.toDomain()
+	}
+	goals := make([]domain.MatchGoal, len(goalRows))
+	for i, row := range goalRows {
+		goals[i] = domain.MatchGoal{MatchID: row.MatchID, TeamID: row.TeamID, Second: row.second()}
+	}
+	return penalties, goals, nil
+}
+
+// CountGames считает матчи с разобранными протоколами в области отчёта
+func (r *DisciplineRepository) CountGames(ctx context.Context, filter application.DisciplineFilter) (int, error) {
+	var query string
+	args := []interface{}{filter.ID, filter.TournamentID}
+	switch filter.Scope {
+	case domain.DisciplineScopePlayer:
+		query = `
+			SELECT COUNT(DISTINCT ml.match_id)
+			FROM match_lineups ml
+			JOIN matches m ON m.id = ml.match_id
+			WHERE ml.player_id = $1 AND m.details_parsed
+				AND ($2 = '' OR m.tournament_id = $2)`
+	case domain.DisciplineScopeTeam:
+		query = `
+			SELECT COUNT(*)
+			FROM matches m
+			WHERE (m.home_team_id = $1 OR m.away_team_id = $1) AND m.details_parsed
+				AND ($2 = '' OR m.tournament_id = $2)`
+	case domain.DisciplineScopeTournament:
+		query = `
+			SELECT COUNT(*)
+			FROM matches m
+			WHERE m.tournament_id = $1 AND m.details_parsed`
+		args = args[:1]
+	default:
+		return 0, fmt.Errorf("unknown discipline scope: %s", filter.Scope)
+	}
+
+	var games int
+	if err := r.db.GetContext(ctx, &games, query, args...); err != nil {
+		return 0, fmt.Errorf("count games: %w", err)
+	}
+	return games, nil
+}
@@ -0,0 +1,78 @@
+// Package gazetteer офлайн-справочник координат арен и городов. Справочник
+// встроен в бинарник (gazetteer.csv): арены не геокодируются внешними сервисами
+package gazetteer
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/application"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+//go:embed gazetteer.csv
+var bundled string
+
+// Gazetteer координаты арен по названию и городу и центров городов
+type Gazetteer struct {
+	arenas map[string]domain.GeoPoint // название|город
+	cities map[string]domain.GeoPoint
+}
+
+// New загружает встроенный справочник
+func New() (*Gazetteer, error) {
+	return Load(strings.NewReader(bundled))
+}
+
+// Load читает справочник в формате CSV: kind,name,city,region,lat,lon.
+// kind - city (name пустой) или arena
+func Load(r io.Reader) (*Gazetteer, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 6
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("read gazetteer: %w", err)
+	}
+
+	g := &Gazetteer{arenas: make(map[string]domain.GeoPoint), cities: make(map[string]domain.GeoPoint)}
+	for i, rec := range records {
+		if i == 0 && rec[0] == "kind" {
+			continue
+		}
+		lat, errLat := strconv.ParseFloat(rec[4], 64)
+		lon, errLon := strconv.ParseFloat(rec[5], 64)
+		if errLat != nil || errLon != nil {
+			return nil, fmt.Errorf("gazetteer line %d: invalid coordinates", i+1)
+		}
+		point := domain.GeoPoint{Lat: lat, Lon: lon}
+
+		switch rec[0] {
+		case "city":
+			g.cities[application.NormalizeVenueName(rec[2])] = point
+		case "arena":
+			g.arenas[arenaKey(rec[1], rec[2])] = point
+		default:
+			return nil, fmt.Errorf("gazetteer line %d: unknown kind %q", i+1, rec[0])
+		}
+	}
+	return g, nil
+}
+
+// Locate ищет арену в городе, затем центр города
+func (g *Gazetteer) Locate(name, city string) (domain.GeoPoint, string, bool) {
+	if point, ok := g.arenas[arenaKey(name, city)]; ok {
+		return point, domain.VenuePrecisionArena, true
+	}
+	if point, ok := g.cities[application.NormalizeVenueName(city)]; ok {
+		return point, domain.VenuePrecisionCity, true
+	}
+	return domain.GeoPoint{}, "", false
+}
+
+func arenaKey(name, city string) string {
+	return application.NormalizeVenueName(name) + "|" + application.NormalizeVenueName(city)
+}
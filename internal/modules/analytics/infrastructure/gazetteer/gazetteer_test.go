@@ -0,0 +1,31 @@
+package gazetteer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+func TestBundledGazetteer(t *testing.T) {
+	g, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, precision, ok := g.Locate("СК «Юбилейный»", "г. Санкт-Петербург"); !ok || precision != domain.VenuePrecisionArena {
+		t.Errorf("arena precision = %q, %v", precision, ok)
+	}
+	if _, precision, ok := g.Locate("ЛД Кристалл", "Королев"); !ok || precision != domain.VenuePrecisionCity {
+		t.Errorf("city precision = %q, %v", precision, ok)
+	}
+	if _, _, ok := g.Locate("Арена", "Атлантида"); ok {
+		t.Error("unknown city located")
+	}
+}
+
+func TestLoadRejectsBadCoordinates(t *testing.T) {
+	if _, err := Load(strings.NewReader("city,,Москва,msk,north,37.6\n")); err == nil {
+		t.Error("expected error for invalid coordinates")
+	}
+}
@@ -0,0 +1,240 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/application"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// goalieLinesCTE игровые строки вратарей из составов и команды, где в матче играл один вратарь
+const goalieLinesCTE = `
+	goalies AS (
+		SELECT ml.match_id, ml.player_id, ml.team_id,
+			COALESCE(ml.saves, 0) as saves,
+			COALESCE(ml.goals_against, 0) as goals_against,
+			COALESCE(ml.time_on_ice, 0) as time_on_ice
+		FROM match_lineups ml
+		WHERE ml.position IN ('G', 'goalkeeper')
+			AND (ml.saves IS NOT NULL OR ml.goals_against IS NOT NULL)
+	),
+	sole_goalies AS (
+		SELECT match_id, team_id, MIN(player_id) as player_id
+		FROM goalies
+		GROUP BY match_id, team_id
+		HAVING COUNT(*) = 1
+	)`
+
+// GoalieRepository читает вратарские данные из match_lineups и match_events
+type GoalieRepository struct {
+	db *sqlx.DB
+}
+
+// NewGoalieRepository создаёт репозиторий вратарской аналитики
+func NewGoalieRepository(db *sqlx.DB) *GoalieRepository {
+	return &GoalieRepository{db: db}
+}
+
+// LoadGoalieGames загружает игровые строки вратарей с бросками соперника по периодам
+func (r *GoalieRepository) LoadGoalieGames(ctx context.Context, filter application.GoalieFilter) ([]domain.GoalieGame, error) {
+	query := `
+		WITH ` + goalieLinesCTE + `
+		SELECT g.match_id, COALESCE(m.tournament_id, '') as tournament_id, g.player_id, p.name, g.team_id,
+			m.scheduled_at, g.saves, g.goals_against, g.time_on_ice,
+			(sg.match_id IS NOT NULL AND COALESCE(opp.shots_total, 0) > 0) as has_period_shots,
+			COALESCE(opp.shots_p1, 0) as shots_p1, COALESCE(opp.shots_p2, 0) as shots_p2,
+			COALESCE(opp.shots_p3, 0) as shots_p3, COALESCE(opp.shots_ot, 0) as shots_ot
+		FROM goalies g
+		JOIN matches m ON m.id = g.match_id
+		JOIN players p ON p.id = g.player_id
+		LEFT JOIN sole_goalies sg ON sg.match_id = g.match_id AND sg.team_id = g.team_id
+		LEFT JOIN match_team_stats opp ON opp.match_id = g.match_id AND opp.team_id <> g.team_id
+		WHERE ($1 = '' OR g.player_id = $1)
+			AND ($2 = '' OR m.tournament_id = $2)
+		ORDER BY m.scheduled_at NULLS LAST, g.match_id
+	`
+
+	var rows []struct {
+		MatchID        string     `db:"match_id"`
+		TournamentID   string     `db:"tournament_id"`
+		PlayerID       string     `db:"player_id"`
+		Name           string     `db:"name"`
+		TeamID         string     `db:"team_id"`
+		ScheduledAt    *time.Time `db:"scheduled_at"`
+		Saves          int        `db:"saves"`
+		GoalsAgainst   int        `db:"goals_against"`
+		TimeOnIce      int        `db:"time_on_ice"`
+		HasPeriodShots bool       `db:"has_period_shots"`
+		ShotsP1        int        `db:"shots_p1"`
+		ShotsP2        int        `db:"shots_p2"`
+		ShotsP3        int        `db:"shots_p3"`
+		ShotsOT        int        `db:"shots_ot"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, filter.PlayerID, filter.TournamentID); err != nil {
+		return nil, fmt.Errorf("select goalie games: %w", err)
+	}
+
+	games := make([]domain.GoalieGame, 0, len(rows))
+	for _, row := range rows {
+		game := domain.GoalieGame{
+			MatchID:      row.MatchID,
+			TournamentID: row.TournamentID,
+			PlayerID:     row.PlayerID,
+			Name:         row.Name,
+			TeamID:       row.TeamID,
+			PlayedAt:     row.ScheduledAt,
+			Saves:        row.Saves,
+			GoalsAgainst: row.GoalsAgainst,
+			TimeOnIce:    row.TimeOnIce,
+		}
+		if row.HasPeriodShots {
+			game.ShotsAgainst = &[domain.PeriodCount]int{row.ShotsP1, row.ShotsP2, row.ShotsP3, row.ShotsOT}
+		}
+		games = append(games, game)
+	}
+	return games, nil
+}
+
+// LoadGoalsAgainst загружает пропущенные голы с привязкой к вратарю.
+//
+// Если вратарь в событии не указан (fhspb), гол относится к единственному вратарю
+// пропустившей команды; голы в пустые ворота вратарю не засчитываются.
+func (r *GoalieRepository) LoadGoalsAgainst(ctx context.Context, filter application.GoalieFilter) ([]domain.GoalAgainst, error) {
+	query := `
+		WITH ` + goalieLinesCTE + `,
+		goals AS (
+			SELECT me.match_id, me.goalie_player_id, COALESCE(me.period, 0) as period,
+				COALESCE(me.goal_type, '') as goal_type, m.tournament_id,
+				CASE
+					WHEN me.team_id = m.home_team_id THEN m.away_team_id
+					WHEN me.team_id = m.away_team_id THEN m.home_team_id
+					WHEN me.is_home THEN m.away_team_id
+					ELSE m.home_team_id
+				END as conceding_team_id
+			FROM match_events me
+			JOIN matches m ON m.id = me.match_id
+			WHERE me.event_type = 'goal'
+		)
+		SELECT gl.match_id, gl.period, gl.goal_type,
+			COALESCE(gl.goalie_player_id, sg.player_id) as goalie_id
+		FROM goals gl
+		LEFT JOIN sole_goalies sg ON sg.match_id = gl.match_id AND sg.team_id = gl.conceding_team_id
+			AND UPPER(gl.goal_type) NOT LIKE 'EN%'
+		WHERE COALESCE(gl.goalie_player_id, sg.player_id) IS NOT NULL
+			AND ($1 = '' OR COALESCE(gl.goalie_player_id, sg.player_id) = $1)
+			AND ($2 = '' OR gl.tournament_id = $2)
+	`
+
+	var rows []struct {
+		MatchID  string `db:"match_id"`
+		Period   int    `db:"period"`
+		GoalType string `db:"goal_type"`
+		GoalieID string `db:"goalie_id"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, filter.PlayerID, filter.TournamentID); err != nil {
+		return nil, fmt.Errorf("select goals against: %w", err)
+	}
+
+	goals := make([]domain.GoalAgainst, len(rows))
+	for i, row := range rows {
+		goals[i] = domain.GoalAgainst{
+			MatchID:  row.MatchID,
+			GoalieID: row.GoalieID,
+			Period:   row.Period,
+			State:    application.GameState(row.GoalType),
+		}
+	}
+	return goals, nil
+}
+
+// LoadLeagueSavePct загружает средний процент отражённых бросков по турнирам
+func (r *GoalieRepository) LoadLeagueSavePct(ctx context.Context, tournamentIDs []string) (map[string]float64, error) {
+	result := make(map[string]float64, len(tournamentIDs))
+	if len(tournamentIDs) == 0 {
+		return result, nil
+	}
+
+	query := `
+		WITH ` + goalieLinesCTE + `
+		SELECT m.tournament_id, SUM(g.saves)::float8 / SUM(g.saves + g.goals_against) as save_pct
+		FROM goalies g
+		JOIN matches m ON m.id = g.match_id
+		WHERE m.tournament_id = ANY($1)
+		GROUP BY m.tournament_id
+		HAVING SUM(g.saves + g.goals_against) > 0
+	`
+
+	var rows []struct {
+		TournamentID string  `db:"tournament_id"`
+		SavePct      float64 `db:"save_pct"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, pq.Array(tournamentIDs)); err != nil {
+		return nil, fmt.Errorf("select league save pct: %w", err)
+	}
+	for _, row := range rows {
+		result[row.TournamentID] = row.SavePct
+	}
+	return result, nil
+}
+
+type timedEventRow struct {
+	MatchID     string `db:"match_id"`
+	TeamID      string `db:"team_id"`
+	Period      int    `db:"period"`
+	TimeMinutes int    `db:"time_minutes"`
+	TimeSeconds int    `db:"time_seconds"`
+}
+
+func (e timedEventRow) second() int {
+	return application.GameSecond(e.Period, e.TimeMinutes, e.TimeSeconds)
+}
+
+// LoadPulls загружает снятия вратаря командой и голы в этих матчах
+func (r *GoalieRepository) LoadPulls(ctx context.Context, teamID, tournamentID string) ([]domain.EmptyNetPull, []domain.MatchGoal, error) {
+	pullsQuery := `
+		SELECT me.match_id, me.team_id, COALESCE(me.period, 0) as period,
+			COALESCE(me.time_minutes, 0) as time_minutes, COALESCE(me.time_seconds, 0) as time_seconds
+		FROM match_events me
+		JOIN matches m ON m.id = me.match_id
+		WHERE me.event_type = 'empty_net' AND me.team_id = $1
+			AND ($2 = '' OR m.tournament_id = $2)
+	`
+	var pullRows []timedEventRow
+	if err := r.db.SelectContext(ctx, &pullRows, pullsQuery, teamID, tournamentID); err != nil {
+		return nil, nil, fmt.Errorf("select pulls: %w", err)
+	}
+	if len(pullRows) == 0 {
+		return nil, nil, nil
+	}
+
+	matchIDs := make([]string, 0, len(pullRows))
+	pulls := make([]domain.EmptyNetPull, len(pullRows))
+	for i, row := range pullRows {
+		matchIDs = append(matchIDs, row.MatchID)
+		pulls[i] = domain.EmptyNetPull{MatchID: row.MatchID, TeamID: row.TeamID, Second: row.second(), Period: row.Period}
+	}
+
+	goalsQuery := `
+		SELECT me.match_id,
+			COALESCE(me.team_id, CASE WHEN me.is_home THEN m.home_team_id ELSE m.away_team_id END, '') as team_id,
+			COALESCE(me.period, 0) as period,
+			COALESCE(me.time_minutes, 0) as time_minutes, COALESCE(me.time_seconds, 0) as time_seconds
+		FROM match_events me
+		JOIN matches m ON m.id = me.match_id
+		WHERE me.event_type = 'goal' AND me.match_id = ANY($1)
+	`
+	var goalRows []timedEventRow
+	if err := r.db.SelectContext(ctx, &goalRows, goalsQuery, pq.Array(matchIDs)); err != nil {
+		return nil, nil, fmt.Errorf("select match goals: %w", err)
+	}
+
+	goals := make([]domain.MatchGoal, len(goalRows))
+	for i, row := range goalRows {
+		goals[i] = domain.MatchGoal{MatchID: row.MatchID, TeamID: row.TeamID, Second: row.second()}
+	}
+	return pulls, goals, nil
+}
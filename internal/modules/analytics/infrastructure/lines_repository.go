@@ -0,0 +1,128 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// LinesRepository читает голы с составами на льду из match_events
+type LinesRepository struct {
+	db *sqlx.DB
+}
+
+// NewLinesRepository создаёт репозиторий сочетаний игроков
+func NewLinesRepository(db *sqlx.DB) *LinesRepository {
+	return &LinesRepository{db: db}
+}
+
+type onIceRow struct {
+	MatchID string `db:"match_id"`
+	TeamID  string `db:"team_id"`
+	IsFor   bool   `db:"is_for"`
+	OnIce   []byte `db:"on_ice"`
+}
+
+// LoadTeamGoals загружает голы в матчах команды с её игроками на льду
+func (r *LinesRepository) LoadTeamGoals(ctx context.Context, teamID, tournamentID string) ([]domain.OnIceGoal, error) {
+	query := `
+		SELECT me.match_id, $1::text as team_id, me.team_id = $1 as is_for,
+			CASE WHEN m.home_team_id = $1 THEN me.home_players_on_ice ELSE me.away_players_on_ice END as on_ice
+		FROM match_events me
+		JOIN matches m ON m.id = me.match_id
+		WHERE me.event_type = 'goal' AND me.team_id IS NOT NULL
+			AND (m.home_team_id = $1 OR m.away_team_id = $1)
+			AND ($2 = '' OR m.tournament_id = $2)
+	`
+	return r.selectGoals(ctx, query, teamID, tournamentID)
+}
+
+// LoadPlayerGoals загружает голы, при которых игрок был на льду
+func (r *LinesRepository) LoadPlayerGoals(ctx context.Context, playerID, tournamentID string) ([]domain.OnIceGoal, error) {
+	query := `
+		WITH player_goals AS (
+			SELECT me.match_id, me.team_id as scoring_team_id,
+				me.home_players_on_ice @> jsonb_build_array($1::text) as on_home,
+				me.home_players_on_ice, me.away_players_on_ice,
+				m.home_team_id, m.away_team_id
+			FROM match_events me
+			JOIN matches m ON m.id = me.match_id
+			WHERE me.event_type = 'goal' AND me.team_id IS NOT NULL
+				AND (me.home_players_on_ice @> jsonb_build_array($1::text)
+					OR me.away_players_on_ice @> jsonb_build_array($1::text))
+				AND ($2 = '' OR m.tournament_id = $2)
+		)
+		SELECT match_id,
+			CASE WHEN on_home THEN home_team_id ELSE away_team_id END as team_id,
+			scoring_team_id = CASE WHEN on_home THEN home_team_id ELSE away_team_id END as is_for,
+			CASE WHEN on_home THEN home_players_on_ice ELSE away_players_on_ice END as on_ice
+		FROM player_goals
+	`
+	return r.selectGoals(ctx, query, playerID, tournamentID)
+}
+
+func (r *LinesRepository) selectGoals(ctx context.Context, query string, args ...interface{}) ([]domain.OnIceGoal, error) {
+	var rows []onIceRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("select on-ice goals: %w", err)
+	}
+
+	goals := make([]domain.OnIceGoal, 0, len(rows))
+	for _, row := range rows {
+		if len(row.OnIce) == 0 {
+			continue
+		}
+		var players []string
+		if err := json.Unmarshal(row.OnIce, &players); err != nil || len(players) == 0 {
+			continue
+		}
+		goals = append(goals, domain.OnIceGoal{
+			MatchID: row.MatchID,
+			TeamID:  row.TeamID,
+			For:     row.IsFor,
+			Players: players,
+		})
+	}
+	return goals, nil
+}
+
+// LoadPlayers загружает имена и позиции игроков
+func (r *LinesRepository) LoadPlayers(ctx context.Context, ids []string) (map[string]domain.LinePlayer, error) {
+	result := make(map[string]domain.LinePlayer, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		ID       string `db:"id"`
+		Name     string `db:"name"`
+		Position string `db:"position"`
+	}
+	query := `SELECT id, name, COALESCE(position, '') as position FROM players WHERE id = ANY($1)`
+	if err := r.db.SelectContext(ctx, &rows, query, pq.Array(ids)); err != nil {
+		return nil, fmt.Errorf("select players: %w", err)
+	}
+
+	for _, row := range rows {
+		result[row.ID] = domain.LinePlayer{ID: row.ID, Name: row.Name, Position: normalizePosition(row.Position)}
+	}
+	return result, nil
+}
+
+// normalizePosition приводит позицию из players к коду F/D/G
+func normalizePosition(position string) string {
+	switch position {
+	case "Нападающий", domain.PositionForward:
+		return domain.PositionForward
+	case "Защитник", domain.PositionDefender:
+		return domain.PositionDefender
+	case "Вратарь", domain.PositionGoalie:
+		return domain.PositionGoalie
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,116 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// StrengthRepository репозиторий коэффициентов силы турниров
+type StrengthRepository struct {
+	db *sqlx.DB
+}
+
+// NewStrengthRepository создаёт новый репозиторий коэффициентов силы
+func NewStrengthRepository(db *sqlx.DB) *StrengthRepository {
+	return &StrengthRepository{db: db}
+}
+
+// LoadObservations загружает результативность игроков и команд, выступавших
+// в нескольких турнирах одного сезона (по player_teams и team_standings)
+func (r *StrengthRepository) LoadObservations(ctx context.Context, minGames int) ([]domain.StrengthObservation, error) {
+	query := `
+		WITH multi_players AS (
+			SELECT pt.player_id, t.season
+			FROM player_teams pt
+			JOIN tournaments t ON t.id = pt.tournament_id
+			WHERE COALESCE(t.season, '') != ''
+			GROUP BY pt.player_id, t.season
+			HAVING COUNT(DISTINCT pt.tournament_id) > 1
+		),
+		player_rows AS (
+			SELECT ps.player_id, ps.tournament_id, ps.birth_year, ps.games, ps.points
+			FROM player_statistics ps
+			WHERE ps.group_name != 'Общая статистика'
+				OR NOT EXISTS (
+					SELECT 1 FROM player_statistics g
+					WHERE g.player_id = ps.player_id AND g.tournament_id = ps.tournament_id
+						AND g.birth_year = ps.birth_year AND g.group_name != 'Общая статистика'
+				)
+		),
+		multi_teams AS (
+			SELECT ts.team_id, t.season
+			FROM team_standings ts
+			JOIN tournaments t ON t.id = ts.tournament_id
+			WHERE COALESCE(t.season, '') != '' AND ts.birth_year IS NOT NULL
+			GROUP BY ts.team_id, t.season
+			HAVING COUNT(DISTINCT ts.tournament_id) > 1
+		)
+		SELECT t.season, 'player:' || pr.player_id as entity_id, pr.tournament_id, pr.birth_year,
+			SUM(pr.games)::int as games, SUM(pr.points)::int as scored
+		FROM player_rows pr
+		JOIN tournaments t ON t.id = pr.tournament_id
+		JOIN multi_players mp ON mp.player_id = pr.player_id AND mp.season = t.season
+		GROUP BY t.season, pr.player_id, pr.tournament_id, pr.birth_year
+		HAVING SUM(pr.games) >= $1
+		UNION ALL
+		SELECT t.season, 'team:' || ts.team_id, ts.tournament_id, ts.birth_year,
+			SUM(ts.games)::int, SUM(ts.goals_for)::int
+		FROM team_standings ts
+		JOIN tournaments t ON t.id = ts.tournament_id
+		JOIN multi_teams mt ON mt.team_id = ts.team_id AND mt.season = t.season
+		WHERE ts.birth_year IS NOT NULL
+		GROUP BY t.season, ts.team_id, ts.tournament_id, ts.birth_year
+		HAVING SUM(ts.games) >= $1
+	`
+
+	rows, err := r.db.QueryxContext(ctx, query, minGames)
+	if err != nil {
+		return nil, fmt.Errorf("load strength observations: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []domain.StrengthObservation
+	for rows.Next() {
+		var o domain.StrengthObservation
+		if err := rows.Scan(&o.Season, &o.EntityID, &o.TournamentID, &o.BirthYear, &o.Games, &o.Scored); err != nil {
+			return nil, fmt.Errorf("scan strength observation: %w", err)
+		}
+		result = append(result, o)
+	}
+	return result, rows.Err()
+}
+
+// ReplaceSeasons перезаписывает коэффициенты пересчитанных сезонов в одной транзакции
+func (r *StrengthRepository) ReplaceSeasons(ctx context.Context, seasons []string, coefficients []domain.StrengthCoefficient) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM tournament_strength WHERE season = ANY($1)`, pq.Array(seasons)); err != nil {
+		return fmt.Errorf("delete strength: %w", err)
+	}
+
+	query := `
+		INSERT INTO tournament_strength (tournament_id, birth_year, season, coefficient, shared_entities, games, computed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		ON CONFLICT (tournament_id, birth_year) DO UPDATE SET
+			season = EXCLUDED.season, coefficient = EXCLUDED.coefficient,
+			shared_entities = EXCLUDED.shared_entities, games = EXCLUDED.games,
+			computed_at = NOW()
+	`
+	for _, c := range coefficients {
+		if _, err := tx.ExecContext(ctx, query,
+			c.TournamentID, c.BirthYear, c.Season, c.Coefficient, c.SharedEntities, c.Games,
+		); err != nil {
+			return fmt.Errorf("insert strength %s/%d: %w", c.TournamentID, c.BirthYear, err)
+		}
+	}
+
+	return tx.Commit()
+}
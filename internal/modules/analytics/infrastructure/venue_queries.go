@@ -0,0 +1,135 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+)
+
+// SearchVenues ищет арены по подстроке названия и городу
+func (r *VenueRepository) SearchVenues(ctx context.Context, query, city string, limit int) ([]domain.Venue, error) {
+	var rows []venueRow
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT `+venueColumns+`
+		FROM venues v
+		LEFT JOIN venue_aliases a ON a.venue_id = v.id
+		WHERE ($1 = '' OR v.name ILIKE '%' || $1 || '%'
+		       OR EXISTS (SELECT 1 FROM venue_aliases s WHERE s.venue_id = v.id AND s.alias ILIKE '%' || $1 || '%'))
+		  AND ($2 = '' OR v.city ILIKE $2)
+		GROUP BY v.id
+		ORDER BY v.city, v.name, v.id
+		LIMIT $3`, query, city, limit)
+	if err != nil {
+		return nil, fmt.Errorf("search venues: %w", err)
+	}
+	return venuesToDomain(rows), nil
+}
+
+// GetVenue возвращает арену с названиями, nil если её нет
+func (r *VenueRepository) GetVenue(ctx context.Context, id string) (*domain.Venue, error) {
+	var row venueRow
+	err := r.db.GetContext(ctx, &row, `
+		SELECT `+venueColumns+`
+		FROM venues v
+		LEFT JOIN venue_aliases a ON a.venue_id = v.id
+		WHERE v.id = $1
+		GROUP BY v.id`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get venue: %w", err)
+	}
+	venue := row.toDomain()
+	return &venue, nil
+}
+
+// VenueGames возвращает матчи арены, новые первыми
+func (r *VenueRepository) VenueGames(ctx context.Context, venueID string, limit int) ([]domain.VenueGame, error) {
+	rows, err := r.db.QueryxContext(ctx, `
+		SELECT m.id, m.scheduled_at, COALESCE(m.home_team_id, ''), COALESCE(m.away_team_id, ''),
+			COALESCE(ht.name, ''), COALESCE(at.name, ''),
+			m.home_score, m.away_score, COALESCE(t.name, ''), COALESCE(m.status, 'scheduled')
+		FROM matches m
+		LEFT JOIN teams ht ON ht.id = m.home_team_id
+		LEFT JOIN teams at ON at.id = m.away_team_id
+		LEFT JOIN tournaments t ON t.id = m.tournament_id
+		WHERE m.venue_id = $1
+		ORDER BY m.scheduled_at DESC NULLS LAST, m.id
+		LIMIT $2`, venueID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("load venue games: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []domain.VenueGame
+	for rows.Next() {
+		var g domain.VenueGame
+		if err := rows.Scan(&g.MatchID, &g.ScheduledAt, &g.HomeTeamID, &g.AwayTeamID, &g.HomeTeam, &g.AwayTeam,
+			&g.HomeScore, &g.AwayScore, &g.Tournament, &g.Status); err != nil {
+			return nil, fmt.Errorf("scan venue game: %w", err)
+		}
+		result = append(result, g)
+	}
+	return result, rows.Err()
+}
+
+// teamVenueGameRow матч команды с ареной; поля арены пустые, если матч не привязан
+type teamVenueGameRow struct {
+	MatchID     string     `db:"match_id"`
+	ScheduledAt *time.Time `db:"scheduled_at"`
+	Home        bool       `db:"home"`
+	VenueID     *string    `db:"venue_id"`
+	venueRow
+}
+
+// TeamSeasonGames возвращает сезон и матчи команды в нём; пустой сезон - последний сезон команды
+func (r *VenueRepository) TeamSeasonGames(ctx context.Context, teamID, season string) (string, []domain.TeamVenueGame, error) {
+	if season == "" {
+		err := r.db.GetContext(ctx, &season, `
+			SELECT COALESCE(MAX(t.season), '')
+			FROM matches m
+			JOIN tournaments t ON t.id = m.tournament_id
+			WHERE m.home_team_id = $1 OR m.away_team_id = $1`, teamID)
+		if err != nil {
+			return "", nil, fmt.Errorf("get team season: %w", err)
+		}
+	}
+
+	var rows []teamVenueGameRow
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT m.id AS match_id, m.scheduled_at, COALESCE(m.home_team_id = $1, false) AS home, m.venue_id,
+			COALESCE(v.id, '') AS id, COALESCE(v.name, '') AS name,
+			COALESCE(v.city, '') AS city, COALESCE(v.region, '') AS region,
+			v.latitude, v.longitude, COALESCE(v.precision, '') AS precision, '{}'::text[] AS aliases
+		FROM matches m
+		JOIN tournaments t ON t.id = m.tournament_id
+		LEFT JOIN venues v ON v.id = m.venue_id
+		WHERE (m.home_team_id = $1 OR m.away_team_id = $1) AND t.season = $2
+		ORDER BY m.scheduled_at NULLS LAST, m.id`, teamID, season)
+	if err != nil {
+		return "", nil, fmt.Errorf("load team games: %w", err)
+	}
+
+	result := make([]domain.TeamVenueGame, len(rows))
+	for i, row := range rows {
+		result[i] = domain.TeamVenueGame{MatchID: row.MatchID, ScheduledAt: row.ScheduledAt, Home: row.Home}
+		if row.VenueID != nil {
+			venue := row.toDomain()
+			result[i].Venue = &venue
+		}
+	}
+	return season, result, nil
+}
+
+func venuesToDomain(rows []venueRow) []domain.Venue {
+	result := make([]domain.Venue, len(rows))
+	for i, v := range rows {
+		result[i] = v.toDomain()
+	}
+	return result
+}
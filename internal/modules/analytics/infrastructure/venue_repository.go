@@ -0,0 +1,150 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// VenueRepository репозиторий арен и привязки к ним матчей
+type VenueRepository struct {
+	db *sqlx.DB
+}
+
+// NewVenueRepository создаёт новый репозиторий арен
+func NewVenueRepository(db *sqlx.DB) *VenueRepository {
+	return &VenueRepository{db: db}
+}
+
+// venueRow строка арены с названиями из матчей
+type venueRow struct {
+	ID        string         `db:"id"`
+	Name      string         `db:"name"`
+	City      string         `db:"city"`
+	Region    string         `db:"region"`
+	Latitude  *float64       `db:"latitude"`
+	Longitude *float64       `db:"longitude"`
+	Precision string         `db:"precision"`
+	Aliases   pq.StringArray `db:"aliases"`
+}
+
+func (v venueRow) toDomain() domain.Venue {
+	venue := domain.Venue{
+		ID: v.ID, Name: v.Name, City: v.City, Region: v.Region,
+		Precision: v.Precision, Aliases: v.Aliases,
+	}
+	if v.Latitude != nil && v.Longitude != nil {
+		venue.Location = &domain.GeoPoint{Lat: *v.Latitude, Lon: *v.Longitude}
+	}
+	return venue
+}
+
+const venueColumns = `
+	v.id, v.name, COALESCE(v.city, '') AS city, COALESCE(v.region, '') AS region,
+	v.latitude, v.longitude, COALESCE(v.precision, '') AS precision,
+	COALESCE(array_agg(a.alias ORDER BY a.alias) FILTER (WHERE a.alias IS NOT NULL), '{}') AS aliases`
+
+// LoadVenues загружает все арены с названиями
+func (r *VenueRepository) LoadVenues(ctx context.Context) ([]domain.Venue, error) {
+	var rows []venueRow
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT `+venueColumns+`
+		FROM venues v
+		LEFT JOIN venue_aliases a ON a.venue_id = v.id
+		GROUP BY v.id`)
+	if err != nil {
+		return nil, fmt.Errorf("load venues: %w", err)
+	}
+	return venuesToDomain(rows), nil
+}
+
+// LoadUnlinkedVenueTexts загружает названия арен матчей без арены с самым частым
+// городом и регионом команды хозяев
+func (r *VenueRepository) LoadUnlinkedVenueTexts(ctx context.Context) ([]domain.VenueText, error) {
+	rows, err := r.db.QueryxContext(ctx, `
+		SELECT m.venue,
+			COALESCE(mode() WITHIN GROUP (ORDER BY ht.city), ''),
+			COALESCE(mode() WITHIN GROUP (ORDER BY ht.region), ''),
+			COUNT(*)
+		FROM matches m
+		LEFT JOIN teams ht ON ht.id = m.home_team_id
+		WHERE m.venue_id IS NULL AND btrim(COALESCE(m.venue, '')) <> ''
+		GROUP BY m.venue
+		ORDER BY COUNT(*) DESC, m.venue`)
+	if err != nil {
+		return nil, fmt.Errorf("load venue texts: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []domain.VenueText
+	for rows.Next() {
+		var t domain.VenueText
+		if err := rows.Scan(&t.Text, &t.HomeCity, &t.Region, &t.Matches); err != nil {
+			return nil, fmt.Errorf("scan venue text: %w", err)
+		}
+		result = append(result, t)
+	}
+	return result, rows.Err()
+}
+
+// CreateVenue создаёт арену и привязывает к ней матчи с названием text
+func (r *VenueRepository) CreateVenue(ctx context.Context, venue *domain.Venue, text string) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var lat, lon *float64
+	if venue.Location != nil {
+		lat, lon = &venue.Location.Lat, &venue.Location.Lon
+	}
+	err = tx.GetContext(ctx, &venue.ID, `
+		INSERT INTO venues (name, city, region, latitude, longitude, precision)
+		VALUES ($1, NULLIF($2, ''), NULLIF($3, ''), $4, $5, NULLIF($6, ''))
+		RETURNING id`, venue.Name, venue.City, venue.Region, lat, lon, venue.Precision)
+	if err != nil {
+		return 0, fmt.Errorf("insert venue: %w", err)
+	}
+	n, err := linkVenue(ctx, tx, venue.ID, text)
+	if err != nil {
+		return 0, err
+	}
+	venue.Aliases = []string{text}
+	return n, tx.Commit()
+}
+
+// LinkVenue добавляет название арене и привязывает к ней матчи с этим названием
+func (r *VenueRepository) LinkVenue(ctx context.Context, venueID, text string) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	n, err := linkVenue(ctx, tx, venueID, text)
+	if err != nil {
+		return 0, err
+	}
+	return n, tx.Commit()
+}
+
+func linkVenue(ctx context.Context, tx *sqlx.Tx, venueID, text string) (int, error) {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO venue_aliases (alias, venue_id) VALUES ($1, $2)
+		ON CONFLICT (alias) DO NOTHING`, text, venueID)
+	if err != nil {
+		return 0, fmt.Errorf("insert venue alias: %w", err)
+	}
+	res, err := tx.ExecContext(ctx, `
+		UPDATE matches SET venue_id = $1, updated_at = NOW()
+		WHERE venue = $2 AND venue_id IS NULL`, venueID, text)
+	if err != nil {
+		return 0, fmt.Errorf("link matches: %w", err)
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
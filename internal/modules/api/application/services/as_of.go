@@ -0,0 +1,27 @@
+package services
+
+import (
+	"fmt"
+	"time"
+)
+
+// asOfRelation returns the relation a versioned table is read from: the table itself,
+// or, when asOf is set, its versions that were valid at that moment. The moment is
+// bound to placeholder $arg, so callers append asOf to their arguments only when it is set.
+func asOfRelation(table string, asOf *time.Time, arg int) string {
+	if asOf == nil {
+		return table
+	}
+	return fmt.Sprintf(
+		"(SELECT * FROM %[1]s_versions WHERE valid_from <= $%[2]d AND (valid_to IS NULL OR valid_to > $%[2]d))",
+		table, arg,
+	)
+}
+
+// withAsOf appends asOf to query arguments when it is set.
+func withAsOf(args []interface{}, asOf *time.Time) []interface{} {
+	if asOf == nil {
+		return args
+	}
+	return append(args, *asOf)
+}
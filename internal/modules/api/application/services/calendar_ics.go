@@ -0,0 +1,124 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	calendarTZID = "Europe/Moscow"
+	// calendarMatchLength is the event length used for matches, the sources have no end time.
+	calendarMatchLength = 2 * time.Hour
+	icsLineLimit        = 75
+)
+
+// Moscow is the timezone of match schedules and calendar days; Moscow has had no DST
+// since 2014, so the fixed offset fallback is exact when the system has no tzdata.
+var Moscow = func() *time.Location {
+	if loc, err := time.LoadLocation(calendarTZID); err == nil {
+		return loc
+	}
+	return time.FixedZone("MSK", 3*60*60)
+}()
+
+var resultTypeLabels = map[string]string{"OT": " (ОТ)", "SO": " (Б)"}
+
+// RenderICS renders the feed as an iCalendar (RFC 5545) document. Output depends only on
+// the feed contents, so it can be hashed for ETags.
+func RenderICS(feed CalendarFeed) []byte {
+	var b bytes.Buffer
+	w := func(line string) { writeICSLine(&b, line) }
+
+	w("BEGIN:VCALENDAR")
+	w("VERSION:2.0")
+	w("PRODID:-//HockeyProject//Schedule//RU")
+	w("CALSCALE:GREGORIAN")
+	w("METHOD:PUBLISH")
+	w("X-WR-CALNAME:" + escapeICS(feed.Name))
+	w("X-WR-TIMEZONE:" + calendarTZID)
+	w("BEGIN:VTIMEZONE")
+	w("TZID:" + calendarTZID)
+	w("BEGIN:STANDARD")
+	w("DTSTART:19700101T000000")
+	w("TZOFFSETFROM:+0300")
+	w("TZOFFSETTO:+0300")
+	w("TZNAME:MSK")
+	w("END:STANDARD")
+	w("END:VTIMEZONE")
+	for _, m := range feed.Matches {
+		writeICSEvent(w, m)
+	}
+	w("END:VCALENDAR")
+	return b.Bytes()
+}
+
+func writeICSEvent(w func(string), m CalendarMatch) {
+	modified := m.ScheduledAt
+	if m.UpdatedAt != nil {
+		modified = *m.UpdatedAt
+	}
+	start := m.ScheduledAt.In(Moscow)
+
+	w("BEGIN:VEVENT")
+	w("UID:" + m.ID + "@hockeyproject")
+	w("DTSTAMP:" + modified.UTC().Format("20060102T150405Z"))
+	w("LAST-MODIFIED:" + modified.UTC().Format("20060102T150405Z"))
+	// Clients replace an event only when SEQUENCE grows: minutes since epoch of the last update
+	w(fmt.Sprintf("SEQUENCE:%d", modified.Unix()/60))
+	if start.Hour() == 0 && start.Minute() == 0 {
+		// The source gave the date only
+		w("DTSTART;VALUE=DATE:" + start.Format("20060102"))
+		w("DTEND;VALUE=DATE:" + start.AddDate(0, 0, 1).Format("20060102"))
+	} else {
+		w("DTSTART;TZID=" + calendarTZID + ":" + start.Format("20060102T150405"))
+		w("DTEND;TZID=" + calendarTZID + ":" + start.Add(calendarMatchLength).Format("20060102T150405"))
+	}
+	w("SUMMARY:" + escapeICS(matchSummary(m)))
+	if m.Venue != "" {
+		w("LOCATION:" + escapeICS(m.Venue))
+	}
+	if m.Tournament != "" {
+		w("DESCRIPTION:" + escapeICS(m.Tournament))
+	}
+	if m.Status == "cancelled" {
+		w("STATUS:CANCELLED")
+	} else {
+		w("STATUS:CONFIRMED")
+	}
+	w("END:VEVENT")
+}
+
+// matchSummary is "Home — Away" before the match and "Home 3:2 Away" once it is finished.
+func matchSummary(m CalendarMatch) string {
+	if m.Status == "finished" && m.HomeScore != nil && m.AwayScore != nil {
+		return fmt.Sprintf("%s %d:%d %s%s", m.HomeTeam, *m.HomeScore, *m.AwayScore, m.AwayTeam, resultTypeLabels[m.ResultType])
+	}
+	return m.HomeTeam + " — " + m.AwayTeam
+}
+
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeICS(s string) string {
+	return icsEscaper.Replace(s)
+}
+
+// writeICSLine writes a content line folded at 75 octets without splitting UTF-8 characters.
+func writeICSLine(b *bytes.Buffer, line string) {
+	limit := icsLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space that counts towards the limit
+		limit = icsLineLimit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
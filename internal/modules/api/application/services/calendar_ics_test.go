@@ -0,0 +1,63 @@
+package services
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestRenderICS(t *testing.T) {
+	home, away := 3, 2
+	updated := time.Date(2026, 10, 12, 18, 0, 0, 0, time.UTC)
+	feed := CalendarFeed{
+		Name: "СКА-Стрельна",
+		Matches: []CalendarMatch{
+			{
+				ID: "m1", ScheduledAt: time.Date(2026, 10, 12, 12, 30, 0, 0, time.UTC), UpdatedAt: &updated,
+				HomeTeam: "СКА-Стрельна", AwayTeam: "Динамо", HomeScore: &home, AwayScore: &away,
+				ResultType: "OT", Status: "finished", Tournament: "Первенство СПб", Venue: "Ледовый дворец, Санкт-Петербург",
+			},
+			{
+				ID: "m2", ScheduledAt: time.Date(2026, 10, 19, 21, 0, 0, 0, time.UTC),
+				HomeTeam: "Динамо", AwayTeam: "СКА-Стрельна", Status: "scheduled",
+			},
+		},
+	}
+
+	ics := string(RenderICS(feed))
+	for _, want := range []string{
+		"UID:m1@hockeyproject\r\n",
+		"DTSTART;TZID=Europe/Moscow:20261012T153000\r\n",
+		"DTEND;TZID=Europe/Moscow:20261012T173000\r\n",
+		"SUMMARY:СКА-Стрельна 3:2 Динамо (ОТ)\r\n",
+		"UID:m2@hockeyproject\r\n",
+		// Midnight in Moscow means the source gave the date only
+		"DTSTART;VALUE=DATE:20261020\r\n",
+		"SUMMARY:Динамо — СКА-Стрельна\r\n",
+	} {
+		if !strings.Contains(ics, want) {
+			t.Errorf("ICS does not contain %q", want)
+		}
+	}
+	if strings.Contains(ics, "\n\n") || !strings.HasSuffix(ics, "END:VCALENDAR\r\n") {
+		t.Error("ICS must use CRLF line endings")
+	}
+}
+
+func TestWriteICSLineFolds(t *testing.T) {
+	line := "LOCATION:" + escapeICS(strings.Repeat("Ледовая арена, ", 10))
+	var b bytes.Buffer
+	writeICSLine(&b, line)
+
+	out := b.String()
+	for _, l := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		if len(l) > icsLineLimit || !utf8.ValidString(l) {
+			t.Errorf("bad folded line %q (%d octets)", l, len(l))
+		}
+	}
+	if got := strings.ReplaceAll(out, "\r\n ", ""); got != line+"\r\n" {
+		t.Errorf("unfolded line = %q", got)
+	}
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// calendarHistory limits feeds to recent and upcoming matches so calendar clients stay fast.
+const calendarHistory = 365 * 24 * time.Hour
+
+// CalendarFilter holds optional calendar feed filters.
+type CalendarFilter struct {
+	TournamentID string
+	BirthYear    int
+}
+
+// CalendarMatch is a match rendered as a calendar event.
+type CalendarMatch struct {
+	ID          string     `db:"id"`
+	ScheduledAt time.Time  `db:"scheduled_at"`
+	UpdatedAt   *time.Time `db:"updated_at"`
+	HomeTeam    string     `db:"home_team"`
+	AwayTeam    string     `db:"away_team"`
+	HomeScore   *int       `db:"home_score"`
+	AwayScore   *int       `db:"away_score"`
+	ResultType  string     `db:"result_type"`
+	Status      string     `db:"status"`
+	Tournament  string     `db:"tournament_name"`
+	Venue       string     `db:"venue"`
+}
+
+// CalendarFeed is a named list of matches for a team or player.
+type CalendarFeed struct {
+	Name    string
+	Matches []CalendarMatch
+}
+
+// CalendarService builds schedule feeds for teams and players.
+type CalendarService struct {
+	db *sqlx.DB
+}
+
+// NewCalendarService creates a new calendar service.
+func NewCalendarService(db *sqlx.DB) *CalendarService {
+	return &CalendarService{db: db}
+}
+
+// TeamCalendar returns the team's matches. Returns nil if the team does not exist.
+func (s *CalendarService) TeamCalendar(ctx context.Context, teamID string, filter CalendarFilter) (*CalendarFeed, error) {
+	var name string
+	err := s.db.GetContext(ctx, &name, `SELECT name FROM teams WHERE id = $1`, teamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	matches, err := s.matches(ctx, "(m.home_team_id = $1 OR m.away_team_id = $1)", teamID, filter)
+	if err != nil {
+		return nil, err
+	}
+	return &CalendarFeed{Name: titleCase(name), Matches: matches}, nil
+}
+
+// PlayerCalendar returns matches of the teams the player is registered for in each tournament.
+// Returns nil if the player does not exist.
+func (s *CalendarService) PlayerCalendar(ctx context.Context, playerID string, filter CalendarFilter) (*CalendarFeed, error) {
+	var name string
+	err := s.db.GetContext(ctx, &name, `SELECT name FROM players WHERE id = $1`, playerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player: %w", err)
+	}
+
+	matches, err := s.matches(ctx, `EXISTS (
+		SELECT 1 FROM player_teams pt
+		WHERE pt.player_id = $1 AND pt.tournament_id = m.tournament_id
+		  AND pt.team_id IN (m.home_team_id, m.away_team_id))`, playerID, filter)
+	if err != nil {
+		return nil, err
+	}
+	return &CalendarFeed{Name: name, Matches: matches}, nil
+}
+
+func (s *CalendarService) matches(ctx context.Context, subject, id string, filter CalendarFilter) ([]CalendarMatch, error) {
+	where := []string{subject, "m.scheduled_at IS NOT NULL", "m.scheduled_at >= $2"}
+	args := []interface{}{id, time.Now().Add(-calendarHistory)}
+	argN := 3
+
+	if filter.TournamentID != "" {
+		where = append(where, fmt.Sprintf("m.tournament_id = $%d", argN))
+		args = append(args, filter.TournamentID)
+		argN++
+	}
+	if filter.BirthYear > 0 {
+		where = append(where, fmt.Sprintf("m.birth_year = $%d", argN))
+		args = append(args, filter.BirthYear)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT m.id, m.scheduled_at, m.updated_at,
+			COALESCE(ht.name, '') as home_team, COALESCE(at.name, '') as away_team,
+			m.home_score, m.away_score, COALESCE(m.result_type, '') as result_type,
+			COALESCE(m.status, 'scheduled') as status, COALESCE(t.name, '') as tournament_name,
+			COALESCE(NULLIF(concat_ws(', ', v.name, v.city), ''), m.venue, '') as venue
+		FROM matches m
+		LEFT JOIN teams ht ON m.home_team_id = ht.id
+		LEFT JOIN teams at ON m.away_team_id = at.id
+		LEFT JOIN tournaments t ON m.tournament_id = t.id
+		LEFT JOIN venues v ON m.venue_id = v.id
+		WHERE %s
+		ORDER BY m.scheduled_at, m.id
+	`, strings.Join(where, " AND "))
+
+	var rows []CalendarMatch
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get calendar matches: %w", err)
+	}
+	for i := range rows {
+		rows[i].HomeTeam = titleCase(rows[i].HomeTeam)
+		rows[i].AwayTeam = titleCase(rows[i].AwayTeam)
+		rows[i].Tournament = titleCase(rows[i].Tournament)
+	}
+	return rows, nil
+}
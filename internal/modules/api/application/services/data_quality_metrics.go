@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// RegisterMetrics registers the open data quality violations gauge.
+// The gauge is read from quality_violations on every scrape.
+func (s *DataQualityService) RegisterMetrics() error {
+	meter := otel.Meter("hockey-data-quality")
+
+	_, err := meter.Int64ObservableGauge(
+		"data_quality_violations",
+		metric.WithDescription("Open data quality violations by rule, entity and severity"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			summary, err := s.Summary(ctx)
+			if err != nil {
+				return err
+			}
+			for _, rule := range summary {
+				o.Observe(int64(rule.Open), metric.WithAttributes(
+					attribute.String("rule", rule.Rule),
+					attribute.String("entity", rule.Entity),
+					attribute.String("severity", rule.Severity),
+				))
+			}
+			return nil
+		}),
+	)
+	return err
+}
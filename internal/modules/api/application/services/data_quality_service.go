@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ErrInvalidQualityFilter is returned for an unknown violation severity.
+var ErrInvalidQualityFilter = errors.New("invalid quality filter")
+
+// QualityViolation is a data quality rule violation by a single row, recorded by the scheduler.
+type QualityViolation struct {
+	ID          int64      `db:"id"`
+	Rule        string     `db:"rule"`
+	Entity      string     `db:"entity"`
+	EntityID    string     `db:"entity_id"`
+	Severity    string     `db:"severity"`
+	Details     string     `db:"details"`
+	JobName     *string    `db:"job_name"`
+	RunID       *string    `db:"run_id"`
+	FirstSeenAt time.Time  `db:"first_seen_at"`
+	LastSeenAt  time.Time  `db:"last_seen_at"`
+	ResolvedAt  *time.Time `db:"resolved_at"`
+}
+
+// QualityRuleSummary is the number of open violations of a rule.
+type QualityRuleSummary struct {
+	Rule        string    `db:"rule"`
+	Entity      string    `db:"entity"`
+	Severity    string    `db:"severity"`
+	Open        int       `db:"open"`
+	FirstSeenAt time.Time `db:"first_seen_at"`
+	LastSeenAt  time.Time `db:"last_seen_at"`
+}
+
+// QualityFilter filters violations. Resolved selects fixed violations instead of open ones.
+type QualityFilter struct {
+	Rule     string
+	Entity   string
+	Severity string
+	Resolved bool
+	Limit    int
+	Offset   int
+}
+
+// DataQualityService reads data quality violations found by the scheduler's rule engine.
+type DataQualityService struct {
+	db *sqlx.DB
+}
+
+// NewDataQualityService creates a new data quality service.
+func NewDataQualityService(db *sqlx.DB) *DataQualityService {
+	return &DataQualityService{db: db}
+}
+
+// Violations returns violations, most recently seen first, and the total number of matching violations.
+func (s *DataQualityService) Violations(ctx context.Context, filter QualityFilter) ([]QualityViolation, int, error) {
+	switch filter.Severity {
+	case "", "info", "warning", "critical":
+	default:
+		return nil, 0, fmt.Errorf("%w: unknown severity %s", ErrInvalidQualityFilter, filter.Severity)
+	}
+	if filter.Limit <= 0 || filter.Limit > 500 {
+		filter.Limit = 100
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	const where = `
+		WHERE ($1 = '' OR rule = $1) AND ($2 = '' OR entity = $2) AND ($3 = '' OR severity = $3)
+		  AND (resolved_at IS NOT NULL) = $4`
+	args := []interface{}{filter.Rule, filter.Entity, filter.Severity, filter.Resolved}
+
+	var total int
+	if err := s.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM quality_violations`+where, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count quality violations: %w", err)
+	}
+
+	violations := []QualityViolation{}
+	err := s.db.SelectContext(ctx, &violations, `
+		SELECT id, rule, entity, entity_id, severity, details, job_name, run_id::text AS run_id,
+		       first_seen_at, last_seen_at, resolved_at
+		FROM quality_violations`+where+`
+		ORDER BY last_seen_at DESC, id DESC
+		LIMIT $5 OFFSET $6`,
+		append(args, filter.Limit, filter.Offset)...,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list quality violations: %w", err)
+	}
+	return violations, total, nil
+}
+
+// Summary returns open violation counts per rule, critical rules first.
+func (s *DataQualityService) Summary(ctx context.Context) ([]QualityRuleSummary, error) {
+	summary := []QualityRuleSummary{}
+	err := s.db.SelectContext(ctx, &summary, `
+		SELECT rule, entity, severity, COUNT(*) AS open,
+		       MIN(first_seen_at) AS first_seen_at, MAX(last_seen_at) AS last_seen_at
+		FROM quality_violations
+		WHERE resolved_at IS NULL
+		GROUP BY rule, entity, severity
+		ORDER BY CASE severity WHEN 'critical' THEN 0 WHEN 'warning' THEN 1 ELSE 2 END, open DESC, rule`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize quality violations: %w", err)
+	}
+	return summary, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -26,6 +27,7 @@ type MatchRow struct {
 	ScheduledAt *time.Time `db:"scheduled_at"`
 	Tournament  string     `db:"tournament_name"`
 	Venue       string     `db:"venue"`
+	VenueID     string     `db:"venue_id"`
 	Status      string     `db:"status"`
 }
 
@@ -46,6 +48,7 @@ type RankedPlayerRow struct {
 	Points         int       `db:"points"`
 	PlusMinus      int       `db:"plus_minus"`
 	PenaltyMinutes int       `db:"penalty_minutes"`
+	AdjustedPoints float64   `db:"adjusted_points"`
 }
 
 // RankingsResult holds rankings with season info.
@@ -64,20 +67,20 @@ func NewExploreMatchesService(db *sqlx.DB) *ExploreMatchesService {
 	return &ExploreMatchesService{db: db}
 }
 
-// GetRecentResults returns recently finished matches.
-func (s *ExploreMatchesService) GetRecentResults(ctx context.Context, tournament string, limit int) ([]MatchRow, error) {
+// GetRecentResults returns recently finished matches, optionally played in a city.
+func (s *ExploreMatchesService) GetRecentResults(ctx context.Context, tournament, city string, limit int) ([]MatchRow, error) {
 	if limit <= 0 {
 		limit = 20
 	}
-	return s.getMatches(ctx, "finished", tournament, limit, "m.scheduled_at DESC NULLS LAST")
+	return s.getMatches(ctx, "finished", tournament, city, limit, "m.scheduled_at DESC NULLS LAST")
 }
 
-// GetUpcomingMatches returns upcoming scheduled matches.
-func (s *ExploreMatchesService) GetUpcomingMatches(ctx context.Context, tournament string, limit int) ([]MatchRow, error) {
+// GetUpcomingMatches returns upcoming scheduled matches, optionally played in a city.
+func (s *ExploreMatchesService) GetUpcomingMatches(ctx context.Context, tournament, city string, limit int) ([]MatchRow, error) {
 	if limit <= 0 {
 		limit = 20
 	}
-	return s.getMatches(ctx, "scheduled", tournament, limit, "m.scheduled_at ASC NULLS LAST")
+	return s.getMatches(ctx, "scheduled", tournament, city, limit, "m.scheduled_at ASC NULLS LAST")
 }
 
 // GetTournamentMatches returns matches for a specific tournament with optional filters.
@@ -107,7 +110,7 @@ func (s *ExploreMatchesService) GetTournamentMatches(ctx context.Context, tourna
 			COALESCE(ht.logo_url, '') as home_logo_url, COALESCE(at.logo_url, '') as away_logo_url,
 			m.home_score, m.away_score, COALESCE(m.result_type, '') as result_type, m.scheduled_at,
 			COALESCE(t.name, '') as tournament_name, COALESCE(m.venue, '') as venue,
-			COALESCE(m.status, 'scheduled') as status
+			COALESCE(m.venue_id, '') as venue_id, COALESCE(m.status, 'scheduled') as status
 		FROM matches m
 		LEFT JOIN teams ht ON m.home_team_id = ht.id
 		LEFT JOIN teams at ON m.away_team_id = at.id
@@ -130,7 +133,7 @@ func (s *ExploreMatchesService) GetTournamentMatches(ctx context.Context, tourna
 	return rows, nil
 }
 
-func (s *ExploreMatchesService) getMatches(ctx context.Context, status, tournament string, limit int, orderBy string) ([]MatchRow, error) {
+func (s *ExploreMatchesService) getMatches(ctx context.Context, status, tournament, city string, limit int, orderBy string) ([]MatchRow, error) {
 	where := []string{"m.status = $1", "m.home_team_id IS NOT NULL", "m.away_team_id IS NOT NULL"}
 	args := []interface{}{status}
 	argN := 2
@@ -140,6 +143,12 @@ func (s *ExploreMatchesService) getMatches(ctx context.Context, status, tourname
 		args = append(args, "%"+tournament+"%")
 		argN++
 	}
+	if city != "" {
+		// Город арены матча (матчи привязываются к аренам задачей venue_linker)
+		where = append(where, fmt.Sprintf("v.city ILIKE $%d", argN))
+		args = append(args, city)
+		argN++
+	}
 
 	query := fmt.Sprintf(`
 		SELECT m.id, COALESCE(ht.name, '') as home_team, COALESCE(at.name, '') as away_team,
@@ -147,11 +156,12 @@ func (s *ExploreMatchesService) getMatches(ctx context.Context, status, tourname
 			COALESCE(ht.logo_url, '') as home_logo_url, COALESCE(at.logo_url, '') as away_logo_url,
 			m.home_score, m.away_score, COALESCE(m.result_type, '') as result_type, m.scheduled_at,
 			COALESCE(t.name, '') as tournament_name, COALESCE(m.venue, '') as venue,
-			COALESCE(m.status, 'scheduled') as status
+			COALESCE(m.venue_id, '') as venue_id, COALESCE(m.status, 'scheduled') as status
 		FROM matches m
 		LEFT JOIN teams ht ON m.home_team_id = ht.id
 		LEFT JOIN teams at ON m.away_team_id = at.id
 		LEFT JOIN tournaments t ON m.tournament_id = t.id
+		LEFT JOIN venues v ON m.venue_id = v.id
 		WHERE %s
 		ORDER BY %s
 		LIMIT $%d
@@ -177,6 +187,10 @@ type RankingsFilter struct {
 	Domain       string
 	TournamentID string
 	GroupName    string
+	// Adjusted ranks by points weighted with tournament strength coefficients.
+	Adjusted bool
+	// AsOf ranks by the numbers as they stood at that moment.
+	AsOf *time.Time
 }
 
 // GetRankings returns players ranked by a stat field for the current season.
@@ -193,11 +207,15 @@ func (s *ExploreMatchesService) GetRankings(ctx context.Context, sortBy string,
 	allowedSorts := map[string]string{
 		"points": "total_points", "goals": "total_goals", "assists": "total_assists",
 		"plusMinus": "total_plus_minus", "penaltyMinutes": "total_penalty",
+		"adjustedPoints": "adjusted_points",
 	}
 	sortCol, ok := allowedSorts[sortBy]
 	if !ok {
 		sortCol = "total_points"
 	}
+	if filter.Adjusted && sortCol == "total_points" {
+		sortCol = "adjusted_points"
+	}
 
 	query := fmt.Sprintf(`
 		SELECT p.id, p.name, COALESCE(p.photo_url, '') as photo_url, COALESCE(p.position, '') as position, p.birth_date,
@@ -210,14 +228,16 @@ func (s *ExploreMatchesService) GetRankings(ctx context.Context, sortBy string,
 			COALESCE(SUM(ps.assists), 0)::int as total_assists,
 			COALESCE(SUM(ps.points), 0)::int as total_points,
 			COALESCE(SUM(ps.plus_minus), 0)::int as total_plus_minus,
-			COALESCE(SUM(ps.penalty_minutes), 0)::int as total_penalty
+			COALESCE(SUM(ps.penalty_minutes), 0)::int as total_penalty,
+			COALESCE(SUM(ps.points * COALESCE(str.coefficient, 1)), 0)::float8 as adjusted_points
 		FROM players p
-		JOIN player_statistics ps ON p.id = ps.player_id AND ps.group_name != 'Общая статистика'
+		JOIN %s ps ON p.id = ps.player_id AND ps.group_name != 'Общая статистика'
 		JOIN tournaments tr ON ps.tournament_id = tr.id AND tr.season = $1
 			AND ($3 = 0 OR ps.birth_year = $3)
 			AND ($4 = '' OR tr.domain = $4)
 			AND ($5 = '' OR ps.tournament_id = $5)
 			AND ($6 = '' OR ps.group_name = $6)
+		LEFT JOIN tournament_strength str ON str.tournament_id = ps.tournament_id AND str.birth_year = ps.birth_year
 		LEFT JOIN LATERAL (
 			SELECT t.name as team_name, t.id as team_id, COALESCE(t.logo_url, '') as team_logo_url, COALESCE(t.city, '') as team_city
 			FROM player_teams pt2
@@ -231,7 +251,7 @@ func (s *ExploreMatchesService) GetRankings(ctx context.Context, sortBy string,
 		HAVING SUM(ps.games) > 0
 		ORDER BY %s DESC
 		LIMIT $2
-	`, sortCol)
+	`, asOfRelation("player_statistics", filter.AsOf, 7), sortCol)
 
 	type rankedRow struct {
 		ID             string    `db:"id"`
@@ -249,10 +269,12 @@ func (s *ExploreMatchesService) GetRankings(ctx context.Context, sortBy string,
 		Points         int       `db:"total_points"`
 		PlusMinus      int       `db:"total_plus_minus"`
 		PenaltyMinutes int       `db:"total_penalty"`
+		AdjustedPoints float64   `db:"adjusted_points"`
 	}
 
 	var rows []rankedRow
-	if err := s.db.SelectContext(ctx, &rows, query, season, limit, filter.BirthYear, filter.Domain, filter.TournamentID, filter.GroupName); err != nil {
+	args := []interface{}{season, limit, filter.BirthYear, filter.Domain, filter.TournamentID, filter.GroupName}
+	if err := s.db.SelectContext(ctx, &rows, query, withAsOf(args, filter.AsOf)...); err != nil {
 		return nil, fmt.Errorf("failed to get rankings: %w", err)
 	}
 
@@ -264,6 +286,7 @@ func (s *ExploreMatchesService) GetRankings(ctx context.Context, sortBy string,
 			Team: titleCase(r.Team), TeamID: r.TeamID, TeamLogoURL: r.TeamLogoURL, TeamCity: r.TeamCity,
 			Games: r.Games, Goals: r.Goals, Assists: r.Assists, Points: r.Points,
 			PlusMinus: r.PlusMinus, PenaltyMinutes: r.PenaltyMinutes,
+			AdjustedPoints: math.Round(r.AdjustedPoints*10) / 10,
 		}
 	}
 	return &RankingsResult{Season: season, Players: players}, nil
@@ -219,20 +219,21 @@ type PlayerStatRow struct {
 }
 
 // GetPlayerStats returns detailed stats for a player across all seasons/tournaments/groups.
-func (s *ExplorePlayersService) GetPlayerStats(ctx context.Context, id string) ([]PlayerStatRow, error) {
+// Returns the numbers as they stood at asOf when it is set.
+func (s *ExplorePlayersService) GetPlayerStats(ctx context.Context, id string, asOf *time.Time) ([]PlayerStatRow, error) {
 	query := `
 		SELECT t.season, ps.tournament_id, t.name as tournament_name,
 			ps.group_name, COALESCE(ps.birth_year, 0) as birth_year,
 			COALESCE(ps.games, 0) as games, COALESCE(ps.goals, 0) as goals,
 			COALESCE(ps.assists, 0) as assists, COALESCE(ps.points, 0) as points,
 			COALESCE(ps.plus_minus, 0) as plus_minus, COALESCE(ps.penalty_minutes, 0) as penalty_minutes
-		FROM player_statistics ps
+		FROM ` + asOfRelation("player_statistics", asOf, 2) + ` ps
 		JOIN tournaments t ON ps.tournament_id = t.id
 		WHERE ps.player_id = $1
 		ORDER BY t.season DESC, t.name, ps.group_name
 	`
 	var rows []PlayerStatRow
-	if err := s.db.SelectContext(ctx, &rows, query, id); err != nil {
+	if err := s.db.SelectContext(ctx, &rows, query, withAsOf([]interface{}{id}, asOf)...); err != nil {
 		return nil, fmt.Errorf("failed to get player stats: %w", err)
 	}
 	for i := range rows {
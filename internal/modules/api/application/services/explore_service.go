@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -142,7 +143,8 @@ func (s *ExploreService) GetTournaments(ctx context.Context, source, domain stri
 }
 
 // GetTournamentStandings returns standings for a tournament with optional filters.
-func (s *ExploreService) GetTournamentStandings(ctx context.Context, tournamentID string, birthYear int, groupName string) ([]StandingRow, error) {
+// Returns the table as it stood at asOf when it is set.
+func (s *ExploreService) GetTournamentStandings(ctx context.Context, tournamentID string, birthYear int, groupName string, asOf *time.Time) ([]StandingRow, error) {
 	where := []string{"ts.tournament_id = $1"}
 	args := []interface{}{tournamentID}
 	argN := 2
@@ -155,7 +157,9 @@ func (s *ExploreService) GetTournamentStandings(ctx context.Context, tournamentI
 	if groupName != "" {
 		where = append(where, fmt.Sprintf("ts.group_name = $%d", argN))
 		args = append(args, groupName)
+		argN++
 	}
+	args = withAsOf(args, asOf)
 
 	query := fmt.Sprintf(`
 		SELECT ts.position, t.name as team_name, ts.team_id,
@@ -165,11 +169,11 @@ func (s *ExploreService) GetTournamentStandings(ctx context.Context, tournamentI
 			COALESCE(ts.losses_ot, 0) as losses_ot, COALESCE(ts.draws, 0) as draws,
 			COALESCE(ts.goals_for, 0) as goals_for, COALESCE(ts.goals_against, 0) as goals_against,
 			COALESCE(ts.points, 0) as points, COALESCE(ts.group_name, '') as group_name
-		FROM team_standings ts
+		FROM %s ts
 		JOIN teams t ON ts.team_id = t.id
 		WHERE %s
 		ORDER BY ts.group_name, ts.position
-	`, strings.Join(where, " AND "))
+	`, asOfRelation("team_standings", asOf, argN), strings.Join(where, " AND "))
 
 	var rows []StandingRow
 	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
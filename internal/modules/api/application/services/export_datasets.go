@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/tabular"
+)
+
+func (s *ExportService) standings(ctx context.Context, req ExportRequest) (*tabular.Table, error) {
+	rows, err := s.explore.GetTournamentStandings(ctx, req.TournamentID, req.BirthYear, req.GroupName, nil)
+	if err != nil {
+		return nil, err
+	}
+	t := tabular.NewTable("Standings",
+		tabular.StringCol("group"), tabular.IntCol("position"), tabular.StringCol("team_id"), tabular.StringCol("team"),
+		tabular.IntCol("games"), tabular.IntCol("wins"), tabular.IntCol("wins_ot"), tabular.IntCol("losses"),
+		tabular.IntCol("losses_ot"), tabular.IntCol("draws"), tabular.IntCol("goals_for"),
+		tabular.IntCol("goals_against"), tabular.IntCol("points"))
+	for _, r := range rows {
+		t.Append(r.GroupName, r.Position, r.TeamID, r.Team, r.Games, r.Wins, r.WinsOT, r.Losses,
+			r.LossesOT, r.Draws, r.GoalsFor, r.GoalsAgainst, r.Points)
+	}
+	return t, nil
+}
+
+func (s *ExportService) scorers(ctx context.Context, req ExportRequest) (*tabular.Table, error) {
+	rows, err := s.explore.GetTournamentScorers(ctx, req.TournamentID, req.BirthYear, req.GroupName, exportMaxScorers)
+	if err != nil {
+		return nil, err
+	}
+	t := tabular.NewTable("Scorers",
+		tabular.IntCol("rank"), tabular.StringCol("player_id"), tabular.StringCol("player"),
+		tabular.StringCol("team_id"), tabular.StringCol("team"), tabular.IntCol("games"),
+		tabular.IntCol("goals"), tabular.IntCol("assists"), tabular.IntCol("points"))
+	for i, r := range rows {
+		t.Append(i+1, r.PlayerID, r.Name, r.TeamID, r.Team, r.Games, r.Goals, r.Assists, r.Points)
+	}
+	return t, nil
+}
+
+func (s *ExportService) roster(ctx context.Context, req ExportRequest) (*tabular.Table, error) {
+	rows, err := s.explore.GetTeamRoster(ctx, req.TeamID, req.TournamentID, req.BirthYear, req.GroupName)
+	if err != nil {
+		return nil, err
+	}
+	t := tabular.NewTable("Roster",
+		tabular.IntCol("jersey_number"), tabular.StringCol("player_id"), tabular.StringCol("player"),
+		tabular.StringCol("birth_date"), tabular.StringCol("position"), tabular.StringCol("handedness"),
+		tabular.IntCol("height"), tabular.IntCol("weight"), tabular.IntCol("birth_year"), tabular.StringCol("group"))
+	for _, r := range rows {
+		t.Append(r.JerseyNumber, r.PlayerID, r.Name, r.BirthDate, r.Position, r.Handedness,
+			r.Height, r.Weight, r.BirthYear, r.GroupName)
+	}
+	return t, nil
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/tabular"
+	"go.uber.org/zap"
+)
+
+// Export job statuses.
+const (
+	ExportJobPending = "pending"
+	ExportJobRunning = "running"
+	ExportJobDone    = "done"
+	ExportJobFailed  = "failed"
+)
+
+const (
+	// exportFileTTL is how long a finished export can be downloaded.
+	exportFileTTL = 24 * time.Hour
+	// exportJobTimeout fails jobs left running by a stopped replica.
+	exportJobTimeout = time.Hour
+)
+
+// ExportJob is an async export.
+type ExportJob struct {
+	ID         string          `db:"id"`
+	UserID     string          `db:"user_id"`
+	Dataset    string          `db:"dataset"`
+	Format     string          `db:"format"`
+	Params     json.RawMessage `db:"params"`
+	Status     string          `db:"status"`
+	Rows       *int            `db:"rows_count"`
+	FilePath   *string         `db:"file_path"`
+	Error      *string         `db:"error"`
+	CreatedAt  time.Time       `db:"created_at"`
+	FinishedAt *time.Time      `db:"finished_at"`
+	ExpiresAt  *time.Time      `db:"expires_at"`
+}
+
+// Request decodes the export request stored with the job.
+func (j *ExportJob) Request() (ExportRequest, error) {
+	var req ExportRequest
+	err := json.Unmarshal(j.Params, &req)
+	return req, err
+}
+
+const exportJobColumns = `id::text AS id, user_id::text AS user_id, dataset, format, params, status, rows_count,
+	file_path, error, created_at, finished_at, expires_at`
+
+// CreateJob queues an export for the background worker.
+func (s *ExportService) CreateJob(ctx context.Context, userID string, req ExportRequest) (*ExportJob, error) {
+	params, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	var job ExportJob
+	err = s.db.GetContext(ctx, &job, `
+		INSERT INTO export_jobs (user_id, dataset, format, params)
+		VALUES ($1, $2, $3, $4)
+		RETURNING `+exportJobColumns, userID, req.Dataset, string(req.Format), params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create export job: %w", err)
+	}
+	return &job, nil
+}
+
+// GetJob returns the user's export job. Returns nil if it does not exist.
+func (s *ExportService) GetJob(ctx context.Context, userID, id string) (*ExportJob, error) {
+	var job ExportJob
+	err := s.db.GetContext(ctx, &job, `SELECT `+exportJobColumns+` FROM export_jobs WHERE id::text = $1 AND user_id::text = $2`, id, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get export job: %w", err)
+	}
+	return &job, nil
+}
+
+// CheckDir makes sure the export directory is configured and writable.
+// Any replica may write a file and any other may serve it, so the directory
+// must be shared storage; an unset directory is an error rather than a local default.
+func (s *ExportService) CheckDir() error {
+	if s.dir == "" {
+		return errors.New("export directory is not configured")
+	}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return fmt.Errorf("create export directory: %w", err)
+	}
+	probe, err := os.CreateTemp(s.dir, ".probe-*")
+	if err != nil {
+		return fmt.Errorf("export directory is not writable: %w", err)
+	}
+	_ = probe.Close()
+	return os.Remove(probe.Name())
+}
+
+// RunWorker processes queued exports until ctx is done. Replicas share the queue,
+// so the export directory must be checked with CheckDir before the worker starts.
+func (s *ExportService) RunWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for {
+			processed, err := s.processNext(ctx)
+			if err != nil {
+				logger.Error(ctx, "Export job failed", zap.Error(err))
+			}
+			if !processed || ctx.Err() != nil {
+				break
+			}
+		}
+		s.cleanup(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// processNext claims the oldest pending job and writes its file.
+func (s *ExportService) processNext(ctx context.Context) (bool, error) {
+	var job ExportJob
+	err := s.db.GetContext(ctx, &job, `
+		UPDATE export_jobs SET status = 'running', started_at = NOW()
+		WHERE id = (
+			SELECT id FROM export_jobs WHERE status = 'pending'
+			ORDER BY created_at LIMIT 1 FOR UPDATE SKIP LOCKED)
+		RETURNING `+exportJobColumns)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to claim export job: %w", err)
+	}
+
+	rows, path, err := s.writeJobFile(ctx, &job)
+	if err != nil {
+		_, _ = s.db.ExecContext(ctx, `
+			UPDATE export_jobs SET status = 'failed', error = $2, finished_at = NOW() WHERE id = $1`,
+			job.ID, err.Error())
+		return true, fmt.Errorf("export job %s: %w", job.ID, err)
+	}
+	_, err = s.db.ExecContext(ctx, `
+		UPDATE export_jobs SET status = 'done', rows_count = $2, file_path = $3,
+			finished_at = NOW(), expires_at = NOW() + $4 * INTERVAL '1 second'
+		WHERE id = $1`, job.ID, rows, path, exportFileTTL.Seconds())
+	if err != nil {
+		return true, fmt.Errorf("failed to finish export job %s: %w", job.ID, err)
+	}
+	logger.Info(ctx, "Export job done", zap.String("job_id", job.ID), zap.String("dataset", job.Dataset), zap.Int("rows", rows))
+	return true, nil
+}
+
+func (s *ExportService) writeJobFile(ctx context.Context, job *ExportJob) (int, string, error) {
+	req, err := job.Request()
+	if err != nil {
+		return 0, "", err
+	}
+	table, err := s.Build(ctx, req)
+	if err != nil {
+		return 0, "", err
+	}
+
+	path := filepath.Join(s.dir, job.ID+"."+string(req.Format))
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, "", err
+	}
+	if err := tabular.Write(f, table, req.Format); err != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		return 0, "", err
+	}
+	return len(table.Rows), path, f.Close()
+}
+
+// cleanup removes expired export files and fails jobs abandoned while running.
+func (s *ExportService) cleanup(ctx context.Context) {
+	var paths []string
+	err := s.db.SelectContext(ctx, &paths, `
+		UPDATE export_jobs SET file_path = NULL
+		WHERE expires_at < NOW() AND file_path IS NOT NULL
+		RETURNING file_path`)
+	if err != nil {
+		logger.Warn(ctx, "Failed to expire export files", zap.Error(err))
+	}
+	for _, path := range paths {
+		_ = os.Remove(path)
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		UPDATE export_jobs SET status = 'failed', error = 'export timed out', finished_at = NOW()
+		WHERE status = 'running' AND started_at < NOW() - $1 * INTERVAL '1 second'`, exportJobTimeout.Seconds())
+	if err != nil {
+		logger.Warn(ctx, "Failed to fail stale export jobs", zap.Error(err))
+	}
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/tabular"
+)
+
+// exportFilters builds the WHERE clause shared by the player stats and match events exports.
+// Columns: tournament in t, birth year and group in the given alias.
+func exportFilters(req ExportRequest, alias string) (string, []interface{}) {
+	var where []string
+	var args []interface{}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+
+	if req.TournamentID != "" {
+		add("t.id = $%d", req.TournamentID)
+	}
+	if req.Season != "" {
+		add("t.season = $%d", req.Season)
+	}
+	if req.BirthYear > 0 {
+		add(alias+".birth_year = $%d", req.BirthYear)
+	}
+	if req.GroupName != "" {
+		add(alias+".group_name = $%d", req.GroupName)
+	}
+	if len(where) == 0 {
+		return "TRUE", nil
+	}
+	return strings.Join(where, " AND "), args
+}
+
+// exportPlayerStatRow is a full player_statistics row.
+type exportPlayerStatRow struct {
+	Tournament        string `db:"tournament_name"`
+	Season            string `db:"season"`
+	GroupName         string `db:"group_name"`
+	BirthYear         int    `db:"birth_year"`
+	PlayerID          string `db:"player_id"`
+	Player            string `db:"player_name"`
+	Team              string `db:"team_name"`
+	Games             int    `db:"games"`
+	Goals             int    `db:"goals"`
+	Assists           int    `db:"assists"`
+	Points            int    `db:"points"`
+	PlusMinus         int    `db:"plus_minus"`
+	PenaltyMinutes    int    `db:"penalty_minutes"`
+	GoalsEvenStrength int    `db:"goals_even_strength"`
+	GoalsPowerPlay    int    `db:"goals_power_play"`
+	GoalsShortHanded  int    `db:"goals_short_handed"`
+	GoalsOvertime     int    `db:"goals_overtime"`
+	HatTricks         int    `db:"hat_tricks"`
+	GameWinningGoals  int    `db:"game_winning_goals"`
+}
+
+func (s *ExportService) playerStats(ctx context.Context, req ExportRequest) (*tabular.Table, error) {
+	where, args := exportFilters(req, "ps")
+	var rows []exportPlayerStatRow
+	err := s.db.SelectContext(ctx, &rows, `
+		SELECT COALESCE(t.name, '') as tournament_name, COALESCE(t.season, '') as season,
+			ps.group_name, ps.birth_year, ps.player_id, p.name as player_name,
+			COALESCE(tm.name, '') as team_name, ps.games, ps.goals, ps.assists, ps.points,
+			ps.plus_minus, ps.penalty_minutes, ps.goals_even_strength, ps.goals_power_play,
+			ps.goals_short_handed, ps.goals_overtime, ps.hat_tricks, ps.game_winning_goals
+		FROM player_statistics ps
+		JOIN tournaments t ON t.id = ps.tournament_id
+		JOIN players p ON p.id = ps.player_id
+		LEFT JOIN teams tm ON tm.id = ps.team_id
+		WHERE `+where+`
+		ORDER BY t.name, ps.group_name, ps.points DESC, ps.goals DESC, p.name`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export player stats: %w", err)
+	}
+
+	t := tabular.NewTable("Player stats",
+		tabular.StringCol("tournament"), tabular.StringCol("season"), tabular.StringCol("group"),
+		tabular.IntCol("birth_year"), tabular.StringCol("player_id"), tabular.StringCol("player"),
+		tabular.StringCol("team"), tabular.IntCol("games"), tabular.IntCol("goals"), tabular.IntCol("assists"),
+		tabular.IntCol("points"), tabular.IntCol("plus_minus"), tabular.IntCol("penalty_minutes"),
+		tabular.IntCol("goals_even_strength"), tabular.IntCol("goals_power_play"),
+		tabular.IntCol("goals_short_handed"), tabular.IntCol("goals_overtime"),
+		tabular.IntCol("hat_tricks"), tabular.IntCol("game_winning_goals"))
+	for _, r := range rows {
+		t.Append(titleCase(r.Tournament), r.Season, r.GroupName, r.BirthYear, r.PlayerID, r.Player,
+			titleCase(r.Team), r.Games, r.Goals, r.Assists, r.Points, r.PlusMinus, r.PenaltyMinutes,
+			r.GoalsEvenStrength, r.GoalsPowerPlay, r.GoalsShortHanded, r.GoalsOvertime,
+			r.HatTricks, r.GameWinningGoals)
+	}
+	return t, nil
+}
+
+// exportEventRow is a match event with match context and player names.
+type exportEventRow struct {
+	MatchID        string `db:"match_id"`
+	Date           string `db:"match_date"`
+	Tournament     string `db:"tournament_name"`
+	HomeTeam       string `db:"home_team"`
+	AwayTeam       string `db:"away_team"`
+	EventType      string `db:"event_type"`
+	Period         int    `db:"period"`
+	Time           string `db:"event_time"`
+	Team           string `db:"team_name"`
+	GoalType       string `db:"goal_type"`
+	Scorer         string `db:"scorer"`
+	Assist1        string `db:"assist1"`
+	Assist2        string `db:"assist2"`
+	PenaltyPlayer  string `db:"penalty_player"`
+	PenaltyMinutes int    `db:"penalty_minutes"`
+	PenaltyReason  string `db:"penalty_reason"`
+}
+
+func (s *ExportService) matchEvents(ctx context.Context, req ExportRequest) (*tabular.Table, error) {
+	where, args := exportFilters(req, "m")
+	var rows []exportEventRow
+	err := s.db.SelectContext(ctx, &rows, `
+		SELECT m.id as match_id, COALESCE(TO_CHAR(m.scheduled_at, 'YYYY-MM-DD'), '') as match_date,
+			COALESCE(t.name, '') as tournament_name,
+			COALESCE(ht.name, '') as home_team, COALESCE(at.name, '') as away_team,
+			e.event_type, COALESCE(e.period, 0) as period,
+			LPAD(COALESCE(e.time_minutes, 0)::text, 2, '0') || ':' || LPAD(COALESCE(e.time_seconds, 0)::text, 2, '0') as event_time,
+			COALESCE(tm.name, '') as team_name, COALESCE(e.goal_type, '') as goal_type,
+			COALESCE(ps.name, '') as scorer, COALESCE(pa1.name, '') as assist1, COALESCE(pa2.name, '') as assist2,
+			COALESCE(pp.name, '') as penalty_player, COALESCE(e.penalty_minutes, 0) as penalty_minutes,
+			COALESCE(e.penalty_reason, '') as penalty_reason
+		FROM match_events e
+		JOIN matches m ON m.id = e.match_id
+		JOIN tournaments t ON t.id = m.tournament_id
+		LEFT JOIN teams ht ON ht.id = m.home_team_id
+		LEFT JOIN teams at ON at.id = m.away_team_id
+		LEFT JOIN teams tm ON tm.id = e.team_id
+		LEFT JOIN players ps ON ps.id = e.scorer_player_id
+		LEFT JOIN players pa1 ON pa1.id = e.assist1_player_id
+		LEFT JOIN players pa2 ON pa2.id = e.assist2_player_id
+		LEFT JOIN players pp ON pp.id = e.penalty_player_id
+		WHERE `+where+`
+		ORDER BY m.scheduled_at NULLS LAST, m.id, e.period, e.time_minutes, e.time_seconds`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export match events: %w", err)
+	}
+
+	t := tabular.NewTable("Match events",
+		tabular.StringCol("match_id"), tabular.StringCol("date"), tabular.StringCol("tournament"),
+		tabular.StringCol("home_team"), tabular.StringCol("away_team"), tabular.StringCol("event_type"),
+		tabular.IntCol("period"), tabular.StringCol("time"), tabular.StringCol("team"),
+		tabular.StringCol("goal_type"), tabular.StringCol("scorer"), tabular.StringCol("assist1"),
+		tabular.StringCol("assist2"), tabular.StringCol("penalty_player"),
+		tabular.IntCol("penalty_minutes"), tabular.StringCol("penalty_reason"))
+	for _, r := range rows {
+		t.Append(r.MatchID, r.Date, titleCase(r.Tournament), titleCase(r.HomeTeam), titleCase(r.AwayTeam),
+			r.EventType, r.Period, r.Time, titleCase(r.Team), r.GoalType, r.Scorer, r.Assist1, r.Assist2,
+			r.PenaltyPlayer, r.PenaltyMinutes, r.PenaltyReason)
+	}
+	return t, nil
+}
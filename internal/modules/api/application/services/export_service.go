@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/tabular"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrUnknownExportDataset = errors.New("unknown export dataset")
+	ErrInvalidExportRequest = errors.New("invalid export request")
+)
+
+// exportMaxScorers caps the scorers export; the explore endpoint shows the top 20.
+const exportMaxScorers = 10000
+
+// Export datasets.
+const (
+	ExportStandings   = "standings"
+	ExportScorers     = "scorers"
+	ExportPlayerStats = "player_stats"
+	ExportRoster      = "roster"
+	ExportMatchEvents = "match_events"
+)
+
+// exportFormatTiers is the minimum subscription tier per export format.
+var exportFormatTiers = map[tabular.Format]string{
+	tabular.CSV:     "pro",
+	tabular.XLSX:    "pro",
+	tabular.Parquet: "ultra",
+}
+
+// ExportRequest describes an export; filters are the ones of the matching explore endpoints.
+type ExportRequest struct {
+	Dataset      string         `json:"dataset"`
+	Format       tabular.Format `json:"format"`
+	TournamentID string         `json:"tournamentId,omitempty"`
+	TeamID       string         `json:"teamId,omitempty"`
+	Season       string         `json:"season,omitempty"`
+	BirthYear    int            `json:"birthYear,omitempty"`
+	GroupName    string         `json:"group,omitempty"`
+}
+
+// ExportService builds tables for bulk data exports.
+type ExportService struct {
+	db      *sqlx.DB
+	explore *ExploreService
+	dir     string
+}
+
+// NewExportService creates a new export service. Async export files are written to dir,
+// which must be shared by all API replicas.
+func NewExportService(db *sqlx.DB, explore *ExploreService, dir string) *ExportService {
+	return &ExportService{db: db, explore: explore, dir: dir}
+}
+
+// RequiredTier returns the subscription tier needed for the export format.
+func RequiredTier(format tabular.Format) string {
+	return exportFormatTiers[format]
+}
+
+// Validate checks the dataset, format and required filters.
+func (s *ExportService) Validate(req ExportRequest) error {
+	if _, ok := tabular.ParseFormat(string(req.Format)); !ok {
+		return fmt.Errorf("%w: unknown format %q", ErrInvalidExportRequest, req.Format)
+	}
+	switch req.Dataset {
+	case ExportStandings, ExportScorers:
+		if req.TournamentID == "" {
+			return fmt.Errorf("%w: tournamentId is required", ErrInvalidExportRequest)
+		}
+	case ExportRoster:
+		if req.TeamID == "" || req.TournamentID == "" {
+			return fmt.Errorf("%w: teamId and tournamentId are required", ErrInvalidExportRequest)
+		}
+	case ExportPlayerStats, ExportMatchEvents:
+		if req.TournamentID == "" && req.Season == "" {
+			return fmt.Errorf("%w: tournamentId or season is required", ErrInvalidExportRequest)
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownExportDataset, req.Dataset)
+	}
+	return nil
+}
+
+// IsLarge reports whether the export should run as an async job: whole-season
+// player stats and event logs span every tournament of the season.
+func (s *ExportService) IsLarge(req ExportRequest) bool {
+	return (req.Dataset == ExportPlayerStats || req.Dataset == ExportMatchEvents) && req.TournamentID == ""
+}
+
+// FileName returns the download file name of the export.
+func (s *ExportService) FileName(req ExportRequest) string {
+	parts := []string{req.Dataset}
+	for _, p := range []string{req.TournamentID, req.TeamID, req.Season} {
+		if p != "" {
+			parts = append(parts, strings.NewReplacer("/", "-", " ", "_").Replace(p))
+		}
+	}
+	return strings.Join(parts, "_") + "." + string(req.Format)
+}
+
+// Build loads the dataset as a table.
+func (s *ExportService) Build(ctx context.Context, req ExportRequest) (*tabular.Table, error) {
+	switch req.Dataset {
+	case ExportStandings:
+		return s.standings(ctx, req)
+	case ExportScorers:
+		return s.scorers(ctx, req)
+	case ExportRoster:
+		return s.roster(ctx, req)
+	case ExportPlayerStats:
+		return s.playerStats(ctx, req)
+	case ExportMatchEvents:
+		return s.matchEvents(ctx, req)
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownExportDataset, req.Dataset)
+}
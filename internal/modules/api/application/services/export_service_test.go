@@ -0,0 +1,71 @@
+package services
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/tabular"
+)
+
+func TestExportValidate(t *testing.T) {
+	s := &ExportService{}
+	tests := []struct {
+		req  ExportRequest
+		want error
+	}{
+		{ExportRequest{Dataset: ExportStandings, Format: tabular.CSV, TournamentID: "t1"}, nil},
+		{ExportRequest{Dataset: ExportStandings, Format: tabular.CSV}, ErrInvalidExportRequest},
+		{ExportRequest{Dataset: ExportRoster, Format: tabular.XLSX, TeamID: "team"}, ErrInvalidExportRequest},
+		{ExportRequest{Dataset: ExportMatchEvents, Format: tabular.Parquet, Season: "2025/2026"}, nil},
+		{ExportRequest{Dataset: ExportScorers, Format: "pdf", TournamentID: "t1"}, ErrInvalidExportRequest},
+		{ExportRequest{Dataset: "goalies", Format: tabular.CSV}, ErrUnknownExportDataset},
+	}
+	for _, tt := range tests {
+		err := s.Validate(tt.req)
+		if (tt.want == nil && err != nil) || (tt.want != nil && !errors.Is(err, tt.want)) {
+			t.Errorf("Validate(%+v) = %v, want %v", tt.req, err, tt.want)
+		}
+	}
+}
+
+func TestExportIsLarge(t *testing.T) {
+	s := &ExportService{}
+	if !s.IsLarge(ExportRequest{Dataset: ExportPlayerStats, Season: "2025/2026"}) {
+		t.Error("season player stats should be an async export")
+	}
+	if s.IsLarge(ExportRequest{Dataset: ExportPlayerStats, TournamentID: "t1"}) {
+		t.Error("tournament player stats should be served directly")
+	}
+}
+
+func TestExportFileName(t *testing.T) {
+	s := &ExportService{}
+	got := s.FileName(ExportRequest{Dataset: ExportMatchEvents, Format: tabular.Parquet, Season: "2025/2026"})
+	if want := "match_events_2025-2026.parquet"; got != want {
+		t.Errorf("FileName = %q, want %q", got, want)
+	}
+}
+
+func TestExportCheckDir(t *testing.T) {
+	if err := (&ExportService{}).CheckDir(); err == nil {
+		t.Error("CheckDir must fail without a configured directory")
+	}
+
+	dir := filepath.Join(t.TempDir(), "exports")
+	if err := (&ExportService{dir: dir}).CheckDir(); err != nil {
+		t.Fatalf("CheckDir(%s) = %v", dir, err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("CheckDir left %d files behind", len(entries))
+	}
+
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&ExportService{dir: file}).CheckDir(); err == nil {
+		t.Error("CheckDir must fail when the path is a file")
+	}
+}
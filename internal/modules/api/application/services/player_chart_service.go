@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/charts"
+	"github.com/jmoiron/sqlx"
+)
+
+// ErrUnknownChartType is returned for chart types other than the PlayerChart* constants.
+var ErrUnknownChartType = errors.New("unknown chart type")
+
+// Player chart types.
+const (
+	PlayerChartSeasons = "seasons" // goals and points by season, line
+	PlayerChartGoals   = "goals"   // goals by strength, pie
+	PlayerChartPeriods = "periods" // goals by period, bar
+	PlayerChartProfile = "profile" // career profile, radar
+)
+
+// PlayerChartService renders player charts as PNG images for sharing.
+type PlayerChartService struct {
+	db *sqlx.DB
+}
+
+// NewPlayerChartService creates a new player chart service.
+func NewPlayerChartService(db *sqlx.DB) *PlayerChartService {
+	return &PlayerChartService{db: db}
+}
+
+// playerSeasonTotals holds a player's stats summed over one season.
+type playerSeasonTotals struct {
+	Season       string `db:"season"`
+	Goals        int    `db:"goals"`
+	Assists      int    `db:"assists"`
+	Points       int    `db:"points"`
+	PlusMinus    int    `db:"plus_minus"`
+	HatTricks    int    `db:"hat_tricks"`
+	WinningGoals int    `db:"winning_goals"`
+	EvenStrength int    `db:"even_strength"`
+	PowerPlay    int    `db:"power_play"`
+	ShortHanded  int    `db:"short_handed"`
+	Period1      int    `db:"period_1"`
+	Period2      int    `db:"period_2"`
+	Period3      int    `db:"period_3"`
+	Overtime     int    `db:"overtime"`
+}
+
+// RenderPNG returns the chart of the given type as PNG.
+// Returns nil when the player has no statistics.
+func (s *PlayerChartService) RenderPNG(ctx context.Context, playerID, chartType string) ([]byte, error) {
+	if !isPlayerChartType(chartType) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownChartType, chartType)
+	}
+
+	query := `
+		SELECT t.season,
+			COALESCE(SUM(ps.goals), 0) as goals, COALESCE(SUM(ps.assists), 0) as assists,
+			COALESCE(SUM(ps.points), 0) as points, COALESCE(SUM(ps.plus_minus), 0) as plus_minus,
+			COALESCE(SUM(ps.hat_tricks), 0) as hat_tricks,
+			COALESCE(SUM(ps.game_winning_goals), 0) as winning_goals,
+			COALESCE(SUM(ps.goals_even_strength), 0) as even_strength,
+			COALESCE(SUM(ps.goals_power_play), 0) as power_play,
+			COALESCE(SUM(ps.goals_short_handed), 0) as short_handed,
+			COALESCE(SUM(ps.goals_period_1), 0) as period_1,
+			COALESCE(SUM(ps.goals_period_2), 0) as period_2,
+			COALESCE(SUM(ps.goals_period_3), 0) as period_3,
+			COALESCE(SUM(ps.goals_overtime), 0) as overtime
+		FROM player_statistics ps
+		JOIN tournaments t ON ps.tournament_id = t.id
+		WHERE ps.player_id = $1
+		GROUP BY t.season
+		ORDER BY t.season
+	`
+	var seasons []playerSeasonTotals
+	if err := s.db.SelectContext(ctx, &seasons, query, playerID); err != nil {
+		return nil, fmt.Errorf("failed to get player season totals: %w", err)
+	}
+	if len(seasons) == 0 {
+		return nil, nil
+	}
+
+	data, err := charts.RenderPNG(playerChartSVG(chartType, seasons), charts.PNGScale)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render player chart: %w", err)
+	}
+	return data, nil
+}
+
+func isPlayerChartType(chartType string) bool {
+	switch chartType {
+	case PlayerChartSeasons, PlayerChartGoals, PlayerChartPeriods, PlayerChartProfile:
+		return true
+	}
+	return false
+}
+
+// playerChartSVG builds the chart with the same labels as the Telegram report.
+func playerChartSVG(chartType string, seasons []playerSeasonTotals) string {
+	var total playerSeasonTotals
+	for _, s := range seasons {
+		total.Goals += s.Goals
+		total.Assists += s.Assists
+		total.PlusMinus += s.PlusMinus
+		total.HatTricks += s.HatTricks
+		total.WinningGoals += s.WinningGoals
+		total.EvenStrength += s.EvenStrength
+		total.PowerPlay += s.PowerPlay
+		total.ShortHanded += s.ShortHanded
+		total.Period1 += s.Period1
+		total.Period2 += s.Period2
+		total.Period3 += s.Period3
+		total.Overtime += s.Overtime
+	}
+
+	switch chartType {
+	case PlayerChartGoals:
+		return charts.GeneratePieChart(
+			[]string{"В равных", "В большинстве", "В меньшинстве"},
+			[]int{total.EvenStrength, total.PowerPlay, total.ShortHanded}, nil)
+	case PlayerChartPeriods:
+		return charts.GenerateBarChart(
+			[]string{"1 период", "2 период", "3 период", "OT"},
+			[]int{total.Period1, total.Period2, total.Period3, total.Overtime}, nil)
+	case PlayerChartProfile:
+		return charts.GenerateRadarChart(
+			[]string{"Голы", "Пасы", "+/-", "Хет-трики", "Поб. голы"},
+			[]float64{
+				float64(total.Goals), float64(total.Assists), float64(max(total.PlusMinus, -total.PlusMinus)),
+				float64(total.HatTricks) * 10, float64(total.WinningGoals),
+			}, nil)
+	}
+
+	labels := make([]string, len(seasons))
+	goals := make([]int, len(seasons))
+	points := make([]int, len(seasons))
+	for i, s := range seasons {
+		labels[i], goals[i], points[i] = s.Season, s.Goals, s.Points
+	}
+	return charts.GenerateLineChart(labels, []charts.LineDataset{
+		{Label: "Очки", Values: points, Color: charts.Colors.Primary},
+		{Label: "Голы", Values: goals, Color: charts.Colors.Accent},
+	}, nil)
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPlayerChartSVG(t *testing.T) {
+	seasons := []playerSeasonTotals{
+		{Season: "2023/2024", Goals: 10, Assists: 5, Points: 15, EvenStrength: 8, PowerPlay: 2, Period1: 4, Period2: 6},
+		{Season: "2024/2025", Goals: 12, Assists: 9, Points: 21, PlusMinus: -3, EvenStrength: 9, ShortHanded: 3, Period3: 12},
+	}
+	tests := []struct {
+		chartType string
+		want      string
+	}{
+		{PlayerChartSeasons, "2024/2025"},
+		{PlayerChartGoals, "В меньшинстве"},
+		{PlayerChartPeriods, "3 период"},
+		{PlayerChartProfile, "Хет-трики"},
+	}
+	for _, tt := range tests {
+		svg := playerChartSVG(tt.chartType, seasons)
+		if !strings.HasPrefix(svg, "<svg") || !strings.Contains(svg, tt.want) {
+			t.Errorf("%s: svg does not contain %q", tt.chartType, tt.want)
+		}
+	}
+}
+
+func TestPlayerChartUnknownType(t *testing.T) {
+	service := NewPlayerChartService(nil)
+	if _, err := service.RenderPNG(context.Background(), "p1", "heatmap"); !errors.Is(err, ErrUnknownChartType) {
+		t.Errorf("err = %v, want ErrUnknownChartType", err)
+	}
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ErrParseSessionNotFound is returned when a parse session recorded no player changes.
+var ErrParseSessionNotFound = errors.New("parse session not found")
+
+// PlayerChange is a single entry of the player change history (player_events).
+type PlayerChange struct {
+	ID         int64           `db:"id"`
+	PlayerID   string          `db:"player_id"`
+	PlayerName string          `db:"player_name"`
+	Type       string          `db:"event_type"`
+	Source     string          `db:"source"`
+	SessionID  *string         `db:"session_id"`
+	Data       json.RawMessage `db:"event_data"`
+	CreatedAt  time.Time       `db:"created_at"`
+}
+
+// ParseSessionReport summarizes the player changes recorded by one scheduler run.
+type ParseSessionReport struct {
+	SessionID string
+	JobName   string
+	StartedAt *time.Time
+	EndedAt   *time.Time
+	Counts    map[string]int
+	Total     int
+	Changes   []PlayerChange
+}
+
+// PlayerHistoryService reads player change history written by the parsers.
+type PlayerHistoryService struct {
+	db *sqlx.DB
+}
+
+// NewPlayerHistoryService creates a new player history service.
+func NewPlayerHistoryService(db *sqlx.DB) *PlayerHistoryService {
+	return &PlayerHistoryService{db: db}
+}
+
+const playerChangeColumns = `
+	e.id, e.player_id, COALESCE(p.name, '') AS player_name, e.event_type,
+	COALESCE(e.source, '') AS source, e.parsing_session_id::text AS session_id,
+	e.event_data, e.created_at`
+
+// Timeline returns the player's changes, newest first. Returns nil if the player does not exist.
+func (s *PlayerHistoryService) Timeline(ctx context.Context, playerID, eventType string, limit, offset int) ([]PlayerChange, error) {
+	var exists bool
+	if err := s.db.GetContext(ctx, &exists, `SELECT EXISTS(SELECT 1 FROM players WHERE id = $1)`, playerID); err != nil {
+		return nil, fmt.Errorf("failed to check player: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	changes := []PlayerChange{}
+	err := s.db.SelectContext(ctx, &changes, `
+		SELECT `+playerChangeColumns+`
+		FROM player_events e
+		LEFT JOIN players p ON p.id = e.player_id
+		WHERE e.player_id = $1 AND ($2 = '' OR e.event_type = $2)
+		ORDER BY e.created_at DESC, e.id DESC
+		LIMIT $3 OFFSET $4`,
+		playerID, eventType, limit, offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player history: %w", err)
+	}
+	return changes, nil
+}
+
+// SessionReport returns what changed during a parse session. An empty sessionID
+// selects the most recent session that recorded any change.
+func (s *PlayerHistoryService) SessionReport(ctx context.Context, sessionID string, limit int) (*ParseSessionReport, error) {
+	if sessionID == "" {
+		err := s.db.GetContext(ctx, &sessionID, `
+			SELECT parsing_session_id::text FROM player_events
+			WHERE parsing_session_id IS NOT NULL
+			ORDER BY created_at DESC, id DESC
+			LIMIT 1`)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrParseSessionNotFound
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find latest parse session: %w", err)
+		}
+	}
+
+	report := &ParseSessionReport{SessionID: sessionID, Counts: make(map[string]int)}
+
+	var counts []struct {
+		Type  string `db:"event_type"`
+		Count int    `db:"count"`
+	}
+	err := s.db.SelectContext(ctx, &counts, `
+		SELECT event_type, COUNT(*) AS count FROM player_events
+		WHERE parsing_session_id = $1::uuid
+		GROUP BY event_type`, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count session changes: %w", err)
+	}
+	if len(counts) == 0 {
+		return nil, ErrParseSessionNotFound
+	}
+	for _, c := range counts {
+		report.Counts[c.Type] = c.Count
+		report.Total += c.Count
+	}
+
+	var run struct {
+		JobName   string     `db:"job_name"`
+		StartedAt time.Time  `db:"started_at"`
+		EndedAt   *time.Time `db:"ended_at"`
+	}
+	err = s.db.GetContext(ctx, &run, `SELECT job_name, started_at, ended_at FROM job_runs WHERE id = $1`, sessionID)
+	switch {
+	case err == nil:
+		report.JobName, report.StartedAt, report.EndedAt = run.JobName, &run.StartedAt, run.EndedAt
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, fmt.Errorf("failed to get session run: %w", err)
+	}
+
+	report.Changes = []PlayerChange{}
+	err = s.db.SelectContext(ctx, &report.Changes, `
+		SELECT `+playerChangeColumns+`
+		FROM player_events e
+		LEFT JOIN players p ON p.id = e.player_id
+		WHERE e.parsing_session_id = $1::uuid
+		ORDER BY e.created_at DESC, e.id DESC
+		LIMIT $2`,
+		sessionID, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session changes: %w", err)
+	}
+	return report, nil
+}
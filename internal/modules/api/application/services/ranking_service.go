@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -27,8 +28,8 @@ func NewRankingService(db *sqlx.DB) *RankingService {
 	return &RankingService{db: db}
 }
 
-// GetTopScorers returns top scorers by goals.
-func (s *RankingService) GetTopScorers(ctx context.Context, limit int) ([]TopScorer, error) {
+// GetTopScorers returns top scorers by goals, as they stood at asOf when it is set.
+func (s *RankingService) GetTopScorers(ctx context.Context, limit int, asOf *time.Time) ([]TopScorer, error) {
 	if limit <= 0 {
 		limit = 5
 	}
@@ -42,7 +43,7 @@ func (s *RankingService) GetTopScorers(ctx context.Context, limit int) ([]TopSco
 			COALESCE(SUM(ps.assists), 0)::int as assists,
 			COALESCE(SUM(ps.games), 0)::int as games
 		FROM players p
-		LEFT JOIN player_statistics ps ON p.id = ps.player_id
+		LEFT JOIN ` + asOfRelation("player_statistics", asOf, 2) + ` ps ON p.id = ps.player_id
 		LEFT JOIN player_teams pt ON p.id = pt.player_id
 		LEFT JOIN teams t ON pt.team_id = t.id
 		GROUP BY p.id, p.name, t.name
@@ -52,7 +53,7 @@ func (s *RankingService) GetTopScorers(ctx context.Context, limit int) ([]TopSco
 	`
 
 	var scorers []TopScorer
-	err := s.db.SelectContext(ctx, &scorers, query, limit)
+	err := s.db.SelectContext(ctx, &scorers, query, withAsOf([]interface{}{limit}, asOf)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get top scorers: %w", err)
 	}
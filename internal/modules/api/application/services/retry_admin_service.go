@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrFailedJobNotFound      = retry.ErrJobNotFound
+	ErrFailedJobNotDead       = retry.ErrJobNotDead
+	ErrInvalidFailedJobFilter = errors.New("invalid failed job filter")
+)
+
+// RetryAdminService inspects the parsing retry queue and resolves dead letters.
+//
+// Requeued jobs are picked up by the scheduler's retry_worker on its next run.
+type RetryAdminService struct {
+	store *retry.Store
+}
+
+// NewRetryAdminService creates a new retry admin service.
+func NewRetryAdminService(db *sqlx.DB) *RetryAdminService {
+	return &RetryAdminService{store: retry.NewStore(db)}
+}
+
+// ListJobs returns failed jobs, most recently updated first, and the total number of matching jobs.
+func (s *RetryAdminService) ListJobs(ctx context.Context, filter retry.Filter) ([]retry.FailedJob, int, error) {
+	if filter.JobType != "" && !filter.JobType.Valid() {
+		return nil, 0, fmt.Errorf("%w: unknown type %s", ErrInvalidFailedJobFilter, filter.JobType)
+	}
+	if filter.Status != "" && filter.Status != retry.StatusPending && filter.Status != retry.StatusDead {
+		return nil, 0, fmt.Errorf("%w: unknown status %s", ErrInvalidFailedJobFilter, filter.Status)
+	}
+	if filter.Limit <= 0 || filter.Limit > 200 {
+		filter.Limit = 50
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	jobs, total, err := s.store.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list failed jobs: %w", err)
+	}
+	return jobs, total, nil
+}
+
+// GetJob returns a single failed job with its payload.
+func (s *RetryAdminService) GetJob(ctx context.Context, id int) (*retry.FailedJob, error) {
+	job, err := s.store.Get(ctx, id)
+	if err != nil && !errors.Is(err, retry.ErrJobNotFound) {
+		return nil, fmt.Errorf("failed to get failed job: %w", err)
+	}
+	return job, err
+}
+
+// Counts returns the number of failed jobs per source, type and status.
+func (s *RetryAdminService) Counts(ctx context.Context) ([]retry.Counts, error) {
+	counts, err := s.store.Counts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count failed jobs: %w", err)
+	}
+	return counts, nil
+}
+
+// Requeue moves a dead-letter job back to the queue with a fresh retry budget.
+func (s *RetryAdminService) Requeue(ctx context.Context, id int) error {
+	return s.store.Requeue(ctx, id)
+}
+
+// Discard deletes a failed job without retrying it.
+func (s *RetryAdminService) Discard(ctx context.Context, id int) error {
+	return s.store.Discard(ctx, id)
+}
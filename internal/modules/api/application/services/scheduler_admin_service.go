@@ -0,0 +1,354 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	schedulerDomain "github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrUnknownJob          = errors.New("unknown scheduler job")
+	ErrJobNotRunning       = errors.New("job is not running")
+	ErrJobRunNotFound      = errors.New("job run not found")
+	ErrCadenceUnavailable  = errors.New("cadence planner is not configured")
+	ErrUnknownCadenceQuery = errors.New("unknown cadence source or kind")
+)
+
+// cadenceSources sources planned by the cadence planner.
+var cadenceSources = map[string]bool{"junior": true, "fhspb": true}
+
+// CadencePlanner builds the tournament refresh queue of a source.
+type CadencePlanner interface {
+	Plan(ctx context.Context, source string, kind schedulerDomain.CadenceKind) (schedulerDomain.CadencePlan, error)
+}
+
+// SchedulerRun represents a persisted scheduler job run.
+type SchedulerRun struct {
+	ID             string
+	JobName        string
+	Pipeline       string
+	Trigger        string
+	InstanceID     string
+	Status         string
+	StartedAt      time.Time
+	EndedAt        *time.Time
+	ItemsProcessed int
+	Error          string
+	SkipReason     string
+	Stats          map[string]int
+}
+
+// SchedulerJob represents a scheduler job with its control flags, lock and last run.
+type SchedulerJob struct {
+	Name             string
+	Paused           bool
+	PausedAt         *time.Time
+	TriggerRequested bool
+	Running          bool
+	InstanceID       string
+	LockedUntil      *time.Time
+	HeartbeatAt      *time.Time
+	FencingToken     int64
+	RunID            string
+	CancelRequested  bool
+	LastRun          *SchedulerRun
+}
+
+// SchedulerRunFilter filters the job run history.
+type SchedulerRunFilter struct {
+	JobName string
+	Status  string
+	Limit   int
+	Offset  int
+}
+
+// SchedulerAdminService reads scheduler run history and sends control commands.
+//
+// The scheduler runs in a separate process, so commands are written to
+// scheduler_job_controls and scheduler_locks and picked up by the instances.
+type SchedulerAdminService struct {
+	db      *sqlx.DB
+	jobs    map[string]bool
+	cadence CadencePlanner
+}
+
+// NewSchedulerAdminService creates a new scheduler admin service.
+// jobNames restricts commands to known jobs; when empty, any name is accepted.
+func NewSchedulerAdminService(db *sqlx.DB, jobNames []string) *SchedulerAdminService {
+	jobs := make(map[string]bool, len(jobNames))
+	for _, name := range jobNames {
+		jobs[name] = true
+	}
+	return &SchedulerAdminService{db: db, jobs: jobs}
+}
+
+type schedulerRunRow struct {
+	ID             string         `db:"id"`
+	JobName        string         `db:"job_name"`
+	Pipeline       string         `db:"pipeline"`
+	Trigger        string         `db:"trigger"`
+	InstanceID     string         `db:"instance_id"`
+	Status         string         `db:"status"`
+	StartedAt      time.Time      `db:"started_at"`
+	EndedAt        *time.Time     `db:"ended_at"`
+	ItemsProcessed int            `db:"items_processed"`
+	Error          sql.NullString `db:"error"`
+	SkipReason     sql.NullString `db:"skip_reason"`
+	Stats          []byte         `db:"stats"`
+}
+
+const schedulerRunColumns = `id, job_name, pipeline, trigger, instance_id, status, started_at, ended_at,
+	items_processed, error, skip_reason, stats`
+
+func (r schedulerRunRow) toRun() SchedulerRun {
+	run := SchedulerRun{
+		ID:             r.ID,
+		JobName:        r.JobName,
+		Pipeline:       r.Pipeline,
+		Trigger:        r.Trigger,
+		InstanceID:     r.InstanceID,
+		Status:         r.Status,
+		StartedAt:      r.StartedAt,
+		EndedAt:        r.EndedAt,
+		ItemsProcessed: r.ItemsProcessed,
+		Error:          r.Error.String,
+		SkipReason:     r.SkipReason.String,
+		Stats:          map[string]int{},
+	}
+	if len(r.Stats) > 0 {
+		_ = json.Unmarshal(r.Stats, &run.Stats)
+	}
+	return run
+}
+
+// WithCadence enables the planned refresh queue.
+func (s *SchedulerAdminService) WithCadence(planner CadencePlanner) *SchedulerAdminService {
+	s.cadence = planner
+	return s
+}
+
+// CadencePlan returns the tournaments the next stats or players run would refresh.
+func (s *SchedulerAdminService) CadencePlan(ctx context.Context, source, kind string) (*schedulerDomain.CadencePlan, error) {
+	if s.cadence == nil {
+		return nil, ErrCadenceUnavailable
+	}
+	cadenceKind := schedulerDomain.CadenceKind(kind)
+	if !cadenceSources[source] || (cadenceKind != schedulerDomain.CadenceStats && cadenceKind != schedulerDomain.CadencePlayers) {
+		return nil, ErrUnknownCadenceQuery
+	}
+
+	plan, err := s.cadence.Plan(ctx, source, cadenceKind)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan cadence: %w", err)
+	}
+	return &plan, nil
+}
+
+// ListRuns returns job runs, newest first, and the total number of matching runs.
+func (s *SchedulerAdminService) ListRuns(ctx context.Context, filter SchedulerRunFilter) ([]SchedulerRun, int, error) {
+	if filter.Limit <= 0 || filter.Limit > 200 {
+		filter.Limit = 50
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	where := `WHERE ($1 = '' OR job_name = $1) AND ($2 = '' OR status = $2)`
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM job_runs ` + where
+	if err := s.db.GetContext(ctx, &total, countQuery, filter.JobName, filter.Status); err != nil {
+		return nil, 0, fmt.Errorf("failed to count job runs: %w", err)
+	}
+
+	query := `SELECT ` + schedulerRunColumns + ` FROM job_runs ` + where + `
+		ORDER BY started_at DESC
+		LIMIT $3 OFFSET $4`
+
+	var rows []schedulerRunRow
+	if err := s.db.SelectContext(ctx, &rows, query, filter.JobName, filter.Status, filter.Limit, filter.Offset); err != nil {
+		return nil, 0, fmt.Errorf("failed to list job runs: %w", err)
+	}
+
+	runs := make([]SchedulerRun, len(rows))
+	for i, row := range rows {
+		runs[i] = row.toRun()
+	}
+	return runs, total, nil
+}
+
+// GetRun returns a single job run.
+func (s *SchedulerAdminService) GetRun(ctx context.Context, id string) (*SchedulerRun, error) {
+	var row schedulerRunRow
+	query := `SELECT ` + schedulerRunColumns + ` FROM job_runs WHERE id = $1`
+	if err := s.db.GetContext(ctx, &row, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrJobRunNotFound
+		}
+		return nil, fmt.Errorf("failed to get job run: %w", err)
+	}
+
+	run := row.toRun()
+	return &run, nil
+}
+
+// ListJobs returns known jobs with pause flags, current lock and last run.
+func (s *SchedulerAdminService) ListJobs(ctx context.Context) ([]SchedulerJob, error) {
+	jobs := make(map[string]*SchedulerJob)
+	job := func(name string) *SchedulerJob {
+		if j, ok := jobs[name]; ok {
+			return j
+		}
+		j := &SchedulerJob{Name: name}
+		jobs[name] = j
+		return j
+	}
+	for name := range s.jobs {
+		job(name)
+	}
+
+	var controls []struct {
+		JobName          string     `db:"job_name"`
+		Paused           bool       `db:"paused"`
+		PausedAt         *time.Time `db:"paused_at"`
+		TriggerRequested bool       `db:"trigger_requested"`
+	}
+	controlsQuery := `
+		SELECT job_name, paused, paused_at, trigger_requested_at IS NOT NULL as trigger_requested
+		FROM scheduler_job_controls
+	`
+	if err := s.db.SelectContext(ctx, &controls, controlsQuery); err != nil {
+		return nil, fmt.Errorf("failed to load job controls: %w", err)
+	}
+	for _, c := range controls {
+		j := job(c.JobName)
+		j.Paused = c.Paused
+		j.PausedAt = c.PausedAt
+		j.TriggerRequested = c.TriggerRequested
+	}
+
+	var locks []struct {
+		JobName         string         `db:"job_name"`
+		InstanceID      sql.NullString `db:"instance_id"`
+		LockedUntil     time.Time      `db:"locked_until"`
+		HeartbeatAt     *time.Time     `db:"heartbeat_at"`
+		FencingToken    int64          `db:"fencing_token"`
+		RunID           sql.NullString `db:"run_id"`
+		CancelRequested bool           `db:"cancel_requested"`
+	}
+	locksQuery := `
+		SELECT job_name, instance_id, locked_until, heartbeat_at, fencing_token, run_id,
+			cancel_requested_at IS NOT NULL as cancel_requested
+		FROM scheduler_locks
+		WHERE locked_until > NOW()
+	`
+	if err := s.db.SelectContext(ctx, &locks, locksQuery); err != nil {
+		return nil, fmt.Errorf("failed to load job locks: %w", err)
+	}
+	for _, l := range locks {
+		j := job(l.JobName)
+		lockedUntil := l.LockedUntil
+		j.Running = true
+		j.InstanceID = l.InstanceID.String
+		j.LockedUntil = &lockedUntil
+		j.HeartbeatAt = l.HeartbeatAt
+		j.FencingToken = l.FencingToken
+		j.RunID = l.RunID.String
+		j.CancelRequested = l.CancelRequested
+	}
+
+	var lastRuns []schedulerRunRow
+	lastRunsQuery := `
+		SELECT DISTINCT ON (job_name) ` + schedulerRunColumns + `
+		FROM job_runs
+		ORDER BY job_name, started_at DESC
+	`
+	if err := s.db.SelectContext(ctx, &lastRuns, lastRunsQuery); err != nil {
+		return nil, fmt.Errorf("failed to load last job runs: %w", err)
+	}
+	for _, row := range lastRuns {
+		run := row.toRun()
+		job(row.JobName).LastRun = &run
+	}
+
+	result := make([]SchedulerJob, 0, len(jobs))
+	for _, j := range jobs {
+		result = append(result, *j)
+	}
+	sort.Slice(result, func(i, k int) bool { return result[i].Name < result[k].Name })
+	return result, nil
+}
+
+// Trigger asks the scheduler to run the job (and its dependants) as soon as possible.
+func (s *SchedulerAdminService) Trigger(ctx context.Context, jobName string) error {
+	if err := s.checkJob(jobName); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO scheduler_job_controls (job_name, trigger_requested_at, updated_at)
+		VALUES ($1, NOW(), NOW())
+		ON CONFLICT (job_name) DO UPDATE
+		SET trigger_requested_at = NOW(), updated_at = NOW()
+	`
+	if _, err := s.db.ExecContext(ctx, query, jobName); err != nil {
+		return fmt.Errorf("failed to trigger job: %w", err)
+	}
+	return nil
+}
+
+// SetPaused pauses or resumes scheduled runs of the job. Manual triggers still run.
+func (s *SchedulerAdminService) SetPaused(ctx context.Context, jobName string, paused bool) error {
+	if err := s.checkJob(jobName); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO scheduler_job_controls (job_name, paused, paused_at, updated_at)
+		VALUES ($1, $2, CASE WHEN $2 THEN NOW() END, NOW())
+		ON CONFLICT (job_name) DO UPDATE
+		SET paused = $2, paused_at = CASE WHEN $2 THEN NOW() END, updated_at = NOW()
+	`
+	if _, err := s.db.ExecContext(ctx, query, jobName, paused); err != nil {
+		return fmt.Errorf("failed to update job pause: %w", err)
+	}
+	return nil
+}
+
+// Cancel asks the instance holding the job lock to cancel the running job.
+func (s *SchedulerAdminService) Cancel(ctx context.Context, jobName string) error {
+	if err := s.checkJob(jobName); err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE scheduler_locks SET cancel_requested_at = NOW()
+		WHERE job_name = $1 AND locked_until > NOW()
+	`
+	result, err := s.db.ExecContext(ctx, query, jobName)
+	if err != nil {
+		return fmt.Errorf("failed to cancel job: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to cancel job: %w", err)
+	}
+	if affected == 0 {
+		return ErrJobNotRunning
+	}
+	return nil
+}
+
+func (s *SchedulerAdminService) checkJob(jobName string) error {
+	if jobName == "" || (len(s.jobs) > 0 && !s.jobs[jobName]) {
+		return ErrUnknownJob
+	}
+	return nil
+}
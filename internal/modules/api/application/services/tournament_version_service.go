@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// TournamentVersionService tracks a per-tournament data version.
+//
+// The API event consumer bumps the version when the scheduler reports new matches
+// or statistics, and tournament endpoints serve it as an ETag. The version lives in
+// the database, so every API replica sees the change no matter which one consumed the event.
+type TournamentVersionService struct {
+	db *sqlx.DB
+}
+
+// NewTournamentVersionService creates a new tournament version service.
+func NewTournamentVersionService(db *sqlx.DB) *TournamentVersionService {
+	return &TournamentVersionService{db: db}
+}
+
+// Bump advances the data version of the tournament. Unknown tournaments are ignored.
+func (s *TournamentVersionService) Bump(ctx context.Context, tournamentID string) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO tournament_data_versions (tournament_id)
+		SELECT id FROM tournaments WHERE id = $1
+		ON CONFLICT (tournament_id) DO UPDATE
+		SET version = tournament_data_versions.version + 1, updated_at = NOW()`, tournamentID)
+	if err != nil {
+		return fmt.Errorf("bump tournament %s version: %w", tournamentID, err)
+	}
+	return nil
+}
+
+// ETag returns the validator of the tournament's current data,
+// or "" when no change has been recorded for the tournament yet.
+func (s *TournamentVersionService) ETag(ctx context.Context, tournamentID string) (string, error) {
+	var version int64
+	err := s.db.GetContext(ctx, &version,
+		`SELECT version FROM tournament_data_versions WHERE tournament_id = $1`, tournamentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("get tournament %s version: %w", tournamentID, err)
+	}
+	return tournamentETag(tournamentID, version), nil
+}
+
+// tournamentETag is weak: it tracks the data version, not the exact response bytes.
+func tournamentETag(tournamentID string, version int64) string {
+	return fmt.Sprintf(`W/"%s.%d"`, tournamentID, version)
+}
@@ -0,0 +1,78 @@
+package services
+
+import "sort"
+
+// findBreakouts returns cohort players whose latest growth is in the top quartile for their age
+// while their level is still below the cohort's elite (90th percentile).
+func findBreakouts(rows []SeasonProduction, birthYear int, growth map[int][]float64, limit int) []BreakoutCandidate {
+	latest := ""
+	byPlayer := make(map[string]map[int]SeasonProduction)
+	for _, r := range rows {
+		if r.BirthDate.Year() != birthYear || r.Games < minTrajectoryGames {
+			continue
+		}
+		if r.Season > latest {
+			latest = r.Season
+		}
+		if byPlayer[r.PlayerID] == nil {
+			byPlayer[r.PlayerID] = make(map[int]SeasonProduction)
+		}
+		byPlayer[r.PlayerID][seasonStartYear(r.Season)] = r
+	}
+	if latest == "" {
+		return nil
+	}
+
+	var levels []float64
+	for _, seasons := range byPlayer {
+		if cur, ok := seasons[seasonStartYear(latest)]; ok {
+			levels = append(levels, cur.Adjusted)
+		}
+	}
+	levels = sortedCopy(levels)
+	median, elite := quantile(levels, 0.5), quantile(levels, 0.9)
+
+	latestYear := seasonStartYear(latest)
+	var candidates []BreakoutCandidate
+	for _, seasons := range byPlayer {
+		cur, okCur := seasons[latestYear]
+		prev, okPrev := seasons[latestYear-1]
+		if !okCur || !okPrev || prev.Adjusted < minGrowthBase {
+			continue
+		}
+		if cur.Adjusted < median || cur.Adjusted > elite {
+			continue
+		}
+
+		ratios := growth[prev.Age()]
+		if len(ratios) < minGrowthSamples {
+			continue
+		}
+		ratio, typical := cur.Adjusted/prev.Adjusted, quantile(ratios, 0.5)
+		if typical <= 0 || ratio < quantile(ratios, 0.75) {
+			continue
+		}
+
+		candidates = append(candidates, BreakoutCandidate{
+			PlayerID:      cur.PlayerID,
+			Name:          cur.Name,
+			BirthDate:     cur.BirthDate,
+			Season:        cur.Season,
+			Games:         cur.Games,
+			Points:        cur.Points,
+			PreviousIndex: RoundTo(prev.Adjusted, 3),
+			Index:         RoundTo(cur.Adjusted, 3),
+			Growth:        RoundTo(ratio, 3),
+			CohortGrowth:  RoundTo(typical, 3),
+			Projection:    projectNext(cur, growth),
+		})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Growth/candidates[i].CohortGrowth > candidates[j].Growth/candidates[j].CohortGrowth
+	})
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// minGrowthBase guards growth ratios against near-zero previous seasons.
+const minGrowthBase = 0.1
+
+// CohortPoint is one age point on a birth-year cohort's typical development curve.
+type CohortPoint struct {
+	Age     int
+	Season  string
+	Players int
+	P25     float64
+	Median  float64
+	P75     float64
+}
+
+// Projection is a projected next-season range for a player.
+type Projection struct {
+	Season        string
+	Low           float64
+	Median        float64
+	High          float64
+	PointsPerGame [3]float64 // low, median, high
+	Samples       int
+}
+
+// BreakoutCandidate is a player whose latest season outgrew the cohort's typical curve.
+type BreakoutCandidate struct {
+	PlayerID      string
+	Name          string
+	BirthDate     time.Time
+	Season        string
+	Games         int
+	Points        int
+	PreviousIndex float64
+	Index         float64
+	Growth        float64
+	CohortGrowth  float64
+	Projection    *Projection
+}
+
+// cohortCurve builds the percentile curve of adjusted production per age for a birth year.
+func cohortCurve(rows []SeasonProduction, birthYear int) []CohortPoint {
+	bySeason := make(map[string][]float64)
+	for _, r := range rows {
+		if r.BirthDate.Year() == birthYear && r.Games >= minTrajectoryGames {
+			bySeason[r.Season] = append(bySeason[r.Season], r.Adjusted)
+		}
+	}
+
+	curve := make([]CohortPoint, 0, len(bySeason))
+	for season, values := range bySeason {
+		sorted := sortedCopy(values)
+		curve = append(curve, CohortPoint{
+			Age:     seasonStartYear(season) - birthYear,
+			Season:  season,
+			Players: len(sorted),
+			P25:     RoundTo(quantile(sorted, 0.25), 3),
+			Median:  RoundTo(quantile(sorted, 0.5), 3),
+			P75:     RoundTo(quantile(sorted, 0.75), 3),
+		})
+	}
+	sort.Slice(curve, func(i, j int) bool { return curve[i].Season < curve[j].Season })
+	return curve
+}
+
+// growthByAge collects adjusted-index ratios between consecutive seasons keyed by the starting age.
+func growthByAge(rows []SeasonProduction) map[int][]float64 {
+	type seasonKey struct {
+		playerID string
+		year     int
+	}
+	index := make(map[seasonKey]SeasonProduction, len(rows))
+	for _, r := range rows {
+		if r.Games >= minTrajectoryGames {
+			index[seasonKey{playerID: r.PlayerID, year: seasonStartYear(r.Season)}] = r
+		}
+	}
+
+	growth := make(map[int][]float64)
+	for key, cur := range index {
+		next, ok := index[seasonKey{playerID: key.playerID, year: key.year + 1}]
+		if !ok || cur.Adjusted < minGrowthBase {
+			continue
+		}
+		growth[cur.Age()] = append(growth[cur.Age()], next.Adjusted/cur.Adjusted)
+	}
+	for age := range growth {
+		sort.Float64s(growth[age])
+	}
+	return growth
+}
+
+// projectNext projects the next-season adjusted index range from the cohort growth distribution.
+func projectNext(current SeasonProduction, growth map[int][]float64) *Projection {
+	ratios := growth[current.Age()]
+	if len(ratios) < minGrowthSamples || current.Games == 0 {
+		return nil
+	}
+
+	low, mid, high := quantile(ratios, 0.1), quantile(ratios, 0.5), quantile(ratios, 0.9)
+	ppg := float64(current.Points) / float64(current.Games)
+	startYear := seasonStartYear(current.Season) + 1
+
+	return &Projection{
+		Season: fmt.Sprintf("%d-%d", startYear, startYear+1),
+		Low:    RoundTo(current.Adjusted*low, 3),
+		Median: RoundTo(current.Adjusted*mid, 3),
+		High:   RoundTo(current.Adjusted*high, 3),
+		PointsPerGame: [3]float64{
+			RoundTo(ppg*low, 2), RoundTo(ppg*mid, 2), RoundTo(ppg*high, 2),
+		},
+		Samples: len(ratios),
+	}
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	// minTrajectoryGames is the minimum number of games for a season to enter the model.
+	minTrajectoryGames = 5
+	// minGrowthSamples is the minimum number of season-to-season transitions for a projection.
+	minGrowthSamples = 10
+	// minRelativeAgeSample is the minimum sample to estimate the birth-month effect.
+	minRelativeAgeSample = 20
+	// midYearMonth is the reference month all players are adjusted to.
+	midYearMonth = 6.5
+)
+
+// SeasonProduction represents a player's production over one season.
+type SeasonProduction struct {
+	PlayerID       string    `db:"player_id"`
+	Name           string    `db:"name"`
+	BirthDate      time.Time `db:"birth_date"`
+	Season         string    `db:"season"`
+	Games          int       `db:"games"`
+	Goals          int       `db:"goals"`
+	Assists        int       `db:"assists"`
+	Points         int       `db:"points"`
+	ExpectedPoints float64   `db:"expected_points"`
+
+	// Index is points relative to the league-average expectation (1.0 = average player).
+	Index float64 `db:"-"`
+	// Adjusted is Index corrected for birth month within the year.
+	Adjusted float64 `db:"-"`
+}
+
+// Age returns the player's age in the season (season start year minus birth year).
+func (p SeasonProduction) Age() int {
+	return seasonStartYear(p.Season) - p.BirthDate.Year()
+}
+
+// seasonStartYear extracts the start year from a season string like "2024-2025".
+func seasonStartYear(season string) int {
+	if len(season) < 4 {
+		return 0
+	}
+	year, err := strconv.Atoi(season[:4])
+	if err != nil {
+		return 0
+	}
+	return year
+}
+
+// normalizeProduction computes the production index and the relative-age adjustment.
+//
+// The birth-month effect is estimated by regressing the index on birth month within
+// each (birth year, season) cohort; every player is then shifted to mid-year.
+func normalizeProduction(rows []SeasonProduction) {
+	type cohortKey struct {
+		birthYear int
+		season    string
+	}
+	groups := make(map[cohortKey][]int)
+
+	for i := range rows {
+		if rows[i].ExpectedPoints > 0 {
+			rows[i].Index = float64(rows[i].Points) / rows[i].ExpectedPoints
+		}
+		rows[i].Adjusted = rows[i].Index
+		if rows[i].Games >= minTrajectoryGames {
+			key := cohortKey{birthYear: rows[i].BirthDate.Year(), season: rows[i].Season}
+			groups[key] = append(groups[key], i)
+		}
+	}
+
+	for _, idx := range groups {
+		if len(idx) < minRelativeAgeSample {
+			continue
+		}
+		months := make([]float64, len(idx))
+		values := make([]float64, len(idx))
+		for j, i := range idx {
+			months[j] = float64(rows[i].BirthDate.Month())
+			values[j] = rows[i].Index
+		}
+		slope := linearSlope(months, values)
+		for _, i := range idx {
+			month := float64(rows[i].BirthDate.Month())
+			rows[i].Adjusted = math.Max(0, rows[i].Index-slope*(month-midYearMonth))
+		}
+	}
+}
+
+// linearSlope returns the least-squares slope b of y = a + b*x.
+func linearSlope(x, y []float64) float64 {
+	n := float64(len(x))
+	if n < 2 {
+		return 0
+	}
+	var sumX, sumY float64
+	for i := range x {
+		sumX += x[i]
+		sumY += y[i]
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var cov, varX float64
+	for i := range x {
+		cov += (x[i] - meanX) * (y[i] - meanY)
+		varX += (x[i] - meanX) * (x[i] - meanX)
+	}
+	if varX == 0 {
+		return 0
+	}
+	return cov / varX
+}
+
+// quantile returns the q-th quantile of a sorted sample with linear interpolation.
+func quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	if lower == upper {
+		return sorted[lower]
+	}
+	frac := pos - float64(lower)
+	return sorted[lower]*(1-frac) + sorted[upper]*frac
+}
+
+// sortedCopy returns a sorted copy of the sample.
+func sortedCopy(values []float64) []float64 {
+	out := append([]float64(nil), values...)
+	sort.Float64s(out)
+	return out
+}
+
+// RoundTo rounds v to the given number of decimal digits.
+func RoundTo(v float64, digits int) float64 {
+	pow := math.Pow(10, float64(digits))
+	return math.Round(v*pow) / pow
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestQuantile(t *testing.T) {
+	tests := []struct {
+		name   string
+		sample []float64
+		q      float64
+		want   float64
+	}{
+		{name: "empty", sample: nil, q: 0.5, want: 0},
+		{name: "single", sample: []float64{3}, q: 0.9, want: 3},
+		{name: "median odd", sample: []float64{1, 2, 3}, q: 0.5, want: 2},
+		{name: "median even", sample: []float64{1, 2, 3, 4}, q: 0.5, want: 2.5},
+		{name: "interpolated", sample: []float64{0, 10}, q: 0.25, want: 2.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := quantile(tt.sample, tt.q); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("quantile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinearSlope(t *testing.T) {
+	x := []float64{1, 2, 3, 4}
+	y := []float64{3, 5, 7, 9}
+	if got := linearSlope(x, y); math.Abs(got-2) > 1e-9 {
+		t.Errorf("linearSlope() = %v, want 2", got)
+	}
+	if got := linearSlope([]float64{1, 1}, []float64{2, 3}); got != 0 {
+		t.Errorf("linearSlope() with zero variance = %v, want 0", got)
+	}
+}
+
+func TestNormalizeProduction_RelativeAge(t *testing.T) {
+	// Older (January-born) players score more; after adjustment the cohort is flat.
+	var rows []SeasonProduction
+	for i := 0; i < minRelativeAgeSample*2; i++ {
+		month := time.Month(i%12 + 1)
+		points := 30 - 2*int(month)
+		rows = append(rows, SeasonProduction{
+			PlayerID:       fmt.Sprintf("p%d", i),
+			BirthDate:      time.Date(2012, month, 15, 0, 0, 0, 0, time.UTC),
+			Season:         "2024-2025",
+			Games:          10,
+			Points:         points,
+			ExpectedPoints: 10,
+		})
+	}
+
+	normalizeProduction(rows)
+
+	first, last := rows[0], rows[11]
+	if first.Index <= last.Index {
+		t.Fatalf("raw index should favour January-born players: %v vs %v", first.Index, last.Index)
+	}
+	if math.Abs(first.Adjusted-last.Adjusted) > 1e-9 {
+		t.Errorf("adjusted index should be flat across months: %v vs %v", first.Adjusted, last.Adjusted)
+	}
+}
+
+func TestProjectNext(t *testing.T) {
+	current := SeasonProduction{
+		BirthDate: time.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC),
+		Season:    "2024-2025",
+		Games:     20,
+		Points:    20,
+		Adjusted:  1.0,
+	}
+
+	if got := projectNext(current, map[int][]float64{12: {1.1}}); got != nil {
+		t.Errorf("projectNext() with too few samples = %+v, want nil", got)
+	}
+
+	ratios := make([]float64, minGrowthSamples)
+	for i := range ratios {
+		ratios[i] = 1.2
+	}
+	got := projectNext(current, map[int][]float64{12: ratios})
+	if got == nil {
+		t.Fatal("projectNext() = nil, want projection")
+	}
+	if got.Season != "2025-2026" {
+		t.Errorf("Season = %q, want 2025-2026", got.Season)
+	}
+	if got.Median != 1.2 || got.PointsPerGame[1] != 1.2 {
+		t.Errorf("Median = %v, PointsPerGame = %v, want 1.2", got.Median, got.PointsPerGame)
+	}
+}
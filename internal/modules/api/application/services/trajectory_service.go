@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// trajectoryCohortWindow is how many older birth years feed the growth distribution.
+const trajectoryCohortWindow = 3
+
+// PlayerTrajectory is a player's normalised development path with cohort context.
+type PlayerTrajectory struct {
+	PlayerID   string
+	Name       string
+	BirthDate  time.Time
+	Seasons    []SeasonProduction
+	Cohort     []CohortPoint
+	Projection *Projection
+}
+
+// TrajectoryService builds development trajectories and projections.
+type TrajectoryService struct {
+	db *sqlx.DB
+}
+
+// NewTrajectoryService creates a new trajectory service.
+func NewTrajectoryService(db *sqlx.DB) *TrajectoryService {
+	return &TrajectoryService{db: db}
+}
+
+// GetPlayerTrajectory returns the player's seasons, cohort curve and next-season projection.
+func (s *TrajectoryService) GetPlayerTrajectory(ctx context.Context, playerID string) (*PlayerTrajectory, error) {
+	var player struct {
+		Name      string    `db:"name"`
+		BirthDate time.Time `db:"birth_date"`
+	}
+	err := s.db.GetContext(ctx, &player, "SELECT name, birth_date FROM players WHERE id = $1", playerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player: %w", err)
+	}
+
+	birthYear := player.BirthDate.Year()
+	rows, err := s.loadProduction(ctx, birthYear-trajectoryCohortWindow, birthYear)
+	if err != nil {
+		return nil, err
+	}
+	normalizeProduction(rows)
+
+	result := &PlayerTrajectory{
+		PlayerID:  playerID,
+		Name:      player.Name,
+		BirthDate: player.BirthDate,
+		Cohort:    cohortCurve(rows, birthYear),
+	}
+	for _, r := range rows {
+		if r.PlayerID == playerID {
+			result.Seasons = append(result.Seasons, r)
+		}
+	}
+	if n := len(result.Seasons); n > 0 && result.Seasons[n-1].Games >= minTrajectoryGames {
+		result.Projection = projectNext(result.Seasons[n-1], growthByAge(rows))
+	}
+	return result, nil
+}
+
+// GetBreakoutCandidates returns likely breakout players for a birth year.
+func (s *TrajectoryService) GetBreakoutCandidates(ctx context.Context, birthYear, limit int) ([]BreakoutCandidate, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	rows, err := s.loadProduction(ctx, birthYear-trajectoryCohortWindow, birthYear)
+	if err != nil {
+		return nil, err
+	}
+	normalizeProduction(rows)
+	return findBreakouts(rows, birthYear, growthByAge(rows), limit), nil
+}
+
+// loadProduction loads per-season skater production for the birth year range.
+//
+// Expected points are games weighted by the average points per game of each
+// tournament age group and divided by its strength coefficient, so production in
+// low- and high-scoring, weak and strong leagues is comparable.
+func (s *TrajectoryService) loadProduction(ctx context.Context, fromYear, toYear int) ([]SeasonProduction, error) {
+	query := `
+		WITH base AS (
+			SELECT ps.player_id, ps.tournament_id, ps.birth_year AS group_year,
+				ps.games, ps.goals, ps.assists, ps.points
+			FROM player_statistics ps
+			WHERE ps.group_name = 'Общая статистика'
+				OR NOT EXISTS (
+					SELECT 1 FROM player_statistics g
+					WHERE g.player_id = ps.player_id AND g.tournament_id = ps.tournament_id
+						AND g.group_name = 'Общая статистика'
+				)
+		),
+		league AS (
+			SELECT tournament_id, group_year, SUM(points)::float8 / NULLIF(SUM(games), 0) AS avg_ppg
+			FROM base
+			GROUP BY tournament_id, group_year
+		)
+		SELECT p.id as player_id, p.name, p.birth_date, t.season,
+			SUM(b.games)::int as games, SUM(b.goals)::int as goals,
+			SUM(b.assists)::int as assists, SUM(b.points)::int as points,
+			COALESCE(SUM(b.games * l.avg_ppg / COALESCE(str.coefficient, 1)), 0)::float8 as expected_points
+		FROM base b
+		JOIN players p ON p.id = b.player_id
+		JOIN tournaments t ON t.id = b.tournament_id
+		JOIN league l ON l.tournament_id = b.tournament_id AND l.group_year = b.group_year
+		LEFT JOIN tournament_strength str ON str.tournament_id = b.tournament_id AND str.birth_year = b.group_year
+		WHERE EXTRACT(YEAR FROM p.birth_date) BETWEEN $1 AND $2
+			AND COALESCE(p.position, '') NOT IN ('Вратарь', 'G')
+			AND COALESCE(t.season, '') != ''
+		GROUP BY p.id, p.name, p.birth_date, t.season
+		HAVING SUM(b.games) > 0
+		ORDER BY p.id, t.season
+	`
+
+	var rows []SeasonProduction
+	if err := s.db.SelectContext(ctx, &rows, query, fromYear, toYear); err != nil {
+		return nil, fmt.Errorf("failed to load season production: %w", err)
+	}
+	return rows, nil
+}
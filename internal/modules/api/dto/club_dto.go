@@ -0,0 +1,65 @@
+package dto
+
+// ClubDTO represents a club grouping per-source team rows.
+type ClubDTO struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	City    string   `json:"city,omitempty"`
+	Region  string   `json:"region,omitempty"`
+	Aliases []string `json:"aliases"`
+}
+
+// ClubTeamDTO represents a source team row linked to a club.
+type ClubTeamDTO struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	City   string `json:"city,omitempty"`
+	Region string `json:"region,omitempty"`
+	Source string `json:"source"`
+}
+
+// ClubSeasonDTO represents a club's standings totals in a season from one source.
+type ClubSeasonDTO struct {
+	Season       string `json:"season"`
+	Source       string `json:"source"`
+	Teams        int    `json:"teams"`
+	Tournaments  int    `json:"tournaments"`
+	Games        int    `json:"games"`
+	Wins         int    `json:"wins"`
+	Losses       int    `json:"losses"`
+	Points       int    `json:"points"`
+	GoalsFor     int    `json:"goalsFor"`
+	GoalsAgainst int    `json:"goalsAgainst"`
+}
+
+// ClubHistoryResponse represents a club with its teams and season history, newest season first.
+type ClubHistoryResponse struct {
+	Club    ClubDTO         `json:"club"`
+	Teams   []ClubTeamDTO   `json:"teams"`
+	Seasons []ClubSeasonDTO `json:"seasons"`
+}
+
+// ClubSearchResponse represents clubs matching a search query.
+type ClubSearchResponse struct {
+	Clubs []ClubDTO `json:"clubs"`
+}
+
+// ClubReviewDTO represents a team-to-club match awaiting an admin decision.
+type ClubReviewDTO struct {
+	ID         int64       `json:"id"`
+	Team       ClubTeamDTO `json:"team"`
+	ClubID     string      `json:"clubId"`
+	ClubName   string      `json:"clubName"`
+	Score      float64     `json:"score"`
+	Status     string      `json:"status"`
+	CreatedAt  string      `json:"createdAt"`
+	ResolvedAt *string     `json:"resolvedAt,omitempty"`
+}
+
+// ClubReviewsResponse represents a page of club match reviews.
+type ClubReviewsResponse struct {
+	Reviews []ClubReviewDTO `json:"reviews"`
+	Total   int             `json:"total"`
+	Limit   int             `json:"limit"`
+	Offset  int             `json:"offset"`
+}
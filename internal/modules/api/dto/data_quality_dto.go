@@ -0,0 +1,36 @@
+package dto
+
+// QualityViolationDTO represents a data quality rule violation by a single row.
+type QualityViolationDTO struct {
+	ID          int64   `json:"id"`
+	Rule        string  `json:"rule"`
+	Entity      string  `json:"entity"`
+	EntityID    string  `json:"entityId"`
+	Severity    string  `json:"severity"`
+	Details     string  `json:"details,omitempty"`
+	JobName     *string `json:"jobName,omitempty"`
+	RunID       *string `json:"runId,omitempty"`
+	FirstSeenAt string  `json:"firstSeenAt"`
+	LastSeenAt  string  `json:"lastSeenAt"`
+	ResolvedAt  *string `json:"resolvedAt,omitempty"`
+}
+
+// QualityRuleSummaryDTO represents the number of open violations of a rule.
+type QualityRuleSummaryDTO struct {
+	Rule        string `json:"rule"`
+	Entity      string `json:"entity"`
+	Severity    string `json:"severity"`
+	Open        int    `json:"open"`
+	FirstSeenAt string `json:"firstSeenAt"`
+	LastSeenAt  string `json:"lastSeenAt"`
+}
+
+// QualityViolationsResponse represents a page of violations with open counts per rule.
+type QualityViolationsResponse struct {
+	Violations []QualityViolationDTO   `json:"violations"`
+	Rules      []QualityRuleSummaryDTO `json:"rules"`
+	Critical   int                     `json:"critical"`
+	Total      int                     `json:"total"`
+	Limit      int                     `json:"limit"`
+	Offset     int                     `json:"offset"`
+}
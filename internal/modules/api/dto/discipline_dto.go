@@ -0,0 +1,48 @@
+package dto
+
+// InfractionDTO represents an infraction type and how often it was called.
+type InfractionDTO struct {
+	Code    string `json:"code,omitempty"`
+	Reason  string `json:"reason"`
+	Count   int    `json:"count"`
+	Minutes int    `json:"minutes"`
+}
+
+// PeriodDisciplineDTO represents penalties taken in one period.
+type PeriodDisciplineDTO struct {
+	Period        string  `json:"period"`
+	Penalties     int     `json:"penalties"`
+	Minutes       int     `json:"minutes"`
+	Share         float64 `json:"share"`
+	PerGame       float64 `json:"perGame"`
+	GoalsConceded int     `json:"goalsConceded"`
+}
+
+// OffenderDTO represents a player or team ranked by penalty minutes.
+type OffenderDTO struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Penalties int    `json:"penalties"`
+	Minutes   int    `json:"minutes"`
+}
+
+// DisciplineResponse represents a discipline report for a player, team or tournament.
+type DisciplineResponse struct {
+	Scope              string                `json:"scope"`
+	ID                 string                `json:"id"`
+	Games              int                   `json:"games"`
+	Penalties          int                   `json:"penalties"`
+	Minutes            int                   `json:"minutes"`
+	MinutesPerGame     float64               `json:"minutesPerGame"`
+	Minors             int                   `json:"minors"`
+	Majors             int                   `json:"majors"`
+	Misconducts        int                   `json:"misconducts"`
+	GameMisconducts    int                   `json:"gameMisconducts"`
+	PowerPlayPenalties int                   `json:"powerPlayPenalties"`
+	CostlyPenalties    int                   `json:"costlyPenalties"`
+	CostlyRate         float64               `json:"costlyRate"`
+	Infractions        []InfractionDTO       `json:"infractions"`
+	Periods            []PeriodDisciplineDTO `json:"periods"`
+	TopPlayers         []OffenderDTO         `json:"topPlayers,omitempty"`
+	TopTeams           []OffenderDTO         `json:"topTeams,omitempty"`
+}
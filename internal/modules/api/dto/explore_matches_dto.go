@@ -16,6 +16,7 @@ type MatchDTO struct {
 	Time        string `json:"time"`
 	Tournament  string `json:"tournament"`
 	Venue       string `json:"venue,omitempty"`
+	VenueID     string `json:"venueId,omitempty"`
 	Status      string `json:"status"`
 }
 
@@ -26,22 +27,23 @@ type MatchListResponse struct {
 
 // RankedPlayerDTO represents a player in rankings.
 type RankedPlayerDTO struct {
-	Rank           int    `json:"rank"`
-	ID             string `json:"id"`
-	Name           string `json:"name"`
-	PhotoURL       string `json:"photoUrl,omitempty"`
-	Position       string `json:"position"`
-	BirthYear      int    `json:"birthYear"`
-	Team           string `json:"team"`
-	TeamID         string `json:"teamId"`
-	TeamLogoURL    string `json:"teamLogoUrl,omitempty"`
-	TeamCity       string `json:"teamCity,omitempty"`
-	Games          int    `json:"games"`
-	Goals          int    `json:"goals"`
-	Assists        int    `json:"assists"`
-	Points         int    `json:"points"`
-	PlusMinus      int    `json:"plusMinus"`
-	PenaltyMinutes int    `json:"penaltyMinutes"`
+	Rank           int     `json:"rank"`
+	ID             string  `json:"id"`
+	Name           string  `json:"name"`
+	PhotoURL       string  `json:"photoUrl,omitempty"`
+	Position       string  `json:"position"`
+	BirthYear      int     `json:"birthYear"`
+	Team           string  `json:"team"`
+	TeamID         string  `json:"teamId"`
+	TeamLogoURL    string  `json:"teamLogoUrl,omitempty"`
+	TeamCity       string  `json:"teamCity,omitempty"`
+	Games          int     `json:"games"`
+	Goals          int     `json:"goals"`
+	Assists        int     `json:"assists"`
+	Points         int     `json:"points"`
+	PlusMinus      int     `json:"plusMinus"`
+	PenaltyMinutes int     `json:"penaltyMinutes"`
+	AdjustedPoints float64 `json:"adjustedPoints,omitempty"`
 }
 
 // RankingsResponse represents player rankings.
@@ -0,0 +1,15 @@
+package dto
+
+// ExportJobDTO represents an async export job.
+type ExportJobDTO struct {
+	ID          string  `json:"id"`
+	Dataset     string  `json:"dataset"`
+	Format      string  `json:"format"`
+	Status      string  `json:"status"`
+	Rows        *int    `json:"rows,omitempty"`
+	Error       string  `json:"error,omitempty"`
+	CreatedAt   string  `json:"createdAt"`
+	FinishedAt  *string `json:"finishedAt,omitempty"`
+	ExpiresAt   *string `json:"expiresAt,omitempty"`
+	DownloadURL string  `json:"downloadUrl,omitempty"`
+}
@@ -0,0 +1,60 @@
+package dto
+
+// GoalieGameDTO represents a goalie's single-game line.
+type GoalieGameDTO struct {
+	MatchID      string  `json:"matchId"`
+	TournamentID string  `json:"tournamentId"`
+	TeamID       string  `json:"teamId"`
+	Date         string  `json:"date,omitempty"`
+	ShotsAgainst int     `json:"shotsAgainst"`
+	Saves        int     `json:"saves"`
+	GoalsAgainst int     `json:"goalsAgainst"`
+	SavePct      float64 `json:"savePct"`
+	TimeOnIce    int     `json:"timeOnIce,omitempty"`
+	QualityStart bool    `json:"qualityStart"`
+	PeriodGoals  [4]int  `json:"periodGoals"`
+	PeriodShots  *[4]int `json:"periodShots,omitempty"`
+}
+
+// GoalieSummaryDTO represents aggregated goalie statistics.
+type GoalieSummaryDTO struct {
+	PlayerID          string         `json:"playerId"`
+	Name              string         `json:"name"`
+	Games             int            `json:"games"`
+	ShotsAgainst      int            `json:"shotsAgainst"`
+	Saves             int            `json:"saves"`
+	GoalsAgainst      int            `json:"goalsAgainst"`
+	SavePct           float64        `json:"savePct"`
+	GoalsAgainstAvg   float64        `json:"goalsAgainstAvg"`
+	QualityStarts     int            `json:"qualityStarts"`
+	QualityStartPct   float64        `json:"qualityStartPct"`
+	PeriodSavePct     []*float64     `json:"periodSavePct"`
+	PeriodGoals       [4]int         `json:"periodGoals"`
+	PeriodSampleGames int            `json:"periodSampleGames"`
+	GoalsByState      map[string]int `json:"goalsByState"` // goals only: sources have no shots per game state
+}
+
+// GoalieProfileResponse represents a goalie's summary and game log.
+type GoalieProfileResponse struct {
+	Summary GoalieSummaryDTO `json:"summary"`
+	Games   []GoalieGameDTO  `json:"games"`
+}
+
+// GoalieLeaderboardResponse represents the goalie leaderboard.
+type GoalieLeaderboardResponse struct {
+	TournamentID string             `json:"tournamentId,omitempty"`
+	MinGames     int                `json:"minGames"`
+	Goalies      []GoalieSummaryDTO `json:"goalies"`
+}
+
+// EmptyNetPullsResponse represents a team's goalie pull analysis.
+type EmptyNetPullsResponse struct {
+	TeamID            string      `json:"teamId"`
+	Pulls             int         `json:"pulls"`
+	AvgSecondsLeft    float64     `json:"avgSecondsLeft"`
+	GoalsFor          int         `json:"goalsFor"`
+	GoalsAgainst      int         `json:"goalsAgainst"`
+	NoGoal            int         `json:"noGoal"`
+	ByDeficit         map[int]int `json:"byDeficit"`
+	GoalsForByDeficit map[int]int `json:"goalsForByDeficit"`
+}
@@ -0,0 +1,40 @@
+package dto
+
+// LinePlayerDTO represents a player within a line combination.
+type LinePlayerDTO struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Position string `json:"position"`
+}
+
+// LineCombinationDTO represents a forward trio or defence pair.
+type LineCombinationDTO struct {
+	Kind         string          `json:"kind"`
+	Players      []LinePlayerDTO `json:"players"`
+	GoalsFor     int             `json:"goalsFor"`
+	GoalsAgainst int             `json:"goalsAgainst"`
+	GoalDiff     int             `json:"goalDiff"`
+	Matches      int             `json:"matches"`
+}
+
+// TeamLinesResponse represents a team's most frequent line combinations.
+type TeamLinesResponse struct {
+	TeamID   string               `json:"teamId"`
+	Forwards []LineCombinationDTO `json:"forwards"`
+	Defence  []LineCombinationDTO `json:"defence"`
+}
+
+// LinemateDTO represents on-ice results with a single teammate.
+type LinemateDTO struct {
+	Player       LinePlayerDTO `json:"player"`
+	GoalsFor     int           `json:"goalsFor"`
+	GoalsAgainst int           `json:"goalsAgainst"`
+	GoalDiff     int           `json:"goalDiff"`
+	Matches      int           `json:"matches"`
+}
+
+// PlayerLinematesResponse represents a player's linemates ranked by goal differential.
+type PlayerLinematesResponse struct {
+	PlayerID  string        `json:"playerId"`
+	Linemates []LinemateDTO `json:"linemates"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "encoding/json"
+
+// PlayerChangeDTO represents a single change from the player change history.
+type PlayerChangeDTO struct {
+	ID         int64           `json:"id"`
+	PlayerID   string          `json:"playerId"`
+	PlayerName string          `json:"playerName,omitempty"`
+	Type       string          `json:"type"`
+	Source     string          `json:"source"`
+	SessionID  *string         `json:"sessionId,omitempty"`
+	Data       json.RawMessage `json:"data"`
+	CreatedAt  string          `json:"createdAt"`
+}
+
+// PlayerHistoryResponse represents a page of a player's change timeline, newest first.
+type PlayerHistoryResponse struct {
+	PlayerID string            `json:"playerId"`
+	Changes  []PlayerChangeDTO `json:"changes"`
+	Limit    int               `json:"limit"`
+	Offset   int               `json:"offset"`
+}
+
+// ParseSessionReportResponse represents what changed during a parse session.
+type ParseSessionReportResponse struct {
+	SessionID string            `json:"sessionId"`
+	JobName   string            `json:"jobName,omitempty"`
+	StartedAt *string           `json:"startedAt,omitempty"`
+	EndedAt   *string           `json:"endedAt,omitempty"`
+	Counts    map[string]int    `json:"counts"`
+	Total     int               `json:"total"`
+	Changes   []PlayerChangeDTO `json:"changes"`
+}
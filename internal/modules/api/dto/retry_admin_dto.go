@@ -0,0 +1,45 @@
+package dto
+
+import "encoding/json"
+
+// FailedJobDTO represents a job in the parsing retry queue.
+type FailedJobDTO struct {
+	ID          int             `json:"id"`
+	Type        string          `json:"type"`
+	Source      string          `json:"source"`
+	ExternalID  string          `json:"externalId"`
+	URL         string          `json:"url,omitempty"`
+	Status      string          `json:"status"`
+	Error       string          `json:"error,omitempty"`
+	RetryCount  int             `json:"retryCount"`
+	MaxRetries  int             `json:"maxRetries"`
+	NextRetryAt *string         `json:"nextRetryAt,omitempty"`
+	DeadAt      *string         `json:"deadAt,omitempty"`
+	CreatedAt   string          `json:"createdAt"`
+	UpdatedAt   string          `json:"updatedAt"`
+	Payload     json.RawMessage `json:"payload,omitempty"`
+}
+
+// FailedJobCountDTO represents the number of failed jobs per source, type and status.
+type FailedJobCountDTO struct {
+	Source string `json:"source"`
+	Type   string `json:"type"`
+	Status string `json:"status"`
+	Count  int    `json:"count"`
+}
+
+// FailedJobsResponse represents a page of failed jobs with queue totals.
+type FailedJobsResponse struct {
+	Jobs   []FailedJobDTO      `json:"jobs"`
+	Counts []FailedJobCountDTO `json:"counts"`
+	Total  int                 `json:"total"`
+	Limit  int                 `json:"limit"`
+	Offset int                 `json:"offset"`
+}
+
+// FailedJobCommandResponse confirms an accepted retry queue command.
+type FailedJobCommandResponse struct {
+	ID      int    `json:"id"`
+	Command string `json:"command"`
+	Status  string `json:"status"`
+}
@@ -0,0 +1,78 @@
+package dto
+
+// SchedulerRunDTO represents a scheduler job run.
+type SchedulerRunDTO struct {
+	ID             string         `json:"id"`
+	JobName        string         `json:"jobName"`
+	Pipeline       string         `json:"pipeline"`
+	Trigger        string         `json:"trigger"`
+	InstanceID     string         `json:"instanceId"`
+	Status         string         `json:"status"`
+	StartedAt      string         `json:"startedAt"`
+	EndedAt        *string        `json:"endedAt,omitempty"`
+	DurationSec    *float64       `json:"durationSec,omitempty"`
+	ItemsProcessed int            `json:"itemsProcessed"`
+	Error          string         `json:"error,omitempty"`
+	SkipReason     string         `json:"skipReason,omitempty"`
+	Stats          map[string]int `json:"stats"`
+}
+
+// SchedulerRunsResponse represents a page of job runs.
+type SchedulerRunsResponse struct {
+	Runs   []SchedulerRunDTO `json:"runs"`
+	Total  int               `json:"total"`
+	Limit  int               `json:"limit"`
+	Offset int               `json:"offset"`
+}
+
+// SchedulerJobDTO represents a scheduler job state.
+type SchedulerJobDTO struct {
+	Name             string           `json:"name"`
+	Paused           bool             `json:"paused"`
+	PausedAt         *string          `json:"pausedAt,omitempty"`
+	TriggerRequested bool             `json:"triggerRequested"`
+	Running          bool             `json:"running"`
+	InstanceID       string           `json:"instanceId,omitempty"`
+	LockedUntil      *string          `json:"lockedUntil,omitempty"`
+	HeartbeatAt      *string          `json:"heartbeatAt,omitempty"`
+	FencingToken     int64            `json:"fencingToken,omitempty"`
+	RunID            string           `json:"runId,omitempty"`
+	CancelRequested  bool             `json:"cancelRequested"`
+	LastRun          *SchedulerRunDTO `json:"lastRun,omitempty"`
+}
+
+// SchedulerJobsResponse represents the list of scheduler jobs.
+type SchedulerJobsResponse struct {
+	Jobs []SchedulerJobDTO `json:"jobs"`
+}
+
+// SchedulerCommandResponse confirms an accepted scheduler command.
+type SchedulerCommandResponse struct {
+	Job     string `json:"job"`
+	Command string `json:"command"`
+	Status  string `json:"status"`
+}
+
+// CadenceTournamentDTO represents a tournament in the planned refresh queue.
+type CadenceTournamentDTO struct {
+	TournamentID string  `json:"tournamentId"`
+	Name         string  `json:"name"`
+	Priority     string  `json:"priority"`
+	LastParsedAt *string `json:"lastParsedAt,omitempty"`
+	DueAt        *string `json:"dueAt,omitempty"`
+	Scheduled    bool    `json:"scheduled"`
+	DeferReason  string  `json:"deferReason,omitempty"`
+}
+
+// CadencePlanResponse represents the planned refresh queue of a source.
+type CadencePlanResponse struct {
+	Source      string                 `json:"source"`
+	Kind        string                 `json:"kind"`
+	GeneratedAt string                 `json:"generatedAt"`
+	DailyBudget int                    `json:"dailyBudget"`
+	UsedToday   int                    `json:"usedToday"`
+	Quota       int                    `json:"quota"`
+	Due         int                    `json:"due"`
+	ByPriority  map[string]int         `json:"byPriority"`
+	Queue       []CadenceTournamentDTO `json:"queue"`
+}
@@ -0,0 +1,68 @@
+package dto
+
+// TrajectorySeasonDTO represents a player's normalised production in one season.
+type TrajectorySeasonDTO struct {
+	Season        string  `json:"season"`
+	Age           int     `json:"age"`
+	Games         int     `json:"games"`
+	Goals         int     `json:"goals"`
+	Assists       int     `json:"assists"`
+	Points        int     `json:"points"`
+	PointsPerGame float64 `json:"pointsPerGame"`
+	Index         float64 `json:"index"`
+	AdjustedIndex float64 `json:"adjustedIndex"`
+}
+
+// CohortPointDTO represents one point on a birth-year cohort curve.
+type CohortPointDTO struct {
+	Age     int     `json:"age"`
+	Season  string  `json:"season"`
+	Players int     `json:"players"`
+	P25     float64 `json:"p25"`
+	Median  float64 `json:"median"`
+	P75     float64 `json:"p75"`
+}
+
+// ProjectionDTO represents a projected next-season range.
+type ProjectionDTO struct {
+	Season              string  `json:"season"`
+	IndexLow            float64 `json:"indexLow"`
+	IndexMedian         float64 `json:"indexMedian"`
+	IndexHigh           float64 `json:"indexHigh"`
+	PointsPerGameLow    float64 `json:"pointsPerGameLow"`
+	PointsPerGameMedian float64 `json:"pointsPerGameMedian"`
+	PointsPerGameHigh   float64 `json:"pointsPerGameHigh"`
+	Samples             int     `json:"samples"`
+}
+
+// PlayerTrajectoryResponse represents a player's development trajectory.
+type PlayerTrajectoryResponse struct {
+	PlayerID   string                `json:"playerId"`
+	Name       string                `json:"name"`
+	BirthYear  int                   `json:"birthYear"`
+	BirthMonth int                   `json:"birthMonth"`
+	Seasons    []TrajectorySeasonDTO `json:"seasons"`
+	Cohort     []CohortPointDTO      `json:"cohort"`
+	Projection *ProjectionDTO        `json:"projection,omitempty"`
+}
+
+// BreakoutCandidateDTO represents a breakout candidate.
+type BreakoutCandidateDTO struct {
+	PlayerID      string         `json:"playerId"`
+	Name          string         `json:"name"`
+	BirthDate     string         `json:"birthDate"`
+	Season        string         `json:"season"`
+	Games         int            `json:"games"`
+	Points        int            `json:"points"`
+	PreviousIndex float64        `json:"previousIndex"`
+	Index         float64        `json:"index"`
+	Growth        float64        `json:"growth"`
+	CohortGrowth  float64        `json:"cohortGrowth"`
+	Projection    *ProjectionDTO `json:"projection,omitempty"`
+}
+
+// BreakoutCandidatesResponse represents breakout candidates for a birth year.
+type BreakoutCandidatesResponse struct {
+	BirthYear  int                    `json:"birthYear"`
+	Candidates []BreakoutCandidateDTO `json:"candidates"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+// VenueDTO represents a canonical venue with its gazetteer coordinates.
+type VenueDTO struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	City      string   `json:"city,omitempty"`
+	Region    string   `json:"region,omitempty"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	Precision string   `json:"precision,omitempty"`
+	Aliases   []string `json:"aliases"`
+}
+
+// VenueListResponse represents a venue search result.
+type VenueListResponse struct {
+	Venues []VenueDTO `json:"venues"`
+}
+
+// VenueScheduleResponse represents a venue with the matches played there.
+type VenueScheduleResponse struct {
+	Venue   VenueDTO   `json:"venue"`
+	Matches []MatchDTO `json:"matches"`
+}
+
+// TeamTripDTO represents a single away trip of a team.
+type TeamTripDTO struct {
+	MatchID    string   `json:"matchId"`
+	Date       string   `json:"date"`
+	Venue      VenueDTO `json:"venue"`
+	DistanceKm float64  `json:"distanceKm"`
+}
+
+// TeamTravelResponse represents a team's away travel in a season.
+type TeamTravelResponse struct {
+	TeamID    string        `json:"teamId"`
+	Season    string        `json:"season"`
+	Home      *VenueDTO     `json:"home"`
+	Trips     []TeamTripDTO `json:"trips"`
+	Unlocated int           `json:"unlocated"`
+	TotalKm   float64       `json:"totalKm"`
+}
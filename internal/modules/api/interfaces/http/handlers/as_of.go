@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/application/services"
+)
+
+var errInvalidAsOf = errors.New("as_of must be a date (2006-01-02) or an RFC 3339 timestamp")
+
+// parseAsOf reads the optional as_of query parameter. A bare date means the end of
+// that day in Moscow time, so "as_of=2024-12-01" returns the numbers as they stood on
+// 1 December regardless of the server timezone.
+func parseAsOf(r *http.Request) (*time.Time, error) {
+	s := r.URL.Query().Get("as_of")
+	if s == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return &t, nil
+	}
+	day, err := time.ParseInLocation(time.DateOnly, s, services.Moscow)
+	if err != nil {
+		return nil, errInvalidAsOf
+	}
+	t := day.AddDate(0, 0, 1).Add(-time.Microsecond)
+	return &t, nil
+}
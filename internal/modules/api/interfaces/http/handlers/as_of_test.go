@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseAsOf(t *testing.T) {
+	asOf, err := parseAsOf(httptest.NewRequest("GET", "/?as_of=2024-12-01", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The end of the Moscow day, whatever the server timezone is.
+	want := time.Date(2024, 12, 1, 20, 59, 59, 999999000, time.UTC)
+	if !asOf.Equal(want) {
+		t.Errorf("date as_of = %v, want end of day %v", asOf, want)
+	}
+
+	asOf, err = parseAsOf(httptest.NewRequest("GET", "/?as_of=2024-12-01T10:30:00Z", nil))
+	if err != nil || !asOf.Equal(time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC)) {
+		t.Errorf("timestamp as_of = %v, %v", asOf, err)
+	}
+
+	if asOf, err := parseAsOf(httptest.NewRequest("GET", "/", nil)); asOf != nil || err != nil {
+		t.Errorf("missing as_of = %v, %v, want nil", asOf, err)
+	}
+	if _, err := parseAsOf(httptest.NewRequest("GET", "/?as_of=yesterday", nil)); err == nil {
+		t.Error("invalid as_of accepted")
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/application/services"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// CalendarHandler serves iCalendar feeds of team and player schedules.
+type CalendarHandler struct {
+	service *services.CalendarService
+}
+
+// NewCalendarHandler creates a new calendar handler.
+func NewCalendarHandler(service *services.CalendarService) *CalendarHandler {
+	return &CalendarHandler{service: service}
+}
+
+// TeamCalendar returns the team schedule as an .ics feed.
+func (h *CalendarHandler) TeamCalendar(w http.ResponseWriter, r *http.Request) {
+	h.serveFeed(w, r, "team", h.service.TeamCalendar)
+}
+
+// PlayerCalendar returns the schedule of the player's teams as an .ics feed.
+func (h *CalendarHandler) PlayerCalendar(w http.ResponseWriter, r *http.Request) {
+	h.serveFeed(w, r, "player", h.service.PlayerCalendar)
+}
+
+func (h *CalendarHandler) serveFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	subject string,
+	load func(ctx context.Context, id string, filter services.CalendarFilter) (*services.CalendarFeed, error),
+) {
+	ctx := r.Context()
+	// Routes are /{file} because a wildcard must take the whole path segment
+	id, ok := strings.CutSuffix(r.PathValue("file"), ".ics")
+	if !ok || id == "" {
+		h.writeError(w, http.StatusNotFound, "Calendar not found")
+		return
+	}
+	filter := services.CalendarFilter{
+		TournamentID: r.URL.Query().Get("tournamentId"),
+		BirthYear:    parseIntQuery(r, "birthYear", 0),
+	}
+
+	feed, err := load(ctx, id, filter)
+	if err != nil {
+		logger.Error(ctx, "Failed to get "+subject+" calendar: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get calendar")
+		return
+	}
+	if feed == nil {
+		h.writeError(w, http.StatusNotFound, strings.ToUpper(subject[:1])+subject[1:]+" not found")
+		return
+	}
+
+	body := services.RenderICS(*feed)
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, max-age=900")
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="`+subject+"-"+id+`.ics"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// etagMatches reports whether an If-None-Match header lists the ETag (weak comparison).
+func etagMatches(header, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *CalendarHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *CalendarHandler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, dto.ErrorResponse{Error: message})
+}
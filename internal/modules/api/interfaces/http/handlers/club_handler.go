@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	analyticsApp "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/application"
+	analyticsDomain "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// ClubHandler handles club search, club history and club match review requests.
+type ClubHandler struct {
+	service *analyticsApp.ClubService
+}
+
+// NewClubHandler creates a new club handler.
+func NewClubHandler(service *analyticsApp.ClubService) *ClubHandler {
+	return &ClubHandler{service: service}
+}
+
+// Search returns clubs whose name or team names contain the query.
+func (h *ClubHandler) Search(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	limit := min(parseIntQuery(r, "limit", 20), 100)
+
+	clubs, err := h.service.SearchClubs(ctx, r.URL.Query().Get("q"), limit)
+	if err != nil {
+		logger.Error(ctx, "Failed to search clubs: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to search clubs")
+		return
+	}
+
+	resp := dto.ClubSearchResponse{Clubs: make([]dto.ClubDTO, len(clubs))}
+	for i, c := range clubs {
+		resp.Clubs[i] = toClubDTO(c)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// Club returns a club with its source teams and season history across sources.
+func (h *ClubHandler) Club(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	history, err := h.service.ClubHistory(ctx, r.PathValue("id"))
+	if err != nil {
+		logger.Error(ctx, "Failed to get club history: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get club")
+		return
+	}
+	if history == nil {
+		h.writeError(w, http.StatusNotFound, "Club not found")
+		return
+	}
+
+	resp := dto.ClubHistoryResponse{
+		Club:    toClubDTO(history.Club),
+		Teams:   make([]dto.ClubTeamDTO, len(history.Teams)),
+		Seasons: make([]dto.ClubSeasonDTO, len(history.Seasons)),
+	}
+	for i, t := range history.Teams {
+		resp.Teams[i] = toClubTeamDTO(t)
+	}
+	for i, s := range history.Seasons {
+		resp.Seasons[i] = dto.ClubSeasonDTO{
+			Season: s.Season, Source: s.Source, Teams: s.Teams, Tournaments: s.Tournaments,
+			Games: s.Games, Wins: s.Wins, Losses: s.Losses, Points: s.Points,
+			GoalsFor: s.GoalsFor, GoalsAgainst: s.GoalsAgainst,
+		}
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+func toClubDTO(c analyticsDomain.Club) dto.ClubDTO {
+	aliases := c.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return dto.ClubDTO{ID: c.ID, Name: c.Name, City: c.City, Region: c.Region, Aliases: aliases}
+}
+
+func toClubTeamDTO(t analyticsDomain.ClubTeam) dto.ClubTeamDTO {
+	return dto.ClubTeamDTO{ID: t.ID, Name: t.Name, City: t.City, Region: t.Region, Source: t.Source}
+}
+
+func (h *ClubHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *ClubHandler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, dto.ErrorResponse{Error: message})
+}
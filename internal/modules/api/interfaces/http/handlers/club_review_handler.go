@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	analyticsApp "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/application"
+	analyticsDomain "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// Reviews returns club match reviews with the given status (pending by default).
+func (h *ClubHandler) Reviews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	status := r.URL.Query().Get("status")
+	switch status {
+	case "":
+		status = analyticsDomain.ClubReviewPending
+	case analyticsDomain.ClubReviewPending, analyticsDomain.ClubReviewApproved, analyticsDomain.ClubReviewRejected:
+	default:
+		h.writeError(w, http.StatusBadRequest, "Unknown status")
+		return
+	}
+	limit := min(parseIntQuery(r, "limit", 50), 200)
+	offset := max(parseIntQuery(r, "offset", 0), 0)
+
+	reviews, total, err := h.service.Reviews(ctx, status, limit, offset)
+	if err != nil {
+		logger.Error(ctx, "Failed to list club reviews: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to list club reviews")
+		return
+	}
+
+	resp := dto.ClubReviewsResponse{Reviews: make([]dto.ClubReviewDTO, len(reviews)), Total: total, Limit: limit, Offset: offset}
+	for i, review := range reviews {
+		resp.Reviews[i] = toClubReviewDTO(review)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// ApproveReview links the reviewed team to the suggested club.
+func (h *ClubHandler) ApproveReview(w http.ResponseWriter, r *http.Request) {
+	h.resolveReview(w, r, "approve", h.service.ApproveReview)
+}
+
+// RejectReview creates a separate club for the reviewed team.
+func (h *ClubHandler) RejectReview(w http.ResponseWriter, r *http.Request) {
+	h.resolveReview(w, r, "reject", h.service.RejectReview)
+}
+
+func (h *ClubHandler) resolveReview(
+	w http.ResponseWriter,
+	r *http.Request,
+	command string,
+	resolve func(ctx context.Context, id int64) (*analyticsDomain.ClubReview, error),
+) {
+	ctx := r.Context()
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || id <= 0 {
+		h.writeError(w, http.StatusBadRequest, "Invalid review id")
+		return
+	}
+
+	review, err := resolve(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, analyticsApp.ErrClubReviewNotFound):
+			h.writeError(w, http.StatusNotFound, "Club review not found")
+		case errors.Is(err, analyticsApp.ErrClubReviewResolved):
+			h.writeError(w, http.StatusConflict, "Club review already resolved")
+		default:
+			logger.Error(ctx, "Failed to "+command+" club review "+strconv.FormatInt(id, 10)+": "+err.Error())
+			h.writeError(w, http.StatusInternalServerError, "Failed to "+command+" club review")
+		}
+		return
+	}
+
+	logger.Info(ctx, "Club review "+command+"d: "+strconv.FormatInt(id, 10))
+	h.writeJSON(w, http.StatusOK, toClubReviewDTO(*review))
+}
+
+func toClubReviewDTO(review analyticsDomain.ClubReview) dto.ClubReviewDTO {
+	return dto.ClubReviewDTO{
+		ID:         review.ID,
+		Team:       toClubTeamDTO(review.Team),
+		ClubID:     review.ClubID,
+		ClubName:   review.ClubName,
+		Score:      review.Score,
+		Status:     review.Status,
+		CreatedAt:  review.CreatedAt.Format(time.RFC3339),
+		ResolvedAt: formatTimestamp(review.ResolvedAt),
+	}
+}
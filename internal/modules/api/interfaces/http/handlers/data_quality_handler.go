@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/application/services"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// DataQualityHandler handles data quality dashboard requests.
+type DataQualityHandler struct {
+	service *services.DataQualityService
+}
+
+// NewDataQualityHandler creates a new data quality handler.
+func NewDataQualityHandler(service *services.DataQualityService) *DataQualityHandler {
+	return &DataQualityHandler{service: service}
+}
+
+// Violations returns violations filtered by rule, entity and severity, with open counts per rule.
+func (h *DataQualityHandler) Violations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	filter := services.QualityFilter{
+		Rule:     query.Get("rule"),
+		Entity:   query.Get("entity"),
+		Severity: query.Get("severity"),
+		Resolved: query.Get("resolved") == "true",
+		Limit:    parseIntQuery(r, "limit", 100),
+		Offset:   parseIntQuery(r, "offset", 0),
+	}
+
+	violations, total, err := h.service.Violations(ctx, filter)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidQualityFilter) {
+			h.writeError(w, http.StatusBadRequest, "Unknown severity")
+			return
+		}
+		logger.Error(ctx, "Failed to list quality violations: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to list quality violations")
+		return
+	}
+
+	summary, err := h.service.Summary(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to summarize quality violations: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to list quality violations")
+		return
+	}
+
+	resp := dto.QualityViolationsResponse{
+		Violations: make([]dto.QualityViolationDTO, len(violations)),
+		Rules:      make([]dto.QualityRuleSummaryDTO, len(summary)),
+		Total:      total,
+		Limit:      filter.Limit,
+		Offset:     filter.Offset,
+	}
+	for i, v := range violations {
+		resp.Violations[i] = dto.QualityViolationDTO{
+			ID:          v.ID,
+			Rule:        v.Rule,
+			Entity:      v.Entity,
+			EntityID:    v.EntityID,
+			Severity:    v.Severity,
+			Details:     v.Details,
+			JobName:     v.JobName,
+			RunID:       v.RunID,
+			FirstSeenAt: v.FirstSeenAt.Format(time.RFC3339),
+			LastSeenAt:  v.LastSeenAt.Format(time.RFC3339),
+			ResolvedAt:  formatTimestamp(v.ResolvedAt),
+		}
+	}
+	for i, s := range summary {
+		resp.Rules[i] = dto.QualityRuleSummaryDTO{
+			Rule:        s.Rule,
+			Entity:      s.Entity,
+			Severity:    s.Severity,
+			Open:        s.Open,
+			FirstSeenAt: s.FirstSeenAt.Format(time.RFC3339),
+			LastSeenAt:  s.LastSeenAt.Format(time.RFC3339),
+		}
+		if s.Severity == "critical" {
+			resp.Critical += s.Open
+		}
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *DataQualityHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *DataQualityHandler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, dto.ErrorResponse{Error: message})
+}
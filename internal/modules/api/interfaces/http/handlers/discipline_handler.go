@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	analyticsApp "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/application"
+	analyticsDomain "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/application/services"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// disciplineTopInfractions is the number of infraction types returned.
+const disciplineTopInfractions = 10
+
+var disciplinePeriodLabels = []string{"1", "2", "3", "OT"}
+
+// DisciplineHandler handles penalty and discipline report requests.
+type DisciplineHandler struct {
+	service *analyticsApp.DisciplineService
+}
+
+// NewDisciplineHandler creates a new discipline handler.
+func NewDisciplineHandler(service *analyticsApp.DisciplineService) *DisciplineHandler {
+	return &DisciplineHandler{service: service}
+}
+
+// PlayerDiscipline returns a player's discipline report.
+func (h *DisciplineHandler) PlayerDiscipline(w http.ResponseWriter, r *http.Request) {
+	h.report(w, r, analyticsDomain.DisciplineScopePlayer)
+}
+
+// TeamDiscipline returns a team's discipline report.
+func (h *DisciplineHandler) TeamDiscipline(w http.ResponseWriter, r *http.Request) {
+	h.report(w, r, analyticsDomain.DisciplineScopeTeam)
+}
+
+// TournamentDiscipline returns a tournament's discipline report.
+func (h *DisciplineHandler) TournamentDiscipline(w http.ResponseWriter, r *http.Request) {
+	h.report(w, r, analyticsDomain.DisciplineScopeTournament)
+}
+
+func (h *DisciplineHandler) report(w http.ResponseWriter, r *http.Request, scope string) {
+	ctx := r.Context()
+	filter := analyticsApp.DisciplineFilter{
+		Scope:        scope,
+		ID:           r.PathValue("id"),
+		TournamentID: r.URL.Query().Get("tournamentId"),
+	}
+
+	report, err := h.service.Report(ctx, filter)
+	if err != nil {
+		logger.Error(ctx, "Failed to get discipline report: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get discipline report")
+		return
+	}
+
+	resp := dto.DisciplineResponse{
+		Scope: report.Scope, ID: report.ID, Games: report.Games,
+		Penalties: report.Penalties, Minutes: report.Minutes, MinutesPerGame: services.RoundTo(report.MinutesPerGame, 3),
+		Minors: report.Minors, Majors: report.Majors,
+		Misconducts: report.Misconducts, GameMisconducts: report.GameMisconducts,
+		PowerPlayPenalties: report.PowerPlayPenalties, CostlyPenalties: report.CostlyPenalties,
+		CostlyRate:  services.RoundTo(report.CostlyRate, 3),
+		Infractions: []dto.InfractionDTO{},
+		TopPlayers:  offendersToDTO(report.TopPlayers),
+		TopTeams:    offendersToDTO(report.TopTeams),
+	}
+	for i, inf := range report.Infractions {
+		if i == disciplineTopInfractions {
+			break
+		}
+		resp.Infractions = append(resp.Infractions, dto.InfractionDTO{
+			Code: inf.Code, Reason: inf.Reason, Count: inf.Count, Minutes: inf.Minutes,
+		})
+	}
+	for i, p := range report.Periods {
+		resp.Periods = append(resp.Periods, dto.PeriodDisciplineDTO{
+			Period: disciplinePeriodLabels[i], Penalties: p.Penalties, Minutes: p.Minutes,
+			Share: services.RoundTo(p.Share, 3), PerGame: services.RoundTo(p.PerGame, 3), GoalsConceded: p.GoalsConceded,
+		})
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+func offendersToDTO(rows []analyticsDomain.Offender) []dto.OffenderDTO {
+	if len(rows) == 0 {
+		return nil
+	}
+	result := make([]dto.OffenderDTO, len(rows))
+	for i, o := range rows {
+		result[i] = dto.OffenderDTO{ID: o.ID, Name: o.Name, Penalties: o.Penalties, Minutes: o.Minutes}
+	}
+	return result
+}
+
+func (h *DisciplineHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *DisciplineHandler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, dto.ErrorResponse{Error: message})
+}
@@ -14,6 +14,7 @@ import (
 type ExploreHandler struct {
 	service        *services.ExploreService
 	matchesService *services.ExploreMatchesService
+	versions       *services.TournamentVersionService
 }
 
 // NewExploreHandler creates a new explore handler.
@@ -21,6 +22,36 @@ func NewExploreHandler(service *services.ExploreService, matchesService *service
 	return &ExploreHandler{service: service, matchesService: matchesService}
 }
 
+// WithVersions enables conditional requests on tournament endpoints.
+func (h *ExploreHandler) WithVersions(versions *services.TournamentVersionService) *ExploreHandler {
+	h.versions = versions
+	return h
+}
+
+// notModified sets the tournament ETag and answers 304 when the client already has
+// the current version. Without a recorded version the response is served in full.
+func (h *ExploreHandler) notModified(w http.ResponseWriter, r *http.Request, tournamentID string) bool {
+	if h.versions == nil {
+		return false
+	}
+	etag, err := h.versions.ETag(r.Context(), tournamentID)
+	if err != nil {
+		logger.Warn(r.Context(), "Failed to get tournament version: "+err.Error())
+		return false
+	}
+	if etag == "" {
+		return false
+	}
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return true
+	}
+	return false
+}
+
 // Overview returns platform-wide KPI stats.
 func (h *ExploreHandler) Overview(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -106,8 +137,17 @@ func (h *ExploreHandler) Standings(w http.ResponseWriter, r *http.Request) {
 	tournamentID := r.PathValue("id")
 	birthYear := parseIntQuery(r, "birthYear", 0)
 	groupName := r.URL.Query().Get("group")
+	asOf, err := parseAsOf(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if h.notModified(w, r, tournamentID) {
+		return
+	}
 
-	rows, err := h.service.GetTournamentStandings(ctx, tournamentID, birthYear, groupName)
+	rows, err := h.service.GetTournamentStandings(ctx, tournamentID, birthYear, groupName, asOf)
 	if err != nil {
 		logger.Error(ctx, "Failed to get standings: "+err.Error())
 		h.writeError(w, http.StatusInternalServerError, "Failed to get standings")
@@ -135,6 +175,10 @@ func (h *ExploreHandler) TournamentMatches(w http.ResponseWriter, r *http.Reques
 	birthYear := parseIntQuery(r, "birthYear", 0)
 	groupName := r.URL.Query().Get("group")
 
+	if h.notModified(w, r, tournamentID) {
+		return
+	}
+
 	rows, err := h.matchesService.GetTournamentMatches(ctx, tournamentID, birthYear, groupName, limit)
 	if err != nil {
 		logger.Error(ctx, "Failed to get tournament matches: "+err.Error())
@@ -152,6 +196,10 @@ func (h *ExploreHandler) Scorers(w http.ResponseWriter, r *http.Request) {
 	birthYear := parseIntQuery(r, "birthYear", 0)
 	groupName := r.URL.Query().Get("group")
 
+	if h.notModified(w, r, tournamentID) {
+		return
+	}
+
 	rows, err := h.service.GetTournamentScorers(ctx, tournamentID, birthYear, groupName, limit)
 	if err != nil {
 		logger.Error(ctx, "Failed to get scorers: "+err.Error())
@@ -300,7 +348,7 @@ func matchRowsToDTO(rows []services.MatchRow) []dto.MatchDTO {
 			HomeLogoURL: m.HomeLogoURL, AwayLogoURL: m.AwayLogoURL,
 			HomeScore: m.HomeScore, AwayScore: m.AwayScore, ResultType: m.ResultType,
 			Date: date, Time: timeStr, Tournament: m.Tournament,
-			Venue: m.Venue, Status: m.Status,
+			Venue: m.Venue, VenueID: m.VenueID, Status: m.Status,
 		}
 	}
 	return matches
@@ -20,13 +20,13 @@ func NewExploreMatchesHandler(service *services.ExploreMatchesService) *ExploreM
 	return &ExploreMatchesHandler{service: service}
 }
 
-// RecentResults returns recently finished matches.
+// RecentResults returns recently finished matches, optionally filtered by venue city.
 func (h *ExploreMatchesHandler) RecentResults(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	tournament := r.URL.Query().Get("tournament")
 	limit := parseIntQuery(r, "limit", 20)
 
-	rows, err := h.service.GetRecentResults(ctx, tournament, limit)
+	rows, err := h.service.GetRecentResults(ctx, tournament, r.URL.Query().Get("city"), limit)
 	if err != nil {
 		logger.Error(ctx, "Failed to get recent results: "+err.Error())
 		h.writeError(w, http.StatusInternalServerError, "Failed to get results")
@@ -35,13 +35,13 @@ func (h *ExploreMatchesHandler) RecentResults(w http.ResponseWriter, r *http.Req
 	h.writeJSON(w, http.StatusOK, dto.MatchListResponse{Matches: matchRowsToDTO(rows)})
 }
 
-// UpcomingMatches returns upcoming scheduled matches.
+// UpcomingMatches returns upcoming scheduled matches, optionally filtered by venue city.
 func (h *ExploreMatchesHandler) UpcomingMatches(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	tournament := r.URL.Query().Get("tournament")
 	limit := parseIntQuery(r, "limit", 20)
 
-	rows, err := h.service.GetUpcomingMatches(ctx, tournament, limit)
+	rows, err := h.service.GetUpcomingMatches(ctx, tournament, r.URL.Query().Get("city"), limit)
 	if err != nil {
 		logger.Error(ctx, "Failed to get upcoming matches: "+err.Error())
 		h.writeError(w, http.StatusInternalServerError, "Failed to get calendar")
@@ -63,7 +63,15 @@ func (h *ExploreMatchesHandler) Rankings(w http.ResponseWriter, r *http.Request)
 		Domain:       r.URL.Query().Get("domain"),
 		TournamentID: r.URL.Query().Get("tournamentId"),
 		GroupName:    r.URL.Query().Get("groupName"),
+		// Sorting by adjusted points implies adjusted mode so the sort key is in the response.
+		Adjusted: r.URL.Query().Get("adjusted") == "true" || sortBy == "adjustedPoints",
 	}
+	asOf, err := parseAsOf(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	filter.AsOf = asOf
 
 	result, err := h.service.GetRankings(ctx, sortBy, limit, filter)
 	if err != nil {
@@ -80,6 +88,9 @@ func (h *ExploreMatchesHandler) Rankings(w http.ResponseWriter, r *http.Request)
 			Games: p.Games, Goals: p.Goals, Assists: p.Assists, Points: p.Points,
 			PlusMinus: p.PlusMinus, PenaltyMinutes: p.PenaltyMinutes,
 		}
+		if filter.Adjusted {
+			players[i].AdjustedPoints = p.AdjustedPoints
+		}
 	}
 	h.writeJSON(w, http.StatusOK, dto.RankingsResponse{Season: result.Season, Players: players})
 }
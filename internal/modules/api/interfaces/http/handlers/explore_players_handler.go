@@ -86,12 +86,17 @@ func (h *ExplorePlayersHandler) PlayerProfile(w http.ResponseWriter, r *http.Req
 	h.writeJSON(w, http.StatusOK, resp)
 }
 
-// PlayerStats returns detailed stats history for a player.
+// PlayerStats returns detailed stats history for a player, optionally as of a past date.
 func (h *ExplorePlayersHandler) PlayerStats(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	id := r.PathValue("id")
+	asOf, err := parseAsOf(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
-	rows, err := h.service.GetPlayerStats(ctx, id)
+	rows, err := h.service.GetPlayerStats(ctx, id, asOf)
 	if err != nil {
 		logger.Error(ctx, "Failed to get player stats: "+err.Error())
 		h.writeError(w, http.StatusInternalServerError, "Failed to get player stats")
@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/application/services"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/interfaces/http/middleware"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/tabular"
+	"go.uber.org/zap"
+)
+
+// ExportHandler handles CSV/XLSX/Parquet data exports for subscribers.
+type ExportHandler struct {
+	service *services.ExportService
+}
+
+// NewExportHandler creates a new export handler.
+func NewExportHandler(service *services.ExportService) *ExportHandler {
+	return &ExportHandler{service: service}
+}
+
+// Export returns the dataset as a file, or queues a job and returns 202 for large exports.
+// Filters: tournamentId, teamId, season, birthYear, group; format defaults to csv.
+func (h *ExportHandler) Export(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+	req := services.ExportRequest{
+		Dataset:      r.PathValue("dataset"),
+		Format:       tabular.CSV,
+		TournamentID: q.Get("tournamentId"),
+		TeamID:       q.Get("teamId"),
+		Season:       q.Get("season"),
+		BirthYear:    parseIntQuery(r, "birthYear", 0),
+		GroupName:    q.Get("group"),
+	}
+	if f := q.Get("format"); f != "" {
+		req.Format = tabular.Format(f)
+	}
+	if !h.checkRequest(w, r, req) {
+		return
+	}
+	if h.service.IsLarge(req) {
+		h.createJob(w, r, req)
+		return
+	}
+
+	table, err := h.service.Build(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to build export", zap.String("dataset", req.Dataset), zap.Error(err))
+		h.writeError(w, http.StatusInternalServerError, "Failed to build export")
+		return
+	}
+	var buf bytes.Buffer
+	if err := tabular.Write(&buf, table, req.Format); err != nil {
+		logger.Error(ctx, "Failed to write export", zap.String("dataset", req.Dataset), zap.Error(err))
+		h.writeError(w, http.StatusInternalServerError, "Failed to write export")
+		return
+	}
+
+	h.writeFileHeaders(w, req.Format, h.service.FileName(req))
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// CreateJob queues an export described by the JSON body regardless of its size.
+func (h *ExportHandler) CreateJob(w http.ResponseWriter, r *http.Request) {
+	var req services.ExportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.Format == "" {
+		req.Format = tabular.CSV
+	}
+	if !h.checkRequest(w, r, req) {
+		return
+	}
+	h.createJob(w, r, req)
+}
+
+// Job returns the status of the user's export job.
+func (h *ExportHandler) Job(w http.ResponseWriter, r *http.Request) {
+	job, ok := h.loadJob(w, r)
+	if !ok {
+		return
+	}
+	h.writeJSON(w, http.StatusOK, toExportJobDTO(job))
+}
+
+// Download returns the file of a finished export job.
+func (h *ExportHandler) Download(w http.ResponseWriter, r *http.Request) {
+	job, ok := h.loadJob(w, r)
+	if !ok {
+		return
+	}
+	if job.Status != services.ExportJobDone {
+		h.writeError(w, http.StatusConflict, "Export is not ready")
+		return
+	}
+	if job.FilePath == nil {
+		h.writeError(w, http.StatusGone, "Export has expired")
+		return
+	}
+
+	f, err := os.Open(*job.FilePath)
+	if err != nil {
+		logger.Error(r.Context(), "Failed to open export file", zap.String("job_id", job.ID), zap.Error(err))
+		h.writeError(w, http.StatusGone, "Export file is no longer available")
+		return
+	}
+	defer func() { _ = f.Close() }()
+
+	req, _ := job.Request()
+	h.writeFileHeaders(w, tabular.Format(job.Format), h.service.FileName(req))
+	http.ServeContent(w, r, filepath.Base(*job.FilePath), *job.FinishedAt, f)
+}
+
+// checkRequest validates the export and the subscription tier of its format.
+func (h *ExportHandler) checkRequest(w http.ResponseWriter, r *http.Request, req services.ExportRequest) bool {
+	if err := h.service.Validate(req); err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, services.ErrUnknownExportDataset) {
+			status = http.StatusNotFound
+		}
+		h.writeError(w, status, err.Error())
+		return false
+	}
+	if tier := services.RequiredTier(req.Format); !middleware.HasTier(middleware.GetUserFromContext(r.Context()), tier) {
+		h.writeError(w, http.StatusForbidden, string(req.Format)+" export requires the "+tier+" subscription")
+		return false
+	}
+	return true
+}
+
+func (h *ExportHandler) createJob(w http.ResponseWriter, r *http.Request, req services.ExportRequest) {
+	ctx := r.Context()
+	claims := middleware.GetUserFromContext(ctx)
+	job, err := h.service.CreateJob(ctx, claims.UserID, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to create export job", zap.Error(err))
+		h.writeError(w, http.StatusInternalServerError, "Failed to create export job")
+		return
+	}
+	w.Header().Set("Location", "/api/v1/exports/jobs/"+job.ID)
+	h.writeJSON(w, http.StatusAccepted, toExportJobDTO(job))
+}
+
+func (h *ExportHandler) loadJob(w http.ResponseWriter, r *http.Request) (*services.ExportJob, bool) {
+	ctx := r.Context()
+	claims := middleware.GetUserFromContext(ctx)
+	job, err := h.service.GetJob(ctx, claims.UserID, r.PathValue("id"))
+	if err != nil {
+		logger.Error(ctx, "Failed to get export job", zap.Error(err))
+		h.writeError(w, http.StatusInternalServerError, "Failed to get export job")
+		return nil, false
+	}
+	if job == nil {
+		h.writeError(w, http.StatusNotFound, "Export job not found")
+		return nil, false
+	}
+	return job, true
+}
+
+func (h *ExportHandler) writeFileHeaders(w http.ResponseWriter, format tabular.Format, name string) {
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="`+name+`"`)
+}
+
+func toExportJobDTO(job *services.ExportJob) dto.ExportJobDTO {
+	result := dto.ExportJobDTO{
+		ID:         job.ID,
+		Dataset:    job.Dataset,
+		Format:     job.Format,
+		Status:     job.Status,
+		Rows:       job.Rows,
+		CreatedAt:  *formatTimestamp(&job.CreatedAt),
+		FinishedAt: formatTimestamp(job.FinishedAt),
+		ExpiresAt:  formatTimestamp(job.ExpiresAt),
+	}
+	if job.Error != nil {
+		result.Error = *job.Error
+	}
+	if job.Status == services.ExportJobDone && job.FilePath != nil {
+		result.DownloadURL = "/api/v1/exports/jobs/" + job.ID + "/download"
+	}
+	return result
+}
+
+func (h *ExportHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *ExportHandler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, dto.ErrorResponse{Error: message})
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	analyticsApp "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/application"
+	analyticsDomain "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/application/services"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// GoalieHandler handles goalie analytics requests.
+type GoalieHandler struct {
+	service *analyticsApp.GoalieService
+}
+
+// NewGoalieHandler creates a new goalie handler.
+func NewGoalieHandler(service *analyticsApp.GoalieService) *GoalieHandler {
+	return &GoalieHandler{service: service}
+}
+
+// GoalieProfile returns a goalie's summary and per-game lines.
+func (h *GoalieHandler) GoalieProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	tournamentID := r.URL.Query().Get("tournamentId")
+
+	profile, err := h.service.GoalieProfile(ctx, id, tournamentID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get goalie profile: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get goalie stats")
+		return
+	}
+	if profile == nil {
+		h.writeError(w, http.StatusNotFound, "Goalie games not found")
+		return
+	}
+
+	games := make([]dto.GoalieGameDTO, len(profile.Games))
+	for i, g := range profile.Games {
+		item := dto.GoalieGameDTO{
+			MatchID: g.MatchID, TournamentID: g.TournamentID, TeamID: g.TeamID,
+			ShotsAgainst: g.Shots(), Saves: g.Saves, GoalsAgainst: g.GoalsAgainst,
+			SavePct: services.RoundTo(g.SavePct()*100, 3), TimeOnIce: g.TimeOnIce,
+			QualityStart: g.QualityStart, PeriodGoals: g.GoalsPeriod, PeriodShots: g.ShotsAgainst,
+		}
+		if g.PlayedAt != nil {
+			item.Date = g.PlayedAt.Format("2006-01-02")
+		}
+		games[i] = item
+	}
+
+	h.writeJSON(w, http.StatusOK, dto.GoalieProfileResponse{
+		Summary: goalieSummaryToDTO(profile.Summary),
+		Games:   games,
+	})
+}
+
+// Leaderboard returns goalies ranked by save percentage.
+func (h *GoalieHandler) Leaderboard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournamentID := r.URL.Query().Get("tournamentId")
+	minGames := parseIntQuery(r, "minGames", 3)
+	limit := parseIntQuery(r, "limit", 50)
+
+	rows, err := h.service.Leaderboard(ctx, tournamentID, minGames, limit)
+	if err != nil {
+		logger.Error(ctx, "Failed to get goalie leaderboard: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get goalie leaderboard")
+		return
+	}
+
+	goalies := make([]dto.GoalieSummaryDTO, len(rows))
+	for i, s := range rows {
+		goalies[i] = goalieSummaryToDTO(s)
+	}
+	h.writeJSON(w, http.StatusOK, dto.GoalieLeaderboardResponse{
+		TournamentID: tournamentID, MinGames: minGames, Goalies: goalies,
+	})
+}
+
+// TeamPulls returns a team's empty-net pull analysis.
+func (h *GoalieHandler) TeamPulls(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamID := r.PathValue("id")
+	tournamentID := r.URL.Query().Get("tournamentId")
+
+	summary, err := h.service.TeamPulls(ctx, teamID, tournamentID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get goalie pulls: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get goalie pulls")
+		return
+	}
+
+	resp := dto.EmptyNetPullsResponse{TeamID: teamID, ByDeficit: map[int]int{}, GoalsForByDeficit: map[int]int{}}
+	if summary != nil {
+		resp.Pulls = summary.Pulls
+		resp.AvgSecondsLeft = services.RoundTo(summary.AvgSecondsLeft, 3)
+		resp.GoalsFor = summary.GoalsFor
+		resp.GoalsAgainst = summary.GoalsAgainst
+		resp.NoGoal = summary.NoGoal
+		resp.ByDeficit = summary.ByDeficit
+		resp.GoalsForByDeficit = summary.GoalsForByDelta
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// goalieSummaryToDTO converts a goalie summary to DTO; percentages are 0-100.
+func goalieSummaryToDTO(s analyticsDomain.GoalieSummary) dto.GoalieSummaryDTO {
+	periodSavePct := make([]*float64, len(s.PeriodSavePct))
+	for i, pct := range s.PeriodSavePct {
+		if pct != nil {
+			v := services.RoundTo(*pct*100, 3)
+			periodSavePct[i] = &v
+		}
+	}
+	return dto.GoalieSummaryDTO{
+		PlayerID: s.PlayerID, Name: s.Name, Games: s.Games,
+		ShotsAgainst: s.Saves + s.GoalsAgainst, Saves: s.Saves, GoalsAgainst: s.GoalsAgainst,
+		SavePct: services.RoundTo(s.SavePct*100, 3), GoalsAgainstAvg: services.RoundTo(s.GoalsAgainstAvg, 3),
+		QualityStarts: s.QualityStarts, QualityStartPct: services.RoundTo(s.QualityStartPct*100, 3),
+		PeriodSavePct: periodSavePct, PeriodGoals: s.PeriodGoals,
+		PeriodSampleGames: s.PeriodSampleGames, GoalsByState: s.GoalsByState,
+	}
+}
+
+func (h *GoalieHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *GoalieHandler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, dto.ErrorResponse{Error: message})
+}
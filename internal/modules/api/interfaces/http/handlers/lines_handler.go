@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	analyticsApp "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/application"
+	analyticsDomain "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// LinesHandler handles line combination and linemate requests.
+type LinesHandler struct {
+	service *analyticsApp.LinesService
+}
+
+// NewLinesHandler creates a new lines handler.
+func NewLinesHandler(service *analyticsApp.LinesService) *LinesHandler {
+	return &LinesHandler{service: service}
+}
+
+// TeamLines returns a team's forward trios and defence pairs.
+func (h *LinesHandler) TeamLines(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamID := r.PathValue("id")
+	tournamentID := r.URL.Query().Get("tournamentId")
+
+	lines, err := h.service.TeamLines(ctx, teamID, tournamentID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get team lines: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get team lines")
+		return
+	}
+
+	resp := dto.TeamLinesResponse{
+		TeamID:   teamID,
+		Forwards: []dto.LineCombinationDTO{},
+		Defence:  []dto.LineCombinationDTO{},
+	}
+	for _, l := range lines {
+		players := make([]dto.LinePlayerDTO, len(l.Players))
+		for i, p := range l.Players {
+			players[i] = linePlayerToDTO(p)
+		}
+		item := dto.LineCombinationDTO{
+			Kind: l.Kind, Players: players,
+			GoalsFor: l.GoalsFor, GoalsAgainst: l.GoalsAgainst, GoalDiff: l.GoalDiff(),
+			Matches: l.Matches,
+		}
+		if l.Kind == analyticsDomain.LineKindForwards {
+			resp.Forwards = append(resp.Forwards, item)
+		} else {
+			resp.Defence = append(resp.Defence, item)
+		}
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// PlayerLinemates returns a player's teammates ranked by shared goal differential.
+func (h *LinesHandler) PlayerLinemates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	playerID := r.PathValue("id")
+	tournamentID := r.URL.Query().Get("tournamentId")
+	limit := parseIntQuery(r, "limit", 20)
+
+	mates, err := h.service.PlayerLinemates(ctx, playerID, tournamentID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get player linemates: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get linemates")
+		return
+	}
+	if limit > 0 && len(mates) > limit {
+		mates = mates[:limit]
+	}
+
+	items := make([]dto.LinemateDTO, len(mates))
+	for i, m := range mates {
+		items[i] = dto.LinemateDTO{
+			Player:   linePlayerToDTO(m.Player),
+			GoalsFor: m.GoalsFor, GoalsAgainst: m.GoalsAgainst, GoalDiff: m.GoalDiff(),
+			Matches: m.Matches,
+		}
+	}
+	h.writeJSON(w, http.StatusOK, dto.PlayerLinematesResponse{PlayerID: playerID, Linemates: items})
+}
+
+func linePlayerToDTO(p analyticsDomain.LinePlayer) dto.LinePlayerDTO {
+	return dto.LinePlayerDTO{ID: p.ID, Name: p.Name, Position: p.Position}
+}
+
+func (h *LinesHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *LinesHandler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, dto.ErrorResponse{Error: message})
+}
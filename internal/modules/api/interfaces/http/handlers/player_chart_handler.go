@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/application/services"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// PlayerChartHandler serves player charts as PNG images for social sharing.
+type PlayerChartHandler struct {
+	service *services.PlayerChartService
+}
+
+// NewPlayerChartHandler creates a new player chart handler.
+func NewPlayerChartHandler(service *services.PlayerChartService) *PlayerChartHandler {
+	return &PlayerChartHandler{service: service}
+}
+
+// PlayerChart returns a player chart as PNG.
+// type: seasons (default), goals, periods or profile.
+func (h *PlayerChartHandler) PlayerChart(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	chartType := r.URL.Query().Get("type")
+	if chartType == "" {
+		chartType = services.PlayerChartSeasons
+	}
+
+	body, err := h.service.RenderPNG(ctx, id, chartType)
+	if errors.Is(err, services.ErrUnknownChartType) {
+		h.writeError(w, http.StatusBadRequest, "type must be one of: seasons, goals, periods, profile")
+		return
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to render player chart: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to render chart")
+		return
+	}
+	if body == nil {
+		h.writeError(w, http.StatusNotFound, "Player statistics not found")
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "image/png")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+func (h *PlayerChartHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *PlayerChartHandler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, dto.ErrorResponse{Error: message})
+}
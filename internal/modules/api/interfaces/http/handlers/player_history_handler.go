@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/application/services"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// PlayerHistoryHandler handles player change history requests.
+type PlayerHistoryHandler struct {
+	service *services.PlayerHistoryService
+}
+
+// NewPlayerHistoryHandler creates a new player history handler.
+func NewPlayerHistoryHandler(service *services.PlayerHistoryService) *PlayerHistoryHandler {
+	return &PlayerHistoryHandler{service: service}
+}
+
+// PlayerHistory returns the player's change timeline, optionally filtered by change type.
+func (h *PlayerHistoryHandler) PlayerHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	playerID := r.PathValue("id")
+	limit := min(parseIntQuery(r, "limit", 50), 200)
+	offset := parseIntQuery(r, "offset", 0)
+
+	changes, err := h.service.Timeline(ctx, playerID, r.URL.Query().Get("type"), limit, offset)
+	if err != nil {
+		logger.Error(ctx, "Failed to get player history: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get player history")
+		return
+	}
+	if changes == nil {
+		h.writeError(w, http.StatusNotFound, "Player not found")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, dto.PlayerHistoryResponse{
+		PlayerID: playerID,
+		Changes:  toPlayerChangeDTOs(changes),
+		Limit:    limit,
+		Offset:   offset,
+	})
+}
+
+// LatestSession returns what changed during the most recent parse session.
+func (h *PlayerHistoryHandler) LatestSession(w http.ResponseWriter, r *http.Request) {
+	h.sessionReport(w, r, "")
+}
+
+// Session returns what changed during the given parse session (scheduler run ID).
+func (h *PlayerHistoryHandler) Session(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, err := uuid.Parse(id); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid session id")
+		return
+	}
+	h.sessionReport(w, r, id)
+}
+
+func (h *PlayerHistoryHandler) sessionReport(w http.ResponseWriter, r *http.Request, sessionID string) {
+	ctx := r.Context()
+	limit := min(parseIntQuery(r, "limit", 500), 5000)
+
+	report, err := h.service.SessionReport(ctx, sessionID, limit)
+	if err != nil {
+		if errors.Is(err, services.ErrParseSessionNotFound) {
+			h.writeError(w, http.StatusNotFound, "Parse session not found")
+			return
+		}
+		logger.Error(ctx, "Failed to get parse session report: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get parse session report")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, dto.ParseSessionReportResponse{
+		SessionID: report.SessionID,
+		JobName:   report.JobName,
+		StartedAt: formatTimestamp(report.StartedAt),
+		EndedAt:   formatTimestamp(report.EndedAt),
+		Counts:    report.Counts,
+		Total:     report.Total,
+		Changes:   toPlayerChangeDTOs(report.Changes),
+	})
+}
+
+func toPlayerChangeDTOs(changes []services.PlayerChange) []dto.PlayerChangeDTO {
+	result := make([]dto.PlayerChangeDTO, len(changes))
+	for i, c := range changes {
+		result[i] = dto.PlayerChangeDTO{
+			ID:         c.ID,
+			PlayerID:   c.PlayerID,
+			PlayerName: c.PlayerName,
+			Type:       c.Type,
+			Source:     c.Source,
+			SessionID:  c.SessionID,
+			Data:       c.Data,
+			CreatedAt:  c.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return result
+}
+
+func (h *PlayerHistoryHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *PlayerHistoryHandler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, dto.ErrorResponse{Error: message})
+}
@@ -32,7 +32,13 @@ func (h *RankingHandler) TopScorers(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	scorers, err := h.rankingService.GetTopScorers(ctx, limit)
+	asOf, err := parseAsOf(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	scorers, err := h.rankingService.GetTopScorers(ctx, limit, asOf)
 	if err != nil {
 		logger.Error(ctx, "Failed to get top scorers: "+err.Error())
 		h.writeError(w, http.StatusInternalServerError, "Failed to get rankings")
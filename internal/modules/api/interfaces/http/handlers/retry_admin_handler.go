@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/application/services"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// RetryAdminHandler handles parsing retry queue administration requests.
+type RetryAdminHandler struct {
+	service *services.RetryAdminService
+}
+
+// NewRetryAdminHandler creates a new retry admin handler.
+func NewRetryAdminHandler(service *services.RetryAdminService) *RetryAdminHandler {
+	return &RetryAdminHandler{service: service}
+}
+
+// Jobs returns failed jobs filtered by source, type and status, with queue totals.
+func (h *RetryAdminHandler) Jobs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter := retry.Filter{
+		Source:  r.URL.Query().Get("source"),
+		JobType: retry.JobType(r.URL.Query().Get("type")),
+		Status:  retry.Status(r.URL.Query().Get("status")),
+		Limit:   parseIntQuery(r, "limit", 50),
+		Offset:  parseIntQuery(r, "offset", 0),
+	}
+
+	jobs, total, err := h.service.ListJobs(ctx, filter)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidFailedJobFilter) {
+			h.writeError(w, http.StatusBadRequest, "Unknown type or status")
+			return
+		}
+		logger.Error(ctx, "Failed to list failed jobs: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to list failed jobs")
+		return
+	}
+
+	counts, err := h.service.Counts(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to count failed jobs: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to list failed jobs")
+		return
+	}
+
+	resp := dto.FailedJobsResponse{
+		Jobs:   make([]dto.FailedJobDTO, len(jobs)),
+		Counts: make([]dto.FailedJobCountDTO, len(counts)),
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+	for i, job := range jobs {
+		resp.Jobs[i] = toFailedJobDTO(job, false)
+	}
+	for i, c := range counts {
+		resp.Counts[i] = dto.FailedJobCountDTO{Source: c.Source, Type: string(c.JobType), Status: string(c.Status), Count: c.Count}
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// Job returns a single failed job with its payload.
+func (h *RetryAdminHandler) Job(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := h.jobID(w, r)
+	if !ok {
+		return
+	}
+
+	job, err := h.service.GetJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, services.ErrFailedJobNotFound) {
+			h.writeError(w, http.StatusNotFound, "Failed job not found")
+			return
+		}
+		logger.Error(ctx, "Failed to get failed job: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get failed job")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, toFailedJobDTO(*job, true))
+}
+
+// Requeue moves a dead-letter job back to the retry queue.
+func (h *RetryAdminHandler) Requeue(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.jobID(w, r)
+	if !ok {
+		return
+	}
+	h.command(w, r, id, "requeue", func() error { return h.service.Requeue(r.Context(), id) })
+}
+
+// Discard deletes a failed job without retrying it.
+func (h *RetryAdminHandler) Discard(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.jobID(w, r)
+	if !ok {
+		return
+	}
+	h.command(w, r, id, "discard", func() error { return h.service.Discard(r.Context(), id) })
+}
+
+func (h *RetryAdminHandler) command(w http.ResponseWriter, r *http.Request, id int, command string, run func() error) {
+	ctx := r.Context()
+
+	if err := run(); err != nil {
+		switch {
+		case errors.Is(err, services.ErrFailedJobNotFound):
+			h.writeError(w, http.StatusNotFound, "Failed job not found")
+		case errors.Is(err, services.ErrFailedJobNotDead):
+			h.writeError(w, http.StatusConflict, "Failed job is still pending")
+		default:
+			logger.Error(ctx, "Failed to "+command+" failed job "+strconv.Itoa(id)+": "+err.Error())
+			h.writeError(w, http.StatusInternalServerError, "Failed to "+command+" failed job")
+		}
+		return
+	}
+
+	logger.Info(ctx, "Retry queue command accepted: "+command+" "+strconv.Itoa(id))
+	h.writeJSON(w, http.StatusOK, dto.FailedJobCommandResponse{ID: id, Command: command, Status: "accepted"})
+}
+
+func (h *RetryAdminHandler) jobID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || id <= 0 {
+		h.writeError(w, http.StatusBadRequest, "Invalid job id")
+		return 0, false
+	}
+	return id, true
+}
+
+func toFailedJobDTO(job retry.FailedJob, withPayload bool) dto.FailedJobDTO {
+	item := dto.FailedJobDTO{
+		ID:         job.ID,
+		Type:       string(job.JobType),
+		Source:     job.Source,
+		ExternalID: job.ExternalID,
+		URL:        job.URL,
+		Status:     string(job.Status),
+		Error:      job.ErrorMessage,
+		RetryCount: job.RetryCount,
+		MaxRetries: job.MaxRetries,
+		DeadAt:     formatTimestamp(job.DeadAt),
+		CreatedAt:  job.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  job.UpdatedAt.Format(time.RFC3339),
+	}
+	if job.Status == retry.StatusPending {
+		item.NextRetryAt = formatTimestamp(&job.NextRetryAt)
+	}
+	if withPayload {
+		item.Payload = job.Payload
+	}
+	return item
+}
+
+func (h *RetryAdminHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *RetryAdminHandler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, dto.ErrorResponse{Error: message})
+}
@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/application/services"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// SchedulerAdminHandler handles scheduler administration requests.
+type SchedulerAdminHandler struct {
+	service *services.SchedulerAdminService
+}
+
+// NewSchedulerAdminHandler creates a new scheduler admin handler.
+func NewSchedulerAdminHandler(service *services.SchedulerAdminService) *SchedulerAdminHandler {
+	return &SchedulerAdminHandler{service: service}
+}
+
+// Jobs returns all scheduler jobs with their current state.
+func (h *SchedulerAdminHandler) Jobs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	jobs, err := h.service.ListJobs(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to list scheduler jobs: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to list scheduler jobs")
+		return
+	}
+
+	resp := dto.SchedulerJobsResponse{Jobs: make([]dto.SchedulerJobDTO, len(jobs))}
+	for i, j := range jobs {
+		item := dto.SchedulerJobDTO{
+			Name:             j.Name,
+			Paused:           j.Paused,
+			PausedAt:         formatTimestamp(j.PausedAt),
+			TriggerRequested: j.TriggerRequested,
+			Running:          j.Running,
+			InstanceID:       j.InstanceID,
+			LockedUntil:      formatTimestamp(j.LockedUntil),
+			HeartbeatAt:      formatTimestamp(j.HeartbeatAt),
+			FencingToken:     j.FencingToken,
+			RunID:            j.RunID,
+			CancelRequested:  j.CancelRequested,
+		}
+		if j.LastRun != nil {
+			run := toSchedulerRunDTO(*j.LastRun)
+			item.LastRun = &run
+		}
+		resp.Jobs[i] = item
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// Runs returns the job run history.
+func (h *SchedulerAdminHandler) Runs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter := services.SchedulerRunFilter{
+		JobName: r.URL.Query().Get("job"),
+		Status:  r.URL.Query().Get("status"),
+		Limit:   parseIntQuery(r, "limit", 50),
+		Offset:  parseIntQuery(r, "offset", 0),
+	}
+
+	runs, total, err := h.service.ListRuns(ctx, filter)
+	if err != nil {
+		logger.Error(ctx, "Failed to list job runs: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to list job runs")
+		return
+	}
+
+	resp := dto.SchedulerRunsResponse{
+		Runs:   make([]dto.SchedulerRunDTO, len(runs)),
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+	for i, run := range runs {
+		resp.Runs[i] = toSchedulerRunDTO(run)
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// Run returns a single job run.
+func (h *SchedulerAdminHandler) Run(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	run, err := h.service.GetRun(ctx, r.PathValue("id"))
+	if err != nil {
+		if errors.Is(err, services.ErrJobRunNotFound) {
+			h.writeError(w, http.StatusNotFound, "Job run not found")
+			return
+		}
+		logger.Error(ctx, "Failed to get job run: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get job run")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, toSchedulerRunDTO(*run))
+}
+
+// Trigger requests an immediate run of the job.
+func (h *SchedulerAdminHandler) Trigger(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	h.command(w, r, name, "trigger", func() error { return h.service.Trigger(r.Context(), name) })
+}
+
+// Pause pauses scheduled runs of the job.
+func (h *SchedulerAdminHandler) Pause(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	h.command(w, r, name, "pause", func() error { return h.service.SetPaused(r.Context(), name, true) })
+}
+
+// Resume resumes scheduled runs of the job.
+func (h *SchedulerAdminHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	h.command(w, r, name, "resume", func() error { return h.service.SetPaused(r.Context(), name, false) })
+}
+
+// Cancel cancels the running job on whichever instance holds its lock.
+func (h *SchedulerAdminHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	h.command(w, r, name, "cancel", func() error { return h.service.Cancel(r.Context(), name) })
+}
+
+// Cadence returns the planned tournament refresh queue of a source.
+func (h *SchedulerAdminHandler) Cadence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	source := r.URL.Query().Get("source")
+	if source == "" {
+		source = "junior"
+	}
+	kind := r.URL.Query().Get("kind")
+	if kind == "" {
+		kind = "stats"
+	}
+	limit := parseIntQuery(r, "limit", 100)
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+
+	plan, err := h.service.CadencePlan(ctx, source, kind)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrUnknownCadenceQuery):
+			h.writeError(w, http.StatusBadRequest, "Unknown source or kind")
+		case errors.Is(err, services.ErrCadenceUnavailable):
+			h.writeError(w, http.StatusServiceUnavailable, "Cadence planner is not configured")
+		default:
+			logger.Error(ctx, "Failed to plan cadence: "+err.Error())
+			h.writeError(w, http.StatusInternalServerError, "Failed to plan cadence")
+		}
+		return
+	}
+
+	resp := dto.CadencePlanResponse{
+		Source:      plan.Source,
+		Kind:        string(plan.Kind),
+		GeneratedAt: plan.GeneratedAt.Format(time.RFC3339),
+		DailyBudget: plan.DailyBudget,
+		UsedToday:   plan.UsedToday,
+		Quota:       plan.Quota,
+		Due:         len(plan.Queue),
+		ByPriority:  make(map[string]int),
+		Queue:       make([]dto.CadenceTournamentDTO, 0, min(limit, len(plan.Queue))),
+	}
+	for i, t := range plan.Queue {
+		resp.ByPriority[string(t.Priority)]++
+		if i >= limit {
+			continue
+		}
+		item := dto.CadenceTournamentDTO{
+			TournamentID: t.TournamentID,
+			Name:         t.Name,
+			Priority:     string(t.Priority),
+			LastParsedAt: formatTimestamp(t.LastParsedAt),
+			Scheduled:    t.Scheduled,
+			DeferReason:  t.DeferReason,
+		}
+		if !t.DueAt.IsZero() {
+			item.DueAt = formatTimestamp(&t.DueAt)
+		}
+		resp.Queue = append(resp.Queue, item)
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *SchedulerAdminHandler) command(w http.ResponseWriter, r *http.Request, name, command string, run func() error) {
+	ctx := r.Context()
+
+	if err := run(); err != nil {
+		switch {
+		case errors.Is(err, services.ErrUnknownJob):
+			h.writeError(w, http.StatusNotFound, "Unknown job")
+		case errors.Is(err, services.ErrJobNotRunning):
+			h.writeError(w, http.StatusConflict, "Job is not running")
+		default:
+			logger.Error(ctx, "Failed to "+command+" job "+name+": "+err.Error())
+			h.writeError(w, http.StatusInternalServerError, "Failed to "+command+" job")
+		}
+		return
+	}
+
+	logger.Info(ctx, "Scheduler command accepted: "+command+" "+name)
+	h.writeJSON(w, http.StatusAccepted, dto.SchedulerCommandResponse{Job: name, Command: command, Status: "accepted"})
+}
+
+func toSchedulerRunDTO(run services.SchedulerRun) dto.SchedulerRunDTO {
+	item := dto.SchedulerRunDTO{
+		ID:             run.ID,
+		JobName:        run.JobName,
+		Pipeline:       run.Pipeline,
+		Trigger:        run.Trigger,
+		InstanceID:     run.InstanceID,
+		Status:         run.Status,
+		StartedAt:      run.StartedAt.Format(time.RFC3339),
+		EndedAt:        formatTimestamp(run.EndedAt),
+		ItemsProcessed: run.ItemsProcessed,
+		Error:          run.Error,
+		SkipReason:     run.SkipReason,
+		Stats:          run.Stats,
+	}
+	if run.EndedAt != nil {
+		duration := services.RoundTo(run.EndedAt.Sub(run.StartedAt).Seconds(), 3)
+		item.DurationSec = &duration
+	}
+	return item
+}
+
+func formatTimestamp(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.RFC3339)
+	return &s
+}
+
+func (h *SchedulerAdminHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *SchedulerAdminHandler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, dto.ErrorResponse{Error: message})
+}
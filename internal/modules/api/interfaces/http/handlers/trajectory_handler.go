@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/application/services"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// TrajectoryHandler handles player development trajectory requests.
+type TrajectoryHandler struct {
+	service *services.TrajectoryService
+}
+
+// NewTrajectoryHandler creates a new trajectory handler.
+func NewTrajectoryHandler(service *services.TrajectoryService) *TrajectoryHandler {
+	return &TrajectoryHandler{service: service}
+}
+
+// PlayerTrajectory returns a player's development trajectory and projection.
+func (h *TrajectoryHandler) PlayerTrajectory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+
+	result, err := h.service.GetPlayerTrajectory(ctx, id)
+	if err != nil {
+		logger.Error(ctx, "Failed to get player trajectory: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get trajectory")
+		return
+	}
+	if result == nil {
+		h.writeError(w, http.StatusNotFound, "Player not found")
+		return
+	}
+
+	seasons := make([]dto.TrajectorySeasonDTO, len(result.Seasons))
+	for i, s := range result.Seasons {
+		ppg := 0.0
+		if s.Games > 0 {
+			ppg = float64(s.Points) / float64(s.Games)
+		}
+		seasons[i] = dto.TrajectorySeasonDTO{
+			Season: s.Season, Age: s.Age(), Games: s.Games,
+			Goals: s.Goals, Assists: s.Assists, Points: s.Points,
+			PointsPerGame: services.RoundTo(ppg, 3), Index: services.RoundTo(s.Index, 3), AdjustedIndex: services.RoundTo(s.Adjusted, 3),
+		}
+	}
+
+	cohort := make([]dto.CohortPointDTO, len(result.Cohort))
+	for i, c := range result.Cohort {
+		cohort[i] = dto.CohortPointDTO{
+			Age: c.Age, Season: c.Season, Players: c.Players,
+			P25: c.P25, Median: c.Median, P75: c.P75,
+		}
+	}
+
+	h.writeJSON(w, http.StatusOK, dto.PlayerTrajectoryResponse{
+		PlayerID: result.PlayerID, Name: result.Name,
+		BirthYear: result.BirthDate.Year(), BirthMonth: int(result.BirthDate.Month()),
+		Seasons: seasons, Cohort: cohort, Projection: projectionToDTO(result.Projection),
+	})
+}
+
+// BreakoutCandidates returns breakout candidates for a birth year.
+func (h *TrajectoryHandler) BreakoutCandidates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	birthYear := parseIntQuery(r, "birthYear", 0)
+	if birthYear == 0 {
+		h.writeError(w, http.StatusBadRequest, "birthYear is required")
+		return
+	}
+	limit := parseIntQuery(r, "limit", 20)
+
+	rows, err := h.service.GetBreakoutCandidates(ctx, birthYear, limit)
+	if err != nil {
+		logger.Error(ctx, "Failed to get breakout candidates: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get breakout candidates")
+		return
+	}
+
+	candidates := make([]dto.BreakoutCandidateDTO, len(rows))
+	for i, c := range rows {
+		candidates[i] = dto.BreakoutCandidateDTO{
+			PlayerID: c.PlayerID, Name: c.Name, BirthDate: c.BirthDate.Format("2006-01-02"),
+			Season: c.Season, Games: c.Games, Points: c.Points,
+			PreviousIndex: c.PreviousIndex, Index: c.Index,
+			Growth: c.Growth, CohortGrowth: c.CohortGrowth,
+			Projection: projectionToDTO(c.Projection),
+		}
+	}
+	h.writeJSON(w, http.StatusOK, dto.BreakoutCandidatesResponse{BirthYear: birthYear, Candidates: candidates})
+}
+
+// projectionToDTO converts a service projection to DTO.
+func projectionToDTO(p *services.Projection) *dto.ProjectionDTO {
+	if p == nil {
+		return nil
+	}
+	return &dto.ProjectionDTO{
+		Season: p.Season, IndexLow: p.Low, IndexMedian: p.Median, IndexHigh: p.High,
+		PointsPerGameLow: p.PointsPerGame[0], PointsPerGameMedian: p.PointsPerGame[1],
+		PointsPerGameHigh: p.PointsPerGame[2], Samples: p.Samples,
+	}
+}
+
+func (h *TrajectoryHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *TrajectoryHandler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, dto.ErrorResponse{Error: message})
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	analyticsApp "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/application"
+	analyticsDomain "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// VenueHandler handles venue search, venue schedule and team travel requests.
+type VenueHandler struct {
+	service *analyticsApp.VenueService
+}
+
+// NewVenueHandler creates a new venue handler.
+func NewVenueHandler(service *analyticsApp.VenueService) *VenueHandler {
+	return &VenueHandler{service: service}
+}
+
+// Search returns venues matching the name query and city.
+func (h *VenueHandler) Search(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+	limit := min(parseIntQuery(r, "limit", 50), 200)
+
+	venues, err := h.service.SearchVenues(ctx, q.Get("q"), q.Get("city"), limit)
+	if err != nil {
+		logger.Error(ctx, "Failed to search venues: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to search venues")
+		return
+	}
+
+	resp := dto.VenueListResponse{Venues: make([]dto.VenueDTO, len(venues))}
+	for i, v := range venues {
+		resp.Venues[i] = toVenueDTO(v)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// Schedule returns a venue with the matches played or scheduled there.
+func (h *VenueHandler) Schedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	limit := min(parseIntQuery(r, "limit", 50), 200)
+
+	schedule, err := h.service.VenueSchedule(ctx, r.PathValue("id"), limit)
+	if err != nil {
+		logger.Error(ctx, "Failed to get venue schedule: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get venue schedule")
+		return
+	}
+	if schedule == nil {
+		h.writeError(w, http.StatusNotFound, "Venue not found")
+		return
+	}
+
+	resp := dto.VenueScheduleResponse{Venue: toVenueDTO(schedule.Venue), Matches: make([]dto.MatchDTO, len(schedule.Games))}
+	for i, g := range schedule.Games {
+		date, timeStr := "", ""
+		if g.ScheduledAt != nil {
+			date = g.ScheduledAt.Format("2006-01-02")
+			timeStr = g.ScheduledAt.Format("15:04")
+		}
+		resp.Matches[i] = dto.MatchDTO{
+			ID: g.MatchID, HomeTeam: titleCase(g.HomeTeam), AwayTeam: titleCase(g.AwayTeam),
+			HomeTeamID: g.HomeTeamID, AwayTeamID: g.AwayTeamID,
+			HomeScore: g.HomeScore, AwayScore: g.AwayScore,
+			Date: date, Time: timeStr, Tournament: titleCase(g.Tournament),
+			Venue: schedule.Venue.Name, VenueID: schedule.Venue.ID, Status: g.Status,
+		}
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// TeamTravel returns a team's away trips and total travel distance in a season.
+func (h *VenueHandler) TeamTravel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	travel, err := h.service.TeamTravel(ctx, r.PathValue("id"), r.URL.Query().Get("season"))
+	if err != nil {
+		logger.Error(ctx, "Failed to get team travel: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "Failed to get team travel")
+		return
+	}
+
+	resp := dto.TeamTravelResponse{
+		TeamID: travel.TeamID, Season: travel.Season, Trips: make([]dto.TeamTripDTO, len(travel.Trips)),
+		Unlocated: travel.Unlocated, TotalKm: travel.TotalKm,
+	}
+	if travel.Home != nil {
+		home := toVenueDTO(*travel.Home)
+		resp.Home = &home
+	}
+	for i, trip := range travel.Trips {
+		date := ""
+		if trip.ScheduledAt != nil {
+			date = trip.ScheduledAt.Format("2006-01-02")
+		}
+		resp.Trips[i] = dto.TeamTripDTO{MatchID: trip.MatchID, Date: date, Venue: toVenueDTO(trip.Venue), DistanceKm: trip.DistanceKm}
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+func toVenueDTO(v analyticsDomain.Venue) dto.VenueDTO {
+	result := dto.VenueDTO{ID: v.ID, Name: v.Name, City: v.City, Region: v.Region, Precision: v.Precision, Aliases: v.Aliases}
+	if result.Aliases == nil {
+		result.Aliases = []string{}
+	}
+	if v.Location != nil {
+		result.Latitude, result.Longitude = &v.Location.Lat, &v.Location.Lon
+	}
+	return result
+}
+
+func (h *VenueHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *VenueHandler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, dto.ErrorResponse{Error: message})
+}
@@ -107,6 +107,34 @@ func (m *AuthMiddleware) RequireSubscription(tier string) func(http.Handler) htt
 	}
 }
 
+// RequireAdmin is middleware that allows only users whose email is in the admin list.
+// Must be applied after RequireAuth.
+func (m *AuthMiddleware) RequireAdmin(adminEmails []string) func(http.Handler) http.Handler {
+	admins := make(map[string]bool, len(adminEmails))
+	for _, email := range adminEmails {
+		if email = strings.ToLower(strings.TrimSpace(email)); email != "" {
+			admins[email] = true
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims := GetUserFromContext(r.Context())
+			if claims == nil {
+				http.Error(w, `{"error":"unauthorized","message":"authentication required"}`, http.StatusUnauthorized)
+				return
+			}
+
+			if !admins[strings.ToLower(claims.Email)] {
+				http.Error(w, `{"error":"forbidden","message":"admin access required"}`, http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 // GetUserFromContext retrieves user claims from context.
 func GetUserFromContext(ctx context.Context) *services.JWTClaims {
 	claims, ok := ctx.Value(UserContextKey).(*services.JWTClaims)
@@ -136,3 +164,8 @@ func hasRequiredTier(userTier, requiredTier string) bool {
 
 	return userLevel >= requiredLevel
 }
+
+// HasTier checks if the authenticated user's subscription meets the required tier.
+func HasTier(claims *services.JWTClaims, requiredTier string) bool {
+	return claims != nil && hasRequiredTier(claims.SubscriptionTier, requiredTier)
+}
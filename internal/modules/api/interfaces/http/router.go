@@ -5,6 +5,7 @@ import (
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/interfaces/http/handlers"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/api/interfaces/http/middleware"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/metrics"
 )
 
 // Router represents the HTTP router with all handlers.
@@ -18,7 +19,21 @@ type Router struct {
 	explorePlayersHandler *handlers.ExplorePlayersHandler
 	exploreMatchesHandler *handlers.ExploreMatchesHandler
 	imageProxyHandler     *handlers.ImageProxyHandler
+	trajectoryHandler     *handlers.TrajectoryHandler
+	linesHandler          *handlers.LinesHandler
+	goalieHandler         *handlers.GoalieHandler
+	disciplineHandler     *handlers.DisciplineHandler
+	schedulerAdminHandler *handlers.SchedulerAdminHandler
+	retryAdminHandler     *handlers.RetryAdminHandler
+	playerHistoryHandler  *handlers.PlayerHistoryHandler
+	dataQualityHandler    *handlers.DataQualityHandler
+	clubHandler           *handlers.ClubHandler
+	venueHandler          *handlers.VenueHandler
+	calendarHandler       *handlers.CalendarHandler
+	exportHandler         *handlers.ExportHandler
+	playerChartHandler    *handlers.PlayerChartHandler
 	authMiddleware        *middleware.AuthMiddleware
+	adminEmails           []string
 	allowedOrigins        []string
 }
 
@@ -32,7 +47,21 @@ func NewRouter(
 	explorePlayersHandler *handlers.ExplorePlayersHandler,
 	exploreMatchesHandler *handlers.ExploreMatchesHandler,
 	imageProxyHandler *handlers.ImageProxyHandler,
+	trajectoryHandler *handlers.TrajectoryHandler,
+	linesHandler *handlers.LinesHandler,
+	goalieHandler *handlers.GoalieHandler,
+	disciplineHandler *handlers.DisciplineHandler,
+	schedulerAdminHandler *handlers.SchedulerAdminHandler,
+	retryAdminHandler *handlers.RetryAdminHandler,
+	playerHistoryHandler *handlers.PlayerHistoryHandler,
+	dataQualityHandler *handlers.DataQualityHandler,
+	clubHandler *handlers.ClubHandler,
+	venueHandler *handlers.VenueHandler,
+	calendarHandler *handlers.CalendarHandler,
+	exportHandler *handlers.ExportHandler,
+	playerChartHandler *handlers.PlayerChartHandler,
 	authMiddleware *middleware.AuthMiddleware,
+	adminEmails []string,
 	allowedOrigins []string,
 ) *Router {
 	return &Router{
@@ -45,7 +74,21 @@ func NewRouter(
 		explorePlayersHandler: explorePlayersHandler,
 		exploreMatchesHandler: exploreMatchesHandler,
 		imageProxyHandler:     imageProxyHandler,
+		trajectoryHandler:     trajectoryHandler,
+		linesHandler:          linesHandler,
+		goalieHandler:         goalieHandler,
+		disciplineHandler:     disciplineHandler,
+		schedulerAdminHandler: schedulerAdminHandler,
+		retryAdminHandler:     retryAdminHandler,
+		playerHistoryHandler:  playerHistoryHandler,
+		dataQualityHandler:    dataQualityHandler,
+		clubHandler:           clubHandler,
+		venueHandler:          venueHandler,
+		calendarHandler:       calendarHandler,
+		exportHandler:         exportHandler,
+		playerChartHandler:    playerChartHandler,
 		authMiddleware:        authMiddleware,
+		adminEmails:           adminEmails,
 		allowedOrigins:        allowedOrigins,
 	}
 }
@@ -55,6 +98,9 @@ func (r *Router) Setup() http.Handler {
 	// Health check (public)
 	r.mux.HandleFunc("GET /api/v1/health", r.healthHandler.Health)
 
+	// Prometheus metrics (public)
+	r.mux.Handle("GET /metrics", metrics.MetricsHandler())
+
 	// Auth routes (public)
 	r.mux.HandleFunc("POST /api/v1/auth/register", r.authHandler.Register)
 	r.mux.HandleFunc("POST /api/v1/auth/login", r.authHandler.Login)
@@ -79,16 +125,71 @@ func (r *Router) Setup() http.Handler {
 	r.mux.HandleFunc("GET /api/v1/explore/tournaments/{id}/matches", r.exploreHandler.TournamentMatches)
 	r.mux.HandleFunc("GET /api/v1/explore/tournaments/{id}/scorers", r.exploreHandler.Scorers)
 	r.mux.HandleFunc("GET /api/v1/explore/tournaments/{id}/teams", r.exploreHandler.TournamentTeams)
+	r.mux.HandleFunc("GET /api/v1/explore/tournaments/{id}/discipline", r.disciplineHandler.TournamentDiscipline)
 	r.mux.HandleFunc("GET /api/v1/explore/players/{id}/stats", r.explorePlayersHandler.PlayerStats)
+	r.mux.HandleFunc("GET /api/v1/explore/players/{id}/trajectory", r.trajectoryHandler.PlayerTrajectory)
+	r.mux.HandleFunc("GET /api/v1/explore/players/{id}/linemates", r.linesHandler.PlayerLinemates)
+	r.mux.HandleFunc("GET /api/v1/explore/players/{id}/discipline", r.disciplineHandler.PlayerDiscipline)
+	r.mux.HandleFunc("GET /api/v1/explore/players/{id}/history", r.playerHistoryHandler.PlayerHistory)
+	r.mux.HandleFunc("GET /api/v1/explore/players/{id}/chart.png", r.playerChartHandler.PlayerChart)
 	r.mux.HandleFunc("GET /api/v1/explore/players/{id}", r.explorePlayersHandler.PlayerProfile)
 	r.mux.HandleFunc("GET /api/v1/explore/players", r.explorePlayersHandler.SearchPlayers)
 	r.mux.HandleFunc("GET /api/v1/explore/teams/{teamId}/roster/{tournamentId}", r.exploreHandler.TeamRoster)
+	r.mux.HandleFunc("GET /api/v1/explore/teams/{id}/lines", r.linesHandler.TeamLines)
+	r.mux.HandleFunc("GET /api/v1/explore/teams/{id}/pulls", r.goalieHandler.TeamPulls)
+	r.mux.HandleFunc("GET /api/v1/explore/teams/{id}/discipline", r.disciplineHandler.TeamDiscipline)
+	r.mux.HandleFunc("GET /api/v1/explore/teams/{id}/travel", r.venueHandler.TeamTravel)
 	r.mux.HandleFunc("GET /api/v1/explore/teams/{id}", r.explorePlayersHandler.TeamProfile)
+	r.mux.HandleFunc("GET /api/v1/explore/clubs", r.clubHandler.Search)
+	r.mux.HandleFunc("GET /api/v1/explore/clubs/{id}", r.clubHandler.Club)
+	r.mux.HandleFunc("GET /api/v1/explore/venues", r.venueHandler.Search)
+	r.mux.HandleFunc("GET /api/v1/explore/venues/{id}/schedule", r.venueHandler.Schedule)
 	r.mux.HandleFunc("GET /api/v1/explore/results", r.exploreMatchesHandler.RecentResults)
 	r.mux.HandleFunc("GET /api/v1/explore/calendar", r.exploreMatchesHandler.UpcomingMatches)
 	r.mux.HandleFunc("GET /api/v1/explore/rankings", r.exploreMatchesHandler.Rankings)
 	r.mux.HandleFunc("GET /api/v1/explore/rankings/filters", r.exploreMatchesHandler.RankingsFilters)
 	r.mux.HandleFunc("GET /api/v1/explore/matches/{id}", r.exploreMatchesHandler.MatchDetail)
+	r.mux.HandleFunc("GET /api/v1/explore/breakouts", r.trajectoryHandler.BreakoutCandidates)
+	r.mux.HandleFunc("GET /api/v1/explore/goalies", r.goalieHandler.Leaderboard)
+	r.mux.HandleFunc("GET /api/v1/explore/goalies/{id}", r.goalieHandler.GoalieProfile)
+
+	// Calendar feeds (public, polled by calendar apps): /calendar/teams/{id}.ics
+	r.mux.HandleFunc("GET /api/v1/calendar/teams/{file}", r.calendarHandler.TeamCalendar)
+	r.mux.HandleFunc("GET /api/v1/calendar/players/{file}", r.calendarHandler.PlayerCalendar)
+
+	// Data exports (pro subscription; parquet requires ultra, checked by the handler)
+	r.handleSubscribed("GET /api/v1/exports/{dataset}", "pro", r.exportHandler.Export)
+	r.handleSubscribed("POST /api/v1/exports", "pro", r.exportHandler.CreateJob)
+	r.handleSubscribed("GET /api/v1/exports/jobs/{id}", "pro", r.exportHandler.Job)
+	r.handleSubscribed("GET /api/v1/exports/jobs/{id}/download", "pro", r.exportHandler.Download)
+
+	// Scheduler admin routes (admin only)
+	r.handleAdmin("GET /api/v1/admin/scheduler/jobs", r.schedulerAdminHandler.Jobs)
+	r.handleAdmin("GET /api/v1/admin/scheduler/runs", r.schedulerAdminHandler.Runs)
+	r.handleAdmin("GET /api/v1/admin/scheduler/runs/{id}", r.schedulerAdminHandler.Run)
+	r.handleAdmin("GET /api/v1/admin/scheduler/cadence", r.schedulerAdminHandler.Cadence)
+	r.handleAdmin("POST /api/v1/admin/scheduler/jobs/{name}/trigger", r.schedulerAdminHandler.Trigger)
+	r.handleAdmin("POST /api/v1/admin/scheduler/jobs/{name}/pause", r.schedulerAdminHandler.Pause)
+	r.handleAdmin("POST /api/v1/admin/scheduler/jobs/{name}/resume", r.schedulerAdminHandler.Resume)
+	r.handleAdmin("POST /api/v1/admin/scheduler/jobs/{name}/cancel", r.schedulerAdminHandler.Cancel)
+
+	// Retry queue admin routes (admin only)
+	r.handleAdmin("GET /api/v1/admin/retry/jobs", r.retryAdminHandler.Jobs)
+	r.handleAdmin("GET /api/v1/admin/retry/jobs/{id}", r.retryAdminHandler.Job)
+	r.handleAdmin("POST /api/v1/admin/retry/jobs/{id}/requeue", r.retryAdminHandler.Requeue)
+	r.handleAdmin("POST /api/v1/admin/retry/jobs/{id}/discard", r.retryAdminHandler.Discard)
+
+	// Player change history admin routes (admin only)
+	r.handleAdmin("GET /api/v1/admin/history/sessions/latest", r.playerHistoryHandler.LatestSession)
+	r.handleAdmin("GET /api/v1/admin/history/sessions/{id}", r.playerHistoryHandler.Session)
+
+	// Data quality dashboard (admin only)
+	r.handleAdmin("GET /api/v1/admin/quality/violations", r.dataQualityHandler.Violations)
+
+	// Club match review admin routes (admin only)
+	r.handleAdmin("GET /api/v1/admin/clubs/reviews", r.clubHandler.Reviews)
+	r.handleAdmin("POST /api/v1/admin/clubs/reviews/{id}/approve", r.clubHandler.ApproveReview)
+	r.handleAdmin("POST /api/v1/admin/clubs/reviews/{id}/reject", r.clubHandler.RejectReview)
 
 	// Image proxy (public)
 	r.mux.HandleFunc("GET /api/v1/proxy/image", r.imageProxyHandler.ProxyImage)
@@ -99,6 +200,18 @@ func (r *Router) Setup() http.Handler {
 	return handler
 }
 
+// handleAdmin registers a route that requires an authenticated admin user.
+func (r *Router) handleAdmin(pattern string, handler http.HandlerFunc) {
+	requireAdmin := r.authMiddleware.RequireAdmin(r.adminEmails)
+	r.mux.Handle(pattern, r.authMiddleware.RequireAuth(requireAdmin(handler)))
+}
+
+// handleSubscribed registers a route that requires a subscription of at least the tier.
+func (r *Router) handleSubscribed(pattern, tier string, handler http.HandlerFunc) {
+	requireTier := r.authMiddleware.RequireSubscription(tier)
+	r.mux.Handle(pattern, r.authMiddleware.RequireAuth(requireTier(handler)))
+}
+
 func (r *Router) applyMiddleware(handler http.Handler) http.Handler {
 	// Apply in reverse order (last applied = first executed)
 	handler = middleware.Logging()(handler)
@@ -0,0 +1,140 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	fhmoscowrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhmoscow"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/parsing"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// tournamentRef турнир из БД с идентификаторами для запросов к API
+type tournamentRef struct {
+	ID        string `json:"id"`       // fhm:14_137
+	APIID     int    `json:"api_id"`   // 14
+	GroupID   int    `json:"group_id"` // 137, 0 - турнир без групп
+	GroupName string `json:"group_name,omitempty"`
+	BirthYear int    `json:"birth_year,omitempty"`
+}
+
+// newTournamentRef разбирает external_id турнира формата "tournament" или "tournament_group"
+func newTournamentRef(t fhmoscowrepo.Tournament) (tournamentRef, error) {
+	ref := tournamentRef{ID: t.ID}
+
+	tournamentPart, groupPart, hasGroup := strings.Cut(t.ExternalID, "_")
+	id, err := strconv.Atoi(tournamentPart)
+	if err != nil {
+		return ref, fmt.Errorf("invalid external id %q", t.ExternalID)
+	}
+	ref.APIID = id
+
+	if hasGroup {
+		if ref.GroupID, err = strconv.Atoi(groupPart); err != nil {
+			return ref, fmt.Errorf("invalid external id %q", t.ExternalID)
+		}
+	}
+	if t.GroupName != nil {
+		ref.GroupName = *t.GroupName
+	}
+	if t.BirthYear != nil {
+		ref.BirthYear = *t.BirthYear
+	}
+	return ref, nil
+}
+
+// filter тело запроса к API календаря и таблицы
+func (r tournamentRef) filter(seasonID int) map[string]interface{} {
+	body := map[string]interface{}{
+		"season":     seasonID,
+		"tournament": r.APIID,
+	}
+	if r.GroupID > 0 {
+		body["group"] = r.GroupID
+	}
+	return body
+}
+
+type tournamentStats struct {
+	matches   int
+	protocols int
+	events    int
+	standings int
+}
+
+func (s *tournamentStats) add(other tournamentStats) {
+	s.matches += other.matches
+	s.protocols += other.protocols
+	s.events += other.events
+	s.standings += other.standings
+}
+
+// processTournament сохраняет календарь, протоколы сыгранных матчей и таблицу турнира
+func (o *Orchestrator) processTournament(ctx context.Context, season dto.SeasonDTO, ref tournamentRef) (tournamentStats, error) {
+	var stats tournamentStats
+
+	data, err := o.client.PostAPI("/api/calendar", ref.filter(season.ID))
+	if err != nil {
+		return stats, fmt.Errorf("get calendar: %w", err)
+	}
+	games, err := parsing.ParseCalendar(data)
+	if err != nil {
+		return stats, fmt.Errorf("parse calendar: %w", err)
+	}
+
+	logger.Debug(ctx, "Calendar parsed",
+		zap.String("tournament_id", ref.ID),
+		zap.Int("matches", len(games)),
+	)
+
+	for _, game := range games {
+		payload := matchRetryPayload{Tournament: ref, Game: game}
+
+		if err := o.saveMatch(ctx, ref, game); err != nil {
+			logger.Warn(ctx, "Failed to save match", zap.Int("game_id", game.ID), zap.Error(err))
+			o.enqueueRetry(ctx, retry.JobTypeMatch, payload, err)
+			continue
+		}
+		stats.matches++
+
+		if !o.config.ParseProtocol() || !game.IsFinished() || !o.needsProtocol(ctx, game) {
+			continue
+		}
+
+		events, err := o.processProtocol(ctx, ref, game)
+		if err != nil {
+			logger.Debug(ctx, "Protocol parsing failed", zap.Int("game_id", game.ID), zap.Error(err))
+			o.enqueueRetry(ctx, retry.JobTypeProtocol, payload, err)
+			continue
+		}
+		stats.protocols++
+		stats.events += events
+	}
+
+	if o.config.ParseStandings() {
+		saved, err := o.processStandings(ctx, season.ID, ref)
+		if err != nil {
+			logger.Warn(ctx, "Standings processing failed", zap.String("tournament_id", ref.ID), zap.Error(err))
+		}
+		stats.standings = saved
+	}
+
+	return stats, nil
+}
+
+// needsProtocol проверяет, нужно ли разбирать протокол матча
+func (o *Orchestrator) needsProtocol(ctx context.Context, game dto.GameDTO) bool {
+	if !o.config.SkipExisting() {
+		return true
+	}
+	match, err := o.matchRepo.GetByID(ctx, matchID(game.ID))
+	if err != nil || match == nil {
+		return true
+	}
+	return !match.DetailsParsed
+}
@@ -0,0 +1,136 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
+)
+
+// ID формат совпадает с репозиториями FHMoscow: fhm:{external_id}
+func matchID(id int) string  { return fmt.Sprintf("fhm:%d", id) }
+func teamID(id int) string   { return fmt.Sprintf("fhm:%d", id) }
+func playerID(id int) string { return fmt.Sprintf("fhm:%d", id) }
+
+// convertGoal конвертирует гол в событие матча
+func convertGoal(id string, game dto.GameDTO, goal dto.GoalDTO, idx int) *entities.MatchEvent {
+	isHome := goal.TeamID == game.Home.ID
+	event := &entities.MatchEvent{
+		ID:              fmt.Sprintf("%s:goal:%d", id, idx),
+		MatchID:         id,
+		EventType:       entities.EventTypeGoal,
+		ScorerPlayerID:  personID(goal.Scorer),
+		Assist1PlayerID: personID(goal.Assist1),
+		Assist2PlayerID: personID(goal.Assist2),
+		TeamID:          eventTeamID(game, goal.TeamID),
+		GoalType:        goalType(goal.Situation),
+		IsHome:          &isHome,
+		Source:          Source,
+	}
+	setEventTime(event, goal.Period, goal.Time)
+
+	if home, away, ok := dto.ParseScore(goal.Score); ok {
+		event.ScoreHome = scorePtr(home)
+		event.ScoreAway = scorePtr(away)
+	}
+	return event
+}
+
+// convertPenalty конвертирует удаление в событие матча
+func convertPenalty(id string, game dto.GameDTO, penalty dto.PenaltyDTO, idx int) *entities.MatchEvent {
+	isHome := penalty.TeamID == game.Home.ID
+	event := &entities.MatchEvent{
+		ID:              fmt.Sprintf("%s:penalty:%d", id, idx),
+		MatchID:         id,
+		EventType:       entities.EventTypePenalty,
+		PenaltyPlayerID: personID(penalty.Player),
+		PenaltyMinutes:  intPtr(penalty.Minutes),
+		PenaltyReason:   strPtr(penalty.Reason),
+		TeamID:          eventTeamID(game, penalty.TeamID),
+		IsHome:          &isHome,
+		Source:          Source,
+	}
+	setEventTime(event, penalty.Period, penalty.Time)
+	return event
+}
+
+// convertLineupPlayer конвертирует игрока состава
+func convertLineupPlayer(id, team string, p dto.LineupPlayerDTO) *entities.MatchLineup {
+	pid := playerID(p.ID)
+	lineup := &entities.MatchLineup{
+		ID:             fmt.Sprintf("%s:%s", id, pid),
+		MatchID:        id,
+		PlayerID:       pid,
+		TeamID:         team,
+		JerseyNumber:   intPtr(p.Number),
+		Position:       strPtr(lineupPosition(p.Position)),
+		CaptainRole:    strPtr(p.Captain),
+		Goals:          p.Goals,
+		Assists:        p.Assists,
+		PenaltyMinutes: p.PenaltyMinutes,
+		PlusMinus:      p.PlusMinus,
+		Saves:          p.Saves,
+		GoalsAgainst:   p.GoalsAgainst,
+		Source:         Source,
+	}
+	if minutes, seconds, ok := dto.ParseClock(p.TimeOnIce); ok {
+		lineup.TimeOnIce = scorePtr(minutes*60 + seconds)
+	}
+	return lineup
+}
+
+// setEventTime заполняет время события, период при отсутствии вычисляется по минутам
+func setEventTime(event *entities.MatchEvent, period int, clock string) {
+	minutes, seconds, ok := dto.ParseClock(clock)
+	if ok {
+		event.TimeMinutes = scorePtr(minutes)
+		event.TimeSeconds = scorePtr(seconds)
+	}
+	if period == 0 && ok {
+		period = min(minutes/20+1, 4)
+	}
+	event.Period = intPtr(period)
+}
+
+func personID(p *dto.ProtocolPersonDTO) *string {
+	if p == nil || p.ID == 0 {
+		return nil
+	}
+	return strPtr(playerID(p.ID))
+}
+
+// eventTeamID возвращает команду события, только если это одна из команд матча
+func eventTeamID(game dto.GameDTO, id int) *string {
+	if id == 0 || (id != game.Home.ID && id != game.Away.ID) {
+		return nil
+	}
+	return strPtr(teamID(id))
+}
+
+// Helper functions
+func intPtr(v int) *int {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+// scorePtr возвращает указатель на int, включая 0 (для счёта)
+func scorePtr(v int) *int {
+	return &v
+}
+
+func strPtr(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
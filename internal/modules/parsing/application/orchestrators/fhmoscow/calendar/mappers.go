@@ -0,0 +1,56 @@
+package calendar
+
+import (
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
+)
+
+// lineupPosition переводит позицию fhmoscow (В, З, Н) в позицию состава
+func lineupPosition(position string) string {
+	switch position {
+	case "В":
+		return entities.LineupPositionGoalie
+	case "З":
+		return entities.LineupPositionDefender
+	case "Н":
+		return entities.LineupPositionForward
+	}
+	return position
+}
+
+// goalType переводит игровую ситуацию гола в тип гола
+func goalType(situation string) *string {
+	switch situation {
+	case "pp":
+		return strPtr(entities.GoalTypePowerPlay)
+	case "sh":
+		return strPtr(entities.GoalTypeShortHand)
+	case "en":
+		return strPtr(entities.GoalTypeEmptyNet)
+	}
+	return strPtr(entities.GoalTypeEven)
+}
+
+// matchStatus переводит статус календаря в статус матча
+func matchStatus(game dto.GameDTO) string {
+	switch {
+	case game.IsFinished():
+		return entities.MatchStatusFinished
+	case game.Status == dto.GameStatusLive:
+		return entities.MatchStatusInProgress
+	case game.Status == dto.GameStatusCancelled:
+		return entities.MatchStatusCancelled
+	}
+	return entities.MatchStatusScheduled
+}
+
+// resultType определяет тип результата сыгранного матча
+func resultType(game dto.GameDTO) *string {
+	switch {
+	case game.Shootout:
+		return strPtr(entities.ResultTypeShootout)
+	case game.Overtime:
+		return strPtr(entities.ResultTypeOT)
+	}
+	return strPtr(entities.ResultTypeRegular)
+}
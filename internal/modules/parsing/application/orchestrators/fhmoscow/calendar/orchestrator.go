@@ -0,0 +1,113 @@
+package calendar
+
+import (
+	"context"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/repositories"
+	fhmoscowrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhmoscow"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
+	"github.com/jmoiron/sqlx"
+)
+
+// CalendarConfig интерфейс конфигурации парсера календаря FHMoscow
+type CalendarConfig interface {
+	MinBirthYear() int
+	TestSeason() string
+	ParseProtocol() bool  // Парсить протоколы сыгранных матчей
+	ParseStandings() bool // Парсить турнирные таблицы
+	SkipExisting() bool   // Не перечитывать уже разобранные протоколы
+	RetryEnabled() bool
+	RetryMaxAttempts() int
+	RetryDelay() time.Duration
+}
+
+// TournamentProgress контрольная точка обработки турниров.
+//
+// Позволяет прерванному запуску продолжить с необработанных турниров.
+type TournamentProgress interface {
+	IsDone(tournamentID string) bool
+	MarkDone(ctx context.Context, tournamentID string)
+}
+
+// Source константа источника
+const Source = fhmoscowrepo.SourceFHMoscow
+
+// Orchestrator оркестратор парсинга календаря, протоколов и таблиц FHMoscow
+//
+// Турниры и группы берутся из БД, их заранее сохраняет fhmoscow_parser
+type Orchestrator struct {
+	client *fhmoscow.Client
+
+	// Репозитории FHMoscow
+	tournamentRepo *fhmoscowrepo.TournamentRepository
+	teamRepo       *fhmoscowrepo.TeamRepository
+	playerRepo     *fhmoscowrepo.PlayerRepository
+
+	// Общие репозитории
+	matchRepo       repositories.MatchRepository
+	matchEventRepo  repositories.MatchEventRepository
+	matchLineupRepo repositories.MatchLineupRepository
+	standingRepo    repositories.StandingRepository
+
+	// Retry manager
+	retryManager *retry.Manager
+
+	// Конфигурация
+	config CalendarConfig
+
+	// Контрольная точка (опционально)
+	progress TournamentProgress
+}
+
+// NewOrchestrator создает новый оркестратор
+func NewOrchestrator(
+	db *sqlx.DB,
+	client *fhmoscow.Client,
+	tournamentRepo *fhmoscowrepo.TournamentRepository,
+	teamRepo *fhmoscowrepo.TeamRepository,
+	playerRepo *fhmoscowrepo.PlayerRepository,
+	matchRepo repositories.MatchRepository,
+	matchEventRepo repositories.MatchEventRepository,
+	matchLineupRepo repositories.MatchLineupRepository,
+	standingRepo repositories.StandingRepository,
+	config CalendarConfig,
+) *Orchestrator {
+	retryManager := retry.NewManager(
+		db,
+		config.RetryMaxAttempts(),
+		config.RetryDelay(),
+	)
+
+	return &Orchestrator{
+		client:          client,
+		tournamentRepo:  tournamentRepo,
+		teamRepo:        teamRepo,
+		playerRepo:      playerRepo,
+		matchRepo:       matchRepo,
+		matchEventRepo:  matchEventRepo,
+		matchLineupRepo: matchLineupRepo,
+		standingRepo:    standingRepo,
+		retryManager:    retryManager,
+		config:          config,
+	}
+}
+
+// WithProgress включает контрольные точки по турнирам
+func (o *Orchestrator) WithProgress(progress TournamentProgress) *Orchestrator {
+	o.progress = progress
+	return o
+}
+
+// isDone возвращает true, если турнир обработан прерванным запуском
+func (o *Orchestrator) isDone(tournamentID string) bool {
+	return o.progress != nil && o.progress.IsDone(tournamentID)
+}
+
+// markDone отмечает турнир обработанным; турнир, прерванный отменой, будет обработан заново
+func (o *Orchestrator) markDone(ctx context.Context, tournamentID string) {
+	if o.progress != nil && ctx.Err() == nil {
+		o.progress.MarkDone(ctx, tournamentID)
+	}
+}
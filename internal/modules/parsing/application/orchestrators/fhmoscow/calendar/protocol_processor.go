@@ -0,0 +1,107 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/parsing"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// processProtocol сохраняет события, составы и счёт по периодам сыгранного матча
+func (o *Orchestrator) processProtocol(ctx context.Context, ref tournamentRef, game dto.GameDTO) (int, error) {
+	data, err := o.client.GetAPI(fmt.Sprintf("/api/game/%d", game.ID))
+	if err != nil {
+		return 0, fmt.Errorf("get protocol: %w", err)
+	}
+
+	proto, err := parsing.ParseProtocol(data)
+	if err != nil {
+		return 0, fmt.Errorf("parse protocol: %w", err)
+	}
+
+	id := matchID(game.ID)
+	homeTeamID := teamID(game.Home.ID)
+	awayTeamID := teamID(game.Away.ID)
+
+	// События и составы ссылаются на игроков, создаём недостающих заранее
+	o.ensurePlayers(ctx, ref, proto)
+
+	if err := o.matchEventRepo.DeleteByMatchID(ctx, id); err != nil {
+		return 0, fmt.Errorf("delete old events: %w", err)
+	}
+	var events []*entities.MatchEvent
+	for i, goal := range proto.Goals {
+		events = append(events, convertGoal(id, game, goal, i))
+	}
+	for i, penalty := range proto.Penalties {
+		events = append(events, convertPenalty(id, game, penalty, i))
+	}
+	if len(events) > 0 {
+		if err := o.matchEventRepo.CreateBatch(ctx, events); err != nil {
+			return 0, fmt.Errorf("create events: %w", err)
+		}
+	}
+
+	if err := o.matchLineupRepo.DeleteByMatchID(ctx, id); err != nil {
+		return 0, fmt.Errorf("delete old lineups: %w", err)
+	}
+	var lineups []*entities.MatchLineup
+	for _, side := range []struct {
+		teamID string
+		lineup []dto.LineupPlayerDTO
+	}{{homeTeamID, proto.Home.Lineup}, {awayTeamID, proto.Away.Lineup}} {
+		for _, p := range side.lineup {
+			if p.ID == 0 {
+				continue // игрок без ссылки на профиль
+			}
+			lineups = append(lineups, convertLineupPlayer(id, side.teamID, p))
+		}
+	}
+	if len(lineups) > 0 {
+		if err := o.matchLineupRepo.CreateBatch(ctx, lineups); err != nil {
+			return 0, fmt.Errorf("create lineups: %w", err)
+		}
+	}
+
+	o.updateMatchScoreByPeriods(ctx, id, proto)
+
+	if err := o.matchRepo.MarkDetailsParsed(ctx, id); err != nil {
+		return 0, fmt.Errorf("mark details parsed: %w", err)
+	}
+
+	logger.Debug(ctx, "Protocol processed",
+		zap.String("match_id", id),
+		zap.Int("events", len(events)),
+		zap.Int("lineups", len(lineups)),
+	)
+
+	return len(events), nil
+}
+
+// updateMatchScoreByPeriods обновляет счёт матча по периодам из протокола
+func (o *Orchestrator) updateMatchScoreByPeriods(ctx context.Context, id string, proto *dto.ProtocolDTO) {
+	home, away := proto.Home.Periods, proto.Away.Periods
+	if len(home) < 3 || len(away) < 3 {
+		return
+	}
+
+	match, err := o.matchRepo.GetByID(ctx, id)
+	if err != nil || match == nil {
+		return
+	}
+
+	match.HomeScoreP1, match.AwayScoreP1 = scorePtr(home[0]), scorePtr(away[0])
+	match.HomeScoreP2, match.AwayScoreP2 = scorePtr(home[1]), scorePtr(away[1])
+	match.HomeScoreP3, match.AwayScoreP3 = scorePtr(home[2]), scorePtr(away[2])
+	if len(home) > 3 && len(away) > 3 {
+		match.HomeScoreOT, match.AwayScoreOT = scorePtr(home[3]), scorePtr(away[3])
+	}
+
+	if err := o.matchRepo.Update(ctx, match); err != nil {
+		logger.Warn(ctx, "Failed to update period scores", zap.String("match_id", id), zap.Error(err))
+	}
+}
@@ -0,0 +1,61 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// retrySource имя источника в очереди повторов, общее с парсером FHMoscow
+const retrySource = "fhmoscow"
+
+// matchRetryPayload данные для повтора матча или его протокола
+type matchRetryPayload struct {
+	Tournament tournamentRef `json:"tournament"`
+	Game       dto.GameDTO   `json:"game"`
+}
+
+// RegisterRetryHandlers регистрирует обработчики повторов матчей и протоколов
+func (o *Orchestrator) RegisterRetryHandlers(w *retry.Worker) {
+	w.Handle(retrySource, retry.JobTypeMatch, o.retryMatch)
+	w.Handle(retrySource, retry.JobTypeProtocol, o.retryProtocol)
+}
+
+// enqueueRetry ставит матч в очередь повторов, если повторы включены
+func (o *Orchestrator) enqueueRetry(ctx context.Context, jobType retry.JobType, payload matchRetryPayload, err error) {
+	if !o.config.RetryEnabled() {
+		return
+	}
+	job := retry.Job{
+		Type:       jobType,
+		Source:     retrySource,
+		ExternalID: strconv.Itoa(payload.Game.ID),
+		URL:        fmt.Sprintf("/api/game/%d", payload.Game.ID),
+		Payload:    payload,
+	}
+	if retryErr := o.retryManager.AddFailedJob(ctx, job, err); retryErr != nil {
+		logger.Error(ctx, "Failed to add retry job", zap.Error(retryErr))
+	}
+}
+
+func (o *Orchestrator) retryMatch(ctx context.Context, job *retry.FailedJob) error {
+	var payload matchRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+	return o.saveMatch(ctx, payload.Tournament, payload.Game)
+}
+
+func (o *Orchestrator) retryProtocol(ctx context.Context, job *retry.FailedJob) error {
+	var payload matchRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+	_, err := o.processProtocol(ctx, payload.Tournament, payload.Game)
+	return err
+}
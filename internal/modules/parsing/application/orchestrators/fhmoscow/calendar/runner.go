@@ -0,0 +1,116 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/parsing"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Run запускает парсинг календаря FHMoscow
+func (o *Orchestrator) Run(ctx context.Context) error {
+	start := time.Now()
+
+	logger.Info(ctx, "========================================")
+	logger.Info(ctx, "FHMoscow Calendar Parser starting",
+		zap.Int("min_birth_year", o.config.MinBirthYear()),
+		zap.Bool("parse_protocol", o.config.ParseProtocol()),
+		zap.Bool("parse_standings", o.config.ParseStandings()),
+	)
+	logger.Info(ctx, "========================================")
+
+	// 1. Определяем сезон
+	season, err := o.fetchSeason(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch season: %w", err)
+	}
+
+	// 2. Турниры сезона сохранены парсером FHMoscow
+	tournaments, err := o.tournamentRepo.ListBySeason(ctx, season.Name, o.config.MinBirthYear())
+	if err != nil {
+		return fmt.Errorf("list tournaments: %w", err)
+	}
+	logger.Info(ctx, "Tournaments to process",
+		zap.String("season", season.Name),
+		zap.Int("count", len(tournaments)),
+	)
+
+	// 3. Календарь, протоколы и таблица каждого турнира
+	var total tournamentStats
+	for _, t := range tournaments {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if o.isDone(t.ID) {
+			continue
+		}
+
+		ref, err := newTournamentRef(t)
+		if err != nil {
+			logger.Warn(ctx, "Skipping tournament", zap.String("id", t.ID), zap.Error(err))
+			continue
+		}
+
+		stats, err := o.processTournament(ctx, season, ref)
+		if err != nil {
+			logger.Error(ctx, "Tournament processing failed",
+				zap.String("tournament_id", ref.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+		total.add(stats)
+		o.markDone(ctx, t.ID)
+	}
+
+	elapsed := time.Since(start)
+	logger.Info(ctx, "========================================")
+	logger.Info(ctx, "FHMoscow Calendar Parser completed",
+		zap.Duration("elapsed", elapsed),
+		zap.Int("tournaments_processed", len(tournaments)),
+		zap.Int("matches_saved", total.matches),
+		zap.Int("protocols_saved", total.protocols),
+		zap.Int("events_saved", total.events),
+		zap.Int("standings_saved", total.standings),
+	)
+	logger.Info(ctx, "========================================")
+
+	return nil
+}
+
+// fetchSeason возвращает тестовый сезон из конфигурации или текущий сезон
+func (o *Orchestrator) fetchSeason(ctx context.Context) (dto.SeasonDTO, error) {
+	data, err := o.client.GetAPI("/api/filter/season")
+	if err != nil {
+		return dto.SeasonDTO{}, fmt.Errorf("get seasons: %w", err)
+	}
+
+	seasons, err := parsing.ParseSeasons(data)
+	if err != nil {
+		return dto.SeasonDTO{}, fmt.Errorf("parse seasons: %w", err)
+	}
+	if len(seasons) == 0 {
+		return dto.SeasonDTO{}, fmt.Errorf("no seasons returned")
+	}
+
+	if testSeason := o.config.TestSeason(); testSeason != "" {
+		for _, s := range seasons {
+			if s.Name == testSeason {
+				logger.Info(ctx, "Test mode: using season", zap.String("season", testSeason))
+				return s, nil
+			}
+		}
+		logger.Warn(ctx, "Test season not found, using current", zap.String("season", testSeason))
+	}
+
+	for _, s := range seasons {
+		if s.Current {
+			return s, nil
+		}
+	}
+	return seasons[len(seasons)-1], nil
+}
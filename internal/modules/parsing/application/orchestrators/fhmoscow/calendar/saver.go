@@ -0,0 +1,134 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	fhmoscowrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhmoscow"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// saveMatch сохраняет матч календаря в базу данных
+func (o *Orchestrator) saveMatch(ctx context.Context, ref tournamentRef, game dto.GameDTO) error {
+	homeTeamID, err := o.ensureTeam(ctx, ref, game.Home.ID, game.Home.Name)
+	if err != nil {
+		return fmt.Errorf("ensure home team: %w", err)
+	}
+	awayTeamID, err := o.ensureTeam(ctx, ref, game.Away.ID, game.Away.Name)
+	if err != nil {
+		return fmt.Errorf("ensure away team: %w", err)
+	}
+
+	domain := fhmoscow.BaseURL
+	entity := &entities.Match{
+		ID:           matchID(game.ID),
+		ExternalID:   strconv.Itoa(game.ID),
+		TournamentID: &ref.ID,
+		HomeTeamID:   &homeTeamID,
+		AwayTeamID:   &awayTeamID,
+		MatchNumber:  intPtr(game.Number),
+		ScheduledAt:  timePtr(game.ScheduledAt()),
+		Status:       matchStatus(game),
+		Venue:        strPtr(game.Stadium),
+		GroupName:    strPtr(ref.GroupName),
+		BirthYear:    intPtr(ref.BirthYear),
+		Source:       Source,
+		Domain:       &domain,
+	}
+
+	if game.IsFinished() {
+		entity.HomeScore = game.Home.Score
+		entity.AwayScore = game.Away.Score
+		entity.ResultType = resultType(game)
+	}
+
+	return o.matchRepo.Upsert(ctx, entity)
+}
+
+// ensureTeam возвращает ID команды, создавая её при первой встрече
+func (o *Orchestrator) ensureTeam(ctx context.Context, ref tournamentRef, externalID int, name string) (string, error) {
+	if externalID == 0 {
+		return "", fmt.Errorf("team %q has no id", name)
+	}
+
+	city := fhmoscowrepo.RegionMoscow
+	url := fmt.Sprintf("/team/%d", externalID)
+	return o.teamRepo.EnsureExists(ctx, &fhmoscowrepo.Team{
+		ExternalID:   strconv.Itoa(externalID),
+		TournamentID: ref.ID,
+		Name:         name,
+		URL:          &url,
+		City:         &city,
+	})
+}
+
+// ensurePlayers создаёт игроков из протокола, которых ещё нет в базе
+// Дата рождения до разбора профиля берётся из года рождения турнира
+func (o *Orchestrator) ensurePlayers(ctx context.Context, ref tournamentRef, proto *dto.ProtocolDTO) {
+	for _, p := range protocolPlayers(proto) {
+		player := &fhmoscowrepo.Player{
+			ExternalID: strconv.Itoa(p.ID),
+			FullName:   p.Name,
+		}
+
+		profileURL := fmt.Sprintf("/player/%d", p.ID)
+		player.ProfileURL = &profileURL
+
+		if ref.BirthYear > 0 {
+			fallbackDate := time.Date(ref.BirthYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+			player.BirthDate = &fallbackDate
+		}
+		if p.Position != "" {
+			player.Position = &p.Position
+		}
+
+		if _, err := o.playerRepo.EnsureExists(ctx, player); err != nil {
+			logger.Warn(ctx, "Failed to create player from protocol",
+				zap.Int("player_id", p.ID),
+				zap.String("name", p.Name),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// protocolPlayers собирает уникальных игроков из составов и событий протокола
+func protocolPlayers(proto *dto.ProtocolDTO) []dto.LineupPlayerDTO {
+	seen := make(map[int]bool)
+	var players []dto.LineupPlayerDTO
+
+	add := func(p dto.LineupPlayerDTO) {
+		if p.ID == 0 || seen[p.ID] {
+			return
+		}
+		seen[p.ID] = true
+		players = append(players, p)
+	}
+	addPerson := func(p *dto.ProtocolPersonDTO) {
+		if p != nil {
+			add(dto.LineupPlayerDTO{ID: p.ID, Name: p.Name, Number: p.Number})
+		}
+	}
+
+	for _, p := range proto.Home.Lineup {
+		add(p)
+	}
+	for _, p := range proto.Away.Lineup {
+		add(p)
+	}
+	for _, g := range proto.Goals {
+		addPerson(g.Scorer)
+		addPerson(g.Assist1)
+		addPerson(g.Assist2)
+	}
+	for _, p := range proto.Penalties {
+		addPerson(p.Player)
+	}
+	return players
+}
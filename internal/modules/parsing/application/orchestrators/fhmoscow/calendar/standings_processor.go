@@ -0,0 +1,76 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/parsing"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// processStandings сохраняет турнирную таблицу турнира (группы)
+func (o *Orchestrator) processStandings(ctx context.Context, seasonID int, ref tournamentRef) (int, error) {
+	data, err := o.client.PostAPI("/api/standings", ref.filter(seasonID))
+	if err != nil {
+		return 0, fmt.Errorf("get standings: %w", err)
+	}
+
+	rows, err := parsing.ParseStandings(data)
+	if err != nil {
+		return 0, fmt.Errorf("parse standings: %w", err)
+	}
+
+	var saved int
+	for _, row := range rows {
+		teamDBID, err := o.ensureTeam(ctx, ref, row.Team.ID, row.Team.Name)
+		if err != nil {
+			logger.Warn(ctx, "Team not found for standing",
+				zap.String("team_name", row.Team.Name),
+				zap.Error(err))
+			continue
+		}
+
+		if err := o.standingRepo.Upsert(ctx, convertStanding(ref, teamDBID, row)); err != nil {
+			logger.Error(ctx, "Failed to save standing",
+				zap.String("team_name", row.Team.Name),
+				zap.Error(err))
+			continue
+		}
+		saved++
+	}
+
+	logger.Debug(ctx, "Standings saved",
+		zap.String("tournament_id", ref.ID),
+		zap.Int("count", saved))
+
+	return saved, nil
+}
+
+func convertStanding(ref tournamentRef, teamDBID string, row dto.StandingDTO) *entities.TeamStanding {
+	standing := &entities.TeamStanding{
+		ID:           uuid.New().String(),
+		TournamentID: ref.ID,
+		TeamID:       teamDBID,
+		Position:     intPtr(row.Position),
+		Points:       row.Points,
+		Games:        row.Games,
+		Wins:         row.Wins,
+		WinsOT:       row.WinsOT,
+		WinsSO:       row.WinsSO,
+		LossesSO:     row.LossesSO,
+		LossesOT:     row.LossesOT,
+		Losses:       row.Losses,
+		Draws:        row.Draws,
+		GoalsFor:     row.GoalsFor,
+		GoalsAgainst: row.GoalsAgainst,
+		GroupName:    strPtr(ref.GroupName),
+		BirthYear:    intPtr(ref.BirthYear),
+		Source:       Source,
+	}
+	standing.CalculateGoalDifference()
+	return standing
+}
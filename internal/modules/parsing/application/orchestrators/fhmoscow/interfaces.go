@@ -1,6 +1,7 @@
 package fhmoscow
 
 import (
+	"context"
 	"time"
 
 	fhmoscowrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhmoscow"
@@ -20,9 +21,20 @@ type Config interface {
 	RetryDelay() time.Duration
 	MaxSeasons() int
 	TestSeason() string
-	// Player scanning options (since team roster pages are JavaScript-rendered)
+	// Обход профилей игроков по фронтиру (страницы составов рендерятся JavaScript)
 	ScanPlayers() bool
 	MaxPlayerID() int
+	ProbeMissLimit() int
+	// Через сколько сохранённый профиль запрашивается повторно (0 - не обновлять)
+	PlayerRefreshAfter() time.Duration
+}
+
+// TournamentProgress контрольная точка обработки турниров.
+//
+// Позволяет прерванному запуску продолжить с необработанных турниров.
+type TournamentProgress interface {
+	IsDone(tournamentID string) bool
+	MarkDone(ctx context.Context, tournamentID string)
 }
 
 // Dependencies зависимости сервиса
@@ -35,4 +47,5 @@ type Dependencies struct {
 	PlayerTeamRepo       *fhmoscowrepo.PlayerTeamRepository
 	PlayerStatisticsRepo *fhmoscowrepo.PlayerStatisticsRepository
 	GoalieStatisticsRepo *fhmoscowrepo.GoalieStatisticsRepository
+	PlayerFrontierRepo   *fhmoscowrepo.PlayerFrontierRepository
 }
@@ -1,6 +1,8 @@
 package fhmoscow
 
 import (
+	"context"
+
 	fhmoscowrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhmoscow"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
@@ -15,8 +17,12 @@ type Orchestrator struct {
 	playerTeamRepo       *fhmoscowrepo.PlayerTeamRepository
 	playerStatisticsRepo *fhmoscowrepo.PlayerStatisticsRepository
 	goalieStatisticsRepo *fhmoscowrepo.GoalieStatisticsRepository
+	playerFrontierRepo   *fhmoscowrepo.PlayerFrontierRepository
 	retryManager         *retry.Manager
 	config               Config
+
+	// Контрольная точка (опционально)
+	progress TournamentProgress
 }
 
 // New создает новый оркестратор FHMoscow
@@ -35,7 +41,26 @@ func New(deps Dependencies, config Config) *Orchestrator {
 		playerTeamRepo:       deps.PlayerTeamRepo,
 		playerStatisticsRepo: deps.PlayerStatisticsRepo,
 		goalieStatisticsRepo: deps.GoalieStatisticsRepo,
+		playerFrontierRepo:   deps.PlayerFrontierRepo,
 		retryManager:         retryManager,
 		config:               config,
 	}
 }
+
+// WithProgress включает контрольные точки по турнирам
+func (o *Orchestrator) WithProgress(progress TournamentProgress) *Orchestrator {
+	o.progress = progress
+	return o
+}
+
+// isDone возвращает true, если турнир обработан прерванным запуском
+func (o *Orchestrator) isDone(tournamentID string) bool {
+	return o.progress != nil && o.progress.IsDone(tournamentID)
+}
+
+// markDone отмечает турнир обработанным; турнир, прерванный отменой, будет обработан заново
+func (o *Orchestrator) markDone(ctx context.Context, tournamentID string) {
+	if o.progress != nil && ctx.Err() == nil {
+		o.progress.MarkDone(ctx, tournamentID)
+	}
+}
@@ -0,0 +1,184 @@
+package fhmoscow
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	fhmoscowrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhmoscow"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// frontierBatchSize сколько ID фронтира обрабатывается за одну выборку
+const frontierBatchSize = 1000
+
+type crawlStats struct {
+	discovered int64
+	fetched    int64
+	skipped    int64
+	missing    int64
+	errors     int64
+	probed     int64
+}
+
+// crawlPlayers обходит профили игроков по фронтиру: сначала найденные в составах
+// и протоколах ID, затем проверяет новые ID выше наибольшего известного
+func (o *Orchestrator) crawlPlayers(ctx context.Context) crawlStats {
+	var stats crawlStats
+
+	logger.Info(ctx, "[STEP 4] Crawling player profiles...",
+		zap.Int("min_birth_year", o.config.MinBirthYear()),
+		zap.Int("probe_miss_limit", o.config.ProbeMissLimit()),
+		zap.Int("workers", o.config.PlayerWorkers()),
+	)
+
+	stats.discovered = o.discoverPlayers(ctx)
+	o.crawlPending(ctx, &stats)
+	o.probeNewPlayers(ctx, &stats)
+
+	logger.Info(ctx, "[STEP 4] Player crawl completed",
+		zap.Int64("discovered", stats.discovered),
+		zap.Int64("fetched", stats.fetched),
+		zap.Int64("skipped", stats.skipped),
+		zap.Int64("missing", stats.missing),
+		zap.Int64("errors", stats.errors),
+		zap.Int64("probed", stats.probed),
+	)
+
+	return stats
+}
+
+// discoverPlayers пополняет фронтир игроками из составов матчей и уже сохранёнными игроками
+func (o *Orchestrator) discoverPlayers(ctx context.Context) int64 {
+	fromLineups, err := o.playerFrontierRepo.DiscoverFromLineups(ctx)
+	if err != nil {
+		logger.Warn(ctx, "Failed to discover players from lineups", zap.Error(err))
+	}
+
+	known, err := o.playerFrontierRepo.SeedKnown(ctx)
+	if err != nil {
+		logger.Warn(ctx, "Failed to seed known players", zap.Error(err))
+	}
+
+	var stale int64
+	if refreshAfter := o.config.PlayerRefreshAfter(); refreshAfter > 0 {
+		stale, err = o.playerFrontierRepo.RequeueStale(ctx, refreshAfter)
+		if err != nil {
+			logger.Warn(ctx, "Failed to requeue stale players", zap.Error(err))
+		}
+	}
+
+	logger.Info(ctx, "Player frontier updated",
+		zap.Int64("from_lineups", fromLineups),
+		zap.Int64("known", known),
+		zap.Int64("stale", stale),
+	)
+
+	return fromLineups + known
+}
+
+// crawlPending запрашивает профили всех ожидающих ID фронтира,
+// включая устаревшие профили, возвращённые в очередь discoverPlayers
+func (o *Orchestrator) crawlPending(ctx context.Context, stats *crawlStats) {
+	afterID := 0
+	for ctx.Err() == nil {
+		ids, err := o.playerFrontierRepo.Pending(ctx, afterID, frontierBatchSize, o.config.RetryMaxAttempts())
+		if err != nil {
+			logger.Error(ctx, "Failed to load player frontier", zap.Error(err))
+			return
+		}
+		if len(ids) == 0 {
+			return
+		}
+
+		idCh := make(chan int, len(ids))
+		for _, id := range ids {
+			idCh <- id
+		}
+		close(idCh)
+
+		var wg sync.WaitGroup
+		for i := 0; i < o.config.PlayerWorkers(); i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for id := range idCh {
+					if ctx.Err() != nil {
+						return
+					}
+					o.crawlFrontierPlayer(ctx, id, stats)
+				}
+			}()
+		}
+		wg.Wait()
+
+		afterID = ids[len(ids)-1]
+		logger.Info(ctx, "Crawl progress",
+			zap.Int("last_id", afterID),
+			zap.Int64("fetched", atomic.LoadInt64(&stats.fetched)),
+			zap.Int64("missing", atomic.LoadInt64(&stats.missing)),
+		)
+	}
+}
+
+// crawlFrontierPlayer запрашивает профиль и сохраняет результат во фронтир
+func (o *Orchestrator) crawlFrontierPlayer(ctx context.Context, id int, stats *crawlStats) {
+	status, err := o.crawlPlayer(ctx, id)
+	if err != nil {
+		atomic.AddInt64(&stats.errors, 1)
+		logger.Debug(ctx, "Failed to crawl player", zap.Int("player_id", id), zap.Error(err))
+		if markErr := o.playerFrontierRepo.MarkFailed(ctx, id, err); markErr != nil {
+			logger.Warn(ctx, "Failed to mark player as failed", zap.Int("player_id", id), zap.Error(markErr))
+		}
+		return
+	}
+
+	// ID уже есть во фронтире, источник обнаружения не меняется
+	stats.count(status)
+	o.markFrontier(ctx, []int{id}, status, fhmoscowrepo.DiscoveredFromKnown)
+}
+
+// crawlPlayer запрашивает профиль игрока и возвращает его статус во фронтире
+func (o *Orchestrator) crawlPlayer(ctx context.Context, id int) (string, error) {
+	profile, err := o.fetchPlayerProfileByID(ctx, id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return fhmoscowrepo.FrontierMissing, nil
+		}
+		return "", err
+	}
+
+	if profile.BirthDate != nil && profile.BirthDate.Year() < o.config.MinBirthYear() {
+		return fhmoscowrepo.FrontierSkipped, nil
+	}
+
+	if err := o.saveCrawledPlayer(ctx, profile); err != nil {
+		return "", err
+	}
+	return fhmoscowrepo.FrontierFetched, nil
+}
+
+func (o *Orchestrator) markFrontier(ctx context.Context, ids []int, status, from string) {
+	if len(ids) == 0 {
+		return
+	}
+	if err := o.playerFrontierRepo.Mark(ctx, ids, status, from); err != nil {
+		logger.Warn(ctx, "Failed to update player frontier",
+			zap.Int("count", len(ids)),
+			zap.String("status", status),
+			zap.Error(err),
+		)
+	}
+}
+
+func (s *crawlStats) count(status string) {
+	switch status {
+	case fhmoscowrepo.FrontierFetched:
+		atomic.AddInt64(&s.fetched, 1)
+	case fhmoscowrepo.FrontierSkipped:
+		atomic.AddInt64(&s.skipped, 1)
+	case fhmoscowrepo.FrontierMissing:
+		atomic.AddInt64(&s.missing, 1)
+	}
+}
@@ -0,0 +1,90 @@
+package fhmoscow
+
+import (
+	"context"
+	"sync/atomic"
+
+	fhmoscowrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhmoscow"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type probeOutcome int
+
+const (
+	probeHit   probeOutcome = iota // профиль существует
+	probeMiss                      // 404
+	probeError                     // ошибка запроса, ID вернётся в очередь
+)
+
+// probeNewPlayers проверяет ID выше наибольшего известного, пока не встретит
+// ProbeMissLimit отсутствующих профилей подряд
+func (o *Orchestrator) probeNewPlayers(ctx context.Context, stats *crawlStats) {
+	start, err := o.playerFrontierRepo.MaxKnownID(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to get max known player id", zap.Error(err))
+		return
+	}
+
+	probed, gaps := probeGaps(ctx, start, o.config.MaxPlayerID(), o.config.ProbeMissLimit(), func(id int) probeOutcome {
+		status, err := o.crawlPlayer(ctx, id)
+		if err != nil {
+			atomic.AddInt64(&stats.errors, 1)
+			if _, discoverErr := o.playerFrontierRepo.Discover(ctx, []int{id}, fhmoscowrepo.DiscoveredFromProbe); discoverErr == nil {
+				_ = o.playerFrontierRepo.MarkFailed(ctx, id, err)
+			}
+			return probeError
+		}
+
+		stats.count(status)
+		if status == fhmoscowrepo.FrontierMissing {
+			return probeMiss
+		}
+		o.markFrontier(ctx, []int{id}, status, fhmoscowrepo.DiscoveredFromProbe)
+		return probeHit
+	})
+
+	// Пропуски между найденными профилями больше не запрашиваем,
+	// хвост после последнего найденного ID проверим в следующий раз
+	o.markFrontier(ctx, gaps, fhmoscowrepo.FrontierMissing, fhmoscowrepo.DiscoveredFromProbe)
+	atomic.StoreInt64(&stats.probed, int64(probed))
+
+	logger.Info(ctx, "Player probe completed",
+		zap.Int("from_id", start+1),
+		zap.Int("probed", probed),
+		zap.Int("gaps", len(gaps)),
+	)
+}
+
+// probeGaps последовательно проверяет ID после start и возвращает число проверенных ID
+// и отсутствующие ID, после которых нашёлся существующий профиль.
+// maxID <= 0 снимает верхнюю границу.
+func probeGaps(ctx context.Context, start, maxID, missLimit int, probe func(id int) probeOutcome) (int, []int) {
+	var (
+		probed int
+		misses int
+		gaps   []int
+		buffer []int
+	)
+
+	for id := start + 1; maxID <= 0 || id <= maxID; id++ {
+		if ctx.Err() != nil || misses >= missLimit {
+			break
+		}
+
+		probed++
+		switch probe(id) {
+		case probeHit:
+			gaps = append(gaps, buffer...)
+			buffer = buffer[:0]
+			misses = 0
+		case probeMiss:
+			buffer = append(buffer, id)
+			misses++
+		case probeError:
+			misses++
+		}
+	}
+
+	return probed, gaps
+}
@@ -0,0 +1,43 @@
+package fhmoscow
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestProbeGaps(t *testing.T) {
+	existing := map[int]bool{101: true, 104: true, 105: true}
+	failing := map[int]bool{103: true}
+
+	probe := func(id int) probeOutcome {
+		switch {
+		case existing[id]:
+			return probeHit
+		case failing[id]:
+			return probeError
+		default:
+			return probeMiss
+		}
+	}
+
+	probed, gaps := probeGaps(context.Background(), 100, 0, 3, probe)
+	if probed != 8 {
+		t.Fatalf("probed %d ids, want 8", probed)
+	}
+	// 102 лежит между найденными профилями, 103 вернётся в очередь, хвост 106-108 не помечается
+	if want := []int{102}; !reflect.DeepEqual(gaps, want) {
+		t.Fatalf("gaps = %v, want %v", gaps, want)
+	}
+}
+
+func TestProbeGapsStopsAtMaxID(t *testing.T) {
+	calls := 0
+	probed, gaps := probeGaps(context.Background(), 10, 12, 100, func(int) probeOutcome {
+		calls++
+		return probeMiss
+	})
+	if probed != 2 || calls != 2 || len(gaps) != 0 {
+		t.Fatalf("probed=%d calls=%d gaps=%v", probed, calls, gaps)
+	}
+}
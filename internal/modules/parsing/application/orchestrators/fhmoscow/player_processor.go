@@ -3,10 +3,12 @@ package fhmoscow
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	fhmoscowrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhmoscow"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/parsing"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -19,6 +21,12 @@ func (o *Orchestrator) processPlayerSafe(ctx context.Context, tournamentID, team
 			zap.String("name", member.Name),
 			zap.Error(err),
 		)
+		o.enqueueRetry(ctx, retry.Job{
+			Type:       retry.JobTypePlayer,
+			ExternalID: member.PlayerID + "@" + teamID,
+			URL:        fmt.Sprintf("/player/%s", member.PlayerID),
+			Payload:    playerRetryPayload{TournamentID: tournamentID, TeamID: teamID, Member: member},
+		}, err)
 		return false
 	}
 	return true
@@ -38,6 +46,9 @@ func (o *Orchestrator) processPlayer(ctx context.Context, tournamentID, teamID s
 			FullName: member.Name,
 			Position: member.Position,
 		}
+	} else if id, convErr := strconv.Atoi(member.PlayerID); convErr == nil {
+		// Профиль получен, повторно при обходе фронтира не запрашиваем
+		o.markFrontier(ctx, []int{id}, fhmoscowrepo.FrontierFetched, fhmoscowrepo.DiscoveredFromRoster)
 	}
 
 	// Сохраняем игрока
@@ -3,8 +3,6 @@ package fhmoscow
 import (
 	"context"
 	"fmt"
-	"sync"
-	"sync/atomic"
 
 	fhmoscowrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhmoscow"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
@@ -13,120 +11,7 @@ import (
 	"go.uber.org/zap"
 )
 
-type scanStats struct {
-	scanned  int64
-	saved    int64
-	skipped  int64
-	notFound int64
-	errors   int64
-}
-
-// scanPlayers сканирует игроков по ID и сохраняет их профили
-func (o *Orchestrator) scanPlayers(ctx context.Context) scanStats {
-	var stats scanStats
-	maxID := o.config.MaxPlayerID()
-
-	logger.Info(ctx, "[STEP 4] Scanning players by ID...",
-		zap.Int("max_player_id", maxID),
-		zap.Int("min_birth_year", o.config.MinBirthYear()),
-		zap.Int("workers", o.config.PlayerWorkers()),
-	)
-
-	// Канал для ID игроков
-	idCh := make(chan int, 1000)
-	go func() {
-		for id := 1; id <= maxID; id++ {
-			select {
-			case <-ctx.Done():
-				close(idCh)
-				return
-			case idCh <- id:
-			}
-		}
-		close(idCh)
-	}()
-
-	var wg sync.WaitGroup
-	for i := 0; i < o.config.PlayerWorkers(); i++ {
-		wg.Add(1)
-		go func(workerID int) {
-			defer wg.Done()
-			o.scanPlayerWorker(ctx, workerID, idCh, &stats)
-		}(i + 1)
-	}
-
-	wg.Wait()
-
-	logger.Info(ctx, "[STEP 4] Player scan completed",
-		zap.Int64("scanned", stats.scanned),
-		zap.Int64("saved", stats.saved),
-		zap.Int64("skipped", stats.skipped),
-		zap.Int64("not_found", stats.notFound),
-		zap.Int64("errors", stats.errors),
-	)
-
-	return stats
-}
-
-func (o *Orchestrator) scanPlayerWorker(ctx context.Context, workerID int, idCh <-chan int, stats *scanStats) {
-	for playerID := range idCh {
-		select {
-		case <-ctx.Done():
-			return
-		default:
-		}
-
-		atomic.AddInt64(&stats.scanned, 1)
-
-		profile, err := o.fetchPlayerProfileByID(ctx, playerID)
-		if err != nil {
-			// 404 = игрок не существует
-			if isNotFoundError(err) {
-				atomic.AddInt64(&stats.notFound, 1)
-				continue
-			}
-			atomic.AddInt64(&stats.errors, 1)
-			logger.Debug(ctx, "Failed to fetch player profile",
-				zap.Int("player_id", playerID),
-				zap.Error(err),
-			)
-			continue
-		}
-
-		// Фильтруем по году рождения
-		if profile.BirthDate != nil {
-			birthYear := profile.BirthDate.Year()
-			if birthYear < o.config.MinBirthYear() {
-				atomic.AddInt64(&stats.skipped, 1)
-				continue
-			}
-		}
-
-		// Сохраняем игрока
-		if err := o.saveScannedPlayer(ctx, profile); err != nil {
-			atomic.AddInt64(&stats.errors, 1)
-			logger.Warn(ctx, "Failed to save player",
-				zap.Int("player_id", playerID),
-				zap.String("name", profile.FullName),
-				zap.Error(err),
-			)
-			continue
-		}
-
-		atomic.AddInt64(&stats.saved, 1)
-
-		// Логируем прогресс каждые 100 игроков
-		scanned := atomic.LoadInt64(&stats.scanned)
-		if scanned%500 == 0 {
-			logger.Info(ctx, "Scan progress",
-				zap.Int64("scanned", scanned),
-				zap.Int64("saved", atomic.LoadInt64(&stats.saved)),
-				zap.Int("worker_id", workerID),
-			)
-		}
-	}
-}
-
+// fetchPlayerProfileByID загружает и разбирает профиль игрока по числовому ID
 func (o *Orchestrator) fetchPlayerProfileByID(ctx context.Context, playerID int) (*dto.PlayerProfileDTO, error) {
 	path := fmt.Sprintf("/player/%d", playerID)
 	html, err := o.client.GetHTML(path)
@@ -142,7 +27,8 @@ func (o *Orchestrator) fetchPlayerProfileByID(ctx context.Context, playerID int)
 	return profile, nil
 }
 
-func (o *Orchestrator) saveScannedPlayer(ctx context.Context, profile *dto.PlayerProfileDTO) error {
+// saveCrawledPlayer сохраняет игрока и статистику по всем записям профиля
+func (o *Orchestrator) saveCrawledPlayer(ctx context.Context, profile *dto.PlayerProfileDTO) error {
 	profileURL := fmt.Sprintf("/player/%s", profile.ID)
 
 	player := &fhmoscowrepo.Player{
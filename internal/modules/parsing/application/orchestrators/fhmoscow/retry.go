@@ -0,0 +1,76 @@
+package fhmoscow
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Source имя источника в очереди повторов
+const Source = "fhmoscow"
+
+// tournamentRetryPayload данные для повтора турнира
+type tournamentRetryPayload struct {
+	Season     dto.SeasonDTO     `json:"season"`
+	Tournament dto.TournamentDTO `json:"tournament"`
+}
+
+// teamRetryPayload данные для повтора команды
+type teamRetryPayload struct {
+	TournamentID string      `json:"tournament_id"`
+	Team         dto.TeamDTO `json:"team"`
+}
+
+// playerRetryPayload данные для повтора игрока
+type playerRetryPayload struct {
+	TournamentID string            `json:"tournament_id"`
+	TeamID       string            `json:"team_id"`
+	Member       dto.TeamMemberDTO `json:"member"`
+}
+
+// RegisterRetryHandlers регистрирует обработчики повторов турниров, команд и игроков
+func (o *Orchestrator) RegisterRetryHandlers(w *retry.Worker) {
+	w.Handle(Source, retry.JobTypeTournament, o.retryTournament)
+	w.Handle(Source, retry.JobTypeTeam, o.retryTeam)
+	w.Handle(Source, retry.JobTypePlayer, o.retryPlayer)
+}
+
+// enqueueRetry ставит сущность в очередь повторов, если повторы включены
+func (o *Orchestrator) enqueueRetry(ctx context.Context, job retry.Job, err error) {
+	if !o.config.RetryEnabled() {
+		return
+	}
+	job.Source = Source
+	if retryErr := o.retryManager.AddFailedJob(ctx, job, err); retryErr != nil {
+		logger.Error(ctx, "Failed to add retry job", zap.Error(retryErr))
+	}
+}
+
+func (o *Orchestrator) retryTournament(ctx context.Context, job *retry.FailedJob) error {
+	var payload tournamentRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+	_, err := o.processTournament(ctx, payload.Season, payload.Tournament)
+	return err
+}
+
+func (o *Orchestrator) retryTeam(ctx context.Context, job *retry.FailedJob) error {
+	var payload teamRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+	_, err := o.processTeam(ctx, payload.TournamentID, payload.Team)
+	return err
+}
+
+func (o *Orchestrator) retryPlayer(ctx context.Context, job *retry.FailedJob) error {
+	var payload playerRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+	return o.processPlayer(ctx, payload.TournamentID, payload.TeamID, payload.Member)
+}
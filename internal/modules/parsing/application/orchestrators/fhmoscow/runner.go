@@ -3,6 +3,7 @@ package fhmoscow
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -61,13 +62,13 @@ func (o *Orchestrator) Run(ctx context.Context) error {
 	logger.Info(ctx, "[STEP 3] Processing tournaments...")
 	stats := o.processAllTournaments(ctx, seasons, tournaments)
 
-	// 4. Сканируем игроков по ID (если включено)
-	var scanStats scanStats
+	// 4. Обходим профили игроков по фронтиру (если включено)
+	var crawl crawlStats
 	if o.config.ScanPlayers() {
-		scanStats = o.scanPlayers(ctx)
-		stats.players += int(scanStats.saved)
+		crawl = o.crawlPlayers(ctx)
+		stats.players += int(crawl.fetched)
 	} else {
-		logger.Info(ctx, "[STEP 4] Player scanning disabled, skipping...")
+		logger.Info(ctx, "[STEP 4] Player crawl disabled, skipping...")
 	}
 
 	elapsed := time.Since(start)
@@ -78,7 +79,8 @@ func (o *Orchestrator) Run(ctx context.Context) error {
 		zap.Int("groups_processed", stats.groups),
 		zap.Int("teams_processed", stats.teams),
 		zap.Int("players_saved", stats.players),
-		zap.Int64("players_scanned", scanStats.scanned),
+		zap.Int64("players_crawled", crawl.fetched+crawl.skipped+crawl.missing),
+		zap.Int64("players_probed", crawl.probed),
 	)
 	logger.Info(ctx, "========================================")
 
@@ -167,6 +169,9 @@ func (o *Orchestrator) processAllTournaments(ctx context.Context, seasons []dto.
 
 	tournamentCh := make(chan dto.TournamentDTO, len(tournaments))
 	for _, t := range tournaments {
+		if o.isDone(strconv.Itoa(t.ID)) {
+			continue
+		}
 		tournamentCh <- t
 	}
 	close(tournamentCh)
@@ -9,6 +9,7 @@ import (
 	fhmoscowrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhmoscow"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/parsing"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -21,6 +22,12 @@ func (o *Orchestrator) processTeamSafe(ctx context.Context, tournamentID string,
 			zap.String("name", team.Name),
 			zap.Error(err),
 		)
+		o.enqueueRetry(ctx, retry.Job{
+			Type:       retry.JobTypeTeam,
+			ExternalID: strconv.Itoa(team.ID) + "@" + tournamentID,
+			URL:        fmt.Sprintf("/team/%d", team.ID),
+			Payload:    teamRetryPayload{TournamentID: tournamentID, Team: team},
+		}, err)
 		return 0
 	}
 	return count
@@ -63,6 +70,8 @@ func (o *Orchestrator) processTeam(ctx context.Context, tournamentID string, tea
 		return 0, nil
 	}
 
+	o.discoverRoster(ctx, members)
+
 	// Обрабатываем игроков
 	playersCount := o.processPlayers(ctx, tournamentID, teamDBID, members)
 
@@ -132,3 +141,19 @@ func (o *Orchestrator) processPlayers(ctx context.Context, tournamentID, teamID
 	wg.Wait()
 	return count
 }
+
+// discoverRoster добавляет игроков состава во фронтир обхода профилей
+func (o *Orchestrator) discoverRoster(ctx context.Context, members []dto.TeamMemberDTO) {
+	ids := make([]int, 0, len(members))
+	for _, m := range members {
+		if id, err := strconv.Atoi(m.PlayerID); err == nil && id > 0 {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+	if _, err := o.playerFrontierRepo.Discover(ctx, ids, fhmoscowrepo.DiscoveredFromRoster); err != nil {
+		logger.Warn(ctx, "Failed to add roster to player frontier", zap.Error(err))
+	}
+}
@@ -9,6 +9,7 @@ import (
 	fhmoscowrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhmoscow"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/parsing"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -27,8 +28,14 @@ func (o *Orchestrator) processTournamentSafe(ctx context.Context, season dto.Sea
 			zap.String("name", tournament.Name),
 			zap.Error(err),
 		)
+		o.enqueueRetry(ctx, retry.Job{
+			Type:       retry.JobTypeTournament,
+			ExternalID: strconv.Itoa(tournament.ID),
+			Payload:    tournamentRetryPayload{Season: season, Tournament: tournament},
+		}, err)
 		return tournamentStats{}
 	}
+	o.markDone(ctx, strconv.Itoa(tournament.ID))
 	return stats
 }
 
@@ -35,24 +35,11 @@ func (o *Orchestrator) processCalendar(ctx context.Context, tournamentID, extern
 
 	// Сохраняем матчи
 	for _, dto := range matchDTOs {
-		match := o.convertCalendarMatchToEntity(dto, tournamentID)
-
-		// Пропускаем существующие если нужно
-		if o.config.SkipExisting() {
-			existing, err := o.matchRepo.GetByExternalID(ctx, match.ExternalID, Source)
-			if err == nil && existing != nil {
-				continue
-			}
-		}
-
-		// Находим ID команд
-		match.HomeTeamID = o.findTeamID(ctx, dto.HomeTeamName, tournamentID)
-		match.AwayTeamID = o.findTeamID(ctx, dto.AwayTeamName, tournamentID)
-
-		if err := o.matchRepo.Upsert(ctx, match); err != nil {
+		if err := o.saveMatch(ctx, dto, tournamentID); err != nil {
 			logger.Error(ctx, "Failed to save match",
 				zap.String("external_id", dto.ExternalID),
 				zap.Error(err))
+			o.enqueueMatchRetry(ctx, matchRetryPayload{TournamentID: tournamentID, Match: dto}, err)
 			continue
 		}
 	}
@@ -60,6 +47,25 @@ func (o *Orchestrator) processCalendar(ctx context.Context, tournamentID, extern
 	return nil
 }
 
+// saveMatch сохраняет матч календаря с командами турнира
+func (o *Orchestrator) saveMatch(ctx context.Context, dto calendarDTO.MatchDTO, tournamentID string) error {
+	match := o.convertCalendarMatchToEntity(dto, tournamentID)
+
+	// Пропускаем существующие если нужно
+	if o.config.SkipExisting() {
+		existing, err := o.matchRepo.GetByExternalID(ctx, match.ExternalID, Source)
+		if err == nil && existing != nil {
+			return nil
+		}
+	}
+
+	// Находим ID команд
+	match.HomeTeamID = o.findTeamID(ctx, dto.HomeTeamName, tournamentID)
+	match.AwayTeamID = o.findTeamID(ctx, dto.AwayTeamName, tournamentID)
+
+	return o.matchRepo.Upsert(ctx, match)
+}
+
 func (o *Orchestrator) convertCalendarMatchToEntity(dto calendarDTO.MatchDTO, tournamentID string) *entities.Match {
 	match := &entities.Match{
 		ID:           fmt.Sprintf("%s:%s", matchIDPrefix, dto.ExternalID),
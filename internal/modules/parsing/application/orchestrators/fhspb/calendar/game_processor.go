@@ -7,6 +7,7 @@ import (
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
 	matchDTO "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhspb/match"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
@@ -44,6 +45,9 @@ func (o *Orchestrator) processGame(ctx context.Context, matchID, externalID, tou
 		if err := o.saveLineups(ctx, matchID, details); err != nil {
 			logger.Warn(ctx, "Failed to save lineups", zap.Error(err))
 		}
+		if err := o.saveGoalies(ctx, matchID, details); err != nil {
+			logger.Warn(ctx, "Failed to save goalies", zap.Error(err))
+		}
 	}
 
 	// Сохраняем статистику бросков
@@ -215,6 +219,56 @@ func (o *Orchestrator) convertLineupToEntity(dto matchDTO.PlayerLineupDTO, match
 	return lineup
 }
 
+// saveGoalies сохраняет игровую статистику вратарей (броски, пропущенные, время)
+func (o *Orchestrator) saveGoalies(ctx context.Context, matchID string, details *matchDTO.MatchDetailsDTO) error {
+	match, err := o.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+
+	save := func(goalies []matchDTO.GoalieStatsDTO, teamID *string) {
+		if teamID == nil {
+			return
+		}
+		for _, g := range goalies {
+			if !g.Played {
+				continue
+			}
+			playerID := o.findOrCreatePlayer(ctx, g.PlayerURL, g.PlayerName)
+			if playerID == nil {
+				continue
+			}
+
+			position := entities.LineupPositionGoalie
+			saves := g.ShotsAgainst - g.GoalsAgainst
+			if saves < 0 {
+				saves = 0
+			}
+			goalsAgainst, timeOnIce := g.GoalsAgainst, g.TimeOnIce
+			lineup := &entities.MatchLineup{
+				ID:             uuid.New().String(),
+				MatchID:        matchID,
+				PlayerID:       *playerID,
+				TeamID:         *teamID,
+				JerseyNumber:   &g.Number,
+				Position:       &position,
+				PenaltyMinutes: g.PenaltyMinutes,
+				Saves:          &saves,
+				GoalsAgainst:   &goalsAgainst,
+				TimeOnIce:      &timeOnIce,
+				Source:         Source,
+			}
+			if err := o.matchLineupRepo.Upsert(ctx, lineup); err != nil {
+				logger.Error(ctx, "Failed to save goalie", zap.Error(err))
+			}
+		}
+	}
+
+	save(details.HomeGoalies, match.HomeTeamID)
+	save(details.AwayGoalies, match.AwayTeamID)
+	return nil
+}
+
 func (o *Orchestrator) saveTeamStats(ctx context.Context, matchID string, details *matchDTO.MatchDetailsDTO) error {
 	match, err := o.matchRepo.GetByID(ctx, matchID)
 	if err != nil {
@@ -1,6 +1,8 @@
 package calendar
 
 import (
+	"context"
+
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhspb/calendar"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhspb/match"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhspb/standings"
@@ -15,6 +17,14 @@ type CalendarConfig interface {
 	SkipExisting() bool  // Пропускать существующие матчи
 }
 
+// TournamentProgress контрольная точка обработки турниров.
+//
+// Позволяет прерванному запуску продолжить с необработанных турниров.
+type TournamentProgress interface {
+	IsDone(tournamentID string) bool
+	MarkDone(ctx context.Context, tournamentID string)
+}
+
 // CalendarParser интерфейс парсера календаря
 type CalendarParser interface {
 	Parse(html []byte, tournamentID int) ([]calendar.MatchDTO, error)
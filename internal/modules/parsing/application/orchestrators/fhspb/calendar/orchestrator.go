@@ -1,9 +1,12 @@
 package calendar
 
 import (
+	"context"
+
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/repositories"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhspb"
 	fhspbClient "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhspb"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
 )
 
 // Source константа источника
@@ -31,8 +34,14 @@ type Orchestrator struct {
 	standingRepo       repositories.StandingRepository
 	matchTeamStatsRepo repositories.MatchTeamStatsRepository
 
+	// Очередь повторов матчей и протоколов, nil - повторы выключены
+	retryManager *retry.Manager
+
 	// Конфигурация
 	config CalendarConfig
+
+	// Контрольная точка (опционально)
+	progress TournamentProgress
 }
 
 // NewOrchestrator создает новый оркестратор
@@ -67,3 +76,27 @@ func NewOrchestrator(
 		config:             config,
 	}
 }
+
+// WithRetry включает постановку неудачных матчей и протоколов в очередь повторов
+func (o *Orchestrator) WithRetry(manager *retry.Manager) *Orchestrator {
+	o.retryManager = manager
+	return o
+}
+
+// WithProgress включает контрольные точки по турнирам
+func (o *Orchestrator) WithProgress(progress TournamentProgress) *Orchestrator {
+	o.progress = progress
+	return o
+}
+
+// isDone возвращает true, если турнир обработан прерванным запуском
+func (o *Orchestrator) isDone(tournamentID string) bool {
+	return o.progress != nil && o.progress.IsDone(tournamentID)
+}
+
+// markDone отмечает турнир обработанным; турнир, прерванный отменой, будет обработан заново
+func (o *Orchestrator) markDone(ctx context.Context, tournamentID string) {
+	if o.progress != nil && ctx.Err() == nil {
+		o.progress.MarkDone(ctx, tournamentID)
+	}
+}
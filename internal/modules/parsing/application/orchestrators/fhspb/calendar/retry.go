@@ -0,0 +1,78 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+
+	calendarDTO "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhspb/calendar"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// matchRetryPayload данные для повтора сохранения матча календаря
+type matchRetryPayload struct {
+	TournamentID string               `json:"tournament_id"`
+	Match        calendarDTO.MatchDTO `json:"match"`
+}
+
+// protocolRetryPayload данные для повтора протокола матча
+type protocolRetryPayload struct {
+	MatchID              string `json:"match_id"`
+	ExternalID           string `json:"external_id"`
+	TournamentExternalID string `json:"tournament_external_id"`
+}
+
+// RegisterRetryHandlers регистрирует обработчики повторов матчей и протоколов
+func (o *Orchestrator) RegisterRetryHandlers(w *retry.Worker) {
+	w.Handle(Source, retry.JobTypeMatch, o.retryMatch)
+	w.Handle(Source, retry.JobTypeProtocol, o.retryProtocol)
+}
+
+// enqueueMatchRetry ставит матч календаря в очередь повторов
+func (o *Orchestrator) enqueueMatchRetry(ctx context.Context, payload matchRetryPayload, err error) {
+	o.enqueueRetry(ctx, retry.Job{
+		Type:       retry.JobTypeMatch,
+		Source:     Source,
+		ExternalID: payload.Match.ExternalID,
+		URL:        payload.Match.MatchDetailsURL(extractExternalID(payload.TournamentID)),
+		Payload:    payload,
+	}, err)
+}
+
+// enqueueProtocolRetry ставит протокол в очередь повторов
+func (o *Orchestrator) enqueueProtocolRetry(ctx context.Context, payload protocolRetryPayload, err error) {
+	o.enqueueRetry(ctx, retry.Job{
+		Type:       retry.JobTypeProtocol,
+		Source:     Source,
+		ExternalID: payload.ExternalID,
+		URL:        fmt.Sprintf("/Match?TournamentID=%s&MatchID=%s", payload.TournamentExternalID, payload.ExternalID),
+		Payload:    payload,
+	}, err)
+}
+
+// enqueueRetry ставит задачу в очередь повторов, если она подключена
+func (o *Orchestrator) enqueueRetry(ctx context.Context, job retry.Job, err error) {
+	if o.retryManager == nil {
+		return
+	}
+	if retryErr := o.retryManager.AddFailedJob(ctx, job, err); retryErr != nil {
+		logger.Error(ctx, "Failed to add retry job", zap.Error(retryErr))
+	}
+}
+
+func (o *Orchestrator) retryMatch(ctx context.Context, job *retry.FailedJob) error {
+	var payload matchRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+	return o.saveMatch(ctx, payload.Match, payload.TournamentID)
+}
+
+func (o *Orchestrator) retryProtocol(ctx context.Context, job *retry.FailedJob) error {
+	var payload protocolRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+	return o.processGame(ctx, payload.MatchID, payload.ExternalID, payload.TournamentExternalID)
+}
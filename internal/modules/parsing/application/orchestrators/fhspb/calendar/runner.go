@@ -22,8 +22,14 @@ func (o *Orchestrator) Run(ctx context.Context) error {
 	logger.Info(ctx, "Found tournaments for parsing",
 		zap.Int("count", len(tournaments)))
 
-	// Обрабатываем каждый турнир
+	// Обрабатываем каждый турнир; протоколы ниже и так продолжаются с неразобранных матчей
 	for _, t := range tournaments {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if o.isDone(t.ID) {
+			continue
+		}
 		if err := o.processTournament(ctx, t.ID, t.ExternalID); err != nil {
 			logger.Error(ctx, "Failed to process tournament",
 				zap.String("tournament", t.Name),
@@ -31,6 +37,7 @@ func (o *Orchestrator) Run(ctx context.Context) error {
 				zap.Error(err))
 			continue
 		}
+		o.markDone(ctx, t.ID)
 	}
 
 	// Парсим детали завершённых матчей
@@ -87,6 +94,7 @@ func (o *Orchestrator) processUnparsedGames(ctx context.Context) error {
 			logger.Error(ctx, "Failed to process game",
 				zap.String("match_id", m.ID),
 				zap.Error(err))
+			o.enqueueProtocolRetry(ctx, protocolRetryPayload{MatchID: m.ID, ExternalID: m.ExternalID, TournamentExternalID: tournamentExternalID}, err)
 			continue
 		}
 	}
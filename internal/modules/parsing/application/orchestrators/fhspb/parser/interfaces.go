@@ -26,6 +26,14 @@ type Config interface {
 	RetryDelay() time.Duration
 }
 
+// TournamentProgress контрольная точка обработки турниров.
+//
+// Позволяет прерванному запуску продолжить с необработанных турниров.
+type TournamentProgress interface {
+	IsDone(tournamentID string) bool
+	MarkDone(ctx context.Context, tournamentID string)
+}
+
 // Dependencies зависимости сервиса
 type Dependencies struct {
 	DB             *sqlx.DB
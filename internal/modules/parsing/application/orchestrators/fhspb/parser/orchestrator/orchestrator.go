@@ -1,6 +1,8 @@
 package parser
 
 import (
+	"context"
+
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/application/orchestrators/fhspb/parser"
 	fhspbrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhspb"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhspb"
@@ -19,6 +21,9 @@ type Orchestrator struct {
 	playerTeamRepo *fhspbrepo.PlayerTeamRepository
 	retryManager   *retry.Manager
 	config         parser.Config
+
+	// Контрольная точка (опционально)
+	progress parser.TournamentProgress
 }
 
 // New создает новый оркестратор
@@ -39,3 +44,21 @@ func New(deps parser.Dependencies, config parser.Config) *Orchestrator {
 		config:         config,
 	}
 }
+
+// WithProgress включает контрольные точки по турнирам
+func (o *Orchestrator) WithProgress(progress parser.TournamentProgress) *Orchestrator {
+	o.progress = progress
+	return o
+}
+
+// isDone возвращает true, если турнир обработан прерванным запуском
+func (o *Orchestrator) isDone(tournamentID string) bool {
+	return o.progress != nil && o.progress.IsDone(tournamentID)
+}
+
+// markDone отмечает турнир обработанным; турнир, прерванный отменой, будет обработан заново
+func (o *Orchestrator) markDone(ctx context.Context, tournamentID string) {
+	if o.progress != nil && ctx.Err() == nil {
+		o.progress.MarkDone(ctx, tournamentID)
+	}
+}
@@ -21,12 +21,12 @@ func (o *Orchestrator) processPlayerSafe(ctx context.Context, teamID, tournament
 			zap.Error(err),
 		)
 
-		if o.config.RetryEnabled() {
-			retryErr := o.retryManager.AddFailedJob(ctx, retry.JobTypePlayer, "fhspb", pURL.PlayerID, pURL.URL, err)
-			if retryErr != nil {
-				logger.Error(ctx, "Failed to add retry job", zap.Error(retryErr))
-			}
-		}
+		o.enqueueRetry(ctx, retry.Job{
+			Type:       retry.JobTypePlayer,
+			ExternalID: pURL.PlayerID + "@" + teamID,
+			URL:        pURL.URL,
+			Payload:    playerRetryPayload{TournamentID: tournamentID, TeamID: teamID, Player: pURL},
+		}, err)
 
 		return false
 	}
@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhspb/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Source имя источника в очереди повторов
+const Source = "fhspb"
+
+// tournamentRetryPayload данные для повтора турнира
+type tournamentRetryPayload struct {
+	Tournament dto.TournamentDTO `json:"tournament"`
+}
+
+// teamRetryPayload данные для повтора команды
+type teamRetryPayload struct {
+	TournamentID string      `json:"tournament_id"`
+	Team         dto.TeamDTO `json:"team"`
+}
+
+// playerRetryPayload данные для повтора игрока
+type playerRetryPayload struct {
+	TournamentID string           `json:"tournament_id"`
+	TeamID       string           `json:"team_id"`
+	Player       dto.PlayerURLDTO `json:"player"`
+}
+
+// RegisterRetryHandlers регистрирует обработчики повторов турниров, команд и игроков
+func (o *Orchestrator) RegisterRetryHandlers(w *retry.Worker) {
+	w.Handle(Source, retry.JobTypeTournament, o.retryTournament)
+	w.Handle(Source, retry.JobTypeTeam, o.retryTeam)
+	w.Handle(Source, retry.JobTypePlayer, o.retryPlayer)
+}
+
+// enqueueRetry ставит сущность в очередь повторов, если повторы включены
+func (o *Orchestrator) enqueueRetry(ctx context.Context, job retry.Job, err error) {
+	if !o.config.RetryEnabled() {
+		return
+	}
+	job.Source = Source
+	if retryErr := o.retryManager.AddFailedJob(ctx, job, err); retryErr != nil {
+		logger.Error(ctx, "Failed to add retry job", zap.Error(retryErr))
+	}
+}
+
+func (o *Orchestrator) retryTournament(ctx context.Context, job *retry.FailedJob) error {
+	var payload tournamentRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+
+	tournamentID, err := o.saveTournament(ctx, payload.Tournament)
+	if err != nil {
+		return fmt.Errorf("save tournament: %w", err)
+	}
+	_, _, err = o.processTournament(ctx, payload.Tournament, tournamentID)
+	return err
+}
+
+func (o *Orchestrator) retryTeam(ctx context.Context, job *retry.FailedJob) error {
+	var payload teamRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+
+	teamID, err := o.saveTeam(ctx, payload.TournamentID, payload.Team)
+	if err != nil {
+		return fmt.Errorf("save team: %w", err)
+	}
+	_, err = o.processTeam(ctx, payload.Team.TournamentID, teamID, payload.TournamentID, payload.Team)
+	return err
+}
+
+func (o *Orchestrator) retryPlayer(ctx context.Context, job *retry.FailedJob) error {
+	var payload playerRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+	return o.processPlayer(ctx, payload.TeamID, payload.TournamentID, payload.Player)
+}
@@ -3,6 +3,7 @@ package parser
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -28,7 +29,17 @@ func (o *Orchestrator) Run(ctx context.Context) error {
 		return nil
 	}
 
-	totalTeams, totalPlayers := o.processAllTournaments(ctx, tournaments)
+	pending := tournaments[:0:0]
+	for _, t := range tournaments {
+		if !o.isDone(strconv.Itoa(t.ID)) {
+			pending = append(pending, t)
+		}
+	}
+	if skipped := len(tournaments) - len(pending); skipped > 0 {
+		logger.Info(ctx, "Resuming from checkpoint", zap.Int("skipped", skipped), zap.Int("pending", len(pending)))
+	}
+
+	totalTeams, totalPlayers := o.processAllTournaments(ctx, pending)
 
 	logger.Info(ctx, "✅ FHSPB parser completed",
 		zap.Duration("elapsed", time.Since(start)),
@@ -7,6 +7,7 @@ import (
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhspb"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhspb/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -21,6 +22,12 @@ func (o *Orchestrator) processTeamSafe(ctx context.Context, tournamentID string,
 	players, err := o.processTeam(ctx, t.TournamentID, teamID, tournamentID, t)
 	if err != nil {
 		logger.Error(ctx, "❌ Team failed", zap.String("name", t.Name), zap.Error(err))
+		o.enqueueRetry(ctx, retry.Job{
+			Type:       retry.JobTypeTeam,
+			ExternalID: fmt.Sprintf("%s@%d", t.ID, t.TournamentID),
+			URL:        fmt.Sprintf("https://www.fhspb.ru/Team?TournamentID=%d&TeamID=%s", t.TournamentID, t.ID),
+			Payload:    teamRetryPayload{TournamentID: tournamentID, Team: t},
+		}, err)
 		return 0
 	}
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/fhspb"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhspb/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -30,8 +31,16 @@ func (o *Orchestrator) processTournamentSafe(ctx context.Context, t dto.Tourname
 	if err != nil {
 		if strings.Contains(err.Error(), "404") {
 			logger.Warn(ctx, "⚠️ Tournament not found", zap.Int("id", t.ID), zap.Error(err))
+			o.markDone(ctx, strconv.Itoa(t.ID))
 		} else {
+			// Турнир, которого больше нет (404), не повторяем, остальные ошибки - в очередь
 			logger.Error(ctx, "❌ Tournament failed", zap.Int("id", t.ID), zap.Error(err))
+			o.enqueueRetry(ctx, retry.Job{
+				Type:       retry.JobTypeTournament,
+				ExternalID: strconv.Itoa(t.ID),
+				URL:        fmt.Sprintf("https://www.fhspb.ru/Tournament?TournamentID=%d", t.ID),
+				Payload:    tournamentRetryPayload{Tournament: t},
+			}, err)
 		}
 		return 0, 0
 	}
@@ -41,6 +50,7 @@ func (o *Orchestrator) processTournamentSafe(ctx context.Context, t dto.Tourname
 		zap.Int("teams", teams),
 		zap.Int("players", players),
 	)
+	o.markDone(ctx, strconv.Itoa(t.ID))
 
 	return teams, players
 }
@@ -163,11 +163,11 @@ func (o *Orchestrator) parseAndSaveMatchesFromAjax(ctx context.Context, domain,
 			}
 		}
 
-		match := o.convertMatch(ctx, tournamentID, domain, m)
-		if err := o.matchRepo.Upsert(ctx, match); err != nil {
+		if err := o.saveMatch(ctx, tournamentID, domain, m); err != nil {
 			logger.Error(ctx, "Failed to save match",
 				zap.String("external_id", m.ExternalID),
 				zap.Error(err))
+			o.enqueueMatchRetry(ctx, matchRetryPayload{TournamentID: tournamentID, Domain: domain, Match: m}, err)
 			continue
 		}
 		savedCount++
@@ -244,11 +244,11 @@ func (o *Orchestrator) parseAndSaveMatchesFromDoc(ctx context.Context, doc *goqu
 			}
 		}
 
-		match := o.convertMatch(ctx, tournamentID, domain, m)
-		if err := o.matchRepo.Upsert(ctx, match); err != nil {
+		if err := o.saveMatch(ctx, tournamentID, domain, m); err != nil {
 			logger.Error(ctx, "Failed to save match",
 				zap.String("external_id", m.ExternalID),
 				zap.Error(err))
+			o.enqueueMatchRetry(ctx, matchRetryPayload{TournamentID: tournamentID, Domain: domain, Match: m}, err)
 			continue
 		}
 		savedCount++
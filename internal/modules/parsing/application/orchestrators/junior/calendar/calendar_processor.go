@@ -33,17 +33,22 @@ func (o *Orchestrator) processCalendar(ctx context.Context, tournamentID, tourna
 			}
 		}
 
-		match := o.convertMatch(ctx, tournamentID, domain, m)
-		if err := o.matchRepo.Upsert(ctx, match); err != nil {
+		if err := o.saveMatch(ctx, tournamentID, domain, m); err != nil {
 			logger.Error(ctx, "Failed to save match",
 				zap.String("external_id", m.ExternalID),
 				zap.Error(err))
+			o.enqueueMatchRetry(ctx, matchRetryPayload{TournamentID: tournamentID, Domain: domain, Match: m}, err)
 		}
 	}
 
 	return nil
 }
 
+// saveMatch сохраняет матч календаря с найденными командами турнира
+func (o *Orchestrator) saveMatch(ctx context.Context, tournamentID, domain string, m jrcal.MatchDTO) error {
+	return o.matchRepo.Upsert(ctx, o.convertMatch(ctx, tournamentID, domain, m))
+}
+
 // extractDomain извлекает базовый URL (scheme + host) из полного URL
 func extractDomain(rawURL string) string {
 	parsed, err := url.Parse(rawURL)
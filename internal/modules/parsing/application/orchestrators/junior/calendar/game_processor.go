@@ -66,6 +66,11 @@ func (o *Orchestrator) processGame(ctx context.Context, matchID, externalID stri
 		logger.Warn(ctx, "Failed to save goalie events", zap.Error(err))
 	}
 
+	// Сохраняем пустые ворота (снятие вратаря)
+	if err := o.saveEmptyNets(ctx, match, details.EmptyNets); err != nil {
+		logger.Warn(ctx, "Failed to save empty net events", zap.Error(err))
+	}
+
 	return o.matchRepo.MarkDetailsParsed(ctx, matchID)
 }
 
@@ -263,6 +268,34 @@ func (o *Orchestrator) saveGoalieEvents(ctx context.Context, match *entities.Mat
 	return nil
 }
 
+func (o *Orchestrator) saveEmptyNets(ctx context.Context, match *entities.Match, events []game.EmptyNetDTO) error {
+	for _, en := range events {
+		event := &entities.MatchEvent{
+			ID:          uuid.New().String(),
+			MatchID:     match.ID,
+			EventType:   entities.EventTypeEmptyNet,
+			Period:      intPtrVal(game.CalculatePeriod(en.TimeMinutes)),
+			TimeMinutes: intPtrVal(en.TimeMinutes),
+			TimeSeconds: intPtrVal(en.TimeSeconds),
+			IsHome:      boolPtr(en.IsHome),
+			Source:      Source,
+		}
+
+		// team_id команды, снявшей вратаря
+		if en.IsHome && match.HomeTeamID != nil {
+			event.TeamID = match.HomeTeamID
+		} else if !en.IsHome && match.AwayTeamID != nil {
+			event.TeamID = match.AwayTeamID
+		}
+
+		if err := o.matchEventRepo.Create(ctx, event); err != nil {
+			logger.Warn(ctx, "Failed to save empty net event", zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
 // resolvePlayersToIDs преобразует список URL или текстовых описаний игроков в список ID
 func (o *Orchestrator) resolvePlayersToIDs(ctx context.Context, matchID string, items []string) []string {
 	if len(items) == 0 {
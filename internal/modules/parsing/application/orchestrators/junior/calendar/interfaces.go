@@ -53,3 +53,11 @@ type PlayerInfo struct {
 	Position    string
 	Citizenship string
 }
+
+// TournamentProgress контрольная точка обработки турниров.
+//
+// Позволяет прерванному запуску продолжить с необработанных турниров.
+type TournamentProgress interface {
+	IsDone(tournamentID string) bool
+	MarkDone(ctx context.Context, tournamentID string)
+}
@@ -3,6 +3,7 @@ package calendar
 import (
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/repositories"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/junior/types"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
 )
 
 // Source константа источника (соответствует junior parser)
@@ -28,8 +29,14 @@ type Orchestrator struct {
 	teamRepo        repositories.TeamRepository
 	playerRepo      repositories.PlayerRepository
 
+	// Очередь повторов матчей и протоколов, nil - повторы выключены
+	retryManager *retry.Manager
+
 	// Конфигурация
 	config CalendarConfig
+
+	// Контрольная точка (опционально)
+	progress TournamentProgress
 }
 
 // NewOrchestrator создает новый оркестратор
@@ -64,3 +71,15 @@ func NewOrchestrator(
 		config:          config,
 	}
 }
+
+// WithRetry включает постановку неудачных матчей и протоколов в очередь повторов
+func (o *Orchestrator) WithRetry(manager *retry.Manager) *Orchestrator {
+	o.retryManager = manager
+	return o
+}
+
+// WithProgress включает контрольные точки по турнирам
+func (o *Orchestrator) WithProgress(progress TournamentProgress) *Orchestrator {
+	o.progress = progress
+	return o
+}
@@ -29,6 +29,21 @@ func (o *Orchestrator) Run(ctx context.Context) error {
 	logger.Info(ctx, "Found base tournaments for calendar parsing",
 		zap.Int("count", len(baseTournaments)))
 
+	if o.progress != nil {
+		pending := baseTournaments[:0:0]
+		for _, t := range baseTournaments {
+			if !o.progress.IsDone(t.ID) {
+				pending = append(pending, t)
+			}
+		}
+		if skipped := len(baseTournaments) - len(pending); skipped > 0 {
+			logger.Info(ctx, "Resuming calendar parsing from checkpoint",
+				zap.Int("skipped", skipped),
+				zap.Int("pending", len(pending)))
+		}
+		baseTournaments = pending
+	}
+
 	// Параллельная обработка турниров
 	o.processTournamentsParallel(ctx, baseTournaments)
 
@@ -99,6 +114,11 @@ func (o *Orchestrator) processSingleTournament(ctx context.Context, task tournam
 	if err := o.processStandingsWithFilters(ctx, task.tournament); err != nil {
 		logger.Warn(ctx, "Failed to parse standings", zap.Error(err))
 	}
+
+	// Турнир, прерванный отменой, будет обработан заново
+	if o.progress != nil && ctx.Err() == nil {
+		o.progress.MarkDone(ctx, task.tournament.ID)
+	}
 }
 
 // processUnparsedGames парсит детали завершённых матчей параллельно
@@ -151,6 +171,9 @@ func (o *Orchestrator) processUnparsedGames(ctx context.Context) error {
 						zap.String("match_id", m.ID),
 						zap.Error(err))
 					atomic.AddInt64(&failed, 1)
+					if ctx.Err() == nil {
+						o.enqueueProtocolRetry(ctx, protocolRetryPayload{MatchID: m.ID, ExternalID: m.ExternalID}, err)
+					}
 					continue
 				}
 				atomic.AddInt64(&processed, 1)
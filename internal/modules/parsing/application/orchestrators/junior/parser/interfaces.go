@@ -7,6 +7,14 @@ import (
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/junior/types"
 )
 
+// TournamentProgress контрольная точка обработки турниров.
+//
+// Позволяет прерванному запуску продолжить с необработанных турниров.
+type TournamentProgress interface {
+	IsDone(tournamentID string) bool
+	MarkDone(ctx context.Context, tournamentID string)
+}
+
 // JuniorParserService интерфейс для парсинга junior.fhr.ru
 type JuniorParserService interface {
 	ParseDomains(ctx context.Context) ([]string, error)
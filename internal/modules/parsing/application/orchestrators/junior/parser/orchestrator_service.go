@@ -1,9 +1,12 @@
 package parser
 
 import (
+	"context"
+
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/application/interfaces"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/repositories"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
 )
 
 type orchestratorService struct {
@@ -13,6 +16,12 @@ type orchestratorService struct {
 	tournamentRepo repositories.TournamentRepository
 	playerTeamRepo repositories.PlayerTeamRepository
 	config         interfaces.JuniorConfig
+
+	// Очередь повторов турниров, команд и игроков, nil - повторы выключены
+	retryManager *retry.Manager
+
+	// Контрольная точка (опционально)
+	progress TournamentProgress
 }
 
 // NewOrchestratorService создает orchestrator для парсинга
@@ -34,5 +43,24 @@ func NewOrchestratorService(
 	}
 }
 
+// WithRetry включает постановку неудачных турниров, команд и игроков в очередь повторов
+func (s *orchestratorService) WithRetry(manager *retry.Manager) *orchestratorService {
+	s.retryManager = manager
+	return s
+}
+
+// WithProgress включает контрольные точки по турнирам
+func (s *orchestratorService) WithProgress(progress TournamentProgress) *orchestratorService {
+	s.progress = progress
+	return s
+}
+
+// markDone отмечает турнир обработанным; турнир, прерванный отменой, будет обработан заново
+func (s *orchestratorService) markDone(ctx context.Context, tournamentID string) {
+	if s.progress != nil && ctx.Err() == nil {
+		s.progress.MarkDone(ctx, tournamentID)
+	}
+}
+
 // Placeholder for entities usage
 var _ = entities.Player{}
@@ -0,0 +1,128 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/junior/types"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Source имя источника в очереди повторов
+const Source = entities.SourceJunior
+
+// teamRetryPayload данные для повтора состава команды
+type teamRetryPayload struct {
+	Team       *entities.Team       `json:"team"`
+	Tournament *entities.Tournament `json:"tournament"`
+	BirthYear  *int                 `json:"birth_year"`
+	GroupName  *string              `json:"group_name"`
+}
+
+// playerRetryPayload данные для повтора игрока из состава команды
+type playerRetryPayload struct {
+	Domain     string               `json:"domain"`
+	TeamID     string               `json:"team_id"`
+	Tournament *entities.Tournament `json:"tournament"`
+	BirthYear  *int                 `json:"birth_year"`
+	GroupName  *string              `json:"group_name"`
+	Player     types.PlayerDTO      `json:"player"`
+}
+
+// RegisterRetryHandlers регистрирует обработчики повторов турниров, команд и игроков
+func (s *orchestratorService) RegisterRetryHandlers(w *retry.Worker) {
+	w.Handle(Source, retry.JobTypeTournament, s.retryTournament)
+	w.Handle(Source, retry.JobTypeTeam, s.retryTeam)
+	w.Handle(Source, retry.JobTypePlayer, s.retryPlayer)
+}
+
+// enqueueTournamentRetry ставит турнир в очередь повторов
+func (s *orchestratorService) enqueueTournamentRetry(ctx context.Context, t *entities.Tournament, err error) {
+	s.enqueueRetry(ctx, retry.Job{
+		Type:       retry.JobTypeTournament,
+		ExternalID: t.ID,
+		URL:        t.Domain + t.URL,
+		Payload:    t,
+	}, err)
+}
+
+// enqueueTeamRetry ставит состав команды в очередь повторов
+func (s *orchestratorService) enqueueTeamRetry(ctx context.Context, payload teamRetryPayload, err error) {
+	s.enqueueRetry(ctx, retry.Job{
+		Type:       retry.JobTypeTeam,
+		ExternalID: payload.Team.ID,
+		URL:        payload.Tournament.Domain + payload.Team.URL,
+		Payload:    payload,
+	}, err)
+}
+
+// enqueuePlayerRetry ставит игрока в очередь повторов
+func (s *orchestratorService) enqueuePlayerRetry(ctx context.Context, payload playerRetryPayload, err error) {
+	s.enqueueRetry(ctx, retry.Job{
+		Type:       retry.JobTypePlayer,
+		ExternalID: payload.Player.ProfileURL,
+		URL:        payload.Domain + payload.Player.ProfileURL,
+		Payload:    payload,
+	}, err)
+}
+
+// enqueueRetry ставит задачу в очередь повторов, если она подключена
+func (s *orchestratorService) enqueueRetry(ctx context.Context, job retry.Job, err error) {
+	if s.retryManager == nil {
+		return
+	}
+	job.Source = Source
+	if retryErr := s.retryManager.AddFailedJob(ctx, job, err); retryErr != nil {
+		logger.Error(ctx, "Failed to add retry job", zap.Error(retryErr))
+	}
+}
+
+func (s *orchestratorService) retryTournament(ctx context.Context, job *retry.FailedJob) error {
+	var t entities.Tournament
+	if err := job.Decode(&t); err != nil {
+		return err
+	}
+	_, _, err := s.processTournament(ctx, &t)
+	return err
+}
+
+func (s *orchestratorService) retryTeam(ctx context.Context, job *retry.FailedJob) error {
+	var payload teamRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+	if payload.Team == nil || payload.Tournament == nil {
+		return fmt.Errorf("job %d: team payload without team or tournament", job.ID)
+	}
+	t := payload.Tournament
+	return s.SavePlayers(ctx, t.Domain, payload.Team.URL, payload.Team.ID, t.ID, t, payload.BirthYear, payload.GroupName)
+}
+
+func (s *orchestratorService) retryPlayer(ctx context.Context, job *retry.FailedJob) error {
+	var payload playerRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+	if payload.Tournament == nil {
+		return fmt.Errorf("job %d: player payload without tournament", job.ID)
+	}
+	t := payload.Tournament
+
+	p, err := s.convertPlayerDTO(payload.Player, t.Season, payload.Domain)
+	if err != nil {
+		// Игрок не проходит фильтры парсера - повторять нечего
+		logger.Info(ctx, "Player skipped on retry", zap.String("profile_url", payload.Player.ProfileURL), zap.Error(err))
+		return nil
+	}
+
+	playerID, _, _, err := s.savePlayer(ctx, p, t.Season)
+	if err != nil {
+		return err
+	}
+
+	links := map[string]playerLinkContext{playerID: newPlayerLinkContext(payload.Player)}
+	return s.CreatePlayerTeamLinksBatch(ctx, []string{playerID}, payload.TeamID, t.ID, t, payload.BirthYear, payload.GroupName, links)
+}
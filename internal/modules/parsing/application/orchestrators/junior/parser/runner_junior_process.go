@@ -26,98 +26,41 @@ func (s *orchestratorService) processTournaments(
 	totalTeams := 0
 	totalErrors := 0
 
-	for idx, t := range tournaments {
-		logger.Info(ctx, fmt.Sprintf("  🏆 Tournament %d/%d: %s (ID: %s, URL: %s)",
-			idx+1, len(tournaments), t.Name, t.ID, t.URL))
-
-		// Парсим команды с контекстом года/группы
-		logger.Info(ctx, "    🔍 Parsing teams with year/group context...")
-		teamsWithContext, err := s.juniorService.ParseTeams(ctx, t.Domain, t.URL, t.FallbackBirthYears...)
-		if err != nil {
-			logger.Warn(ctx, fmt.Sprintf("    ⚠️  Failed to parse teams: %v", err))
-			logger.Warn(ctx, "    ⏭️  SKIPPING tournament, continuing with next...")
-			totalErrors++
-			continue
+	if s.progress != nil {
+		pending := tournaments[:0:0]
+		for _, t := range tournaments {
+			if !s.progress.IsDone(t.ID) {
+				pending = append(pending, t)
+			}
 		}
+		if skipped := len(tournaments) - len(pending); skipped > 0 {
+			logger.Info(ctx, fmt.Sprintf("  ⏭️  Resuming from checkpoint: %d done, %d pending", skipped, len(pending)))
+		}
+		tournaments = pending
+	}
 
-		logger.Info(ctx, fmt.Sprintf("    ✅ Found %d teams from page", len(teamsWithContext)))
-
-		// Собираем birth_year_groups из контекста команд и сохраняем в турнир
-		if byg := collectBirthYearGroups(teamsWithContext); byg != nil {
-			if raw, err := json.Marshal(byg); err == nil {
-				if err := s.tournamentRepo.UpdateBirthYearGroups(ctx, t.ID, string(raw)); err != nil {
-					logger.Warn(ctx, fmt.Sprintf("    ⚠️  Failed to update birth_year_groups: %v", err))
-				} else {
-					logger.Info(ctx, fmt.Sprintf("    📅 Saved birth_year_groups: %d years", len(byg)))
-				}
-			}
+	for idx, t := range tournaments {
+		if err := ctx.Err(); err != nil {
+			return err
 		}
 
-		// Дедупликация для сохранения в таблицу teams (одна запись на команду)
-		uniqueTeams := uniqueTeamsForSave(teamsWithContext)
-		logger.Info(ctx, fmt.Sprintf("    📊 Unique teams: %d (from %d contexts)", len(uniqueTeams), len(teamsWithContext)))
+		logger.Info(ctx, fmt.Sprintf("  🏆 Tournament %d/%d: %s (ID: %s, URL: %s)",
+			idx+1, len(tournaments), t.Name, t.ID, t.URL))
 
-		// Сохраняем уникальные команды в БД
-		logger.Info(ctx, fmt.Sprintf("    💾 Saving teams for %s...", t.Name))
-		teams, err := s.SaveTeams(ctx, uniqueTeams, t.ID)
+		teamProcessed, teamErrors, err := s.processTournament(ctx, t)
 		if err != nil {
-			logger.Error(ctx, fmt.Sprintf("    ❌ Failed to save teams: %v", err))
+			logger.Warn(ctx, "    ⏭️  SKIPPING tournament, continuing with next...")
 			totalErrors++
+			s.enqueueTournamentRetry(ctx, t, err)
 			continue
 		}
-		logger.Info(ctx, fmt.Sprintf("    ✅ Saved %d teams", len(teams)))
-
-		if len(teams) == 0 {
-			logger.Info(ctx, "    ℹ️  No teams to process, moving to next tournament")
-			continue
-		}
-
-		// Строим задачи: одна на каждую комбинацию (team, year, group)
-		teamTasks := buildTeamTasks(teamsWithContext, teams, t)
-		logger.Info(ctx, fmt.Sprintf("    📋 Team tasks: %d (team × year × group combinations)", len(teamTasks)))
-
-		// Worker Pool для параллельного парсинга команд
-		logger.Info(ctx, fmt.Sprintf("    🚀 Starting team worker pool (%d workers) to parse players...", teamWorkers))
-		pool := NewTeamWorkerPool(ctx, s, teamWorkers)
-		pool.Start()
-
-		teamProcessed := 0
-		teamErrors := 0
-		done := make(chan struct{})
-
-		logger.Debug(ctx, "    📥 Starting result reader goroutine...")
-		go func() {
-			resultCount := 0
-			for result := range pool.Results() {
-				resultCount++
-				if result.Error != nil {
-					logger.Warn(ctx, fmt.Sprintf("      ⚠️  Team error: %s - %v", result.TeamName, result.Error))
-					teamErrors++
-				} else {
-					teamProcessed++
-				}
-			}
-			logger.Debug(ctx, fmt.Sprintf("    📥 Reader done, read %d results", resultCount))
-			close(done)
-		}()
-
-		// Добавляем задачи в очередь (каждая комбинация team+year+group)
-		for _, task := range teamTasks {
-			pool.AddTask(task)
-		}
-
-		pool.Close()
-		logger.Debug(ctx, "    ⏳ Waiting for workers to finish...")
-		pool.Wait()
-		logger.Debug(ctx, "    ✅ All workers finished!")
-		<-done
-		logger.Debug(ctx, "    ✅ Result reader finished!")
 
 		totalTeams += teamProcessed
 		totalErrors += teamErrors
 
 		logger.Info(ctx, fmt.Sprintf("    📊 Tournament result: %d teams processed, %d errors", teamProcessed, teamErrors))
 		logger.Info(ctx, "    ✅ Tournament COMPLETED")
+		s.markDone(ctx, t.ID)
 	}
 
 	logger.Info(ctx, "")
@@ -131,6 +74,94 @@ func (s *orchestratorService) processTournaments(
 	return nil
 }
 
+// processTournament обрабатывает команды и игроков одного турнира.
+//
+// Возвращает число обработанных команд и ошибок команд; ошибка означает,
+// что турнир не обработан целиком и его нужно повторить.
+func (s *orchestratorService) processTournament(ctx context.Context, t *entities.Tournament) (int, int, error) {
+	// Парсим команды с контекстом года/группы
+	logger.Info(ctx, "    🔍 Parsing teams with year/group context...")
+	teamsWithContext, err := s.juniorService.ParseTeams(ctx, t.Domain, t.URL, t.FallbackBirthYears...)
+	if err != nil {
+		logger.Warn(ctx, fmt.Sprintf("    ⚠️  Failed to parse teams: %v", err))
+		return 0, 0, fmt.Errorf("parse teams: %w", err)
+	}
+
+	logger.Info(ctx, fmt.Sprintf("    ✅ Found %d teams from page", len(teamsWithContext)))
+
+	// Собираем birth_year_groups из контекста команд и сохраняем в турнир
+	if byg := collectBirthYearGroups(teamsWithContext); byg != nil {
+		if raw, err := json.Marshal(byg); err == nil {
+			if err := s.tournamentRepo.UpdateBirthYearGroups(ctx, t.ID, string(raw)); err != nil {
+				logger.Warn(ctx, fmt.Sprintf("    ⚠️  Failed to update birth_year_groups: %v", err))
+			} else {
+				logger.Info(ctx, fmt.Sprintf("    📅 Saved birth_year_groups: %d years", len(byg)))
+			}
+		}
+	}
+
+	// Дедупликация для сохранения в таблицу teams (одна запись на команду)
+	uniqueTeams := uniqueTeamsForSave(teamsWithContext)
+	logger.Info(ctx, fmt.Sprintf("    📊 Unique teams: %d (from %d contexts)", len(uniqueTeams), len(teamsWithContext)))
+
+	// Сохраняем уникальные команды в БД
+	logger.Info(ctx, fmt.Sprintf("    💾 Saving teams for %s...", t.Name))
+	teams, err := s.SaveTeams(ctx, uniqueTeams, t.ID)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("    ❌ Failed to save teams: %v", err))
+		return 0, 0, fmt.Errorf("save teams: %w", err)
+	}
+	logger.Info(ctx, fmt.Sprintf("    ✅ Saved %d teams", len(teams)))
+
+	if len(teams) == 0 {
+		logger.Info(ctx, "    ℹ️  No teams to process, moving to next tournament")
+		return 0, 0, nil
+	}
+
+	// Строим задачи: одна на каждую комбинацию (team, year, group)
+	teamTasks := buildTeamTasks(teamsWithContext, teams, t)
+	logger.Info(ctx, fmt.Sprintf("    📋 Team tasks: %d (team × year × group combinations)", len(teamTasks)))
+
+	// Worker Pool для параллельного парсинга команд
+	logger.Info(ctx, fmt.Sprintf("    🚀 Starting team worker pool (%d workers) to parse players...", teamWorkers))
+	pool := NewTeamWorkerPool(ctx, s, teamWorkers)
+	pool.Start()
+
+	teamProcessed := 0
+	teamErrors := 0
+	done := make(chan struct{})
+
+	logger.Debug(ctx, "    📥 Starting result reader goroutine...")
+	go func() {
+		resultCount := 0
+		for result := range pool.Results() {
+			resultCount++
+			if result.Error != nil {
+				logger.Warn(ctx, fmt.Sprintf("      ⚠️  Team error: %s - %v", result.TeamName, result.Error))
+				teamErrors++
+			} else {
+				teamProcessed++
+			}
+		}
+		logger.Debug(ctx, fmt.Sprintf("    📥 Reader done, read %d results", resultCount))
+		close(done)
+	}()
+
+	// Добавляем задачи в очередь (каждая комбинация team+year+group)
+	for _, task := range teamTasks {
+		pool.AddTask(task)
+	}
+
+	pool.Close()
+	logger.Debug(ctx, "    ⏳ Waiting for workers to finish...")
+	pool.Wait()
+	logger.Debug(ctx, "    ✅ All workers finished!")
+	<-done
+	logger.Debug(ctx, "    ✅ Result reader finished!")
+
+	return teamProcessed, teamErrors, nil
+}
+
 // uniqueTeamsForSave дедуплицирует команды по ID (для сохранения в таблицу teams)
 func uniqueTeamsForSave(teamsWithContext []types.TeamWithContext) []types.TeamDTO {
 	seen := make(map[string]bool)
@@ -33,7 +33,7 @@ func (s *orchestratorService) SavePlayers(ctx context.Context, domain, teamURL,
 		currentSeason = t.Season
 	}
 
-	savedCount, updatedCount, existingCount, skippedCount, skippedTooOld := 0, 0, 0, 0, 0
+	savedCount, updatedCount, existingCount, skippedCount, skippedTooOld, failedCount := 0, 0, 0, 0, 0, 0
 	playerIDs := make([]string, 0, len(playersDTO))
 	playerCtx := make(map[string]playerLinkContext) // playerID → context
 
@@ -55,46 +55,33 @@ func (s *orchestratorService) SavePlayers(ctx context.Context, domain, teamURL,
 			continue
 		}
 
-		existing, err := s.playerRepo.GetByProfileURL(ctx, p.ProfileURL)
+		playerID, created, updated, err := s.savePlayer(ctx, p, currentSeason)
 		if err != nil {
-			return fmt.Errorf("failed to check existing player: %w", err)
+			// Игрок уходит в очередь повторов, остальная команда сохраняется
+			logger.Warn(ctx, fmt.Sprintf("    ⚠️  Failed to save player %s: %v", p.Name, err))
+			failedCount++
+			s.enqueuePlayerRetry(ctx, playerRetryPayload{
+				Domain:     domain,
+				TeamID:     teamID,
+				Tournament: t,
+				BirthYear:  birthYear,
+				GroupName:  groupName,
+				Player:     dto,
+			}, err)
+			continue
 		}
-
-		var playerID string
-		if existing == nil {
-			logger.Info(ctx, fmt.Sprintf("    ✅ Creating NEW player: %s (ID: %s)", p.Name, p.ID))
-			if err := s.playerRepo.Create(ctx, p); err != nil {
-				return fmt.Errorf("failed to create player %s: %w", p.Name, err)
-			}
+		switch {
+		case created:
 			savedCount++
-			playerID = p.ID
-		} else {
-			updated := s.updatePlayerIfNeeded(ctx, existing, p, currentSeason)
-			if updated {
-				updatedCount++
-			}
+		case updated:
+			updatedCount++
+			existingCount++
+		default:
 			existingCount++
-			playerID = existing.ID
 		}
 
 		playerIDs = append(playerIDs, playerID)
-		pctx := playerLinkContext{PhotoURL: dto.PhotoURL}
-		if dto.Number != "" {
-			if num, err := strconv.Atoi(dto.Number); err == nil {
-				pctx.JerseyNumber = &num
-			}
-		}
-		if dto.Height != "" {
-			if h, err := strconv.Atoi(strings.TrimSpace(dto.Height)); err == nil {
-				pctx.Height = &h
-			}
-		}
-		if dto.Weight != "" {
-			if w, err := strconv.Atoi(strings.TrimSpace(dto.Weight)); err == nil {
-				pctx.Weight = &w
-			}
-		}
-		playerCtx[playerID] = pctx
+		playerCtx[playerID] = newPlayerLinkContext(dto)
 	}
 
 	if len(playerIDs) > 0 {
@@ -103,11 +90,53 @@ func (s *orchestratorService) SavePlayers(ctx context.Context, domain, teamURL,
 		}
 	}
 
-	logger.Info(ctx, fmt.Sprintf("  📊 ИТОГО: новых=%d, обновлено=%d, существующих=%d, пропущено=%d, слишком старых=%d",
-		savedCount, updatedCount, existingCount, skippedCount, skippedTooOld))
+	logger.Info(ctx, fmt.Sprintf("  📊 ИТОГО: новых=%d, обновлено=%d, существующих=%d, пропущено=%d, слишком старых=%d, с ошибкой=%d",
+		savedCount, updatedCount, existingCount, skippedCount, skippedTooOld, failedCount))
 	return nil
 }
 
+// savePlayer создаёт игрока или обновляет существующего по URL профиля.
+//
+// Возвращает ID игрока в БД и признаки создания и обновления записи.
+func (s *orchestratorService) savePlayer(ctx context.Context, p *entities.Player, currentSeason string) (string, bool, bool, error) {
+	existing, err := s.playerRepo.GetByProfileURL(ctx, p.ProfileURL)
+	if err != nil {
+		return "", false, false, fmt.Errorf("failed to check existing player: %w", err)
+	}
+
+	if existing == nil {
+		logger.Info(ctx, fmt.Sprintf("    ✅ Creating NEW player: %s (ID: %s)", p.Name, p.ID))
+		if err := s.playerRepo.Create(ctx, p); err != nil {
+			return "", false, false, fmt.Errorf("failed to create player %s: %w", p.Name, err)
+		}
+		return p.ID, true, false, nil
+	}
+
+	updated := s.updatePlayerIfNeeded(ctx, existing, p, currentSeason)
+	return existing.ID, false, updated, nil
+}
+
+// newPlayerLinkContext собирает данные связи игрок-команда из строки состава
+func newPlayerLinkContext(dto types.PlayerDTO) playerLinkContext {
+	pctx := playerLinkContext{PhotoURL: dto.PhotoURL}
+	if dto.Number != "" {
+		if num, err := strconv.Atoi(dto.Number); err == nil {
+			pctx.JerseyNumber = &num
+		}
+	}
+	if dto.Height != "" {
+		if h, err := strconv.Atoi(strings.TrimSpace(dto.Height)); err == nil {
+			pctx.Height = &h
+		}
+	}
+	if dto.Weight != "" {
+		if w, err := strconv.Atoi(strings.TrimSpace(dto.Weight)); err == nil {
+			pctx.Weight = &w
+		}
+	}
+	return pctx
+}
+
 // updatePlayerIfNeeded обновляет данные игрока если текущий сезон новее
 func (s *orchestratorService) updatePlayerIfNeeded(ctx context.Context, existing, p *entities.Player, currentSeason string) bool {
 	existingDataSeason := ""
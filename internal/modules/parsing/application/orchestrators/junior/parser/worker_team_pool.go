@@ -123,6 +123,14 @@ func (wp *TeamWorkerPool) processTeam(workerID int, task TeamTask) TeamResult {
 	err := wp.orchestrator.SavePlayers(ctx, tournament.Domain, t.URL, t.ID, tournament.ID, tournament, task.BirthYear, task.GroupName)
 	if err != nil {
 		logger.Warn(ctx, fmt.Sprintf("      ⚠️  Worker %d failed: %v", workerID, err))
+		if ctx.Err() == nil {
+			wp.orchestrator.enqueueTeamRetry(ctx, teamRetryPayload{
+				Team:       t,
+				Tournament: tournament,
+				BirthYear:  task.BirthYear,
+				GroupName:  task.GroupName,
+			}, err)
+		}
 		return TeamResult{
 			TeamName: t.Name,
 			Error:    err,
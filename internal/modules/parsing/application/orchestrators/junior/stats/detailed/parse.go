@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/junior/stats"
@@ -32,7 +33,7 @@ func (p *Parser) ParseTournamentStats(
 
 	p.statsLogger.LogTournamentStart(tournamentID, "Tournament", statsURL)
 
-	resp, err := http.Get(statsURL) //nolint:gosec
+	resp, err := p.httpClient.Get(statsURL)
 	if err != nil {
 		return 0, fmt.Errorf("failed to fetch stats page: %w", err)
 	}
@@ -49,7 +50,7 @@ func (p *Parser) ParseTournamentStats(
 
 	p.zapLogger.Info("🔍 Парсинг комбинаций", zap.String("tournament_id", tournamentID), zap.String("season", season))
 
-	combinations, err := stats.ParseCombinationsWithAjax(ctx, doc, domain, http.DefaultClient)
+	combinations, err := stats.ParseCombinationsWithAjax(ctx, doc, domain, p.httpClient)
 	if err != nil {
 		p.zapLogger.Error("❌ Ошибка парсинга комбинаций", zap.Error(err))
 		return 0, fmt.Errorf("failed to parse combinations: %w", err)
@@ -71,14 +72,15 @@ func (p *Parser) ParseTournamentStats(
 		return 0, nil
 	}
 
+	scrapedAt := time.Now()
 	result, conversionLosses := ConvertWithTracking(allDTOs, tournamentID, p.convertOne)
 
-	if err := p.deleteOldStats(ctx, tournamentID); err != nil {
+	savedCount, savingLosses := p.saveStatsEntities(ctx, result)
+
+	if err := p.deleteStaleStats(ctx, tournamentID, scrapedAt); err != nil {
 		return 0, err
 	}
 
-	savedCount, savingLosses := p.saveStatsEntities(ctx, result)
-
 	p.logLosses(conversionLosses, savingLosses)
 
 	p.statsLogger.LogTournamentSummary(totalReceivedFromAPI, savedCount)
@@ -3,9 +3,11 @@ package detailed
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/junior/stats"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/governor"
 	"go.uber.org/zap"
 )
 
@@ -22,6 +24,7 @@ type StatsLogger interface {
 
 // Parser парсер детальной статистики турнира
 type Parser struct {
+	httpClient  *http.Client
 	repo        Repository
 	statsLogger StatsLogger
 	zapLogger   *zap.Logger
@@ -36,6 +39,7 @@ func NewParser(
 	convertOne func(stats.PlayerStatisticDTO, string) (*entities.PlayerStatistic, error),
 ) *Parser {
 	return &Parser{
+		httpClient:  governor.NewHTTPClient(30 * time.Second),
 		repo:        repo,
 		statsLogger: statsLogger,
 		zapLogger:   zapLogger,
@@ -68,7 +72,7 @@ func (p *Parser) fetchAllCombinations(
 
 		p.statsLogger.LogCombinationStart(combo.YearLabel, combo.YearID, combo.GroupName, combo.GroupID)
 
-		statsResp, err := stats.FetchStatistics(ctx, http.DefaultClient, domain, tournamentID, combo.YearID, combo.GroupID, season)
+		statsResp, err := stats.FetchStatistics(ctx, p.httpClient, domain, tournamentID, combo.YearID, combo.GroupID, season)
 		if err != nil {
 			p.zapLogger.Warn("❌ Ошибка запроса", zap.Error(err))
 			p.statsLogger.LogCombinationError(err)
@@ -100,14 +104,15 @@ func (p *Parser) fetchAllCombinations(
 	return allDTOs, totalReceivedFromAPI
 }
 
-// deleteOldStats удаляет старую статистику турнира
-func (p *Parser) deleteOldStats(ctx context.Context, tournamentID string) error {
-	type deleter interface {
-		DeleteByTournament(ctx context.Context, tournamentID string) error
+// deleteStaleStats удаляет статистику турнира, которой не оказалось в этом сборе.
+// Остальные строки обновляются на месте, чтобы их версии не переписывались на каждом сборе
+func (p *Parser) deleteStaleStats(ctx context.Context, tournamentID string, scrapedAt time.Time) error {
+	type staleDeleter interface {
+		DeleteStale(ctx context.Context, tournamentID string, before time.Time) error
 	}
 
-	if repo, ok := p.repo.(deleter); ok {
-		return repo.DeleteByTournament(ctx, tournamentID)
+	if repo, ok := p.repo.(staleDeleter); ok {
+		return repo.DeleteStale(ctx, tournamentID, scrapedAt)
 	}
 
 	return nil
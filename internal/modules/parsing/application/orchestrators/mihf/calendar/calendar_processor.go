@@ -7,6 +7,7 @@ import (
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/mihf/dto"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/mihf/parsing"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -45,6 +46,14 @@ func (o *Orchestrator) processSeason(ctx context.Context, season dto.SeasonDTO)
 			}
 
 			for _, sub := range subTournaments {
+				if err := ctx.Err(); err != nil {
+					return totalMatches, totalEvents, err
+				}
+				tournamentID := calendarTournamentID(tournament, sub)
+				if o.isDone(tournamentID) {
+					continue
+				}
+
 				m, e, err := o.processCalendar(ctx, season, tournament, sub)
 				if err != nil {
 					logger.Warn(ctx, "Calendar processing failed", zap.Error(err))
@@ -52,6 +61,7 @@ func (o *Orchestrator) processSeason(ctx context.Context, season dto.SeasonDTO)
 				}
 				totalMatches += m
 				totalEvents += e
+				o.markDone(ctx, tournamentID)
 			}
 		}
 	}
@@ -83,8 +93,7 @@ func (o *Orchestrator) processCalendar(ctx context.Context, season dto.SeasonDTO
 	// Определяем даты турнира
 	startDate, endDate := parsing.FindTournamentDates(matches)
 	if !startDate.IsZero() {
-		tournamentID := fmt.Sprintf("msk:%s-%s-%s", tournament.ID, sub.ID, tournament.GroupID)
-		o.updateTournamentDates(ctx, tournamentID, startDate, endDate)
+		o.updateTournamentDates(ctx, calendarTournamentID(tournament, sub), startDate, endDate)
 	}
 
 	// Сохраняем матчи
@@ -92,6 +101,7 @@ func (o *Orchestrator) processCalendar(ctx context.Context, season dto.SeasonDTO
 	for _, match := range matches {
 		if err := o.saveMatch(ctx, match, season, tournament, sub); err != nil {
 			logger.Warn(ctx, "Failed to save match", zap.Error(err))
+			o.enqueueRetry(ctx, retry.JobTypeMatch, matchRetryPayload{Season: season, Tournament: tournament, Sub: sub, Match: match}, err)
 			continue
 		}
 		savedMatches++
@@ -111,6 +121,7 @@ func (o *Orchestrator) processCalendar(ctx context.Context, season dto.SeasonDTO
 			events, err := o.processProtocol(ctx, match, tournament, sub)
 			if err != nil {
 				logger.Debug(ctx, "Protocol parsing failed", zap.Error(err))
+				o.enqueueRetry(ctx, retry.JobTypeProtocol, matchRetryPayload{Season: season, Tournament: tournament, Sub: sub, Match: match}, err)
 				continue
 			}
 			savedEvents += events
@@ -120,6 +131,11 @@ func (o *Orchestrator) processCalendar(ctx context.Context, season dto.SeasonDTO
 	return savedMatches, savedEvents, nil
 }
 
+// calendarTournamentID ID подтурнира в БД: турнир, подтурнир и группа
+func calendarTournamentID(tournament dto.TournamentDTO, sub dto.SubTournamentDTO) string {
+	return fmt.Sprintf("msk:%s-%s-%s", tournament.ID, sub.ID, tournament.GroupID)
+}
+
 // updateTournamentDates обновляет даты турнира
 func (o *Orchestrator) updateTournamentDates(ctx context.Context, id string, start, end time.Time) {
 	tournament, err := o.tournamentRepo.GetByID(ctx, id)
@@ -1,6 +1,7 @@
 package calendar
 
 import (
+	"context"
 	"time"
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/repositories"
@@ -25,6 +26,14 @@ type CalendarConfig interface {
 	RetryDelay() time.Duration
 }
 
+// TournamentProgress контрольная точка обработки турниров.
+//
+// Позволяет прерванному запуску продолжить с необработанных турниров.
+type TournamentProgress interface {
+	IsDone(tournamentID string) bool
+	MarkDone(ctx context.Context, tournamentID string)
+}
+
 // Source константа источника
 const Source = "mihf.ru"
 
@@ -48,6 +57,9 @@ type Orchestrator struct {
 
 	// Конфигурация
 	config CalendarConfig
+
+	// Контрольная точка (опционально)
+	progress TournamentProgress
 }
 
 // NewOrchestrator создает новый оркестратор
@@ -82,3 +94,21 @@ func NewOrchestrator(
 		config:          config,
 	}
 }
+
+// WithProgress включает контрольные точки по турнирам
+func (o *Orchestrator) WithProgress(progress TournamentProgress) *Orchestrator {
+	o.progress = progress
+	return o
+}
+
+// isDone возвращает true, если турнир обработан прерванным запуском
+func (o *Orchestrator) isDone(tournamentID string) bool {
+	return o.progress != nil && o.progress.IsDone(tournamentID)
+}
+
+// markDone отмечает турнир обработанным; турнир, прерванный отменой, будет обработан заново
+func (o *Orchestrator) markDone(ctx context.Context, tournamentID string) {
+	if o.progress != nil && ctx.Err() == nil {
+		o.progress.MarkDone(ctx, tournamentID)
+	}
+}
@@ -0,0 +1,61 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/mihf/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// retrySource имя источника в очереди повторов, общее с парсером MIHF
+const retrySource = "mihf"
+
+// matchRetryPayload данные для повтора матча или его протокола
+type matchRetryPayload struct {
+	Season     dto.SeasonDTO        `json:"season"`
+	Tournament dto.TournamentDTO    `json:"tournament"`
+	Sub        dto.SubTournamentDTO `json:"sub"`
+	Match      dto.MatchDTO         `json:"match"`
+}
+
+// RegisterRetryHandlers регистрирует обработчики повторов матчей и протоколов
+func (o *Orchestrator) RegisterRetryHandlers(w *retry.Worker) {
+	w.Handle(retrySource, retry.JobTypeMatch, o.retryMatch)
+	w.Handle(retrySource, retry.JobTypeProtocol, o.retryProtocol)
+}
+
+// enqueueRetry ставит матч в очередь повторов, если повторы включены
+func (o *Orchestrator) enqueueRetry(ctx context.Context, jobType retry.JobType, payload matchRetryPayload, err error) {
+	if !o.config.RetryEnabled() {
+		return
+	}
+	job := retry.Job{
+		Type:       jobType,
+		Source:     retrySource,
+		ExternalID: payload.Match.ExternalID,
+		URL:        payload.Match.ProtoURL,
+		Payload:    payload,
+	}
+	if retryErr := o.retryManager.AddFailedJob(ctx, job, err); retryErr != nil {
+		logger.Error(ctx, "Failed to add retry job", zap.Error(retryErr))
+	}
+}
+
+func (o *Orchestrator) retryMatch(ctx context.Context, job *retry.FailedJob) error {
+	var payload matchRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+	return o.saveMatch(ctx, payload.Match, payload.Season, payload.Tournament, payload.Sub)
+}
+
+func (o *Orchestrator) retryProtocol(ctx context.Context, job *retry.FailedJob) error {
+	var payload matchRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+	_, err := o.processProtocol(ctx, payload.Match, payload.Tournament, payload.Sub)
+	return err
+}
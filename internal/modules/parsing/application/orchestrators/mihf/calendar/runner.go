@@ -35,6 +35,9 @@ func (o *Orchestrator) Run(ctx context.Context) error {
 	// 2. Для каждого сезона получаем турниры и парсим календари
 	var totalMatches, totalEvents int
 	for _, season := range seasons {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		matches, events, err := o.processSeason(ctx, season)
 		if err != nil {
 			logger.Error(ctx, "Season processing failed",
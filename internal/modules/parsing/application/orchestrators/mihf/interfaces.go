@@ -1,6 +1,7 @@
 package mihf
 
 import (
+	"context"
 	"time"
 
 	mihfrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/mihf"
@@ -23,6 +24,14 @@ type Config interface {
 	TestSeason() string
 }
 
+// TournamentProgress контрольная точка обработки турниров.
+//
+// Позволяет прерванному запуску продолжить с необработанных турниров.
+type TournamentProgress interface {
+	IsDone(tournamentID string) bool
+	MarkDone(ctx context.Context, tournamentID string)
+}
+
 // Dependencies зависимости сервиса
 type Dependencies struct {
 	DB                   *sqlx.DB
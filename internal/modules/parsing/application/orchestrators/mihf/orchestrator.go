@@ -1,6 +1,8 @@
 package mihf
 
 import (
+	"context"
+
 	mihfrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/mihf"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/mihf"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
@@ -17,6 +19,9 @@ type Orchestrator struct {
 	goalieStatisticsRepo *mihfrepo.GoalieStatisticsRepository
 	retryManager         *retry.Manager
 	config               Config
+
+	// Контрольная точка (опционально)
+	progress TournamentProgress
 }
 
 // New создает новый оркестратор MIHF
@@ -39,3 +44,21 @@ func New(deps Dependencies, config Config) *Orchestrator {
 		config:               config,
 	}
 }
+
+// WithProgress включает контрольные точки по турнирам
+func (o *Orchestrator) WithProgress(progress TournamentProgress) *Orchestrator {
+	o.progress = progress
+	return o
+}
+
+// isDone возвращает true, если турнир обработан прерванным запуском
+func (o *Orchestrator) isDone(tournamentID string) bool {
+	return o.progress != nil && o.progress.IsDone(tournamentID)
+}
+
+// markDone отмечает турнир обработанным; турнир, прерванный отменой, будет обработан заново
+func (o *Orchestrator) markDone(ctx context.Context, tournamentID string) {
+	if o.progress != nil && ctx.Err() == nil {
+		o.progress.MarkDone(ctx, tournamentID)
+	}
+}
@@ -22,12 +22,12 @@ func (o *Orchestrator) processPlayerStatsSafe(ctx context.Context, tournamentID,
 			zap.Error(err),
 		)
 
-		if o.config.RetryEnabled() {
-			retryErr := o.retryManager.AddFailedJob(ctx, retry.JobTypePlayer, "mihf", stats.ID, stats.ProfileURL, err)
-			if retryErr != nil {
-				logger.Error(ctx, "Failed to add retry job", zap.Error(retryErr))
-			}
-		}
+		o.enqueueRetry(ctx, retry.Job{
+			Type:       retry.JobTypePlayer,
+			ExternalID: stats.ID + "@" + teamID,
+			URL:        stats.ProfileURL,
+			Payload:    playerRetryPayload{TournamentID: tournamentID, TeamID: teamID, BirthYear: birthYear, Player: &stats},
+		}, err)
 
 		return false
 	}
@@ -77,6 +77,14 @@ func (o *Orchestrator) processGoalieStatsSafe(ctx context.Context, tournamentID,
 			zap.String("name", stats.Name),
 			zap.Error(err),
 		)
+
+		o.enqueueRetry(ctx, retry.Job{
+			Type:       retry.JobTypePlayer,
+			ExternalID: stats.ID + "@" + teamID,
+			URL:        stats.ProfileURL,
+			Payload:    playerRetryPayload{TournamentID: tournamentID, TeamID: teamID, BirthYear: birthYear, Goalie: &stats},
+		}, err)
+
 		return false
 	}
 	return true
@@ -0,0 +1,97 @@
+package mihf
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/mihf/dto"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Source имя источника в очереди повторов
+const Source = "mihf"
+
+// tournamentRetryPayload данные для повтора турнира
+type tournamentRetryPayload struct {
+	Path dto.TournamentPathDTO `json:"path"`
+}
+
+// teamRetryPayload данные для повтора команды
+type teamRetryPayload struct {
+	Path         dto.TournamentPathDTO `json:"path"`
+	TournamentID string                `json:"tournament_id"`
+	Team         dto.TeamDTO           `json:"team"`
+}
+
+// playerRetryPayload данные для повтора игрока: полевого или вратаря
+type playerRetryPayload struct {
+	TournamentID string              `json:"tournament_id"`
+	TeamID       string              `json:"team_id"`
+	BirthYear    int                 `json:"birth_year"`
+	Player       *dto.PlayerStatsDTO `json:"player,omitempty"`
+	Goalie       *dto.GoalieStatsDTO `json:"goalie,omitempty"`
+}
+
+// RegisterRetryHandlers регистрирует обработчики повторов турниров, команд и игроков
+func (o *Orchestrator) RegisterRetryHandlers(w *retry.Worker) {
+	w.Handle(Source, retry.JobTypeTournament, o.retryTournament)
+	w.Handle(Source, retry.JobTypeTeam, o.retryTeam)
+	w.Handle(Source, retry.JobTypePlayer, o.retryPlayer)
+}
+
+// enqueueRetry ставит сущность в очередь повторов, если повторы включены
+func (o *Orchestrator) enqueueRetry(ctx context.Context, job retry.Job, err error) {
+	if !o.config.RetryEnabled() {
+		return
+	}
+	job.Source = Source
+	if retryErr := o.retryManager.AddFailedJob(ctx, job, err); retryErr != nil {
+		logger.Error(ctx, "Failed to add retry job", zap.Error(retryErr))
+	}
+}
+
+func (o *Orchestrator) retryTournament(ctx context.Context, job *retry.FailedJob) error {
+	var payload tournamentRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+
+	tournamentID, err := o.saveTournament(ctx, payload.Path)
+	if err != nil {
+		return fmt.Errorf("save tournament: %w", err)
+	}
+	_, err = o.processTournament(ctx, payload.Path, tournamentID)
+	return err
+}
+
+func (o *Orchestrator) retryTeam(ctx context.Context, job *retry.FailedJob) error {
+	var payload teamRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+
+	teamID, err := o.saveTeam(ctx, payload.TournamentID, payload.Team)
+	if err != nil {
+		return fmt.Errorf("save team: %w", err)
+	}
+	_, err = o.processTeam(ctx, payload.Path, payload.TournamentID, teamID, payload.Team)
+	return err
+}
+
+func (o *Orchestrator) retryPlayer(ctx context.Context, job *retry.FailedJob) error {
+	var payload playerRetryPayload
+	if err := job.Decode(&payload); err != nil {
+		return err
+	}
+
+	switch {
+	case payload.Player != nil:
+		return o.processPlayerStats(ctx, payload.TournamentID, payload.TeamID, payload.BirthYear, *payload.Player)
+	case payload.Goalie != nil:
+		return o.processGoalieStats(ctx, payload.TournamentID, payload.TeamID, payload.BirthYear, *payload.Goalie)
+	default:
+		return fmt.Errorf("job %d: payload has no player", job.ID)
+	}
+}
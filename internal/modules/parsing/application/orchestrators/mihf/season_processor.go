@@ -200,6 +200,9 @@ func (o *Orchestrator) processAllTournaments(ctx context.Context, paths []dto.To
 
 	pathCh := make(chan dto.TournamentPathDTO, len(paths))
 	for _, p := range paths {
+		if o.isDone(tournamentExternalID(p)) {
+			continue
+		}
 		pathCh <- p
 	}
 	close(pathCh)
@@ -9,6 +9,7 @@ import (
 	mihfrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/mihf"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/mihf/dto"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/mihf/parsing"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -40,6 +41,12 @@ func (o *Orchestrator) processTeamSafe(ctx context.Context, path dto.TournamentP
 			zap.String("name", team.Name),
 			zap.Error(err),
 		)
+		o.enqueueRetry(ctx, retry.Job{
+			Type:       retry.JobTypeTeam,
+			ExternalID: team.ID + "@" + tournamentID,
+			URL:        path.TeamURL(team.ID),
+			Payload:    teamRetryPayload{Path: path, TournamentID: tournamentID, Team: team},
+		}, err)
 		return 0
 	}
 
@@ -8,6 +8,7 @@ import (
 	mihfrepo "github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories/mihf"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/mihf/dto"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/mihf/parsing"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -40,6 +41,12 @@ func (o *Orchestrator) processTournamentSafe(ctx context.Context, path dto.Tourn
 			zap.String("tournament_id", tournamentID),
 			zap.Error(err),
 		)
+		o.enqueueRetry(ctx, retry.Job{
+			Type:       retry.JobTypeTournament,
+			ExternalID: tournamentExternalID(path),
+			URL:        path.ScoreboardURL(),
+			Payload:    tournamentRetryPayload{Path: path},
+		}, err)
 		return runStats{}
 	}
 
@@ -48,12 +55,13 @@ func (o *Orchestrator) processTournamentSafe(ctx context.Context, path dto.Tourn
 		zap.Int("teams", stats.teams),
 		zap.Int("players", stats.players),
 	)
+	o.markDone(ctx, tournamentExternalID(path))
 
 	return stats
 }
 
 func (o *Orchestrator) saveTournament(ctx context.Context, path dto.TournamentPathDTO) (string, error) {
-	externalID := fmt.Sprintf("%s-%s-%s", path.TournamentID, path.SubID, path.GroupID)
+	externalID := tournamentExternalID(path)
 	season := path.SeasonYear + "-" + nextYear(path.SeasonYear)
 
 	tournament := &mihfrepo.Tournament{
@@ -81,6 +89,11 @@ func (o *Orchestrator) saveTournament(ctx context.Context, path dto.TournamentPa
 	return o.tournamentRepo.Upsert(ctx, tournament)
 }
 
+// tournamentExternalID ID турнира на stats.mihf.ru: турнир, подтурнир и группа
+func tournamentExternalID(path dto.TournamentPathDTO) string {
+	return fmt.Sprintf("%s-%s-%s", path.TournamentID, path.SubID, path.GroupID)
+}
+
 func nextYear(year string) string {
 	var y int
 	fmt.Sscanf(year, "%d", &y)
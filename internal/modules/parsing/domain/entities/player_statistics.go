@@ -5,6 +5,12 @@ import (
 	"time"
 )
 
+// Допустимый год рождения в статистике; 0 - статистика без разбивки по годам
+const (
+	MinStatBirthYear = 2000
+	MaxStatBirthYear = 2020
+)
+
 // PlayerStatistic представляет статистику игрока в турнире
 type PlayerStatistic struct {
 	ID           int       `db:"id"`
@@ -59,8 +65,8 @@ func (ps *PlayerStatistic) Validate() error {
 	if ps.GroupName == "" {
 		return fmt.Errorf("group_name is required")
 	}
-	if ps.BirthYear != 0 && (ps.BirthYear < 2000 || ps.BirthYear > 2020) {
-		return fmt.Errorf("birth_year must be 0 or between 2000 and 2020")
+	if ps.BirthYear != 0 && (ps.BirthYear < MinStatBirthYear || ps.BirthYear > MaxStatBirthYear) {
+		return fmt.Errorf("birth_year must be 0 or between %d and %d", MinStatBirthYear, MaxStatBirthYear)
 	}
 	if ps.Games < 0 || ps.Goals < 0 || ps.Assists < 0 {
 		return fmt.Errorf("stats cannot be negative")
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
 )
@@ -10,6 +11,7 @@ import (
 type PlayerStatisticsRepository interface {
 	CreateBatch(ctx context.Context, stats []*entities.PlayerStatistic) (int, error)
 	DeleteByTournament(ctx context.Context, tournamentID string) error
+	DeleteStale(ctx context.Context, tournamentID string, before time.Time) error
 	DeleteAll(ctx context.Context) error
 	GetByPlayerID(ctx context.Context, playerID string) ([]*entities.PlayerStatistic, error)
 	GetByTournament(ctx context.Context, tournamentID string) ([]*entities.PlayerStatistic, error)
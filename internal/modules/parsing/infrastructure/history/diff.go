@@ -0,0 +1,149 @@
+package history
+
+import (
+	"sort"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/domain"
+)
+
+// diffPlayers новые игроки, смена амплуа, изменение роста и веса.
+// Значения, которые источник перестал отдавать (nil после сохранения), изменением не считаются
+func diffPlayers(before, after map[string]playerState) []events.Event {
+	var result []events.Event
+	for _, id := range sortedKeys(after) {
+		cur := after[id]
+		prev, existed := before[id]
+		if !existed {
+			result = append(result, domain.NewPlayerChange(domain.EventPlayerCreated, id, cur.Source, map[string]interface{}{
+				"name": cur.Name, "position": cur.Position, "height": cur.Height, "weight": cur.Weight,
+			}))
+			continue
+		}
+
+		if changed(prev.Position, cur.Position) {
+			result = append(result, domain.NewPlayerChange(domain.EventPositionChanged, id, cur.Source,
+				domain.ValueChange{Before: prev.Position, After: cur.Position}))
+		}
+
+		measurements := make(map[string]domain.ValueChange)
+		if changed(prev.Height, cur.Height) {
+			measurements["height"] = domain.ValueChange{Before: prev.Height, After: cur.Height}
+		}
+		if changed(prev.Weight, cur.Weight) {
+			measurements["weight"] = domain.ValueChange{Before: prev.Weight, After: cur.Weight}
+		}
+		if len(measurements) > 0 {
+			result = append(result, domain.NewPlayerChange(domain.EventMeasurementsUpdated, id, cur.Source, measurements))
+		}
+	}
+	return result
+}
+
+// diffLinks появление игрока в новом турнире и переход в команду, за которую он раньше не играл
+func diffLinks(before, after map[string][]link) []events.Event {
+	var result []events.Event
+	for _, playerID := range sortedKeys(after) {
+		prev := before[playerID]
+		teams := make(map[string]bool, len(prev))
+		tournaments := make(map[string]bool, len(prev))
+		known := make(map[[2]string]bool, len(prev))
+		var latest *link
+		for i := range prev {
+			l := &prev[i]
+			teams[l.TeamID] = true
+			tournaments[l.TournamentID] = true
+			known[[2]string{l.TeamID, l.TournamentID}] = true
+			if latest == nil || l.UpdatedAt.After(latest.UpdatedAt) {
+				latest = l
+			}
+		}
+
+		added := make([]link, 0)
+		for _, l := range after[playerID] {
+			key := [2]string{l.TeamID, l.TournamentID}
+			if !known[key] {
+				known[key] = true
+				added = append(added, l)
+			}
+		}
+		sort.Slice(added, func(i, j int) bool {
+			if added[i].TournamentID != added[j].TournamentID {
+				return added[i].TournamentID < added[j].TournamentID
+			}
+			return added[i].TeamID < added[j].TeamID
+		})
+
+		for _, l := range added {
+			if !tournaments[l.TournamentID] {
+				tournaments[l.TournamentID] = true
+				result = append(result, domain.NewPlayerChange(domain.EventTournamentJoined, playerID, l.Source,
+					domain.TournamentEntry{TournamentID: l.TournamentID, TeamID: l.TeamID}))
+			}
+			if latest != nil && !teams[l.TeamID] {
+				teams[l.TeamID] = true
+				result = append(result, domain.NewPlayerChange(domain.EventTeamTransfer, playerID, l.Source,
+					domain.TeamChange{FromTeamID: latest.TeamID, ToTeamID: l.TeamID, TournamentID: l.TournamentID}))
+			}
+		}
+	}
+	return result
+}
+
+// diffStats изменение итоговой статистики игрока в турнире
+func diffStats(before, after map[StatKey]statState) []events.Event {
+	keys := make([]StatKey, 0, len(after))
+	for k := range after {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].PlayerID != keys[j].PlayerID {
+			return keys[i].PlayerID < keys[j].PlayerID
+		}
+		return keys[i].TournamentID < keys[j].TournamentID
+	})
+
+	var result []events.Event
+	for _, k := range keys {
+		prev, cur := statLine(before[k]), statLine(after[k])
+		if prev == cur {
+			continue
+		}
+		result = append(result, domain.NewPlayerChange(domain.EventStatsDelta, k.PlayerID, after[k].Source, domain.StatsDelta{
+			TournamentID: k.TournamentID,
+			Before:       prev,
+			After:        cur,
+			Delta: domain.StatLine{
+				Games:          cur.Games - prev.Games,
+				Goals:          cur.Goals - prev.Goals,
+				Assists:        cur.Assists - prev.Assists,
+				Points:         cur.Points - prev.Points,
+				PenaltyMinutes: cur.PenaltyMinutes - prev.PenaltyMinutes,
+			},
+		}))
+	}
+	return result
+}
+
+func statLine(s statState) domain.StatLine {
+	return domain.StatLine{
+		Games: s.Games, Goals: s.Goals, Assists: s.Assists, Points: s.Points, PenaltyMinutes: s.PenaltyMinutes,
+	}
+}
+
+// changed новое значение задано и отличается от прежнего
+func changed[T comparable](before, after *T) bool {
+	if after == nil {
+		return false
+	}
+	return before == nil || *before != *after
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
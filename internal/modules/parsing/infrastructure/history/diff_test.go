@@ -0,0 +1,114 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/domain"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func types(evts []events.Event) []string {
+	result := make([]string, len(evts))
+	for i, e := range evts {
+		result[i] = e.AggregateID() + ":" + e.EventType()
+	}
+	return result
+}
+
+func assertTypes(t *testing.T, got []events.Event, want ...string) {
+	t.Helper()
+	gotTypes := types(got)
+	if len(gotTypes) != len(want) {
+		t.Fatalf("events = %v, want %v", gotTypes, want)
+	}
+	for i := range want {
+		if gotTypes[i] != want[i] {
+			t.Fatalf("events = %v, want %v", gotTypes, want)
+		}
+	}
+}
+
+func TestDiffPlayers(t *testing.T) {
+	before := map[string]playerState{
+		"p1": {ID: "p1", Position: ptr("нападающий"), Height: ptr(170), Weight: ptr(60)},
+		"p2": {ID: "p2", Position: ptr("защитник"), Height: ptr(180)},
+	}
+	after := map[string]playerState{
+		"p1": {ID: "p1", Position: ptr("защитник"), Height: ptr(175), Weight: ptr(60)},
+		"p2": {ID: "p2", Position: nil, Height: ptr(180)},
+		"p3": {ID: "p3", Source: "fhspb"},
+	}
+
+	got := diffPlayers(before, after)
+	assertTypes(t, got,
+		"p1:"+domain.EventPositionChanged,
+		"p1:"+domain.EventMeasurementsUpdated,
+		"p3:"+domain.EventPlayerCreated,
+	)
+
+	measurements := got[1].EventData().(map[string]domain.ValueChange)
+	if _, ok := measurements["weight"]; ok {
+		t.Errorf("unchanged weight reported: %v", measurements)
+	}
+	if h := measurements["height"]; *h.Before.(*int) != 170 || *h.After.(*int) != 175 {
+		t.Errorf("height change = %v", h)
+	}
+	if src := got[2].(*domain.PlayerChange).Source; src != "fhspb" {
+		t.Errorf("source = %q, want fhspb", src)
+	}
+}
+
+func TestDiffLinks(t *testing.T) {
+	now := time.Now()
+	before := map[string][]link{
+		"p1": {
+			{PlayerID: "p1", TeamID: "old", TournamentID: "t1", UpdatedAt: now.Add(-2 * time.Hour)},
+			{PlayerID: "p1", TeamID: "last", TournamentID: "t1", UpdatedAt: now.Add(-time.Hour)},
+		},
+	}
+	after := map[string][]link{
+		"p1": append(before["p1"],
+			link{PlayerID: "p1", TeamID: "new", TournamentID: "t2"},
+			link{PlayerID: "p1", TeamID: "last", TournamentID: "t3"},
+		),
+		"p2": {{PlayerID: "p2", TeamID: "new", TournamentID: "t2"}},
+	}
+
+	got := diffLinks(before, after)
+	assertTypes(t, got,
+		"p1:"+domain.EventTournamentJoined,
+		"p1:"+domain.EventTeamTransfer,
+		"p1:"+domain.EventTournamentJoined,
+		"p2:"+domain.EventTournamentJoined,
+	)
+
+	transfer := got[1].EventData().(domain.TeamChange)
+	if transfer.FromTeamID != "last" || transfer.ToTeamID != "new" || transfer.TournamentID != "t2" {
+		t.Errorf("transfer = %+v", transfer)
+	}
+}
+
+func TestDiffStats(t *testing.T) {
+	k1 := StatKey{PlayerID: "p1", TournamentID: "t1"}
+	k2 := StatKey{PlayerID: "p2", TournamentID: "t1"}
+	before := map[StatKey]statState{
+		k1: {Games: 3, Goals: 1, Points: 1},
+		k2: {Games: 2},
+	}
+	after := map[StatKey]statState{
+		k1: {Games: 4, Goals: 3, Assists: 1, Points: 4},
+		k2: {Games: 2},
+	}
+
+	got := diffStats(before, after)
+	assertTypes(t, got, "p1:"+domain.EventStatsDelta)
+
+	delta := got[0].EventData().(domain.StatsDelta).Delta
+	want := domain.StatLine{Games: 1, Goals: 2, Assists: 1, Points: 3}
+	if delta != want {
+		t.Errorf("delta = %+v, want %+v", delta, want)
+	}
+}
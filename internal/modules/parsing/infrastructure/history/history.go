@@ -0,0 +1,141 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/snapshots"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/store"
+	"github.com/jmoiron/sqlx"
+)
+
+// enabled запись истории игроков в player_events (флаг USE_EVENT_SOURCING)
+var enabled atomic.Bool
+
+// SetEnabled включает или выключает запись истории
+func SetEnabled(on bool) {
+	enabled.Store(on)
+}
+
+// Enabled сообщает, пишется ли история
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// SaveFunc сохранение данных парсера; выполняется в транзакции записи истории
+type SaveFunc func(q sqlx.ExtContext) error
+
+// StatKey статистика игрока в турнире
+type StatKey struct {
+	PlayerID     string `db:"player_id"`
+	TournamentID string `db:"tournament_id"`
+}
+
+// Players сохраняет игроков и записывает изменения их профилей
+// (новый игрок, смена амплуа, рост и вес)
+func Players(ctx context.Context, db *sqlx.DB, playerIDs []string, save SaveFunc) error {
+	ids := unique(playerIDs)
+	return track(ctx, db, save,
+		func(q sqlx.QueryerContext) (map[string]playerState, error) { return loadPlayers(ctx, q, ids) },
+		diffPlayers,
+	)
+}
+
+// PlayerTeams сохраняет связи игроков с командами и записывает переходы
+// в другую команду и появление в новых турнирах
+func PlayerTeams(ctx context.Context, db *sqlx.DB, playerIDs []string, save SaveFunc) error {
+	ids := unique(playerIDs)
+	return track(ctx, db, save,
+		func(q sqlx.QueryerContext) (map[string][]link, error) { return loadLinks(ctx, q, ids) },
+		diffLinks,
+	)
+}
+
+// Statistics сохраняет статистику, обновляет её версии и записывает изменение
+// по каждому игроку в турнире
+func Statistics(ctx context.Context, db *sqlx.DB, keys []StatKey, save SaveFunc) error {
+	keys = uniqueKeys(keys)
+	versionKeys := make([]snapshots.Key, len(keys))
+	for i, k := range keys {
+		versionKeys[i] = snapshots.Key{TournamentID: k.TournamentID, EntityID: k.PlayerID}
+	}
+
+	saveVersioned := func(q sqlx.ExtContext) error {
+		if err := save(q); err != nil {
+			return err
+		}
+		return snapshots.Statistics(ctx, q, versionKeys)
+	}
+	return track(ctx, db, saveVersioned,
+		func(q sqlx.QueryerContext) (map[StatKey]statState, error) { return loadStats(ctx, q, keys) },
+		diffStats,
+	)
+}
+
+// track выполняет save в транзакции. Если история включена, состояние до и после
+// сохранения сравнивается и изменения пишутся в player_events в той же транзакции
+func track[S any](
+	ctx context.Context,
+	db *sqlx.DB,
+	save SaveFunc,
+	load func(q sqlx.QueryerContext) (S, error),
+	diff func(before, after S) []events.Event,
+) error {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if !Enabled() {
+		if err := save(tx); err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+
+	before, err := load(tx)
+	if err != nil {
+		return fmt.Errorf("load history state: %w", err)
+	}
+	if err := save(tx); err != nil {
+		return err
+	}
+	after, err := load(tx)
+	if err != nil {
+		return fmt.Errorf("load history state: %w", err)
+	}
+
+	if err := store.Append(ctx, tx, diff(before, after)...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func unique(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func uniqueKeys(keys []StatKey) []StatKey {
+	seen := make(map[StatKey]bool, len(keys))
+	result := make([]StatKey, 0, len(keys))
+	for _, k := range keys {
+		if !seen[k] {
+			seen[k] = true
+			result = append(result, k)
+		}
+	}
+	return result
+}
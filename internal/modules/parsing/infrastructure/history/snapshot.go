@@ -0,0 +1,109 @@
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// playerState поля профиля, изменения которых попадают в историю
+type playerState struct {
+	ID       string  `db:"id"`
+	Source   string  `db:"source"`
+	Name     string  `db:"name"`
+	Position *string `db:"position"`
+	Height   *int    `db:"height"`
+	Weight   *int    `db:"weight"`
+}
+
+// link связь игрока с командой в турнире
+type link struct {
+	PlayerID     string    `db:"player_id"`
+	TeamID       string    `db:"team_id"`
+	TournamentID string    `db:"tournament_id"`
+	Source       string    `db:"source"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+// statState итоговая статистика игрока в турнире (по всем командам и группам)
+type statState struct {
+	PlayerID       string `db:"player_id"`
+	TournamentID   string `db:"tournament_id"`
+	Source         string `db:"source"`
+	Games          int    `db:"games"`
+	Goals          int    `db:"goals"`
+	Assists        int    `db:"assists"`
+	Points         int    `db:"points"`
+	PenaltyMinutes int    `db:"penalty_minutes"`
+}
+
+func loadPlayers(ctx context.Context, q sqlx.QueryerContext, ids []string) (map[string]playerState, error) {
+	var rows []playerState
+	err := sqlx.SelectContext(ctx, q, &rows, `
+		SELECT id, source, name, position, height, weight
+		FROM players WHERE id = ANY($1)`,
+		pq.Array(ids),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]playerState, len(rows))
+	for _, row := range rows {
+		result[row.ID] = row
+	}
+	return result, nil
+}
+
+func loadLinks(ctx context.Context, q sqlx.QueryerContext, playerIDs []string) (map[string][]link, error) {
+	var rows []link
+	err := sqlx.SelectContext(ctx, q, &rows, `
+		SELECT player_id, team_id, tournament_id, COALESCE(source, '') AS source,
+		       COALESCE(updated_at, created_at, NOW()) AS updated_at
+		FROM player_teams WHERE player_id = ANY($1)`,
+		pq.Array(playerIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]link, len(playerIDs))
+	for _, row := range rows {
+		result[row.PlayerID] = append(result[row.PlayerID], row)
+	}
+	return result, nil
+}
+
+func loadStats(ctx context.Context, q sqlx.QueryerContext, keys []StatKey) (map[StatKey]statState, error) {
+	playerIDs := make([]string, len(keys))
+	tournamentIDs := make([]string, len(keys))
+	for i, k := range keys {
+		playerIDs[i] = k.PlayerID
+		tournamentIDs[i] = k.TournamentID
+	}
+
+	var rows []statState
+	err := sqlx.SelectContext(ctx, q, &rows, `
+		SELECT s.player_id, s.tournament_id, COALESCE(MAX(p.source), '') AS source,
+		       COALESCE(SUM(s.games), 0) AS games, COALESCE(SUM(s.goals), 0) AS goals,
+		       COALESCE(SUM(s.assists), 0) AS assists, COALESCE(SUM(s.points), 0) AS points,
+		       COALESCE(SUM(s.penalty_minutes), 0) AS penalty_minutes
+		FROM player_statistics s
+		JOIN unnest($1::text[], $2::text[]) AS k(player_id, tournament_id)
+		  ON k.player_id = s.player_id AND k.tournament_id = s.tournament_id
+		LEFT JOIN players p ON p.id = s.player_id
+		GROUP BY s.player_id, s.tournament_id`,
+		pq.Array(playerIDs), pq.Array(tournamentIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[StatKey]statState, len(rows))
+	for _, row := range rows {
+		result[StatKey{PlayerID: row.PlayerID, TournamentID: row.TournamentID}] = row
+	}
+	return result, nil
+}
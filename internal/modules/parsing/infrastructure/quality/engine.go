@@ -0,0 +1,125 @@
+package quality
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// RuleResult итог проверки одного правила
+type RuleResult struct {
+	Rule       Rule
+	Violations []Violation
+	New        int // нарушений, впервые найденных (или открытых повторно) в этом прогоне
+	Err        error
+}
+
+// Report итог прогона всех правил
+type Report struct {
+	Results []RuleResult
+}
+
+// Count возвращает число нарушений правил указанной серьёзности
+func (r Report) Count(severity Severity) int {
+	n := 0
+	for _, res := range r.Results {
+		if res.Rule.Severity == severity {
+			n += len(res.Violations)
+		}
+	}
+	return n
+}
+
+// FailedRules возвращает имена правил указанной серьёзности, у которых есть нарушения
+func (r Report) FailedRules(severity Severity) []string {
+	var names []string
+	for _, res := range r.Results {
+		if res.Rule.Severity == severity && len(res.Violations) > 0 {
+			names = append(names, res.Rule.Name)
+		}
+	}
+	return names
+}
+
+// NewRules возвращает имена правил указанной серьёзности с новыми нарушениями
+func (r Report) NewRules(severity Severity) []string {
+	var names []string
+	for _, res := range r.Results {
+		if res.Rule.Severity == severity && res.New > 0 {
+			names = append(names, res.Rule.Name)
+		}
+	}
+	return names
+}
+
+// Engine выполняет правила и сохраняет нарушения в quality_violations
+type Engine struct {
+	db       *sqlx.DB
+	registry *Registry
+}
+
+// NewEngine создаёт движок проверок с набором правил
+func NewEngine(db *sqlx.DB, registry *Registry) *Engine {
+	return &Engine{db: db, registry: registry}
+}
+
+// Run выполняет все правила для строк, изменённых начиная с since; нулевой since - полная
+// проверка таблиц. Ошибка правила не прерывает прогон и попадает в RuleResult.Err;
+// нарушения правила, которое не удалось выполнить, не пересохраняются
+func (e *Engine) Run(ctx context.Context, runID, jobName string, since time.Time) Report {
+	var from interface{}
+	if !since.IsZero() {
+		from = since
+	}
+
+	var report Report
+	for _, rule := range e.registry.Rules() {
+		res := RuleResult{Rule: rule}
+		res.Violations, res.Err = e.evaluate(ctx, rule, from)
+		if res.Err == nil {
+			res.New, res.Err = e.store(ctx, rule, res.Violations, runID, jobName, since.IsZero())
+		}
+		if res.Err != nil {
+			res.Err = fmt.Errorf("rule %s: %w", rule.Name, res.Err)
+		}
+		report.Results = append(report.Results, res)
+	}
+	return report
+}
+
+func (e *Engine) evaluate(ctx context.Context, rule Rule, since interface{}) ([]Violation, error) {
+	if rule.SQL != "" {
+		var rows []struct {
+			EntityID string `db:"entity_id"`
+			Details  string `db:"details"`
+		}
+		if err := e.db.SelectContext(ctx, &rows, rule.SQL, since); err != nil {
+			return nil, err
+		}
+		result := make([]Violation, len(rows))
+		for i, r := range rows {
+			result[i] = Violation{EntityID: r.EntityID, Details: r.Details}
+		}
+		return result, nil
+	}
+
+	rows, err := e.db.QueryxContext(ctx, rule.Query, since)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []Violation
+	for rows.Next() {
+		row := make(Row)
+		if err := rows.MapScan(row); err != nil {
+			return nil, err
+		}
+		if details := rule.Check(row); details != "" {
+			result = append(result, Violation{EntityID: row.String("entity_id"), Details: details})
+		}
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,41 @@
+package quality
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// Check проверяет строки, изменённые задачей планировщика с начала её запуска since, и
+// возвращает имена критических правил с новыми нарушениями: старые нарушения задачу не
+// блокируют. Ошибки отдельных правил объединяются в err, остальные правила при этом проверены
+func (e *Engine) Check(ctx context.Context, runID, jobName string, since time.Time) ([]string, error) {
+	report := e.Run(ctx, runID, jobName, since)
+	return report.NewRules(SeverityCritical), summarize(ctx, report, jobName)
+}
+
+// Scan полная проверка таблиц: находит нарушения в строках, которые задачи давно не меняли,
+// и закрывает исправленные
+func (e *Engine) Scan(ctx context.Context, runID, jobName string) error {
+	return summarize(ctx, e.Run(ctx, runID, jobName, time.Time{}), jobName)
+}
+
+// summarize пишет итог прогона в лог и объединяет ошибки правил
+func summarize(ctx context.Context, report Report, jobName string) error {
+	var errs []error
+	newCount := 0
+	for _, res := range report.Results {
+		if res.Err != nil {
+			errs = append(errs, res.Err)
+		}
+		newCount += res.New
+	}
+
+	logger.Info(ctx, fmt.Sprintf("Data quality after %s: critical %d, warning %d, info %d, new %d",
+		jobName, report.Count(SeverityCritical), report.Count(SeverityWarning), report.Count(SeverityInfo), newCount))
+
+	return errors.Join(errs...)
+}
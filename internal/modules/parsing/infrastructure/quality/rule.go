@@ -0,0 +1,135 @@
+package quality
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Severity серьёзность нарушения
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical" // блокирует зависимые задачи планировщика
+)
+
+// Row строка выборки Go-правила: колонка -> значение
+type Row map[string]interface{}
+
+// Rule правило качества данных сущности.
+//
+// SQL-правило задаёт SQL - запрос, возвращающий нарушителей колонками entity_id и details.
+// Go-правило задаёт Query, выбирающий строки-кандидаты с колонкой entity_id, и Check,
+// возвращающий описание нарушения или пустую строку, если строка корректна.
+//
+// Оба запроса получают параметр $1 - начало запуска задачи - и проверяют только строки,
+// изменённые с этого момента; при полной проверке $1 равен NULL.
+type Rule struct {
+	Name        string
+	Entity      string // таблица проверяемой сущности
+	Severity    Severity
+	Description string
+
+	SQL string
+
+	Query string
+	Check func(row Row) string
+}
+
+// Violation нарушение правила одной строкой сущности
+type Violation struct {
+	EntityID string
+	Details  string
+}
+
+// Validate проверяет, что правило задано одним из способов
+func (r Rule) Validate() error {
+	if r.Name == "" || r.Entity == "" {
+		return fmt.Errorf("quality rule: name and entity are required")
+	}
+	switch r.Severity {
+	case SeverityInfo, SeverityWarning, SeverityCritical:
+	default:
+		return fmt.Errorf("quality rule %s: unknown severity %q", r.Name, r.Severity)
+	}
+	if (r.SQL == "") == (r.Query == "" || r.Check == nil) {
+		return fmt.Errorf("quality rule %s: either SQL or Query with Check is required", r.Name)
+	}
+	return nil
+}
+
+// Registry набор правил по сущностям
+type Registry struct {
+	mu    sync.RWMutex
+	rules map[string]Rule
+}
+
+// NewRegistry создаёт пустой набор правил
+func NewRegistry() *Registry {
+	return &Registry{rules: make(map[string]Rule)}
+}
+
+// Register добавляет правило; правило с тем же именем заменяется
+func (r *Registry) Register(rule Rule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules[rule.Name] = rule
+	return nil
+}
+
+// Rules возвращает правила, упорядоченные по сущности и имени
+func (r *Registry) Rules() []Rule {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]Rule, 0, len(r.rules))
+	for _, rule := range r.rules {
+		result = append(result, rule)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Entity != result[j].Entity {
+			return result[i].Entity < result[j].Entity
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// Int значение целочисленной колонки, false если оно NULL или не число
+func (r Row) Int(col string) (int64, bool) {
+	switch v := r[col].(type) {
+	case int64:
+		return v, true
+	case int32:
+		return int64(v), true
+	case int:
+		return int64(v), true
+	case []byte:
+		n, err := strconv.ParseInt(string(v), 10, 64)
+		return n, err == nil
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// String значение текстовой колонки, пустая строка для NULL
+func (r Row) String(col string) string {
+	switch v := r[col].(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
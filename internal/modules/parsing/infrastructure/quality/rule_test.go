@@ -0,0 +1,98 @@
+package quality
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	reg := NewRegistry()
+	for _, rule := range DefaultRules() {
+		if err := reg.Register(rule); err != nil {
+			t.Fatalf("register %s: %v", rule.Name, err)
+		}
+	}
+
+	invalid := []Rule{
+		{Name: "no_entity", Severity: SeverityWarning, SQL: "SELECT 1"},
+		{Name: "bad_severity", Entity: "players", Severity: "fatal", SQL: "SELECT 1"},
+		{Name: "no_predicate", Entity: "players", Severity: SeverityInfo},
+		{Name: "both", Entity: "players", Severity: SeverityInfo, SQL: "SELECT 1", Query: "SELECT 1", Check: checkMeasurements},
+		{Name: "query_without_check", Entity: "players", Severity: SeverityInfo, Query: "SELECT 1"},
+	}
+	for _, rule := range invalid {
+		if err := reg.Register(rule); err == nil {
+			t.Errorf("rule %s registered, want error", rule.Name)
+		}
+	}
+
+	rules := reg.Rules()
+	if len(rules) != len(DefaultRules()) {
+		t.Fatalf("rules = %d, want %d", len(rules), len(DefaultRules()))
+	}
+	for i := 1; i < len(rules); i++ {
+		prev, cur := rules[i-1], rules[i]
+		if prev.Entity > cur.Entity || (prev.Entity == cur.Entity && prev.Name > cur.Name) {
+			t.Errorf("rules not sorted: %s/%s before %s/%s", prev.Entity, prev.Name, cur.Entity, cur.Name)
+		}
+	}
+}
+
+func TestCheckMeasurements(t *testing.T) {
+	tests := []struct {
+		row  Row
+		want string
+	}{
+		{Row{"height": int64(180), "weight": int64(75)}, ""},
+		{Row{"height": nil, "weight": nil}, ""},
+		{Row{"height": int64(300), "weight": int64(75)}, "height=300"},
+		{Row{"height": []byte("170"), "weight": []byte("400")}, "weight=400"},
+		{Row{"height": int64(50)}, "height=50"},
+	}
+	for _, tt := range tests {
+		if got := checkMeasurements(tt.row); got != tt.want {
+			t.Errorf("checkMeasurements(%v) = %q, want %q", tt.row, got, tt.want)
+		}
+	}
+}
+
+func TestReport(t *testing.T) {
+	report := Report{Results: []RuleResult{
+		{Rule: Rule{Name: "a", Severity: SeverityCritical}, Violations: []Violation{{EntityID: "1"}, {EntityID: "2"}}},
+		{Rule: Rule{Name: "b", Severity: SeverityCritical}},
+		{Rule: Rule{Name: "c", Severity: SeverityWarning}, Violations: []Violation{{EntityID: "3"}}},
+	}}
+
+	if got := report.Count(SeverityCritical); got != 2 {
+		t.Errorf("critical = %d, want 2", got)
+	}
+	if got := report.FailedRules(SeverityCritical); !reflect.DeepEqual(got, []string{"a"}) {
+		t.Errorf("failed critical = %v, want [a]", got)
+	}
+
+	report.Results[0].New = 0
+	report.Results[2].New = 1
+	if got := report.NewRules(SeverityCritical); got != nil {
+		t.Errorf("new critical = %v, want none", got)
+	}
+	if got := report.NewRules(SeverityWarning); !reflect.DeepEqual(got, []string{"c"}) {
+		t.Errorf("new warning = %v, want [c]", got)
+	}
+}
+
+func TestDefaultRulesScopedBySince(t *testing.T) {
+	for _, rule := range DefaultRules() {
+		query := rule.SQL + rule.Query
+		if !strings.Contains(query, "$1::timestamptz IS NULL") {
+			t.Errorf("rule %s does not limit rows by $1", rule.Name)
+		}
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	ids, details := dedupe([]Violation{{"1", "x"}, {"2", "y"}, {"1", "z"}})
+	if !reflect.DeepEqual(ids, []string{"1", "2"}) || !reflect.DeepEqual(details, []string{"x", "y"}) {
+		t.Errorf("dedupe = %v %v", ids, details)
+	}
+}
@@ -0,0 +1,109 @@
+package quality
+
+import (
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+)
+
+// Правдоподобные антропометрические данные игрока
+const (
+	minHeight = 90
+	maxHeight = 230
+	minWeight = 15
+	maxWeight = 160
+)
+
+// DefaultRules правила, найденные по реальным ошибкам парсеров. Составы матчей и
+// турнирные таблицы проверяются целиком по матчам и турнирам, затронутым запуском:
+// дубль может появиться из строки, которую задача не меняла
+func DefaultRules() []Rule {
+	return []Rule{
+		{
+			Name:        "stats_birth_year_range",
+			Entity:      "player_statistics",
+			Severity:    SeverityWarning,
+			Description: "Год рождения вне диапазона, допустимого PlayerStatistic.Validate",
+			SQL: fmt.Sprintf(`
+				SELECT id::text AS entity_id, 'birth_year=' || birth_year AS details
+				FROM player_statistics
+				WHERE birth_year <> 0 AND birth_year NOT BETWEEN %d AND %d
+				  AND ($1::timestamptz IS NULL OR updated_at >= $1)`,
+				entities.MinStatBirthYear, entities.MaxStatBirthYear),
+		},
+		{
+			Name:        "stats_points_sum",
+			Entity:      "player_statistics",
+			Severity:    SeverityCritical,
+			Description: "Очки не равны сумме голов и передач",
+			SQL: `
+				SELECT id::text AS entity_id,
+				       format('points=%s goals=%s assists=%s', points, goals, assists) AS details
+				FROM player_statistics
+				WHERE points <> goals + assists
+				  AND ($1::timestamptz IS NULL OR updated_at >= $1)`,
+		},
+		{
+			Name:        "standings_duplicate_team",
+			Entity:      "team_standings",
+			Severity:    SeverityCritical,
+			Description: "У команды несколько строк в одной турнирной таблице",
+			SQL: `
+				SELECT tournament_id || ':' || team_id || ':' || COALESCE(group_name, '') || ':' || COALESCE(birth_year, 0) AS entity_id,
+				       format('rows=%s sources=%s', COUNT(*), string_agg(source, ',' ORDER BY source)) AS details
+				FROM team_standings
+				WHERE $1::timestamptz IS NULL
+				   OR tournament_id IN (SELECT tournament_id FROM team_standings WHERE updated_at >= $1)
+				GROUP BY tournament_id, team_id, COALESCE(group_name, ''), COALESCE(birth_year, 0)
+				HAVING COUNT(*) > 1`,
+		},
+		{
+			Name:        "match_score_without_goals",
+			Entity:      "matches",
+			Severity:    SeverityWarning,
+			Description: "В разобранном матче есть счёт, но нет голов в протоколе",
+			SQL: `
+				SELECT m.id AS entity_id, format('score=%s:%s', m.home_score, m.away_score) AS details
+				FROM matches m
+				WHERE m.details_parsed AND COALESCE(m.home_score, 0) + COALESCE(m.away_score, 0) > 0
+				  AND ($1::timestamptz IS NULL OR m.updated_at >= $1)
+				  AND NOT EXISTS (SELECT 1 FROM match_events e WHERE e.match_id = m.id AND e.event_type = 'goal')`,
+		},
+		{
+			Name:        "lineup_duplicate_jersey",
+			Entity:      "match_lineups",
+			Severity:    SeverityWarning,
+			Description: "Два игрока команды в составе матча с одним номером",
+			SQL: `
+				SELECT match_id || ':' || team_id || ':' || jersey_number AS entity_id,
+				       format('jersey=%s players=%s', jersey_number, string_agg(player_id, ',' ORDER BY player_id)) AS details
+				FROM match_lineups
+				WHERE jersey_number IS NOT NULL
+				  AND ($1::timestamptz IS NULL OR match_id IN (SELECT id FROM matches WHERE updated_at >= $1))
+				GROUP BY match_id, team_id, jersey_number
+				HAVING COUNT(*) > 1`,
+		},
+		{
+			Name:        "player_measurements_range",
+			Entity:      "players",
+			Severity:    SeverityWarning,
+			Description: "Неправдоподобные рост или вес игрока",
+			Query: `
+				SELECT id AS entity_id, height, weight FROM players
+				WHERE (height IS NOT NULL OR weight IS NOT NULL)
+				  AND ($1::timestamptz IS NULL OR updated_at >= $1)`,
+			Check: checkMeasurements,
+		},
+	}
+}
+
+// checkMeasurements рост и вес игрока в правдоподобных пределах
+func checkMeasurements(row Row) string {
+	if h, ok := row.Int("height"); ok && (h < minHeight || h > maxHeight) {
+		return fmt.Sprintf("height=%d", h)
+	}
+	if w, ok := row.Int("weight"); ok && (w < minWeight || w > maxWeight) {
+		return fmt.Sprintf("weight=%d", w)
+	}
+	return ""
+}
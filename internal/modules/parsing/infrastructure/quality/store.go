@@ -0,0 +1,89 @@
+package quality
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// store сохраняет нарушения правила: новые открываются, повторные продлевают last_seen_at.
+// Открытые нарушения, которых больше нет, закрываются resolved_at только после полной
+// проверки: проверка строк одного запуска не видит остальных. Возвращает число новых нарушений
+func (e *Engine) store(ctx context.Context, rule Rule, violations []Violation, runID, jobName string, full bool) (int, error) {
+	ids, details := dedupe(violations)
+
+	var run interface{}
+	if runID != "" {
+		run = runID
+	}
+
+	tx, err := e.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	created := 0
+	if len(ids) > 0 {
+		if created, err = upsertViolations(ctx, tx, rule, ids, details, run, jobName); err != nil {
+			return 0, err
+		}
+	}
+
+	if !full {
+		return created, tx.Commit()
+	}
+	_, err = tx.ExecContext(ctx, `
+		UPDATE quality_violations SET resolved_at = NOW()
+		WHERE rule = $1 AND resolved_at IS NULL AND NOT (entity_id = ANY($2::text[]))`,
+		rule.Name, pq.Array(ids),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return created, tx.Commit()
+}
+
+// upsertViolations сохраняет нарушения и возвращает число новых: у новых и открытых
+// повторно first_seen_at и last_seen_at равны времени транзакции
+func upsertViolations(ctx context.Context, tx *sqlx.Tx, rule Rule, ids, details []string, run interface{}, jobName string) (int, error) {
+	var created int
+	err := tx.GetContext(ctx, &created, `
+		WITH upserted AS (
+			INSERT INTO quality_violations (rule, entity, entity_id, severity, details, job_name, run_id)
+			SELECT $1, $2, v.entity_id, $3, v.details, $4, $5::uuid
+			FROM unnest($6::text[], $7::text[]) AS v(entity_id, details)
+			ON CONFLICT (rule, entity_id) DO UPDATE SET
+				entity = EXCLUDED.entity,
+				severity = EXCLUDED.severity,
+				details = EXCLUDED.details,
+				job_name = EXCLUDED.job_name,
+				run_id = EXCLUDED.run_id,
+				first_seen_at = CASE WHEN quality_violations.resolved_at IS NULL
+					THEN quality_violations.first_seen_at ELSE NOW() END,
+				last_seen_at = NOW(),
+				resolved_at = NULL
+			RETURNING first_seen_at = last_seen_at AS new
+		)
+		SELECT COUNT(*) FILTER (WHERE new) FROM upserted`,
+		rule.Name, rule.Entity, string(rule.Severity), jobName, run, pq.Array(ids), pq.Array(details),
+	)
+	return created, err
+}
+
+// dedupe одна запись на строку сущности: повтор в одном INSERT ... ON CONFLICT недопустим
+func dedupe(violations []Violation) (ids, details []string) {
+	ids = make([]string, 0, len(violations))
+	details = make([]string, 0, len(violations))
+	seen := make(map[string]bool, len(violations))
+	for _, v := range violations {
+		if seen[v.EntityID] {
+			continue
+		}
+		seen[v.EntityID] = true
+		ids = append(ids, v.EntityID)
+		details = append(details, v.Details)
+	}
+	return ids, details
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/history"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -52,7 +53,9 @@ func (r *PlayerRepository) Upsert(ctx context.Context, p *Player) (string, error
 		RETURNING id`
 
 	var returnedID string
-	err := r.db.QueryRowContext(ctx, query, id, p.ExternalID, p.FullName, p.ProfileURL, p.BirthDate, p.BirthPlace, p.Position, p.Height, p.Weight, p.Handedness, SourceFHMoscow, RegionMoscow).Scan(&returnedID)
+	err := history.Players(ctx, r.db, []string{id}, func(q sqlx.ExtContext) error {
+		return q.QueryRowxContext(ctx, query, id, p.ExternalID, p.FullName, p.ProfileURL, p.BirthDate, p.BirthPlace, p.Position, p.Height, p.Weight, p.Handedness, SourceFHMoscow, RegionMoscow).Scan(&returnedID)
+	})
 	return returnedID, err
 }
 
@@ -65,3 +68,19 @@ func (r *PlayerRepository) GetByExternalID(ctx context.Context, externalID strin
 	}
 	return &p, nil
 }
+
+// EnsureExists создаёт игрока из протокола, если его ещё нет, не трогая существующую запись
+func (r *PlayerRepository) EnsureExists(ctx context.Context, p *Player) (string, error) {
+	id := fmt.Sprintf("fhm:%s", p.ExternalID)
+
+	query := `
+		INSERT INTO players (id, external_id, name, profile_url, birth_date, position, source, region, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		ON CONFLICT DO NOTHING`
+
+	err := history.Players(ctx, r.db, []string{id}, func(q sqlx.ExtContext) error {
+		_, err := q.ExecContext(ctx, query, id, p.ExternalID, p.FullName, p.ProfileURL, p.BirthDate, p.Position, SourceFHMoscow, RegionMoscow)
+		return err
+	})
+	return id, err
+}
@@ -0,0 +1,130 @@
+package fhmoscow
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Статусы ID во фронтире обхода игроков
+const (
+	FrontierPending = "pending" // ждёт запроса профиля
+	FrontierFetched = "fetched" // профиль сохранён
+	FrontierSkipped = "skipped" // игрок старше MinBirthYear
+	FrontierMissing = "missing" // профиля нет, больше не запрашиваем
+)
+
+// Откуда ID попал во фронтир
+const (
+	DiscoveredFromRoster = "roster"
+	DiscoveredFromLineup = "lineup"
+	DiscoveredFromKnown  = "known"
+	DiscoveredFromProbe  = "probe"
+)
+
+// PlayerFrontierRepository фронтир обхода профилей игроков fhmoscow.com
+type PlayerFrontierRepository struct {
+	db *sqlx.DB
+}
+
+func NewPlayerFrontierRepository(db *sqlx.DB) *PlayerFrontierRepository {
+	return &PlayerFrontierRepository{db: db}
+}
+
+// SeedKnown добавляет уже сохранённых игроков как обработанные
+// Вызывается после DiscoverFromLineups, чтобы игроки из протоколов остались в очереди
+func (r *PlayerFrontierRepository) SeedKnown(ctx context.Context) (int64, error) {
+	query := `
+		INSERT INTO player_crawl_frontier (source, external_id, status, discovered_from, checked_at)
+		SELECT $1, external_id::int, $2, $3, updated_at
+		FROM players
+		WHERE source = $1 AND external_id ~ '^[0-9]+$'
+		ON CONFLICT DO NOTHING`
+	return r.exec(ctx, query, SourceFHMoscow, FrontierFetched, DiscoveredFromKnown)
+}
+
+// DiscoverFromLineups добавляет игроков из составов матчей, которых ещё нет во фронтире
+// Календарь создаёт таких игроков только по данным протокола, без профиля
+func (r *PlayerFrontierRepository) DiscoverFromLineups(ctx context.Context) (int64, error) {
+	query := `
+		INSERT INTO player_crawl_frontier (source, external_id, status, discovered_from)
+		SELECT DISTINCT $1, substring(player_id from 5)::int, $2, $3
+		FROM match_lineups
+		WHERE source = $1 AND player_id ~ '^fhm:[0-9]+$'
+		ON CONFLICT DO NOTHING`
+	return r.exec(ctx, query, SourceFHMoscow, FrontierPending, DiscoveredFromLineup)
+}
+
+// Discover добавляет новые ID в очередь обхода, известные ID не меняются
+func (r *PlayerFrontierRepository) Discover(ctx context.Context, ids []int, from string) (int64, error) {
+	query := `
+		INSERT INTO player_crawl_frontier (source, external_id, status, discovered_from)
+		SELECT $1, id, $2, $3 FROM unnest($4::int[]) AS id
+		ON CONFLICT DO NOTHING`
+	return r.exec(ctx, query, SourceFHMoscow, FrontierPending, from, pq.Array(ids))
+}
+
+// RequeueStale возвращает в очередь сохранённые профили, проверенные раньше olderThan назад,
+// чтобы обход обновил данные игроков
+func (r *PlayerFrontierRepository) RequeueStale(ctx context.Context, olderThan time.Duration) (int64, error) {
+	query := `
+		UPDATE player_crawl_frontier
+		SET status = $2, attempts = 0, last_error = NULL, updated_at = NOW()
+		WHERE source = $1 AND status = $3
+			AND (checked_at IS NULL OR checked_at < NOW() - make_interval(secs => $4))`
+	return r.exec(ctx, query, SourceFHMoscow, FrontierPending, FrontierFetched, olderThan.Seconds())
+}
+
+// Pending возвращает ID после afterID, ожидающие запроса профиля
+func (r *PlayerFrontierRepository) Pending(ctx context.Context, afterID, limit, maxAttempts int) ([]int, error) {
+	var ids []int
+	query := `
+		SELECT external_id FROM player_crawl_frontier
+		WHERE source = $1 AND status = $2 AND attempts < $3 AND external_id > $4
+		ORDER BY external_id
+		LIMIT $5`
+	err := r.db.SelectContext(ctx, &ids, query, SourceFHMoscow, FrontierPending, maxAttempts, afterID, limit)
+	return ids, err
+}
+
+// MaxKnownID возвращает наибольший ID существующего профиля
+func (r *PlayerFrontierRepository) MaxKnownID(ctx context.Context) (int, error) {
+	var maxID int
+	query := `SELECT COALESCE(MAX(external_id), 0) FROM player_crawl_frontier WHERE source = $1 AND status <> $2`
+	err := r.db.GetContext(ctx, &maxID, query, SourceFHMoscow, FrontierMissing)
+	return maxID, err
+}
+
+// Mark сохраняет результат запроса профилей
+func (r *PlayerFrontierRepository) Mark(ctx context.Context, ids []int, status, from string) error {
+	query := `
+		INSERT INTO player_crawl_frontier (source, external_id, status, discovered_from, checked_at)
+		SELECT $1, id, $2, $3, NOW() FROM unnest($4::int[]) AS id
+		ON CONFLICT (source, external_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			last_error = NULL,
+			checked_at = NOW(),
+			updated_at = NOW()`
+	_, err := r.exec(ctx, query, SourceFHMoscow, status, from, pq.Array(ids))
+	return err
+}
+
+// MarkFailed считает неудачную попытку, ID остаётся в очереди до исчерпания попыток
+func (r *PlayerFrontierRepository) MarkFailed(ctx context.Context, id int, cause error) error {
+	query := `
+		UPDATE player_crawl_frontier
+		SET attempts = attempts + 1, last_error = $3, checked_at = NOW(), updated_at = NOW()
+		WHERE source = $1 AND external_id = $2`
+	_, err := r.exec(ctx, query, SourceFHMoscow, id, cause.Error())
+	return err
+}
+
+func (r *PlayerFrontierRepository) exec(ctx context.Context, query string, args ...interface{}) (int64, error) {
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
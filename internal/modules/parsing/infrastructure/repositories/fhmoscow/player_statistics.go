@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/history"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -60,30 +61,33 @@ func (r *PlayerStatisticsRepository) Upsert(ctx context.Context, s *PlayerStatis
 			WHERE player_id = $1 AND team_id = $2 AND tournament_id = $3 AND group_name IS NULL
 		)`
 
-	result, err := r.db.ExecContext(ctx, query,
-		s.PlayerID, s.TeamID, s.TournamentID,
-		s.Games, s.Goals, s.Assists, s.Points, s.PenaltyMinutes,
-		goalsPP, goalsSH, goalsES,
-	)
-	if err != nil {
-		return err
-	}
-
-	rowsAffected, _ := result.RowsAffected()
-	if rowsAffected == 0 {
-		updateQuery := `
-			UPDATE player_statistics SET
-				games = $4, goals = $5, assists = $6, points = $7, penalty_minutes = $8,
-				goals_power_play = $9, goals_short_handed = $10, goals_even_strength = $11,
-				updated_at = NOW()
-			WHERE player_id = $1 AND team_id = $2 AND tournament_id = $3 AND group_name IS NULL`
-
-		_, err = r.db.ExecContext(ctx, updateQuery,
+	key := history.StatKey{PlayerID: s.PlayerID, TournamentID: s.TournamentID}
+	return history.Statistics(ctx, r.db, []history.StatKey{key}, func(q sqlx.ExtContext) error {
+		result, err := q.ExecContext(ctx, query,
 			s.PlayerID, s.TeamID, s.TournamentID,
 			s.Games, s.Goals, s.Assists, s.Points, s.PenaltyMinutes,
 			goalsPP, goalsSH, goalsES,
 		)
-	}
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, _ := result.RowsAffected()
+		if rowsAffected == 0 {
+			updateQuery := `
+				UPDATE player_statistics SET
+					games = $4, goals = $5, assists = $6, points = $7, penalty_minutes = $8,
+					goals_power_play = $9, goals_short_handed = $10, goals_even_strength = $11,
+					updated_at = NOW()
+				WHERE player_id = $1 AND team_id = $2 AND tournament_id = $3 AND group_name IS NULL`
 
-	return err
+			_, err = q.ExecContext(ctx, updateQuery,
+				s.PlayerID, s.TeamID, s.TournamentID,
+				s.Games, s.Goals, s.Assists, s.Points, s.PenaltyMinutes,
+				goalsPP, goalsSH, goalsES,
+			)
+		}
+
+		return err
+	})
 }
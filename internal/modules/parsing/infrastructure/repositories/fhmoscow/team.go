@@ -96,3 +96,17 @@ func (r *TeamRepository) FindByNameAndTournamentName(ctx context.Context, teamNa
 	}
 	return &t, nil
 }
+
+// EnsureExists создаёт команду, если её ещё нет, не трогая существующую запись
+// Нужна для матчей против команд, которых парсер не встретил в своих турнирах
+func (r *TeamRepository) EnsureExists(ctx context.Context, t *Team) (string, error) {
+	id := fmt.Sprintf("fhm:%s", t.ExternalID)
+
+	query := `
+		INSERT INTO teams (id, external_id, tournament_id, name, url, city, region, source, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		ON CONFLICT DO NOTHING`
+
+	_, err := r.db.ExecContext(ctx, query, id, t.ExternalID, t.TournamentID, t.Name, t.URL, t.City, RegionMoscow, SourceFHMoscow)
+	return id, err
+}
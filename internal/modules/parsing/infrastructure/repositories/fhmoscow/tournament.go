@@ -65,3 +65,17 @@ func (r *TournamentRepository) GetByExternalID(ctx context.Context, externalID s
 	}
 	return &t, nil
 }
+
+// ListBySeason возвращает турниры сезона с годом рождения не раньше minBirthYear
+func (r *TournamentRepository) ListBySeason(ctx context.Context, season string, minBirthYear int) ([]Tournament, error) {
+	var tournaments []Tournament
+	query := `
+		SELECT id, external_id, url, name, domain, birth_year, group_name, season, start_date, end_date, is_ended, region, created_at
+		FROM tournaments
+		WHERE source = $1 AND season = $2 AND COALESCE(birth_year, 0) >= $3
+		ORDER BY id`
+	if err := r.db.SelectContext(ctx, &tournaments, query, SourceFHMoscow, season, minBirthYear); err != nil {
+		return nil, err
+	}
+	return tournaments, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/history"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -56,7 +57,9 @@ func (r *PlayerRepository) Upsert(ctx context.Context, p *Player) (string, error
 		RETURNING id`
 
 	var returnedID string
-	err := r.db.QueryRowContext(ctx, query, id, p.ExternalID, p.FullName, p.ProfileURL, p.BirthDate, p.BirthPlace, p.Citizenship, p.Position, p.Height, p.Weight, p.Handedness, p.School, SourceFHSPB, RegionSPB).Scan(&returnedID)
+	err := history.Players(ctx, r.db, []string{id}, func(q sqlx.ExtContext) error {
+		return q.QueryRowxContext(ctx, query, id, p.ExternalID, p.FullName, p.ProfileURL, p.BirthDate, p.BirthPlace, p.Citizenship, p.Position, p.Height, p.Weight, p.Handedness, p.School, SourceFHSPB, RegionSPB).Scan(&returnedID)
+	})
 	return returnedID, err
 }
 
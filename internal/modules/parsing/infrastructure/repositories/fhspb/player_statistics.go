@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/history"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -45,27 +46,30 @@ func (r *PlayerStatisticsRepository) Upsert(ctx context.Context, s *PlayerStatis
 			WHERE player_id = $1 AND team_id = $2 AND tournament_id = $3 AND group_name IS NULL
 		)`
 
-	result, err := r.db.ExecContext(ctx, query,
-		s.PlayerID, s.TeamID, s.TournamentID,
-		s.Games, s.Points, s.PointsAvg, s.Goals, s.Assists, s.PlusMinus, s.PenaltyMinutes, s.PenaltyAvg,
-	)
-	if err != nil {
-		return err
-	}
-
-	rowsAffected, _ := result.RowsAffected()
-	if rowsAffected == 0 {
-		updateQuery := `
-			UPDATE player_statistics SET
-				games = $4, points = $5, points_avg = $6, goals = $7, assists = $8,
-				plus_minus = $9, penalty_minutes = $10, penalty_avg = $11, updated_at = NOW()
-			WHERE player_id = $1 AND team_id = $2 AND tournament_id = $3 AND group_name IS NULL`
-
-		_, err = r.db.ExecContext(ctx, updateQuery,
+	key := history.StatKey{PlayerID: s.PlayerID, TournamentID: s.TournamentID}
+	return history.Statistics(ctx, r.db, []history.StatKey{key}, func(q sqlx.ExtContext) error {
+		result, err := q.ExecContext(ctx, query,
 			s.PlayerID, s.TeamID, s.TournamentID,
 			s.Games, s.Points, s.PointsAvg, s.Goals, s.Assists, s.PlusMinus, s.PenaltyMinutes, s.PenaltyAvg,
 		)
-	}
+		if err != nil {
+			return err
+		}
 
-	return err
+		rowsAffected, _ := result.RowsAffected()
+		if rowsAffected == 0 {
+			updateQuery := `
+				UPDATE player_statistics SET
+					games = $4, points = $5, points_avg = $6, goals = $7, assists = $8,
+					plus_minus = $9, penalty_minutes = $10, penalty_avg = $11, updated_at = NOW()
+				WHERE player_id = $1 AND team_id = $2 AND tournament_id = $3 AND group_name IS NULL`
+
+			_, err = q.ExecContext(ctx, updateQuery,
+				s.PlayerID, s.TeamID, s.TournamentID,
+				s.Games, s.Points, s.PointsAvg, s.Goals, s.Assists, s.PlusMinus, s.PenaltyMinutes, s.PenaltyAvg,
+			)
+		}
+
+		return err
+	})
 }
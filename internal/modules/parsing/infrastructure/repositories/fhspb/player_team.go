@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/history"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -45,6 +46,8 @@ func (r *PlayerTeamRepository) Upsert(ctx context.Context, pt *PlayerTeam) error
 			position = EXCLUDED.position,
 			updated_at = NOW()`
 
-	_, err := r.db.ExecContext(ctx, query, pt.PlayerID, pt.TeamID, pt.TournamentID, pt.Season, pt.StartedAt, pt.EndedAt, pt.IsActive, pt.Number, pt.Role, pt.Position, SourceFHSPB)
-	return err
+	return history.PlayerTeams(ctx, r.db, []string{pt.PlayerID}, func(q sqlx.ExtContext) error {
+		_, err := q.ExecContext(ctx, query, pt.PlayerID, pt.TeamID, pt.TournamentID, pt.Season, pt.StartedAt, pt.EndedAt, pt.IsActive, pt.Number, pt.Role, pt.Position, SourceFHSPB)
+		return err
+	})
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/outbox"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -84,8 +85,13 @@ func (r *MatchPostgres) Update(ctx context.Context, m *entities.Match) error {
 	return nil
 }
 
+// Upsert сохраняет матч и в той же транзакции пишет в outbox события о новом, завершённом
+// и исправленном после завершения матче
 func (r *MatchPostgres) Upsert(ctx context.Context, m *entities.Match) error {
 	query := `
+		WITH previous AS (
+			SELECT status, home_score, away_score FROM matches WHERE source = $24 AND external_id = $2
+		)
 		INSERT INTO matches (id, external_id, tournament_id, home_team_id, away_team_id,
 			home_score, away_score, home_score_p1, away_score_p1, home_score_p2, away_score_p2,
 			home_score_p3, away_score_p3, home_score_ot, away_score_ot, match_number,
@@ -106,9 +112,20 @@ func (r *MatchPostgres) Upsert(ctx context.Context, m *entities.Match) error {
 			group_name = COALESCE(EXCLUDED.group_name, matches.group_name),
 			birth_year = COALESCE(EXCLUDED.birth_year, matches.birth_year),
 			video_url = COALESCE(EXCLUDED.video_url, matches.video_url),
-			updated_at = NOW()`
+			updated_at = NOW()
+		RETURNING id, tournament_id, home_team_id, away_team_id, home_score, away_score, status, source,
+			updated_at, (xmax = 0) AS inserted, (SELECT status FROM previous) AS previous_status,
+			(SELECT home_score FROM previous) AS previous_home_score,
+			(SELECT away_score FROM previous) AS previous_away_score`
 
-	_, err := r.db.ExecContext(ctx, query,
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("upsert match: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var saved matchUpsertResult
+	err = tx.GetContext(ctx, &saved, query,
 		m.ID, m.ExternalID, m.TournamentID, m.HomeTeamID, m.AwayTeamID,
 		m.HomeScore, m.AwayScore, m.HomeScoreP1, m.AwayScoreP1, m.HomeScoreP2, m.AwayScoreP2,
 		m.HomeScoreP3, m.AwayScoreP3, m.HomeScoreOT, m.AwayScoreOT, m.MatchNumber,
@@ -118,7 +135,11 @@ func (r *MatchPostgres) Upsert(ctx context.Context, m *entities.Match) error {
 	if err != nil {
 		return fmt.Errorf("upsert match: %w", err)
 	}
-	return nil
+
+	if err := outbox.Append(ctx, tx, saved.events()...); err != nil {
+		return fmt.Errorf("upsert match: %w", err)
+	}
+	return tx.Commit()
 }
 
 func (r *MatchPostgres) GetByID(ctx context.Context, id string) (*entities.Match, error) {
@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
+	eventdomain "github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/outbox"
+)
+
+// matchUpsertResult сохранённый матч и его статус и счёт до сохранения
+type matchUpsertResult struct {
+	ID                string    `db:"id"`
+	TournamentID      *string   `db:"tournament_id"`
+	HomeTeamID        *string   `db:"home_team_id"`
+	AwayTeamID        *string   `db:"away_team_id"`
+	HomeScore         *int      `db:"home_score"`
+	AwayScore         *int      `db:"away_score"`
+	Status            string    `db:"status"`
+	Source            string    `db:"source"`
+	UpdatedAt         time.Time `db:"updated_at"`
+	Inserted          bool      `db:"inserted"`
+	PreviousStatus    *string   `db:"previous_status"`
+	PreviousHomeScore *int      `db:"previous_home_score"`
+	PreviousAwayScore *int      `db:"previous_away_score"`
+}
+
+// events события сохранения матча. Ключи появления и завершения привязаны к матчу,
+// поэтому повторный парсинг календаря не порождает повторных событий. Исправление
+// счёта завершённого матча ключуется временем сохранения: каждое исправление,
+// в том числе возврат к прежнему счёту, порождает своё событие
+func (r matchUpsertResult) events() []events.Event {
+	data := eventdomain.MatchData{
+		MatchID:      r.ID,
+		TournamentID: deref(r.TournamentID),
+		Source:       r.Source,
+		Status:       r.Status,
+		HomeTeamID:   deref(r.HomeTeamID),
+		AwayTeamID:   deref(r.AwayTeamID),
+		HomeScore:    r.HomeScore,
+		AwayScore:    r.AwayScore,
+	}
+
+	var result []events.Event
+	if r.Inserted {
+		result = append(result, outbox.WithKey(eventdomain.NewMatchCreated(data), eventdomain.EventMatchCreated+":"+r.ID))
+	}
+
+	wasFinished := r.PreviousStatus != nil && *r.PreviousStatus == entities.MatchStatusFinished
+	if r.Status == entities.MatchStatusFinished && !wasFinished {
+		result = append(result, outbox.WithKey(eventdomain.NewMatchFinished(data), eventdomain.EventMatchFinished+":"+r.ID))
+	}
+
+	if r.Status == entities.MatchStatusFinished && wasFinished &&
+		(!sameScore(r.HomeScore, r.PreviousHomeScore) || !sameScore(r.AwayScore, r.PreviousAwayScore)) {
+		key := eventdomain.EventMatchUpdated + ":" + r.ID + ":" + strconv.FormatInt(r.UpdatedAt.UnixMicro(), 10)
+		result = append(result, outbox.WithKey(eventdomain.NewMatchUpdated(data), key))
+	}
+	return result
+}
+
+func sameScore(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// statsUpdatedEvents по событию на каждый турнир пачки статистики, строки которого
+// изменились. Ключ - турнир и время транзакции (valid_from новых версий статистики):
+// повторный запуск с той же статистикой событий не порождает, а возврат к прежним
+// значениям после промежуточных - порождает новое событие
+func statsUpdatedEvents(stats []*entities.PlayerStatistic, changed []string, at time.Time) []events.Event {
+	rows := make(map[string]int)
+	for _, s := range stats {
+		rows[s.TournamentID]++
+	}
+
+	result := make([]events.Event, 0, len(changed))
+	for _, tournamentID := range changed {
+		event := eventdomain.NewStatsUpdated(eventdomain.StatsData{
+			TournamentID: tournamentID,
+			Rows:         rows[tournamentID],
+		})
+		key := eventdomain.EventStatsUpdated + ":" + tournamentID + ":" + strconv.FormatInt(at.UnixMicro(), 10)
+		result = append(result, outbox.WithKey(event, key))
+	}
+	return result
+}
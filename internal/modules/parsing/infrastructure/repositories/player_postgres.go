@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/history"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -30,12 +31,15 @@ func (r *PlayerPostgres) Create(ctx context.Context, p *entities.Player) error {
 		ON CONFLICT (id) DO NOTHING
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
-		p.ID, p.ProfileURL, p.Name, p.BirthDate, p.Position,
-		p.Height, p.Weight, p.Handedness,
-		p.DataSeason, p.ExternalID, p.BirthPlace, p.Citizenship, p.Region, p.PhotoURL, p.Domain,
-		p.Source, p.CreatedAt, p.UpdatedAt,
-	)
+	err := history.Players(ctx, r.db, []string{p.ID}, func(q sqlx.ExtContext) error {
+		_, err := q.ExecContext(ctx, query,
+			p.ID, p.ProfileURL, p.Name, p.BirthDate, p.Position,
+			p.Height, p.Weight, p.Handedness,
+			p.DataSeason, p.ExternalID, p.BirthPlace, p.Citizenship, p.Region, p.PhotoURL, p.Domain,
+			p.Source, p.CreatedAt, p.UpdatedAt,
+		)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create player: %w", err)
 	}
@@ -64,7 +68,15 @@ func (r *PlayerPostgres) CreateBatch(ctx context.Context, players []*entities.Pl
 		ON CONFLICT (id) DO NOTHING
 	`
 
-	_, err := r.db.NamedExecContext(ctx, query, players)
+	ids := make([]string, len(players))
+	for i, p := range players {
+		ids[i] = p.ID
+	}
+
+	err := history.Players(ctx, r.db, ids, func(q sqlx.ExtContext) error {
+		_, err := sqlx.NamedExecContext(ctx, q, query, players)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create players batch: %w", err)
 	}
@@ -95,10 +107,13 @@ func (r *PlayerPostgres) Upsert(ctx context.Context, p *entities.Player) error {
 			updated_at = NOW()
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
-		p.ID, p.ExternalID, p.Name, p.ProfileURL, p.BirthDate, p.BirthPlace,
-		p.Position, p.Height, p.Weight, p.Handedness, p.Citizenship, p.Region, p.PhotoURL, p.Source,
-	)
+	err := history.Players(ctx, r.db, []string{p.ID}, func(q sqlx.ExtContext) error {
+		_, err := q.ExecContext(ctx, query,
+			p.ID, p.ExternalID, p.Name, p.ProfileURL, p.BirthDate, p.BirthPlace,
+			p.Position, p.Height, p.Weight, p.Handedness, p.Citizenship, p.Region, p.PhotoURL, p.Source,
+		)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to upsert player: %w", err)
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/history"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -49,13 +50,16 @@ func (r *playerTeamRepository) Upsert(ctx context.Context, pt *entities.PlayerTe
 			photo_url = COALESCE(EXCLUDED.photo_url, player_teams.photo_url),
 			updated_at = EXCLUDED.updated_at`
 
-	_, err := r.db.ExecContext(ctx, query,
-		pt.PlayerID, pt.TeamID, pt.TournamentID, pt.Season,
-		pt.StartedAt, pt.EndedAt, pt.IsActive,
-		pt.JerseyNumber, pt.Role, pt.Height, pt.Weight, pt.PhotoURL,
-		pt.BirthYear, pt.GroupName,
-		pt.Source, pt.CreatedAt, pt.UpdatedAt,
-	)
+	err := history.PlayerTeams(ctx, r.db, []string{pt.PlayerID}, func(q sqlx.ExtContext) error {
+		_, err := q.ExecContext(ctx, query,
+			pt.PlayerID, pt.TeamID, pt.TournamentID, pt.Season,
+			pt.StartedAt, pt.EndedAt, pt.IsActive,
+			pt.JerseyNumber, pt.Role, pt.Height, pt.Weight, pt.PhotoURL,
+			pt.BirthYear, pt.GroupName,
+			pt.Source, pt.CreatedAt, pt.UpdatedAt,
+		)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to upsert player_team: %w", err)
 	}
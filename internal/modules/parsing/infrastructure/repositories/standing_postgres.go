@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/snapshots"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -25,11 +26,14 @@ func (r *StandingPostgres) Create(ctx context.Context, s *entities.TeamStanding)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, NOW(), NOW())
 		ON CONFLICT (tournament_id, team_id, COALESCE(birth_year, 0), COALESCE(group_name, ''), source) DO NOTHING`
 
-	_, err := r.db.ExecContext(ctx, query,
-		s.ID, s.TournamentID, s.TeamID, s.Position, s.Points,
-		s.Games, s.Wins, s.WinsOT, s.WinsSO, s.LossesSO, s.LossesOT, s.Losses, s.Draws,
-		s.GoalsFor, s.GoalsAgainst, s.GoalDifference, s.GroupName, s.BirthYear, s.Source,
-	)
+	err := r.versioned(ctx, standingKeys(s), func(q sqlx.ExtContext) error {
+		_, err := q.ExecContext(ctx, query,
+			s.ID, s.TournamentID, s.TeamID, s.Position, s.Points,
+			s.Games, s.Wins, s.WinsOT, s.WinsSO, s.LossesSO, s.LossesOT, s.Losses, s.Draws,
+			s.GoalsFor, s.GoalsAgainst, s.GoalDifference, s.GroupName, s.BirthYear, s.Source,
+		)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("create standing: %w", err)
 	}
@@ -52,7 +56,10 @@ func (r *StandingPostgres) CreateBatch(ctx context.Context, standings []*entitie
 			:source, NOW(), NOW())
 		ON CONFLICT (tournament_id, team_id, COALESCE(birth_year, 0), COALESCE(group_name, ''), source) DO NOTHING`
 
-	_, err := r.db.NamedExecContext(ctx, query, standings)
+	err := r.versioned(ctx, standingKeys(standings...), func(q sqlx.ExtContext) error {
+		_, err := sqlx.NamedExecContext(ctx, q, query, standings)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("create standings batch: %w", err)
 	}
@@ -82,11 +89,14 @@ func (r *StandingPostgres) Upsert(ctx context.Context, s *entities.TeamStanding)
 			goal_difference = EXCLUDED.goal_difference,
 			updated_at = NOW()`
 
-	_, err := r.db.ExecContext(ctx, query,
-		s.ID, s.TournamentID, s.TeamID, s.Position, s.Points,
-		s.Games, s.Wins, s.WinsOT, s.WinsSO, s.LossesSO, s.LossesOT, s.Losses, s.Draws,
-		s.GoalsFor, s.GoalsAgainst, s.GoalDifference, s.GroupName, s.BirthYear, s.Source,
-	)
+	err := r.versioned(ctx, standingKeys(s), func(q sqlx.ExtContext) error {
+		_, err := q.ExecContext(ctx, query,
+			s.ID, s.TournamentID, s.TeamID, s.Position, s.Points,
+			s.Games, s.Wins, s.WinsOT, s.WinsSO, s.LossesSO, s.LossesOT, s.Losses, s.Draws,
+			s.GoalsFor, s.GoalsAgainst, s.GoalDifference, s.GroupName, s.BirthYear, s.Source,
+		)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("upsert standing: %w", err)
 	}
@@ -132,8 +142,22 @@ func (r *StandingPostgres) GetByTournamentAndGroup(ctx context.Context, tourname
 	return standings, nil
 }
 
+// DeleteByTournament удаляет турнирную таблицу; её версии закрываются и остаются в истории
 func (r *StandingPostgres) DeleteByTournament(ctx context.Context, tournamentID string) error {
-	_, err := r.db.ExecContext(ctx, `DELETE FROM team_standings WHERE tournament_id = $1`, tournamentID)
+	var teamIDs []string
+	err := r.db.SelectContext(ctx, &teamIDs, `SELECT DISTINCT team_id FROM team_standings WHERE tournament_id = $1`, tournamentID)
+	if err != nil {
+		return fmt.Errorf("delete standings by tournament: %w", err)
+	}
+
+	keys := make([]snapshots.Key, len(teamIDs))
+	for i, teamID := range teamIDs {
+		keys[i] = snapshots.Key{TournamentID: tournamentID, EntityID: teamID}
+	}
+	err = r.versioned(ctx, keys, func(q sqlx.ExtContext) error {
+		_, err := q.ExecContext(ctx, `DELETE FROM team_standings WHERE tournament_id = $1`, tournamentID)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("delete standings by tournament: %w", err)
 	}
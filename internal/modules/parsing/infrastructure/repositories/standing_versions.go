@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/snapshots"
+	"github.com/jmoiron/sqlx"
+)
+
+// versioned выполняет save в транзакции и обновляет версии строк турнирной таблицы
+func (r *StandingPostgres) versioned(ctx context.Context, keys []snapshots.Key, save func(q sqlx.ExtContext) error) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := save(tx); err != nil {
+		return err
+	}
+	if err := snapshots.Standings(ctx, tx, keys); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func standingKeys(standings ...*entities.TeamStanding) []snapshots.Key {
+	keys := make([]snapshots.Key, len(standings))
+	for i, s := range standings {
+		keys[i] = snapshots.Key{TournamentID: s.TournamentID, EntityID: s.TeamID}
+	}
+	return keys
+}
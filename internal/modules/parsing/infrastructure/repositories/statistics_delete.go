@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/history"
+	"github.com/jmoiron/sqlx"
+)
+
+// DeleteByTournament удаляет статистики турнира; их версии закрываются и остаются в истории
+func (r *StatisticsPostgres) DeleteByTournament(ctx context.Context, tournamentID string) error {
+	err := r.deleteVersioned(ctx, `WHERE tournament_id = $1`, tournamentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete statistics by tournament: %w", err)
+	}
+	return nil
+}
+
+// DeleteStale удаляет статистики турнира, не обновлявшиеся с момента before:
+// строки, которых не оказалось в последнем сборе
+func (r *StatisticsPostgres) DeleteStale(ctx context.Context, tournamentID string, before time.Time) error {
+	err := r.deleteVersioned(ctx, `WHERE tournament_id = $1 AND updated_at < $2`, tournamentID, before)
+	if err != nil {
+		return fmt.Errorf("failed to delete stale statistics: %w", err)
+	}
+	return nil
+}
+
+// DeleteAll удаляет все статистики
+func (r *StatisticsPostgres) DeleteAll(ctx context.Context) error {
+	if err := r.deleteVersioned(ctx, ""); err != nil {
+		return fmt.Errorf("failed to delete all statistics: %w", err)
+	}
+	return nil
+}
+
+// deleteVersioned удаляет статистики по условию where, закрывая их версии
+func (r *StatisticsPostgres) deleteVersioned(ctx context.Context, where string, args ...interface{}) error {
+	var keys []history.StatKey
+	err := r.db.SelectContext(ctx, &keys,
+		`SELECT DISTINCT player_id, tournament_id FROM player_statistics `+where, args...)
+	if err != nil {
+		return err
+	}
+
+	return history.Statistics(ctx, r.db, keys, func(q sqlx.ExtContext) error {
+		_, err := q.ExecContext(ctx, `DELETE FROM player_statistics `+where, args...)
+		return err
+	})
+}
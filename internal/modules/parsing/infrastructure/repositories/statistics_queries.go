@@ -5,9 +5,15 @@ import (
 	"fmt"
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/history"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/snapshots"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/outbox"
+	"github.com/jmoiron/sqlx"
 )
 
-// insertBatch вставляет статистики в БД
+// insertBatch вставляет статистики в БД и в той же транзакции пишет в outbox
+// событие обновления статистики по каждому турниру, где она изменилась, а в историю
+// игроков - изменения их статистики
 func (r *StatisticsPostgres) insertBatch(ctx context.Context, stats []*entities.PlayerStatistic) (int, error) {
 	query := `
 		INSERT INTO player_statistics (
@@ -46,12 +52,32 @@ func (r *StatisticsPostgres) insertBatch(ctx context.Context, stats []*entities.
 			updated_at = EXCLUDED.updated_at
 	`
 
-	result, err := r.db.NamedExecContext(ctx, query, stats)
+	keys := make([]history.StatKey, len(stats))
+	versionKeys := make([]snapshots.Key, len(stats))
+	for i, st := range stats {
+		keys[i] = history.StatKey{PlayerID: st.PlayerID, TournamentID: st.TournamentID}
+		versionKeys[i] = snapshots.Key{TournamentID: st.TournamentID, EntityID: st.PlayerID}
+	}
+
+	var rowsAffected int64
+	err := history.Statistics(ctx, r.db, keys, func(q sqlx.ExtContext) error {
+		result, err := sqlx.NamedExecContext(ctx, q, query, stats)
+		if err != nil {
+			return err
+		}
+		rowsAffected, _ = result.RowsAffected()
+
+		// Версии статистики ещё не обновлены: сравнение с ними показывает, что изменилось
+		changed, at, err := snapshots.StatisticsChanged(ctx, q, versionKeys)
+		if err != nil {
+			return err
+		}
+		return outbox.Append(ctx, q, statsUpdatedEvents(stats, changed, at)...)
+	})
 	if err != nil {
 		return 0, fmt.Errorf("failed to create statistics batch: %w", err)
 	}
 
-	rowsAffected, _ := result.RowsAffected()
 	return int(rowsAffected), nil
 }
 
@@ -99,24 +125,6 @@ func (r *StatisticsPostgres) GetByTournament(ctx context.Context, tournamentID s
 	return stats, nil
 }
 
-// DeleteByTournament удаляет статистики турнира
-func (r *StatisticsPostgres) DeleteByTournament(ctx context.Context, tournamentID string) error {
-	_, err := r.db.ExecContext(ctx, `DELETE FROM player_statistics WHERE tournament_id = $1`, tournamentID)
-	if err != nil {
-		return fmt.Errorf("failed to delete statistics by tournament: %w", err)
-	}
-	return nil
-}
-
-// DeleteAll удаляет все статистики
-func (r *StatisticsPostgres) DeleteAll(ctx context.Context) error {
-	_, err := r.db.ExecContext(ctx, `DELETE FROM player_statistics`)
-	if err != nil {
-		return fmt.Errorf("failed to delete all statistics: %w", err)
-	}
-	return nil
-}
-
 // CountAll возвращает общее количество статистик
 func (r *StatisticsPostgres) CountAll(ctx context.Context) (int, error) {
 	var count int
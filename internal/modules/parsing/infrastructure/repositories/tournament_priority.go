@@ -189,3 +189,77 @@ func (r *TournamentPostgres) GetParsedBefore(ctx context.Context, before time.Ti
 	}
 	return tournaments, nil
 }
+
+// cadenceParsedAtField возвращает поле времени последнего обновления для вида обновления
+func cadenceParsedAtField(kind domain.CadenceKind) string {
+	if kind == domain.CadencePlayers {
+		return "last_players_parsed_at"
+	}
+	return "last_stats_parsed_at"
+}
+
+// GetCadenceCandidates возвращает турниры источника с классом приоритета и матчем в окне дня матча
+func (r *TournamentPostgres) GetCadenceCandidates(ctx context.Context, kind domain.CadenceKind, source string, matchDayWindow time.Duration) ([]domain.CadenceCandidate, error) {
+	query := fmt.Sprintf(`
+		SELECT t.id, t.name, t.source,
+			CASE
+				WHEN t.is_ended = false OR t.end_date IS NULL THEN 'ACTIVE'
+				WHEN t.end_date > NOW() - INTERVAL '1 month' THEN 'RECENT'
+				WHEN t.end_date > NOW() - INTERVAL '6 months' THEN 'MEDIUM'
+				WHEN t.end_date > NOW() - INTERVAL '1 year' THEN 'OLD'
+				ELSE 'ARCHIVE'
+			END AS priority,
+			t.%s AS last_parsed_at,
+			(
+				SELECT MIN(m.scheduled_at) FROM matches m
+				WHERE m.tournament_id = t.id
+				  AND m.scheduled_at BETWEEN NOW() - $1::interval AND NOW() + $1::interval
+			) AS next_match_at
+		FROM tournaments t
+		WHERE TRUE %s
+	`, cadenceParsedAtField(kind), sourceCondition(source))
+
+	var rows []struct {
+		ID           string     `db:"id"`
+		Name         string     `db:"name"`
+		Source       string     `db:"source"`
+		Priority     string     `db:"priority"`
+		LastParsedAt *time.Time `db:"last_parsed_at"`
+		NextMatchAt  *time.Time `db:"next_match_at"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, matchDayWindow.String()); err != nil {
+		return nil, fmt.Errorf("get cadence candidates: %w", err)
+	}
+
+	candidates := make([]domain.CadenceCandidate, len(rows))
+	for i, row := range rows {
+		candidates[i] = domain.CadenceCandidate{
+			TournamentID: row.ID,
+			Name:         row.Name,
+			Source:       row.Source,
+			Priority:     domain.Priority(row.Priority),
+			LastParsedAt: row.LastParsedAt,
+			NextMatchAt:  row.NextMatchAt,
+		}
+	}
+	return candidates, nil
+}
+
+// GetCadenceUsage возвращает, сколько турниров источника обновлено за сутки, и время последнего обновления
+func (r *TournamentPostgres) GetCadenceUsage(ctx context.Context, kind domain.CadenceKind, source string) (domain.CadenceUsage, error) {
+	field := cadenceParsedAtField(kind)
+	query := fmt.Sprintf(`
+		SELECT COUNT(*) FILTER (WHERE %s > NOW() - INTERVAL '1 day') AS used_today, MAX(%s) AS last_parsed_at
+		FROM tournaments
+		WHERE TRUE %s
+	`, field, field, sourceCondition(source))
+
+	var row struct {
+		UsedToday    int        `db:"used_today"`
+		LastParsedAt *time.Time `db:"last_parsed_at"`
+	}
+	if err := r.db.GetContext(ctx, &row, query); err != nil {
+		return domain.CadenceUsage{}, fmt.Errorf("get cadence usage: %w", err)
+	}
+	return domain.CadenceUsage{UsedToday: row.UsedToday, LastParsedAt: row.LastParsedAt}, nil
+}
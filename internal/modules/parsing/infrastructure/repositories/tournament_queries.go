@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/domain/entities"
+	"github.com/lib/pq"
 )
 
 // GetByID получает турнир по ID
@@ -24,6 +25,30 @@ func (r *TournamentPostgres) GetByID(ctx context.Context, id string) (*entities.
 	return &t, nil
 }
 
+// GetByIDs получает турниры по ID в порядке ids; отсутствующие пропускаются
+func (r *TournamentPostgres) GetByIDs(ctx context.Context, ids []string) ([]*entities.Tournament, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var found []*entities.Tournament
+	if err := r.db.SelectContext(ctx, &found, `SELECT * FROM tournaments WHERE id = ANY($1)`, pq.Array(ids)); err != nil {
+		return nil, fmt.Errorf("failed to get tournaments by IDs: %w", err)
+	}
+
+	byID := make(map[string]*entities.Tournament, len(found))
+	for _, t := range found {
+		byID[t.ID] = t
+	}
+	tournaments := make([]*entities.Tournament, 0, len(found))
+	for _, id := range ids {
+		if t, ok := byID[id]; ok {
+			tournaments = append(tournaments, t)
+		}
+	}
+	return tournaments, nil
+}
+
 // GetByURL получает турнир по URL
 func (r *TournamentPostgres) GetByURL(ctx context.Context, url string) (*entities.Tournament, error) {
 	query := `SELECT * FROM tournaments WHERE url = $1`
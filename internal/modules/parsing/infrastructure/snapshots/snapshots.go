@@ -0,0 +1,184 @@
+package snapshots
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Key строки турнира, версии которых нужно обновить: игрок или команда в турнире
+type Key struct {
+	TournamentID string
+	EntityID     string
+}
+
+// versioned таблица, изменения строк которой сохраняются версиями в отдельной таблице
+type versioned struct {
+	table    string   // текущее состояние
+	versions string   // версии с valid_from / valid_to
+	entity   string   // колонка игрока или команды
+	key      []string // естественный ключ строки
+	values   []string // версионируемые значения
+}
+
+var statistics = versioned{
+	table:    "player_statistics",
+	versions: "player_statistics_versions",
+	entity:   "player_id",
+	key:      []string{"tournament_id", "player_id", "team_id", "group_name", "birth_year"},
+	values: []string{
+		"games", "goals", "assists", "points", "plus", "minus", "plus_minus", "penalty_minutes",
+		"goals_even_strength", "goals_power_play", "goals_short_handed",
+		"goals_period_1", "goals_period_2", "goals_period_3", "goals_overtime",
+		"hat_tricks", "game_winning_goals",
+	},
+}
+
+var standings = versioned{
+	table:    "team_standings",
+	versions: "team_standings_versions",
+	entity:   "team_id",
+	key:      []string{"tournament_id", "team_id", "group_name", "birth_year", "source"},
+	values: []string{
+		"position", "points", "games", "wins", "wins_ot", "wins_so", "losses_so", "losses_ot",
+		"losses", "draws", "goals_for", "goals_against", "goal_difference",
+	},
+}
+
+// Statistics обновляет версии статистики игроков в турнирах. Вызывается в транзакции
+// сохранения после записи в player_statistics
+func Statistics(ctx context.Context, q sqlx.ExecerContext, keys []Key) error {
+	return statistics.snapshot(ctx, q, keys)
+}
+
+// StatisticsChanged возвращает турниры, строки статистики которых отличаются от текущих
+// версий, и время транзакции - valid_from версий, которые откроет Statistics.
+// Вызывается в транзакции сохранения до Statistics
+func StatisticsChanged(ctx context.Context, q sqlx.QueryerContext, keys []Key) ([]string, time.Time, error) {
+	return statistics.changed(ctx, q, keys)
+}
+
+// Standings обновляет версии строк турнирных таблиц команд. Вызывается в транзакции
+// сохранения после записи в team_standings
+func Standings(ctx context.Context, q sqlx.ExecerContext, keys []Key) error {
+	return standings.snapshot(ctx, q, keys)
+}
+
+// snapshot закрывает текущие версии, значения которых изменились или строка удалена,
+// и открывает версии для новых и изменившихся строк. Время версии - время транзакции,
+// поэтому valid_to закрытой версии совпадает с valid_from новой
+func (t versioned) snapshot(ctx context.Context, q sqlx.ExecerContext, keys []Key) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	tournamentIDs := make([]string, len(keys))
+	entityIDs := make([]string, len(keys))
+	for i, k := range keys {
+		tournamentIDs[i], entityIDs[i] = k.TournamentID, k.EntityID
+	}
+
+	var sessionID interface{}
+	if id := events.SessionID(ctx); id != "" {
+		sessionID = id
+	}
+
+	if _, err := q.ExecContext(ctx, t.closeQuery(), pq.Array(tournamentIDs), pq.Array(entityIDs)); err != nil {
+		return fmt.Errorf("close %s: %w", t.versions, err)
+	}
+	if _, err := q.ExecContext(ctx, t.openQuery(), pq.Array(tournamentIDs), pq.Array(entityIDs), sessionID); err != nil {
+		return fmt.Errorf("open %s: %w", t.versions, err)
+	}
+	return nil
+}
+
+// changed возвращает турниры, где snapshot откроет новые версии, и время транзакции
+func (t versioned) changed(ctx context.Context, q sqlx.QueryerContext, keys []Key) ([]string, time.Time, error) {
+	var now time.Time
+	if err := sqlx.GetContext(ctx, q, &now, `SELECT NOW()`); err != nil {
+		return nil, now, fmt.Errorf("transaction time: %w", err)
+	}
+	if len(keys) == 0 {
+		return nil, now, nil
+	}
+
+	tournamentIDs := make([]string, len(keys))
+	entityIDs := make([]string, len(keys))
+	for i, k := range keys {
+		tournamentIDs[i], entityIDs[i] = k.TournamentID, k.EntityID
+	}
+
+	var changed []string
+	if err := sqlx.SelectContext(ctx, q, &changed, t.changedQuery(), pq.Array(tournamentIDs), pq.Array(entityIDs)); err != nil {
+		return nil, now, fmt.Errorf("changed %s: %w", t.table, err)
+	}
+	return changed, now, nil
+}
+
+func (t versioned) changedQuery() string {
+	return fmt.Sprintf(`
+		SELECT DISTINCT s.tournament_id
+		FROM %[1]s s
+		JOIN (SELECT DISTINCT * FROM unnest($1::text[], $2::text[])) AS k(tournament_id, entity_id)
+		  ON s.tournament_id = k.tournament_id AND s.%[2]s = k.entity_id
+		WHERE NOT EXISTS (
+			SELECT 1 FROM %[3]s v
+			WHERE v.valid_to IS NULL AND %[4]s AND (%[5]s) IS NOT DISTINCT FROM (%[6]s)
+		)
+		ORDER BY s.tournament_id`,
+		t.table, t.entity, t.versions, t.sameKey(), columns("s", t.values), columns("v", t.values),
+	)
+}
+
+func (t versioned) closeQuery() string {
+	return fmt.Sprintf(`
+		UPDATE %[1]s v SET valid_to = NOW()
+		FROM (SELECT DISTINCT * FROM unnest($1::text[], $2::text[])) AS k(tournament_id, entity_id)
+		WHERE v.valid_to IS NULL AND v.tournament_id = k.tournament_id AND v.%[2]s = k.entity_id
+		  AND NOT EXISTS (
+			SELECT 1 FROM %[3]s s
+			WHERE %[4]s AND (%[5]s) IS NOT DISTINCT FROM (%[6]s)
+		  )`,
+		t.versions, t.entity, t.table, t.sameKey(), columns("s", t.values), columns("v", t.values),
+	)
+}
+
+func (t versioned) openQuery() string {
+	cols := strings.Join(append(append([]string{}, t.key...), t.values...), ", ")
+	return fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, valid_from, session_id)
+		SELECT DISTINCT ON (%[3]s) %[4]s, NOW(), $3::uuid
+		FROM %[5]s s
+		JOIN (SELECT DISTINCT * FROM unnest($1::text[], $2::text[])) AS k(tournament_id, entity_id)
+		  ON s.tournament_id = k.tournament_id AND s.%[6]s = k.entity_id
+		WHERE NOT EXISTS (
+			SELECT 1 FROM %[1]s v WHERE v.valid_to IS NULL AND %[7]s
+		)
+		ORDER BY %[3]s
+		ON CONFLICT DO NOTHING`,
+		t.versions, cols, columns("s", t.key), columns("s", append(append([]string{}, t.key...), t.values...)),
+		t.table, t.entity, t.sameKey(),
+	)
+}
+
+// sameKey условие совпадения естественного ключа строки s и версии v
+func (t versioned) sameKey() string {
+	conds := make([]string, len(t.key))
+	for i, col := range t.key {
+		conds[i] = fmt.Sprintf("v.%[1]s IS NOT DISTINCT FROM s.%[1]s", col)
+	}
+	return strings.Join(conds, " AND ")
+}
+
+func columns(alias string, cols []string) string {
+	result := make([]string, len(cols))
+	for i, col := range cols {
+		result[i] = alias + "." + col
+	}
+	return strings.Join(result, ", ")
+}
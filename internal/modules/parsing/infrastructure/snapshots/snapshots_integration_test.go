@@ -0,0 +1,322 @@
+//go:build integration
+
+package snapshots
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/migrator/pg"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+// openTestDB подключается к TEST_DATABASE_URL и применяет миграции
+func openTestDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := sqlx.Connect("postgres", dsn)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	if err := pg.NewMigrator(db.DB, "../../../../../migrations").Up(context.Background()); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+// standingFixture строка турнирной таблицы с уникальными турниром и командой
+type standingFixture struct {
+	db  *sqlx.DB
+	id  string
+	key Key
+}
+
+func newStandingFixture(t *testing.T, db *sqlx.DB) *standingFixture {
+	t.Helper()
+	ctx := context.Background()
+
+	suffix := uuid.New().String()[:8]
+	f := &standingFixture{
+		db:  db,
+		id:  "test_standing_" + suffix,
+		key: Key{TournamentID: "test_tournament_" + suffix, EntityID: "test_team_" + suffix},
+	}
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, `DELETE FROM team_standings_versions WHERE tournament_id = $1`, f.key.TournamentID)
+		_, _ = db.ExecContext(ctx, `DELETE FROM team_standings WHERE tournament_id = $1`, f.key.TournamentID)
+		_, _ = db.ExecContext(ctx, `DELETE FROM teams WHERE id = $1`, f.key.EntityID)
+		_, _ = db.ExecContext(ctx, `DELETE FROM tournaments WHERE id = $1`, f.key.TournamentID)
+	})
+
+	if _, err := db.ExecContext(ctx, `INSERT INTO tournaments (id, name) VALUES ($1, 'Snapshot test')`, f.key.TournamentID); err != nil {
+		t.Fatalf("insert tournament: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `INSERT INTO teams (id, name) VALUES ($1, 'Snapshot test')`, f.key.EntityID); err != nil {
+		t.Fatalf("insert team: %v", err)
+	}
+	return f
+}
+
+// save записывает очки команды и версии в одной транзакции, как это делает сохранение парсера.
+// group_name остаётся NULL, чтобы проверить сравнение ключа через IS NOT DISTINCT FROM
+func (f *standingFixture) save(t *testing.T, points int) {
+	t.Helper()
+	ctx := context.Background()
+
+	tx, err := f.db.BeginTxx(ctx, nil)
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		INSERT INTO team_standings (id, tournament_id, team_id, points, games, source)
+		VALUES ($1, $2, $3, $4, 1, 'test')
+		ON CONFLICT (id) DO UPDATE SET points = EXCLUDED.points`
+	if _, err := tx.ExecContext(ctx, query, f.id, f.key.TournamentID, f.key.EntityID, points); err != nil {
+		t.Fatalf("save standing: %v", err)
+	}
+	if err := Standings(ctx, tx, []Key{f.key}); err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+}
+
+type standingVersion struct {
+	Points    int        `db:"points"`
+	ValidFrom time.Time  `db:"valid_from"`
+	ValidTo   *time.Time `db:"valid_to"`
+}
+
+func (f *standingFixture) versions(t *testing.T) []standingVersion {
+	t.Helper()
+
+	var rows []standingVersion
+	query := `SELECT points, valid_from, valid_to FROM team_standings_versions WHERE tournament_id = $1 ORDER BY id`
+	if err := f.db.SelectContext(context.Background(), &rows, query, f.key.TournamentID); err != nil {
+		t.Fatalf("select versions: %v", err)
+	}
+	return rows
+}
+
+// pointsAsOf читает очки на момент asOf так же, как API при запросе с as_of
+func (f *standingFixture) pointsAsOf(t *testing.T, asOf time.Time) (int, bool) {
+	t.Helper()
+
+	var points []int
+	query := `
+		SELECT points FROM team_standings_versions
+		WHERE tournament_id = $1 AND team_id = $2
+			AND valid_from <= $3 AND (valid_to IS NULL OR valid_to > $3)`
+	if err := f.db.SelectContext(context.Background(), &points, query, f.key.TournamentID, f.key.EntityID, asOf); err != nil {
+		t.Fatalf("select as of: %v", err)
+	}
+	switch len(points) {
+	case 0:
+		return 0, false
+	case 1:
+		return points[0], true
+	default:
+		t.Fatalf("as of %s: %d versions valid at once", asOf, len(points))
+		return 0, false
+	}
+}
+
+func TestStandings_UnchangedRerunKeepsVersion(t *testing.T) {
+	f := newStandingFixture(t, openTestDB(t))
+
+	f.save(t, 3)
+	f.save(t, 3)
+
+	versions := f.versions(t)
+	if len(versions) != 1 {
+		t.Fatalf("versions = %d, want 1", len(versions))
+	}
+	if versions[0].ValidTo != nil {
+		t.Errorf("unchanged version closed at %s", versions[0].ValidTo)
+	}
+}
+
+func TestStandings_ChangedRowOpensVersion(t *testing.T) {
+	f := newStandingFixture(t, openTestDB(t))
+
+	f.save(t, 3)
+	f.save(t, 5)
+
+	versions := f.versions(t)
+	if len(versions) != 2 {
+		t.Fatalf("versions = %d, want 2", len(versions))
+	}
+	prev, cur := versions[0], versions[1]
+	if prev.Points != 3 || cur.Points != 5 {
+		t.Errorf("points = %d, %d, want 3, 5", prev.Points, cur.Points)
+	}
+	if prev.ValidTo == nil || !prev.ValidTo.Equal(cur.ValidFrom) {
+		t.Errorf("previous valid_to = %v, want %s", prev.ValidTo, cur.ValidFrom)
+	}
+	if cur.ValidTo != nil {
+		t.Errorf("current version closed at %s", cur.ValidTo)
+	}
+}
+
+func TestStandings_DeletedRowClosesVersion(t *testing.T) {
+	f := newStandingFixture(t, openTestDB(t))
+	ctx := context.Background()
+
+	f.save(t, 3)
+
+	tx, err := f.db.BeginTxx(ctx, nil)
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	if _, err := tx.ExecContext(ctx, `DELETE FROM team_standings WHERE id = $1`, f.id); err != nil {
+		t.Fatalf("delete standing: %v", err)
+	}
+	if err := Standings(ctx, tx, []Key{f.key}); err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+
+	versions := f.versions(t)
+	if len(versions) != 1 || versions[0].ValidTo == nil {
+		t.Fatalf("versions = %+v, want one closed version", versions)
+	}
+}
+
+func TestStandings_AsOfReadsValuesAtThatMoment(t *testing.T) {
+	f := newStandingFixture(t, openTestDB(t))
+
+	f.save(t, 3)
+	versions := f.versions(t)
+	if len(versions) != 1 {
+		t.Fatalf("versions = %d, want 1", len(versions))
+	}
+	before := versions[0].ValidFrom.Add(-time.Second)
+	between := versions[0].ValidFrom.Add(time.Millisecond)
+
+	// Гарантирует, что вторая транзакция начнётся позже момента between
+	time.Sleep(10 * time.Millisecond)
+	f.save(t, 5)
+
+	if _, ok := f.pointsAsOf(t, before); ok {
+		t.Errorf("as of %s: version found before the first snapshot", before)
+	}
+	if points, ok := f.pointsAsOf(t, between); !ok || points != 3 {
+		t.Errorf("as of %s: points = %d (found %v), want 3", between, points, ok)
+	}
+	versions = f.versions(t)
+	if len(versions) != 2 {
+		t.Fatalf("versions = %d, want 2", len(versions))
+	}
+	latest := versions[1].ValidFrom
+	if points, ok := f.pointsAsOf(t, latest); !ok || points != 5 {
+		t.Errorf("as of %s: points = %d (found %v), want 5", latest, points, ok)
+	}
+}
+
+// statisticFixture строка статистики игрока с уникальными турниром, командой и игроком
+type statisticFixture struct {
+	db     *sqlx.DB
+	teamID string
+	key    Key
+}
+
+func newStatisticFixture(t *testing.T, db *sqlx.DB) *statisticFixture {
+	t.Helper()
+	ctx := context.Background()
+
+	suffix := uuid.New().String()[:8]
+	f := &statisticFixture{
+		db:     db,
+		teamID: "test_team_" + suffix,
+		key:    Key{TournamentID: "test_tournament_" + suffix, EntityID: "test_player_" + suffix},
+	}
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, `DELETE FROM player_statistics_versions WHERE tournament_id = $1`, f.key.TournamentID)
+		_, _ = db.ExecContext(ctx, `DELETE FROM player_statistics WHERE tournament_id = $1`, f.key.TournamentID)
+		_, _ = db.ExecContext(ctx, `DELETE FROM players WHERE id = $1`, f.key.EntityID)
+		_, _ = db.ExecContext(ctx, `DELETE FROM teams WHERE id = $1`, f.teamID)
+		_, _ = db.ExecContext(ctx, `DELETE FROM tournaments WHERE id = $1`, f.key.TournamentID)
+	})
+
+	if _, err := db.ExecContext(ctx, `INSERT INTO tournaments (id, name) VALUES ($1, 'Snapshot test')`, f.key.TournamentID); err != nil {
+		t.Fatalf("insert tournament: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `INSERT INTO teams (id, name) VALUES ($1, 'Snapshot test')`, f.teamID); err != nil {
+		t.Fatalf("insert team: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `INSERT INTO players (id, name, birth_date) VALUES ($1, 'Snapshot test', '2010-01-01')`, f.key.EntityID); err != nil {
+		t.Fatalf("insert player: %v", err)
+	}
+	return f
+}
+
+// save перезаписывает очки игрока и версии в одной транзакции и возвращает то,
+// что StatisticsChanged увидел между записью строки и обновлением версий
+func (f *statisticFixture) save(t *testing.T, points int) ([]string, time.Time) {
+	t.Helper()
+	ctx := context.Background()
+
+	tx, err := f.db.BeginTxx(ctx, nil)
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM player_statistics WHERE tournament_id = $1`, f.key.TournamentID); err != nil {
+		t.Fatalf("delete statistic: %v", err)
+	}
+	query := `
+		INSERT INTO player_statistics (tournament_id, player_id, team_id, games, points)
+		VALUES ($1, $2, $3, 1, $4)`
+	if _, err := tx.ExecContext(ctx, query, f.key.TournamentID, f.key.EntityID, f.teamID, points); err != nil {
+		t.Fatalf("save statistic: %v", err)
+	}
+	changed, at, err := StatisticsChanged(ctx, tx, []Key{f.key})
+	if err != nil {
+		t.Fatalf("changed: %v", err)
+	}
+	if err := Statistics(ctx, tx, []Key{f.key}); err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	return changed, at
+}
+
+func TestStatisticsChanged_RevertedValuesReportedAgain(t *testing.T) {
+	f := newStatisticFixture(t, openTestDB(t))
+
+	var last time.Time
+	for i, points := range []int{3, 5, 3} {
+		changed, at := f.save(t, points)
+		if len(changed) != 1 || changed[0] != f.key.TournamentID {
+			t.Fatalf("save %d (points %d): changed = %v, want [%s]", i, points, changed, f.key.TournamentID)
+		}
+		if !at.After(last) {
+			t.Errorf("save %d: transaction time %s not after %s", i, at, last)
+		}
+		last = at
+	}
+
+	if changed, _ := f.save(t, 3); len(changed) != 0 {
+		t.Errorf("unchanged rerun: changed = %v, want none", changed)
+	}
+}
@@ -9,9 +9,9 @@ import (
 	"net/http"
 	"net/http/cookiejar"
 	"strings"
-	"sync"
 	"time"
 
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/governor"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -24,64 +24,48 @@ const (
 )
 
 // Client HTTP клиент для работы с fhmoscow.com API
+// Частоту запросов, robots.txt и паузы после 429/503 контролирует общий governor
 type Client struct {
 	httpClient *http.Client
 	baseURL    string
-	delay      time.Duration
-	mu         sync.Mutex
-	lastReq    time.Time
 }
 
 // NewClient создает новый клиент для fhmoscow.com
 func NewClient() *Client {
 	jar, _ := cookiejar.New(nil)
-	return &Client{
+	c := &Client{
 		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-			Jar:     jar,
+			Timeout:   DefaultTimeout,
+			Jar:       jar,
+			Transport: governor.NewTransport(),
 		},
 		baseURL: BaseURL,
-		delay:   DefaultDelay,
 	}
+	c.SetDelay(DefaultDelay)
+	return c
 }
 
-// SetDelay устанавливает задержку между запросами
+// SetDelay задаёт интервал между запросами, если для хоста нет лимита в конфигурации governor
 func (c *Client) SetDelay(d time.Duration) {
-	c.delay = d
+	governor.Default().SuggestInterval(c.baseURL, d)
 }
 
 // GetHTML выполняет GET запрос и возвращает HTML
 func (c *Client) GetHTML(path string) ([]byte, error) {
 	url := c.baseURL + path
-	return c.doRequestWithRateLimit(http.MethodGet, url, nil, "text/html")
+	return c.doRequest(http.MethodGet, url, nil, "text/html")
 }
 
 // GetAPI выполняет GET запрос к API и возвращает JSON
 func (c *Client) GetAPI(path string) ([]byte, error) {
 	url := c.baseURL + path
-	return c.doRequestWithRateLimit(http.MethodGet, url, nil, "application/json")
+	return c.doRequest(http.MethodGet, url, nil, "application/json")
 }
 
 // PostAPI выполняет POST запрос к API с JSON телом
 func (c *Client) PostAPI(path string, body interface{}) ([]byte, error) {
 	url := c.baseURL + path
-	return c.doRequestWithRateLimit(http.MethodPost, url, body, "application/json")
-}
-
-// doRequestWithRateLimit выполняет запрос с блокировкой
-func (c *Client) doRequestWithRateLimit(method, url string, body interface{}, accept string) ([]byte, error) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	elapsed := time.Since(c.lastReq)
-	if elapsed < c.delay {
-		time.Sleep(c.delay - elapsed)
-	}
-
-	result, err := c.doRequest(method, url, body, accept)
-	c.lastReq = time.Now()
-
-	return result, err
+	return c.doRequest(http.MethodPost, url, body, "application/json")
 }
 
 // doRequest выполняет HTTP запрос с retry логикой
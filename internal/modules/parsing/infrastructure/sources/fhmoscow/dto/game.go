@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"strings"
+	"time"
+)
+
+// Статусы матча в API календаря
+const (
+	GameStatusScheduled = "scheduled"
+	GameStatusLive      = "live"
+	GameStatusFinished  = "finished"
+	GameStatusCancelled = "cancelled"
+)
+
+// moscowTime часовой пояс расписания fhmoscow.com
+var moscowTime = time.FixedZone("MSK", 3*60*60)
+
+// GameDTO матч из API /api/calendar
+type GameDTO struct {
+	ID       int         `json:"id"`
+	Number   int         `json:"number"`
+	Date     string      `json:"date"` // "18.01.2025"
+	Time     string      `json:"time"` // "12:30"
+	Tour     string      `json:"tour"` // "5 тур"
+	Stadium  string      `json:"stadium"`
+	Status   string      `json:"status"`
+	Overtime bool        `json:"overtime"`
+	Shootout bool        `json:"shootout"`
+	Home     GameTeamDTO `json:"home"`
+	Away     GameTeamDTO `json:"away"`
+}
+
+// GameTeamDTO команда в матче календаря
+type GameTeamDTO struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Logo  string `json:"logo"`
+	Score *int   `json:"score"` // null до окончания матча
+}
+
+// ScheduledAt возвращает время начала матча по Москве
+// Без времени матч ставится на полночь, без даты - нулевое время
+func (g *GameDTO) ScheduledAt() time.Time {
+	date := strings.TrimSpace(g.Date)
+	if date == "" {
+		return time.Time{}
+	}
+
+	if clock := strings.TrimSpace(g.Time); clock != "" {
+		if t, err := time.ParseInLocation("02.01.2006 15:04", date+" "+clock, moscowTime); err == nil {
+			return t
+		}
+	}
+
+	t, err := time.ParseInLocation("02.01.2006", date, moscowTime)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// IsFinished проверяет, сыгран ли матч
+// Старые сезоны отдают пустой статус, тогда смотрим на наличие счёта
+func (g *GameDTO) IsFinished() bool {
+	if g.Status != "" {
+		return g.Status == GameStatusFinished
+	}
+	return g.Home.Score != nil && g.Away.Score != nil
+}
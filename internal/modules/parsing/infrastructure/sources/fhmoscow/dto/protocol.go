@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ProtocolDTO протокол матча из API /api/game/{id}
+type ProtocolDTO struct {
+	ID        int             `json:"id"`
+	Home      ProtocolTeamDTO `json:"home"`
+	Away      ProtocolTeamDTO `json:"away"`
+	Goals     []GoalDTO       `json:"goals"`
+	Penalties []PenaltyDTO    `json:"penalties"`
+}
+
+// ProtocolTeamDTO команда в протоколе
+type ProtocolTeamDTO struct {
+	ID      int               `json:"id"`
+	Name    string            `json:"name"`
+	Logo    string            `json:"logo"`
+	Score   int               `json:"score"`
+	Periods []int             `json:"periods"` // счёт по периодам, 4-й элемент - овертайм
+	Lineup  []LineupPlayerDTO `json:"lineup"`
+}
+
+// ProtocolPersonDTO игрок, упомянутый в событии протокола
+type ProtocolPersonDTO struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Number int    `json:"number"`
+}
+
+// GoalDTO гол из протокола
+type GoalDTO struct {
+	Period    int                `json:"period"`
+	Time      string             `json:"time"` // игровое время "23:15" от начала матча
+	TeamID    int                `json:"team"`
+	Scorer    *ProtocolPersonDTO `json:"scorer"`
+	Assist1   *ProtocolPersonDTO `json:"assist1"`
+	Assist2   *ProtocolPersonDTO `json:"assist2"`
+	Situation string             `json:"situation"` // "", "pp", "sh", "en"
+	Score     string             `json:"score"`     // счёт после гола "2:1"
+}
+
+// PenaltyDTO удаление из протокола
+type PenaltyDTO struct {
+	Period  int                `json:"period"`
+	Time    string             `json:"time"`
+	TeamID  int                `json:"team"`
+	Player  *ProtocolPersonDTO `json:"player"`
+	Minutes int                `json:"minutes"`
+	Reason  string             `json:"reason"`
+}
+
+// LineupPlayerDTO игрок в составе на матч
+type LineupPlayerDTO struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Number         int    `json:"number"`
+	Position       string `json:"position"` // "В", "З", "Н"
+	Captain        string `json:"captain"`  // "К", "А" или пусто
+	Goals          int    `json:"goals"`
+	Assists        int    `json:"assists"`
+	PenaltyMinutes int    `json:"pim"`
+	PlusMinus      int    `json:"plus_minus"`
+	Saves          *int   `json:"saves"`
+	GoalsAgainst   *int   `json:"goals_against"`
+	TimeOnIce      string `json:"toi"` // "58:12"
+}
+
+// ParseClock разбирает игровое время "MM:SS" в минуты и секунды
+func ParseClock(clock string) (minutes, seconds int, ok bool) {
+	mm, ss, found := strings.Cut(strings.TrimSpace(clock), ":")
+	if !found {
+		return 0, 0, false
+	}
+	minutes, errM := strconv.Atoi(mm)
+	seconds, errS := strconv.Atoi(ss)
+	if errM != nil || errS != nil || seconds >= 60 {
+		return 0, 0, false
+	}
+	return minutes, seconds, true
+}
+
+// ParseScore разбирает счёт "2:1"
+func ParseScore(score string) (home, away int, ok bool) {
+	h, a, found := strings.Cut(strings.TrimSpace(score), ":")
+	if !found {
+		return 0, 0, false
+	}
+	home, errH := strconv.Atoi(strings.TrimSpace(h))
+	away, errA := strconv.Atoi(strings.TrimSpace(a))
+	if errH != nil || errA != nil {
+		return 0, 0, false
+	}
+	return home, away, true
+}
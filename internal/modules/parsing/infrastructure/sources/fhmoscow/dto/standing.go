@@ -0,0 +1,18 @@
+package dto
+
+// StandingDTO строка турнирной таблицы из API /api/standings
+type StandingDTO struct {
+	Position     int     `json:"position"`
+	Team         TeamDTO `json:"team"`
+	Games        int     `json:"games"`
+	Wins         int     `json:"wins"`
+	WinsOT       int     `json:"wins_ot"`
+	WinsSO       int     `json:"wins_so"`
+	LossesSO     int     `json:"losses_so"`
+	LossesOT     int     `json:"losses_ot"`
+	Losses       int     `json:"losses"`
+	Draws        int     `json:"draws"`
+	GoalsFor     int     `json:"goals_for"`
+	GoalsAgainst int     `json:"goals_against"`
+	Points       int     `json:"points"`
+}
@@ -0,0 +1,42 @@
+package parsing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
+)
+
+// ParseCalendar парсит ответ API /api/calendar
+func ParseCalendar(data []byte) ([]dto.GameDTO, error) {
+	var games []dto.GameDTO
+	if err := json.Unmarshal(data, &games); err != nil {
+		return nil, err
+	}
+	return games, nil
+}
+
+// ParseProtocol парсит ответ API /api/game/{id}
+// Для несыгранного матча API возвращает пустой массив
+func ParseProtocol(data []byte) (*dto.ProtocolDTO, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		return nil, fmt.Errorf("protocol is not available")
+	}
+
+	var protocol dto.ProtocolDTO
+	if err := json.Unmarshal(trimmed, &protocol); err != nil {
+		return nil, err
+	}
+	return &protocol, nil
+}
+
+// ParseStandings парсит ответ API /api/standings
+func ParseStandings(data []byte) ([]dto.StandingDTO, error) {
+	var standings []dto.StandingDTO
+	if err := json.Unmarshal(data, &standings); err != nil {
+		return nil, err
+	}
+	return standings, nil
+}
@@ -0,0 +1,92 @@
+package parsing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/fhmoscow/dto"
+)
+
+func TestParseCalendar(t *testing.T) {
+	data := []byte(`[
+		{"id": 5012, "number": 41, "date": "18.01.2025", "time": "12:30", "stadium": "Ледовая арена ЦСКА",
+		 "status": "finished", "overtime": true,
+		 "home": {"id": 301, "name": "ЦСКА 2009", "score": 3},
+		 "away": {"id": 302, "name": "Динамо 2009", "score": 2}},
+		{"id": 5013, "date": "25.01.2025", "status": "",
+		 "home": {"id": 303, "name": "Спартак 2009", "score": null},
+		 "away": {"id": 301, "name": "ЦСКА 2009", "score": null}}
+	]`)
+
+	games, err := ParseCalendar(data)
+	if err != nil {
+		t.Fatalf("parse calendar: %v", err)
+	}
+	if len(games) != 2 {
+		t.Fatalf("got %d games, want 2", len(games))
+	}
+
+	played := games[0]
+	if !played.IsFinished() || !played.Overtime || *played.Home.Score != 3 {
+		t.Fatalf("unexpected finished game: %+v", played)
+	}
+	want := time.Date(2025, 1, 18, 9, 30, 0, 0, time.UTC)
+	if got := played.ScheduledAt(); !got.Equal(want) {
+		t.Fatalf("scheduled at %s, want %s", got.UTC(), want)
+	}
+
+	upcoming := games[1]
+	if upcoming.IsFinished() {
+		t.Fatal("game without score is finished")
+	}
+	if got := upcoming.ScheduledAt(); got.Day() != 25 || got.Hour() != 0 {
+		t.Fatalf("date without time parsed as %s", got)
+	}
+}
+
+func TestParseProtocol(t *testing.T) {
+	data := []byte(`{
+		"id": 5012,
+		"home": {"id": 301, "score": 3, "periods": [1, 0, 1, 1],
+			"lineup": [{"id": 9001, "name": "Иванов Иван", "number": 17, "position": "Н", "captain": "К", "goals": 2, "toi": "18:40"}]},
+		"away": {"id": 302, "score": 2, "periods": [0, 2, 0, 0], "lineup": []},
+		"goals": [{"period": 4, "time": "62:05", "team": 301, "scorer": {"id": 9001, "name": "Иванов Иван"},
+			"situation": "pp", "score": "3:2"}],
+		"penalties": [{"period": 2, "time": "31:10", "team": 302, "player": {"id": 9102}, "minutes": 2, "reason": "Подножка"}]
+	}`)
+
+	proto, err := ParseProtocol(data)
+	if err != nil {
+		t.Fatalf("parse protocol: %v", err)
+	}
+	if len(proto.Home.Periods) != 4 || len(proto.Goals) != 1 || len(proto.Penalties) != 1 {
+		t.Fatalf("unexpected protocol: %+v", proto)
+	}
+	if proto.Home.Lineup[0].Captain != "К" || proto.Goals[0].Scorer.ID != 9001 {
+		t.Fatalf("unexpected lineup or goal: %+v", proto)
+	}
+
+	if minutes, seconds, ok := dto.ParseClock(proto.Goals[0].Time); !ok || minutes != 62 || seconds != 5 {
+		t.Fatalf("clock = %d:%d (%v)", minutes, seconds, ok)
+	}
+	if home, away, ok := dto.ParseScore(proto.Goals[0].Score); !ok || home != 3 || away != 2 {
+		t.Fatalf("score = %d:%d (%v)", home, away, ok)
+	}
+
+	if _, err := ParseProtocol([]byte(`[]`)); err == nil {
+		t.Fatal("empty protocol parsed without error")
+	}
+}
+
+func TestParseStandings(t *testing.T) {
+	data := []byte(`[{"position": 1, "team": {"id": 301, "name": "ЦСКА 2009"}, "games": 10, "wins": 8,
+		"wins_ot": 1, "losses": 1, "goals_for": 41, "goals_against": 12, "points": 26}]`)
+
+	standings, err := ParseStandings(data)
+	if err != nil {
+		t.Fatalf("parse standings: %v", err)
+	}
+	if len(standings) != 1 || standings[0].Team.ID != 301 || standings[0].WinsOT != 1 || standings[0].Points != 26 {
+		t.Fatalf("unexpected standings: %+v", standings)
+	}
+}
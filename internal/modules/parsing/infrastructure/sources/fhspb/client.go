@@ -6,9 +6,9 @@ import (
 	"io"
 	"net/http"
 	"strings"
-	"sync"
 	"time"
 
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/governor"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -21,49 +21,33 @@ const (
 )
 
 // Client HTTP клиент для работы с fhspb.ru
+// Частоту запросов, robots.txt и паузы после 429/503 контролирует общий governor
 type Client struct {
 	httpClient *http.Client
 	baseURL    string
-	delay      time.Duration
-	mu         sync.Mutex
-	lastReq    time.Time
 }
 
 // NewClient создает новый клиент для fhspb.ru
 func NewClient() *Client {
-	return &Client{
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-		},
-		baseURL: BaseURL,
-		delay:   DefaultDelay,
+	c := &Client{
+		httpClient: governor.NewHTTPClient(DefaultTimeout),
+		baseURL:    BaseURL,
 	}
+	c.SetDelay(DefaultDelay)
+	return c
 }
 
-// SetDelay устанавливает задержку между запросами
+// SetDelay задаёт интервал между запросами, если для хоста нет лимита в конфигурации governor
 func (c *Client) SetDelay(d time.Duration) {
-	c.delay = d
+	governor.Default().SuggestInterval(c.baseURL, d)
 }
 
 // Get выполняет GET запрос с rate limiting
 func (c *Client) Get(path string) ([]byte, error) {
-	c.rateLimit()
 	url := c.baseURL + path
 	return c.doRequest(url)
 }
 
-// rateLimit обеспечивает задержку между запросами
-func (c *Client) rateLimit() {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	elapsed := time.Since(c.lastReq)
-	if elapsed < c.delay {
-		time.Sleep(c.delay - elapsed)
-	}
-	c.lastReq = time.Now()
-}
-
 // doRequest выполняет HTTP запрос с retry логикой
 func (c *Client) doRequest(url string) ([]byte, error) {
 	ctx := context.Background()
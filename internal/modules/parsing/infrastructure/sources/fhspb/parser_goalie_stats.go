@@ -42,8 +42,6 @@ func (c *Client) GetGoalieStatsFirstPage(ctx context.Context, tournamentID int)
 
 // GetGoalieStatsPage загружает конкретную страницу статистики вратарей
 func (c *Client) GetGoalieStatsPage(ctx context.Context, tournamentID, page int, pageInfo dto.StatsPageDTO) ([]dto.GoalieStatsDTO, error) {
-	c.rateLimit()
-
 	reqURL := fmt.Sprintf("%s/StatsGoalie?TournamentID=%d", c.baseURL, tournamentID)
 
 	data := url.Values{}
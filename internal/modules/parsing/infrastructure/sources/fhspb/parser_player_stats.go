@@ -42,8 +42,6 @@ func (c *Client) GetPlayerStatsFirstPage(ctx context.Context, tournamentID int)
 
 // GetPlayerStatsPage загружает конкретную страницу статистики используя ViewState
 func (c *Client) GetPlayerStatsPage(ctx context.Context, tournamentID, page int, pageInfo dto.StatsPageDTO) ([]dto.PlayerStatsDTO, error) {
-	c.rateLimit()
-
 	reqURL := fmt.Sprintf("%s/StatsPlayer?TournamentID=%d", c.baseURL, tournamentID)
 
 	data := url.Values{}
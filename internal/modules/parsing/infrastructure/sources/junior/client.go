@@ -13,6 +13,7 @@ import (
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/junior/team"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/junior/tournament"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/sources/junior/types"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/governor"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -32,10 +33,9 @@ type Client struct {
 // NewClient создает новый клиент для junior.fhr.ru
 func NewClient() *Client {
 	c := &Client{
-		httpClient: &http.Client{
-			Timeout: 60 * time.Second,
-		},
-		baseURL: "https://cfo.fhr.ru",
+		// Лимит на каждый региональный домен задаёт общий governor
+		httpClient: governor.NewHTTPClient(60 * time.Second),
+		baseURL:    "https://cfo.fhr.ru",
 	}
 
 	// Инициализируем парсеры
@@ -7,9 +7,9 @@ import (
 	"net/http"
 	"net/http/cookiejar"
 	"strings"
-	"sync"
 	"time"
 
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/governor"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -22,62 +22,41 @@ const (
 )
 
 // Client HTTP клиент для работы с stats.mihf.ru
+// Частоту запросов, robots.txt и паузы после 429/503 контролирует общий governor
 type Client struct {
 	httpClient *http.Client
 	baseURL    string
-	delay      time.Duration
-	mu         sync.Mutex
-	lastReq    time.Time
 }
 
 // NewClient создает новый клиент для stats.mihf.ru
 func NewClient() *Client {
 	jar, _ := cookiejar.New(nil)
-	return &Client{
+	c := &Client{
 		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-			Jar:     jar,
+			Timeout:   DefaultTimeout,
+			Jar:       jar,
+			Transport: governor.NewTransport(),
 		},
 		baseURL: BaseURL,
-		delay:   DefaultDelay,
 	}
+	c.SetDelay(DefaultDelay)
+	return c
 }
 
-// SetDelay устанавливает задержку между запросами
+// SetDelay задаёт интервал между запросами, если для хоста нет лимита в конфигурации governor
 func (c *Client) SetDelay(d time.Duration) {
-	c.delay = d
+	governor.Default().SuggestInterval(c.baseURL, d)
 }
 
 // Get выполняет GET запрос с rate limiting
 func (c *Client) Get(path string) ([]byte, error) {
 	url := c.baseURL + path
-	return c.doRequestWithRateLimit(url)
+	return c.doRequest(url)
 }
 
 // GetURL выполняет GET запрос по полному URL
 func (c *Client) GetURL(url string) ([]byte, error) {
-	return c.doRequestWithRateLimit(url)
-}
-
-// doRequestWithRateLimit выполняет запрос с блокировкой на время всего запроса
-// Это гарантирует, что параллельные запросы выполняются последовательно
-func (c *Client) doRequestWithRateLimit(url string) ([]byte, error) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	// Ждем минимальную задержку с момента последнего запроса
-	elapsed := time.Since(c.lastReq)
-	if elapsed < c.delay {
-		time.Sleep(c.delay - elapsed)
-	}
-
-	// Выполняем запрос под мьютексом
-	result, err := c.doRequest(url)
-
-	// Обновляем время последнего запроса после завершения
-	c.lastReq = time.Now()
-
-	return result, err
+	return c.doRequest(url)
 }
 
 // doRequest выполняет HTTP запрос с retry логикой
@@ -114,13 +114,23 @@ func parseScore(scoreStr string, match *dto.MatchDTO) {
 	}
 }
 
-// parseDateTime парсит дату и время матча
+// moscow часовой пояс расписания МИХФ; без tzdata фиксированное смещение точно,
+// переходов на летнее время в Москве нет с 2014 года
+var moscow = func() *time.Location {
+	if loc, err := time.LoadLocation("Europe/Moscow"); err == nil {
+		return loc
+	}
+	return time.FixedZone("MSK", 3*60*60)
+}()
+
+// parseDateTime парсит дату и время матча по московскому времени.
+// Без времени возвращает полночь по Москве - календарь покажет матч на весь день
 func parseDateTime(dateStr, timeStr string) time.Time {
-	datetime := dateStr
-	if timeStr != "" {
-		datetime += " " + timeStr
+	layout, value := "02.01.2006", strings.TrimSpace(dateStr)
+	if timeStr = strings.TrimSpace(timeStr); timeStr != "" {
+		layout, value = layout+" 15:04", value+" "+timeStr
 	}
-	t, _ := time.Parse("02.01.2006 15:04", datetime)
+	t, _ := time.ParseInLocation(layout, value, moscow)
 	return t
 }
 
@@ -0,0 +1,32 @@
+package parsing
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDateTimeMoscow(t *testing.T) {
+	tests := []struct {
+		name       string
+		date, time string
+		want       time.Time
+	}{
+		{"date and time", "12.10.2026", "15:30", time.Date(2026, 10, 12, 12, 30, 0, 0, time.UTC)},
+		{"date only", "12.10.2026", "", time.Date(2026, 10, 11, 21, 0, 0, 0, time.UTC)},
+		{"padded", " 12.10.2026 ", " 09:05 ", time.Date(2026, 10, 12, 6, 5, 0, 0, time.UTC)},
+		{"no date", "", "", time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseDateTime(tt.date, tt.time)
+			if !got.Equal(tt.want) {
+				t.Errorf("parseDateTime(%q, %q) = %v, want %v", tt.date, tt.time, got, tt.want)
+			}
+		})
+	}
+
+	// Дата без времени - полночь по Москве, которую календарь выгружает событием на весь день
+	if got := parseDateTime("12.10.2026", "").In(moscow); got.Hour() != 0 || got.Minute() != 0 || got.Day() != 12 {
+		t.Errorf("date-only match is not Moscow midnight: %v", got)
+	}
+}
@@ -0,0 +1,166 @@
+package application
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/config/modules"
+)
+
+// dueTolerance допуск срока обновления: запуск по cron начинается в одно и то же время,
+// а время обновления турнира записывается позже, по ходу запуска. Без допуска турнир
+// с интервалом, равным периоду cron, ждал бы лишний запуск
+const dueTolerance = 15 * time.Minute
+
+// Причины, по которым турнир отложен до следующего запуска
+const (
+	deferBudget         = "budget"
+	deferMaxTournaments = "max_tournaments"
+)
+
+// CadenceSource турниры-кандидаты и расход суточного бюджета источника
+type CadenceSource interface {
+	GetCadenceCandidates(ctx context.Context, kind domain.CadenceKind, source string, matchDayWindow time.Duration) ([]domain.CadenceCandidate, error)
+	GetCadenceUsage(ctx context.Context, kind domain.CadenceKind, source string) (domain.CadenceUsage, error)
+}
+
+// CadencePlanner планирует, какие турниры обновлять в очередном запуске.
+//
+// Каждый класс приоритета обновляется со своим интервалом, турниры с матчем
+// в окне дня матча - чаще всех. Суточный бюджет источника распределяется по
+// запускам пропорционально времени с последнего обновления, поэтому частые
+// запуски делят бюджет на части, а не расходуют его весь утром. Турниры в день
+// матча обновляются сверх бюджета (но в пределах max_tournaments) и уменьшают
+// остаток бюджета для остальных.
+type CadencePlanner struct {
+	config *modules.SchedulerConfig
+	source CadenceSource
+}
+
+// NewCadencePlanner создаёт планировщик обновлений
+func NewCadencePlanner(config *modules.SchedulerConfig, source CadenceSource) *CadencePlanner {
+	return &CadencePlanner{config: config, source: source}
+}
+
+// Plan строит очередь обновления турниров источника.
+// max_tournaments задачи <source>_<kind> (junior_stats, fhspb_stats) ограничивает запуск сверх бюджета.
+func (p *CadencePlanner) Plan(ctx context.Context, source string, kind domain.CadenceKind) (domain.CadencePlan, error) {
+	cadence := p.config.Cadence
+
+	candidates, err := p.source.GetCadenceCandidates(ctx, kind, source, cadence.Window())
+	if err != nil {
+		return domain.CadencePlan{}, err
+	}
+	usage, err := p.source.GetCadenceUsage(ctx, kind, source)
+	if err != nil {
+		return domain.CadencePlan{}, err
+	}
+
+	maxTournaments := 0
+	if job, ok := p.config.GetJob(source + "_" + string(kind)); ok {
+		maxTournaments = job.MaxTournaments
+	}
+
+	return planCadence(candidates, usage, cadence, source, kind, maxTournaments, time.Now()), nil
+}
+
+func planCadence(
+	candidates []domain.CadenceCandidate,
+	usage domain.CadenceUsage,
+	cadence modules.CadenceConfig,
+	source string,
+	kind domain.CadenceKind,
+	maxTournaments int,
+	now time.Time,
+) domain.CadencePlan {
+	plan := domain.CadencePlan{
+		Source:      source,
+		Kind:        kind,
+		GeneratedAt: now,
+		DailyBudget: cadence.DailyBudget(source),
+		UsedToday:   usage.UsedToday,
+	}
+
+	for _, c := range candidates {
+		priority := c.Priority
+		if c.NextMatchAt != nil {
+			priority = domain.PriorityMatchDay
+		}
+
+		planned := domain.PlannedTournament{
+			TournamentID: c.TournamentID,
+			Name:         c.Name,
+			Priority:     priority,
+			LastParsedAt: c.LastParsedAt,
+		}
+		if c.LastParsedAt != nil {
+			planned.DueAt = c.LastParsedAt.Add(cadence.Interval(string(priority)))
+			if planned.DueAt.After(now.Add(dueTolerance)) {
+				continue
+			}
+		}
+		plan.Queue = append(plan.Queue, planned)
+	}
+
+	// Срочные классы первыми, внутри класса - дольше всех ждущие (ни разу не обновлявшиеся - в начале)
+	sort.SliceStable(plan.Queue, func(i, j int) bool {
+		a, b := plan.Queue[i], plan.Queue[j]
+		if a.Priority.Rank() != b.Priority.Rank() {
+			return a.Priority.Rank() < b.Priority.Rank()
+		}
+		if !a.DueAt.Equal(b.DueAt) {
+			return a.DueAt.Before(b.DueAt)
+		}
+		return a.TournamentID < b.TournamentID
+	})
+
+	// Турниры в день матча стоят в начале очереди и не ждут бюджета, но расходуют его
+	matchDays := 0
+	for _, planned := range plan.Queue {
+		if planned.Priority == domain.PriorityMatchDay {
+			matchDays++
+		}
+	}
+	usage.UsedToday += matchDays
+
+	allowed := matchDays + budgetQuota(plan.DailyBudget, usage, len(plan.Queue)-matchDays, now)
+	plan.Quota = allowed
+	if maxTournaments > 0 && plan.Quota > maxTournaments {
+		plan.Quota = maxTournaments
+	}
+
+	for i := range plan.Queue {
+		switch {
+		case i < plan.Quota:
+			plan.Queue[i].Scheduled = true
+		case i < allowed:
+			plan.Queue[i].DeferReason = deferMaxTournaments
+		default:
+			plan.Queue[i].DeferReason = deferBudget
+		}
+	}
+	return plan
+}
+
+// budgetQuota возвращает, сколько турниров можно обновить сейчас.
+//
+// Запуску достаётся доля суточного бюджета, пропорциональная времени с последнего
+// обновления источника, но не больше остатка бюджета за последние сутки.
+func budgetQuota(dailyBudget int, usage domain.CadenceUsage, due int, now time.Time) int {
+	if dailyBudget <= 0 {
+		return due
+	}
+
+	remaining := max(dailyBudget-usage.UsedToday, 0)
+
+	elapsed := 24 * time.Hour
+	if usage.LastParsedAt != nil {
+		elapsed = min(max(now.Sub(*usage.LastParsedAt), 0), 24*time.Hour)
+	}
+	paced := int(math.Ceil(float64(dailyBudget) * elapsed.Hours() / 24))
+
+	return min(remaining, paced, due)
+}
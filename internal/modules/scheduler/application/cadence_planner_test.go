@@ -0,0 +1,169 @@
+package application
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/config/modules"
+	"github.com/robfig/cron/v3"
+)
+
+func TestPlanCadence_PriorityIntervalsAndMatchDay(t *testing.T) {
+	now := time.Date(2025, 1, 18, 12, 0, 0, 0, time.UTC)
+	ago := func(d time.Duration) *time.Time {
+		at := now.Add(-d)
+		return &at
+	}
+
+	candidates := []domain.CadenceCandidate{
+		{TournamentID: "active-fresh", Priority: domain.PriorityActive, LastParsedAt: ago(2 * time.Hour)},
+		{TournamentID: "active-stale", Priority: domain.PriorityActive, LastParsedAt: ago(5 * time.Hour)},
+		{TournamentID: "match-day", Priority: domain.PriorityActive, LastParsedAt: ago(2 * time.Hour), NextMatchAt: &now},
+		{TournamentID: "archive-fresh", Priority: domain.PriorityArchive, LastParsedAt: ago(100 * 24 * time.Hour)},
+		{TournamentID: "archive-never", Priority: domain.PriorityArchive},
+		{TournamentID: "recent-stale", Priority: domain.PriorityRecent, LastParsedAt: ago(30 * time.Hour)},
+	}
+
+	plan := planCadence(candidates, domain.CadenceUsage{}, modules.CadenceConfig{}, "junior", domain.CadenceStats, 0, now)
+
+	want := []string{"match-day", "active-stale", "recent-stale", "archive-never"}
+	if len(plan.Queue) != len(want) {
+		t.Fatalf("queue = %+v, want %v", plan.Queue, want)
+	}
+	for i, id := range want {
+		if plan.Queue[i].TournamentID != id || !plan.Queue[i].Scheduled {
+			t.Fatalf("queue[%d] = %+v, want scheduled %s", i, plan.Queue[i], id)
+		}
+	}
+	if plan.Queue[0].Priority != domain.PriorityMatchDay {
+		t.Fatalf("match day priority = %s", plan.Queue[0].Priority)
+	}
+}
+
+func TestPlanCadence_DailyBudgetIsPaced(t *testing.T) {
+	now := time.Date(2025, 1, 18, 12, 0, 0, 0, time.UTC)
+	var candidates []domain.CadenceCandidate
+	for _, id := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		candidates = append(candidates, domain.CadenceCandidate{TournamentID: id, Priority: domain.PriorityActive})
+	}
+	cadence := modules.CadenceConfig{DailyBudgets: map[string]int{"junior": 48}}
+
+	// Последнее обновление час назад: запуску достаётся 1/24 бюджета
+	lastRun := now.Add(-time.Hour)
+	plan := planCadence(candidates, domain.CadenceUsage{UsedToday: 10, LastParsedAt: &lastRun}, cadence, "junior", domain.CadenceStats, 0, now)
+	if plan.Quota != 2 || len(plan.ScheduledIDs()) != 2 {
+		t.Fatalf("hourly quota = %d, scheduled %v", plan.Quota, plan.ScheduledIDs())
+	}
+	if plan.Queue[2].DeferReason != deferBudget {
+		t.Fatalf("defer reason = %q", plan.Queue[2].DeferReason)
+	}
+
+	// Сутки без обновлений: доступен остаток бюджета, но не больше max_tournaments
+	plan = planCadence(candidates, domain.CadenceUsage{UsedToday: 44}, cadence, "junior", domain.CadenceStats, 0, now)
+	if plan.Quota != 4 {
+		t.Fatalf("remaining budget quota = %d, want 4", plan.Quota)
+	}
+	plan = planCadence(candidates, domain.CadenceUsage{}, cadence, "junior", domain.CadenceStats, 3, now)
+	if plan.Quota != 3 || plan.Queue[3].DeferReason != deferMaxTournaments {
+		t.Fatalf("max_tournaments quota = %d, queue[3] = %+v", plan.Quota, plan.Queue[3])
+	}
+}
+
+// Прогон конфигурации config/scheduler.yaml за двое суток: junior_stats запускается по своему
+// cron при включённом парсере, турнир в день матча обновляется не реже раза в час, а
+// остальные турниры укладываются в суточный бюджет источника
+func TestSchedulerConfig_MatchDayRevisitedHourly(t *testing.T) {
+	cfg, err := modules.LoadSchedulerConfig("../../../../config/scheduler.yaml")
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	for _, name := range []string{"junior_parser", "junior_stats"} {
+		job := cfg.Jobs[name]
+		job.Enabled = true
+		cfg.Jobs[name] = job
+	}
+	if !cfg.IsPipelineRoot("junior_stats") {
+		t.Fatal("junior_stats is not scheduled by its cron")
+	}
+	job := cfg.Jobs["junior_stats"]
+	schedule, err := cron.ParseStandard(job.Cron)
+	if err != nil {
+		t.Fatalf("parse cron %q: %v", job.Cron, err)
+	}
+
+	const (
+		matchDay = "match-day"
+		// runDuration время от начала запуска до записи времени обновления турнира
+		runDuration = 10 * time.Minute
+	)
+	start := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	matchAt := start.Add(24 * time.Hour)
+
+	// Окно дня матча определяет репозиторий; здесь турнир остаётся в нём весь прогон
+	candidates := []domain.CadenceCandidate{{TournamentID: matchDay, Priority: domain.PriorityActive, NextMatchAt: &matchAt}}
+	for i := 0; i < 300; i++ {
+		candidates = append(candidates, domain.CadenceCandidate{TournamentID: fmt.Sprintf("active-%03d", i), Priority: domain.PriorityActive})
+	}
+
+	var (
+		parsed       []time.Time // время каждого обновления турнира - расход бюджета
+		background   []time.Time // обновления вне дня матча, ограниченные бюджетом
+		matchDayRuns []time.Time
+	)
+	for now := schedule.Next(start.Add(-time.Second)); now.Before(start.Add(48 * time.Hour)); now = schedule.Next(now) {
+		usage := domain.CadenceUsage{}
+		for _, at := range parsed {
+			if at.After(now.Add(-24 * time.Hour)) {
+				usage.UsedToday++
+			}
+			if usage.LastParsedAt == nil || at.After(*usage.LastParsedAt) {
+				last := at
+				usage.LastParsedAt = &last
+			}
+		}
+
+		plan := planCadence(candidates, usage, cfg.Cadence, "junior", domain.CadenceStats, job.MaxTournaments, now)
+		scheduled := make(map[string]bool)
+		for _, id := range plan.ScheduledIDs() {
+			scheduled[id] = true
+		}
+
+		finished := now.Add(runDuration)
+		for i := range candidates {
+			if !scheduled[candidates[i].TournamentID] {
+				continue
+			}
+			candidates[i].LastParsedAt = &finished
+			parsed = append(parsed, finished)
+			if candidates[i].TournamentID == matchDay {
+				matchDayRuns = append(matchDayRuns, now)
+			} else {
+				background = append(background, finished)
+			}
+		}
+	}
+
+	if len(matchDayRuns) < 2 {
+		t.Fatalf("match day tournament refreshed %d times", len(matchDayRuns))
+	}
+	for i := 1; i < len(matchDayRuns); i++ {
+		if gap := matchDayRuns[i].Sub(matchDayRuns[i-1]); gap > time.Hour {
+			t.Fatalf("match day tournament not refreshed between %s and %s (%s)", matchDayRuns[i-1], matchDayRuns[i], gap)
+		}
+	}
+
+	budget := cfg.Cadence.DailyBudget("junior")
+	for _, at := range background {
+		used := 0
+		for _, other := range parsed {
+			if !other.After(at) && other.After(at.Add(-24*time.Hour)) {
+				used++
+			}
+		}
+		if used > budget {
+			t.Fatalf("%d tournaments refreshed in 24h up to %s, budget %d", used, at, budget)
+		}
+	}
+}
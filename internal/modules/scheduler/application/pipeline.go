@@ -0,0 +1,134 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/config/modules"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// jobRunner выполняет одну задачу и возвращает её статус
+type jobRunner func(name string, cfg modules.JobConfig) domain.JobRunStatus
+
+// upstreamCheck проверяет зависимости задачи, не попавшие в прогон, и возвращает причину
+// пропуска или пустую строку, если задачу можно запускать
+type upstreamCheck func(name string, upstreams []string) string
+
+// executePipeline выполняет задачи последовательно в топологическом порядке.
+//
+// Задача запускается, только если все её зависимости, попавшие в прогон, завершились успешно,
+// а зависимости вне прогона (из другого конвейера) одобрил outside; иначе она пропускается
+// с указанием причины, и пропуск распространяется дальше по графу. При outside == nil
+// зависимости вне прогона не учитываются.
+func executePipeline(root string, jobs []modules.JobWithName, run jobRunner, outside upstreamCheck) domain.PipelineRun {
+	pipeline := domain.PipelineRun{Root: root, StartedAt: time.Now()}
+	statuses := make(map[string]domain.JobStatus, len(jobs))
+
+	for _, job := range jobs {
+		var status domain.JobRunStatus
+		reason, external := blockedBy(job.Job.DependsOn, statuses)
+		if reason == "" && len(external) > 0 && outside != nil {
+			reason = outside(job.Name, external)
+		}
+		if reason != "" {
+			status = domain.JobRunStatus{JobName: job.Name, Status: domain.JobStatusSkipped, SkipReason: reason}
+		} else {
+			status = run(job.Name, job.Job)
+		}
+
+		statuses[job.Name] = status.Status
+		pipeline.Jobs = append(pipeline.Jobs, status)
+	}
+
+	pipeline.EndedAt = time.Now()
+	return pipeline
+}
+
+// blockedBy возвращает причину пропуска, если какая-то из зависимостей прогона не выполнена,
+// и зависимости, которых нет в прогоне
+func blockedBy(dependsOn []string, statuses map[string]domain.JobStatus) (string, []string) {
+	var reasons, external []string
+	for _, dep := range dependsOn {
+		status, ok := statuses[dep]
+		if !ok {
+			external = append(external, dep)
+			continue
+		}
+		if status != domain.JobStatusSucceeded {
+			reasons = append(reasons, fmt.Sprintf("upstream %s %s", dep, status))
+		}
+	}
+	return strings.Join(reasons, ", "), external
+}
+
+// logPipeline пишет в лог итог прогона конвейера
+func logPipeline(ctx context.Context, pipeline domain.PipelineRun) {
+	parts := make([]string, 0, len(pipeline.Jobs))
+	for _, job := range pipeline.Jobs {
+		part := job.JobName + "=" + string(job.Status)
+		switch {
+		case job.SkipReason != "":
+			part += " (" + job.SkipReason + ")"
+		case job.Error != "":
+			part += " (" + job.Error + ")"
+		}
+		parts = append(parts, part)
+	}
+
+	msg := fmt.Sprintf("Pipeline %s finished in %s: succeeded %d, failed %d, skipped %d [%s]",
+		pipeline.Root, pipeline.EndedAt.Sub(pipeline.StartedAt).Round(time.Second),
+		pipeline.Count(domain.JobStatusSucceeded), pipeline.Count(domain.JobStatusFailed),
+		pipeline.Count(domain.JobStatusSkipped), strings.Join(parts, "; "))
+
+	if pipeline.Succeeded() {
+		logger.Info(ctx, msg)
+	} else {
+		logger.Warn(ctx, msg)
+	}
+}
+
+// upstreamsReady проверяет зависимости из других конвейеров по истории запусков: последний
+// запуск каждой включённой зависимости должен быть успешным и завершиться после начала
+// последнего запуска задачи. Так задача, собирающая данные нескольких конвейеров,
+// выполняется один раз за цикл - в конвейере, который завершается последним.
+//
+// Корню конвейера с keep_cron, запущенному по своему cron, достаточно успешного
+// последнего запуска зависимостей: он обновляет данные чаще, чем запускаются зависимости
+func (s *SchedulerService) upstreamsReady(ctx context.Context, root string) upstreamCheck {
+	return func(name string, upstreams []string) string {
+		var enabled []string
+		for _, dep := range upstreams {
+			if s.config.Jobs[dep].Enabled {
+				enabled = append(enabled, dep)
+			}
+		}
+		if len(enabled) == 0 || s.history == nil {
+			return ""
+		}
+
+		last, err := s.history.LastFinished(ctx, append([]string{name}, enabled...))
+		if err != nil {
+			logger.Warn(ctx, "Failed to load upstream runs of "+name+": "+err.Error())
+			return "upstream runs unavailable"
+		}
+		own, ranBefore := last[name]
+
+		var reasons []string
+		for _, dep := range enabled {
+			run, ok := last[dep]
+			switch {
+			case !ok:
+				reasons = append(reasons, "upstream "+dep+" never ran")
+			case run.Status != domain.JobStatusSucceeded:
+				reasons = append(reasons, fmt.Sprintf("upstream %s %s", dep, run.Status))
+			case name != root && ranBefore && !run.StartedAt.Add(run.Duration).After(own.StartedAt):
+				reasons = append(reasons, "upstream "+dep+" not rerun since last run")
+			}
+		}
+		return strings.Join(reasons, ", ")
+	}
+}
@@ -0,0 +1,152 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/config/modules"
+)
+
+func TestExecutePipeline_SkipsDependantsOfFailedJob(t *testing.T) {
+	jobs := []modules.JobWithName{
+		{Name: "parser"},
+		{Name: "stats", Job: modules.JobConfig{DependsOn: []string{"parser"}}},
+		{Name: "calendar", Job: modules.JobConfig{DependsOn: []string{"stats"}}},
+		{Name: "other", Job: modules.JobConfig{DependsOn: []string{"disabled"}}},
+	}
+
+	var ran []string
+	run := func(name string, _ modules.JobConfig) domain.JobRunStatus {
+		ran = append(ran, name)
+		if name == "stats" {
+			return domain.JobRunStatus{JobName: name, Status: domain.JobStatusFailed, Error: "boom"}
+		}
+		return domain.JobRunStatus{JobName: name, Status: domain.JobStatusSucceeded}
+	}
+
+	pipeline := executePipeline("parser", jobs, run, nil)
+
+	if len(ran) != 3 || ran[0] != "parser" || ran[1] != "stats" || ran[2] != "other" {
+		t.Fatalf("unexpected executed jobs: %v", ran)
+	}
+
+	calendar := pipeline.Jobs[2]
+	if calendar.Status != domain.JobStatusSkipped || calendar.SkipReason != "upstream stats failed" {
+		t.Errorf("calendar: got %s (%s)", calendar.Status, calendar.SkipReason)
+	}
+	if pipeline.Succeeded() {
+		t.Error("pipeline with failed job should not succeed")
+	}
+	if pipeline.Count(domain.JobStatusSucceeded) != 2 || pipeline.Count(domain.JobStatusFailed) != 1 || pipeline.Count(domain.JobStatusSkipped) != 1 {
+		t.Errorf("unexpected counts: %+v", pipeline.Jobs)
+	}
+}
+
+func TestExecutePipeline_SkipPropagates(t *testing.T) {
+	jobs := []modules.JobWithName{
+		{Name: "parser"},
+		{Name: "stats", Job: modules.JobConfig{DependsOn: []string{"parser"}}},
+		{Name: "calendar", Job: modules.JobConfig{DependsOn: []string{"stats"}}},
+	}
+
+	run := func(name string, _ modules.JobConfig) domain.JobRunStatus {
+		return domain.JobRunStatus{JobName: name, Status: domain.JobStatusSkipped, SkipReason: "already running"}
+	}
+
+	pipeline := executePipeline("parser", jobs, run, nil)
+	if got := pipeline.Jobs[2].SkipReason; got != "upstream stats skipped" {
+		t.Errorf("calendar skip reason = %q", got)
+	}
+}
+
+type fakeHistory struct {
+	last map[string]domain.JobRunStatus
+}
+
+func (h fakeHistory) Save(context.Context, domain.JobResult) error  { return nil }
+func (h fakeHistory) Abandon(context.Context, string, string) error { return nil }
+
+func (h fakeHistory) LastFinished(_ context.Context, names []string) (map[string]domain.JobRunStatus, error) {
+	result := make(map[string]domain.JobRunStatus)
+	for _, name := range names {
+		if run, ok := h.last[name]; ok {
+			result[name] = run
+		}
+	}
+	return result, nil
+}
+
+func TestExecutePipeline_ChecksUpstreamsOutsideRun(t *testing.T) {
+	jobs := []modules.JobWithName{
+		{Name: "junior_calendar"},
+		{Name: "league_strength", Job: modules.JobConfig{DependsOn: []string{"junior_calendar", "fhspb_calendar"}}},
+	}
+	run := func(name string, _ modules.JobConfig) domain.JobRunStatus {
+		return domain.JobRunStatus{JobName: name, Status: domain.JobStatusSucceeded}
+	}
+	outside := func(name string, upstreams []string) string {
+		if name != "league_strength" || len(upstreams) != 1 || upstreams[0] != "fhspb_calendar" {
+			t.Fatalf("outside(%s, %v)", name, upstreams)
+		}
+		return "upstream fhspb_calendar failed"
+	}
+
+	pipeline := executePipeline("junior_calendar", jobs, run, outside)
+	if got := pipeline.Jobs[1]; got.Status != domain.JobStatusSkipped || got.SkipReason != "upstream fhspb_calendar failed" {
+		t.Errorf("league_strength: got %s (%s)", got.Status, got.SkipReason)
+	}
+}
+
+func TestUpstreamsReady(t *testing.T) {
+	day := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	at := func(hour int, status domain.JobStatus) domain.JobRunStatus {
+		return domain.JobRunStatus{Status: status, StartedAt: day.Add(time.Duration(hour) * time.Hour), Duration: 30 * time.Minute}
+	}
+	config := &modules.SchedulerConfig{Jobs: map[string]modules.JobConfig{
+		"junior_calendar": {Enabled: true},
+		"fhspb_calendar":  {Enabled: true},
+		"mihf_calendar":   {Enabled: false},
+		"league_strength": {Enabled: true},
+	}}
+
+	tests := []struct {
+		name string
+		root string
+		last map[string]domain.JobRunStatus
+		want string
+	}{
+		{"fresh success", "junior_calendar", map[string]domain.JobRunStatus{
+			"league_strength": at(-15, domain.JobStatusSucceeded),
+			"fhspb_calendar":  at(3, domain.JobStatusSucceeded),
+		}, ""},
+		{"never ran", "junior_calendar", map[string]domain.JobRunStatus{}, "upstream fhspb_calendar never ran"},
+		{"last run failed", "junior_calendar", map[string]domain.JobRunStatus{
+			"fhspb_calendar": at(3, domain.JobStatusFailed),
+		}, "upstream fhspb_calendar failed"},
+		{"not rerun since dependant", "junior_calendar", map[string]domain.JobRunStatus{
+			"league_strength": at(9, domain.JobStatusSucceeded),
+			"fhspb_calendar":  at(3, domain.JobStatusSucceeded),
+		}, "upstream fhspb_calendar not rerun since last run"},
+		// Запуск по своему cron (keep_cron) ждёт только успеха зависимостей
+		{"own cron after earlier success", "league_strength", map[string]domain.JobRunStatus{
+			"league_strength": at(9, domain.JobStatusSucceeded),
+			"fhspb_calendar":  at(3, domain.JobStatusSucceeded),
+		}, ""},
+		{"own cron after failure", "league_strength", map[string]domain.JobRunStatus{
+			"league_strength": at(9, domain.JobStatusSucceeded),
+			"fhspb_calendar":  at(3, domain.JobStatusFailed),
+		}, "upstream fhspb_calendar failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &SchedulerService{config: config, history: fakeHistory{last: tt.last}}
+			check := s.upstreamsReady(context.Background(), tt.root)
+			if got := check("league_strength", []string{"fhspb_calendar", "mihf_calendar"}); got != tt.want {
+				t.Errorf("reason = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
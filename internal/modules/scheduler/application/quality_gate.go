@@ -0,0 +1,58 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+)
+
+// QualityGate проверки качества данных после успешной задачи
+type QualityGate interface {
+	// Check проверяет строки, изменённые задачей с since, и возвращает имена критических
+	// правил с нарушениями, впервые найденными в этом запуске
+	Check(ctx context.Context, runID, jobName string, since time.Time) ([]string, error)
+}
+
+// UseQualityGate включает проверки качества данных после каждой успешной задачи
+func (s *SchedulerService) UseQualityGate(gate QualityGate) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.quality = gate
+}
+
+// checkQuality проверяет данные, изменённые задачей. При новых критических нарушениях и block_on_critical
+// задача получает статус blocked: её работа сохранена, но зависимые задачи не запускаются.
+// Ошибка самих проверок задачу не блокирует
+func (s *SchedulerService) checkQuality(ctx context.Context, runID string, status *domain.JobRunStatus) {
+	s.mu.RLock()
+	gate := s.quality
+	s.mu.RUnlock()
+	if gate == nil {
+		return
+	}
+
+	critical, err := gate.Check(ctx, runID, status.JobName, status.StartedAt)
+	if err != nil {
+		logger.Warn(ctx, "Data quality checks failed after "+status.JobName+": "+err.Error())
+		if s.metrics != nil {
+			s.metrics.RecordError(ctx, status.JobName, "quality_check_failed")
+		}
+	}
+	if len(critical) == 0 {
+		return
+	}
+
+	msg := fmt.Sprintf("critical data quality violations: %s", strings.Join(critical, ", "))
+	if !s.config.Quality.BlockOnCritical {
+		logger.Warn(ctx, "Job "+status.JobName+" left "+msg)
+		return
+	}
+
+	status.Status = domain.JobStatusBlocked
+	status.Error = msg
+	logger.Error(ctx, "Job blocked: "+status.JobName+" ("+msg+")")
+}
@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/config/modules"
+)
+
+type fakeGate struct {
+	critical []string
+	err      error
+}
+
+func (g fakeGate) Check(context.Context, string, string, time.Time) ([]string, error) {
+	return g.critical, g.err
+}
+
+func TestCheckQuality(t *testing.T) {
+	tests := []struct {
+		name  string
+		gate  QualityGate
+		block bool
+		want  domain.JobStatus
+	}{
+		{"no gate", nil, true, domain.JobStatusSucceeded},
+		{"clean", fakeGate{}, true, domain.JobStatusSucceeded},
+		{"critical blocks", fakeGate{critical: []string{"stats_points_sum"}}, true, domain.JobStatusBlocked},
+		{"critical without blocking", fakeGate{critical: []string{"stats_points_sum"}}, false, domain.JobStatusSucceeded},
+		{"check error", fakeGate{err: errors.New("db down")}, true, domain.JobStatusSucceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &SchedulerService{config: &modules.SchedulerConfig{Quality: modules.QualityConfig{Enabled: true, BlockOnCritical: tt.block}}}
+			s.UseQualityGate(tt.gate)
+
+			status := domain.JobRunStatus{JobName: "junior_stats", Status: domain.JobStatusSucceeded}
+			s.checkQuality(context.Background(), "run", &status)
+			if status.Status != tt.want {
+				t.Errorf("status = %s (%s), want %s", status.Status, status.Error, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecutePipeline_SkipsDependantsOfBlockedJob(t *testing.T) {
+	jobs := []modules.JobWithName{
+		{Name: "stats"},
+		{Name: "league_strength", Job: modules.JobConfig{DependsOn: []string{"stats"}}},
+	}
+	run := func(name string, _ modules.JobConfig) domain.JobRunStatus {
+		return domain.JobRunStatus{JobName: name, Status: domain.JobStatusBlocked}
+	}
+
+	pipeline := executePipeline("stats", jobs, run, nil)
+	if got := pipeline.Jobs[1].SkipReason; got != "upstream stats blocked" {
+		t.Errorf("league_strength skip reason = %q", got)
+	}
+}
@@ -5,108 +5,71 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/parsing/infrastructure/repositories"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 )
 
-// RetryWorker обрабатывает неудачные задачи парсинга
-type RetryWorker struct {
-	failedJobRepo *repositories.FailedJobRepository
-	handlers      map[string]RetryHandler
-}
-
-// RetryHandler функция для повторной попытки
-type RetryHandler func(ctx context.Context, job *repositories.FailedJob) error
+// retryBatchSize задач за один проход по очереди
+const retryBatchSize = 100
 
-// NewRetryWorker создаёт новый retry worker
-func NewRetryWorker(failedJobRepo *repositories.FailedJobRepository) *RetryWorker {
-	return &RetryWorker{
-		failedJobRepo: failedJobRepo,
-		handlers:      make(map[string]RetryHandler),
-	}
+// RetryWorker задача scheduler, повторяющая неудачные задачи парсинга
+type RetryWorker struct {
+	worker *retry.Worker
+	store  *retry.Store
 }
 
-// RegisterHandler регистрирует обработчик для типа задачи
-func (w *RetryWorker) RegisterHandler(jobType string, handler RetryHandler) {
-	w.handlers[jobType] = handler
+// NewRetryWorker создаёт задачу повторов поверх очереди с зарегистрированными обработчиками
+func NewRetryWorker(worker *retry.Worker, store *retry.Store) *RetryWorker {
+	return &RetryWorker{worker: worker, store: store}
 }
 
-// Process обрабатывает pending задачи
-func (w *RetryWorker) Process(ctx context.Context) error {
-	jobs, err := w.failedJobRepo.GetPendingRetries(ctx, 100)
-	if err != nil {
-		return fmt.Errorf("get pending retries: %w", err)
-	}
-
-	if len(jobs) == 0 {
-		logger.Info(ctx, "No pending retry jobs")
-		return nil
-	}
+// Process проходит очередь пачками, пока в ней есть задачи с наступившим временем повтора
+func (w *RetryWorker) Process(ctx context.Context, run domain.JobRun) error {
+	var total retry.Result
 
-	logger.Info(ctx, fmt.Sprintf("Processing %d retry jobs", len(jobs)))
+	for {
+		result, err := w.worker.Process(ctx, retryBatchSize)
+		total.Processed += result.Processed
+		total.Succeeded += result.Succeeded
+		total.Retried += result.Retried
+		total.Dead += result.Dead
 
-	var processed, succeeded, failed int
+		run.Track("succeeded", result.Succeeded)
+		run.Track("retried", result.Retried)
+		run.Track("dead", result.Dead)
 
-	for _, job := range jobs {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
+		if err != nil {
+			return err
 		}
-
-		handler, ok := w.handlers[job.JobType]
-		if !ok {
-			logger.Warn(ctx, "No handler for job type: "+job.JobType)
-			continue
+		if result.Processed < retryBatchSize {
+			break
 		}
+	}
 
-		processed++
-		err := handler(ctx, job)
-
-		if err == nil {
-			// Успех - удаляем задачу
-			if delErr := w.failedJobRepo.Delete(ctx, job.ID); delErr != nil {
-				logger.Error(ctx, "Failed to delete job: "+delErr.Error())
-			}
-			succeeded++
-		} else {
-			// Ошибка - увеличиваем счётчик
-			nextRetry := time.Now().Add(repositories.GetRetryInterval(job.RetryCount + 1))
-			if updErr := w.failedJobRepo.IncrementRetry(ctx, job.ID, nextRetry, err.Error()); updErr != nil {
-				logger.Error(ctx, "Failed to update job: "+updErr.Error())
-			}
-			failed++
-		}
+	if total.Processed == 0 {
+		logger.Info(ctx, "No pending retry jobs")
+		return nil
 	}
 
-	logger.Info(ctx, fmt.Sprintf("Retry complete: processed=%d, succeeded=%d, failed=%d", processed, succeeded, failed))
+	logger.Info(ctx, fmt.Sprintf("Retry complete: processed=%d, succeeded=%d, retried=%d, dead=%d",
+		total.Processed, total.Succeeded, total.Retried, total.Dead))
 	return nil
 }
 
 // Run запускает обработку как задачу scheduler
-func (w *RetryWorker) Run() error {
-	ctx := context.Background()
-	return w.Process(ctx)
+func (w *RetryWorker) Run(ctx context.Context, run domain.JobRun) error {
+	return w.Process(ctx, run)
 }
 
-// Cleanup удаляет старые записи
+// Cleanup удаляет dead-задачи старше olderThan
 func (w *RetryWorker) Cleanup(ctx context.Context, olderThan time.Duration) error {
-	deleted, err := w.failedJobRepo.CleanupOld(ctx, olderThan)
+	deleted, err := w.store.CleanupDead(ctx, olderThan)
 	if err != nil {
 		return err
 	}
 	if deleted > 0 {
-		logger.Info(ctx, fmt.Sprintf("Cleaned up %d old failed jobs", deleted))
+		logger.Info(ctx, fmt.Sprintf("Cleaned up %d dead failed jobs", deleted))
 	}
 	return nil
 }
-
-// Stats возвращает статистику
-func (w *RetryWorker) Stats(ctx context.Context) (pending, failed int, err error) {
-	pending, err = w.failedJobRepo.CountPending(ctx)
-	if err != nil {
-		return 0, 0, err
-	}
-	failed, err = w.failedJobRepo.CountFailed(ctx)
-	return pending, failed, err
-}
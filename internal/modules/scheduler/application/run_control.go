@@ -0,0 +1,173 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// controlPollInterval интервал опроса запросов ручного запуска из админки.
+// Отмена задачи приходит с heartbeat аренды блокировки.
+const controlPollInterval = 15 * time.Second
+
+// RunHistory хранилище истории запусков задач
+type RunHistory interface {
+	Save(ctx context.Context, result domain.JobResult) error
+	// Abandon завершает с ошибкой запуск инстанса, переставшего продлевать аренду
+	Abandon(ctx context.Context, runID, reason string) error
+	// LastFinished возвращает последний завершённый (не пропущенный) запуск каждой задачи
+	LastFinished(ctx context.Context, jobNames []string) (map[string]domain.JobRunStatus, error)
+}
+
+// JobControls управление задачами из админки: пауза и ручной запуск
+type JobControls interface {
+	IsPaused(ctx context.Context, jobName string) (bool, error)
+	ClaimTriggers(ctx context.Context) ([]string, error)
+}
+
+func (s *SchedulerService) saveResult(ctx context.Context, result domain.JobResult) {
+	if s.history == nil {
+		return
+	}
+	if err := s.history.Save(ctx, result); err != nil {
+		logger.Warn(ctx, "Failed to save job run "+result.JobName+": "+err.Error())
+	}
+}
+
+// saveSkipped записывает в историю задачу, которая не запускалась
+func (s *SchedulerService) saveSkipped(ctx context.Context, pipeline domain.PipelineRun, status domain.JobRunStatus) {
+	trigger := pipeline.Trigger
+	if status.JobName != pipeline.Root && trigger != domain.TriggerRunOnce {
+		trigger = domain.TriggerUpstream
+	}
+
+	now := time.Now()
+	s.saveResult(context.WithoutCancel(ctx), domain.JobResult{
+		RunID:      uuid.New().String(),
+		JobName:    status.JobName,
+		Pipeline:   pipeline.Root,
+		Trigger:    trigger,
+		InstanceID: s.instanceID,
+		Status:     status.Status,
+		StartedAt:  now,
+		EndedAt:    now,
+		Error:      status.Error,
+		SkipReason: status.SkipReason,
+	})
+}
+
+func (s *SchedulerService) isPaused(ctx context.Context, jobName string) bool {
+	if s.controls == nil {
+		return false
+	}
+	paused, err := s.controls.IsPaused(ctx, jobName)
+	if err != nil {
+		logger.Warn(ctx, "Failed to check pause for "+jobName+": "+err.Error())
+		return false
+	}
+	return paused
+}
+
+// keepAlive продлевает аренду блокировки, пока задача выполняется.
+//
+// stop вызывается не более одного раза: с lost=false, если из админки запрошена отмена, и с
+// lost=true, если блокировку перехватили или её не удалось продлить до Lease.SafeUntil.
+// Fencing token проверяют записи контрольных точек, а не доменные записи между ними, поэтому
+// задача останавливается (отменой её контекста) до истечения аренды: пока аренда действует,
+// блокировку никто не перехватит и доменные записи задачи не пересекутся с записями нового
+// владельца. Если это не удалось, запуск остановит первая отклонённая контрольная точка. Каждое продление
+// ограничено интервалом heartbeat, чтобы зависший запрос не отложил остановку.
+func (s *SchedulerService) keepAlive(ctx context.Context, lease *domain.Lease, stop func(lost bool), done <-chan struct{}) {
+	interval := lease.HeartbeatInterval()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	renewedAt := time.Now()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Аренда продлевается от момента запроса, а не ответа
+			attemptAt := time.Now()
+			heartbeatCtx, cancel := context.WithTimeout(ctx, interval)
+			cancelRequested, err := s.lockRepo.Heartbeat(heartbeatCtx, lease)
+			cancel()
+			switch {
+			case errors.Is(err, domain.ErrLeaseLost):
+				logger.Error(ctx, "Lock lease lost: "+lease.JobName)
+				stop(true)
+				return
+			case err != nil:
+				if ctx.Err() != nil {
+					return
+				}
+				logger.Warn(ctx, "Failed to renew lock lease for "+lease.JobName+": "+err.Error())
+				if !time.Now().Before(lease.SafeUntil(renewedAt)) {
+					logger.Error(ctx, "Lock lease about to expire, stopping job: "+lease.JobName)
+					stop(true)
+					return
+				}
+			case cancelRequested:
+				logger.Info(ctx, "Cancel requested for job: "+lease.JobName)
+				stop(false)
+				return
+			default:
+				renewedAt = attemptAt
+			}
+		}
+	}
+}
+
+// abandonRun отмечает упавшим запуск прежнего владельца перехваченной блокировки
+func (s *SchedulerService) abandonRun(ctx context.Context, lease *domain.Lease) {
+	logger.Warn(ctx, fmt.Sprintf("Lock of %s taken over from instance %s (stopped heartbeating)", lease.JobName, lease.PreviousInstanceID))
+	if s.history == nil || lease.PreviousRunID == "" {
+		return
+	}
+	reason := "lock lease expired: instance " + lease.PreviousInstanceID + " stopped heartbeating"
+	if err := s.history.Abandon(ctx, lease.PreviousRunID, reason); err != nil {
+		logger.Warn(ctx, "Failed to mark abandoned run "+lease.PreviousRunID+": "+err.Error())
+	}
+}
+
+// watchControls забирает запросы ручного запуска и выполняет конвейеры этих задач
+func (s *SchedulerService) watchControls(ctx context.Context) {
+	defer s.background.Done()
+
+	ticker := time.NewTicker(controlPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			names, err := s.controls.ClaimTriggers(ctx)
+			if err != nil {
+				logger.Warn(ctx, "Failed to claim job triggers: "+err.Error())
+				continue
+			}
+			for _, name := range names {
+				if _, ok := s.config.GetJob(name); !ok {
+					logger.Warn(ctx, "Trigger for unknown job ignored: "+name)
+					continue
+				}
+				logger.Info(ctx, "Manual trigger: "+name)
+
+				s.background.Add(1)
+				go func(name string) {
+					defer s.background.Done()
+					s.RunPipeline(ctx, name, domain.TriggerManual)
+				}(name)
+			}
+		}
+	}
+}
@@ -3,63 +3,96 @@ package application
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/infrastructure"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/config/modules"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
 	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	"github.com/google/uuid"
 )
 
 // SchedulerService сервис планировщика
 type SchedulerService struct {
-	config     *modules.SchedulerConfig
-	cron       *infrastructure.CronAdapter
-	lockRepo   *infrastructure.LockRepository
-	metrics    *infrastructure.SchedulerMetrics
-	instanceID string
-	handlers   map[string]func() error
-	mu         sync.RWMutex
-	running    bool
+	config      *modules.SchedulerConfig
+	cron        *infrastructure.CronAdapter
+	lockRepo    *infrastructure.LockRepository
+	metrics     *infrastructure.SchedulerMetrics
+	checkpoints domain.CheckpointStore
+	history     RunHistory
+	controls    JobControls
+	quality     QualityGate
+	instanceID  string
+	handlers    map[string]domain.Job
+	mu          sync.RWMutex
+	running     bool
+
+	// baseCtx родительский контекст задач, запущенных по cron; отменяется в Stop
+	baseCtx    context.Context
+	cancelJobs context.CancelFunc
+	background sync.WaitGroup // ручные запуски и опрос управляющих команд
+
+	statusMu      sync.RWMutex
+	lastStatuses  map[string]domain.JobRunStatus
+	lastPipelines map[string]domain.PipelineRun
 }
 
+// allJobsPipeline имя прогона всех задач в режиме run-once
+const allJobsPipeline = "all"
+
 // NewSchedulerService создаёт новый сервис планировщика
 func NewSchedulerService(
 	config *modules.SchedulerConfig,
 	lockRepo *infrastructure.LockRepository,
+	checkpoints domain.CheckpointStore,
+	history RunHistory,
+	controls JobControls,
 	metrics *infrastructure.SchedulerMetrics,
 ) *SchedulerService {
 	return &SchedulerService{
-		config:     config,
-		cron:       infrastructure.NewCronAdapter(),
-		lockRepo:   lockRepo,
-		metrics:    metrics,
-		instanceID: uuid.New().String()[:8],
-		handlers:   make(map[string]func() error),
+		config:      config,
+		cron:        infrastructure.NewCronAdapter(),
+		lockRepo:    lockRepo,
+		metrics:     metrics,
+		checkpoints: checkpoints,
+		history:     history,
+		controls:    controls,
+		instanceID:  uuid.New().String()[:8],
+		handlers:    make(map[string]domain.Job),
+
+		lastStatuses:  make(map[string]domain.JobRunStatus),
+		lastPipelines: make(map[string]domain.PipelineRun),
 	}
 }
 
 // RegisterHandler регистрирует обработчик для задачи
-func (s *SchedulerService) RegisterHandler(jobName string, handler func() error) {
+func (s *SchedulerService) RegisterHandler(jobName string, job domain.Job) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.handlers[jobName] = handler
+	s.handlers[jobName] = job
 }
 
 // GetHandlers возвращает все зарегистрированные handlers
-func (s *SchedulerService) GetHandlers() map[string]func() error {
+func (s *SchedulerService) GetHandlers() map[string]domain.Job {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	result := make(map[string]func() error)
+	result := make(map[string]domain.Job)
 	for k, v := range s.handlers {
 		result[k] = v
 	}
 	return result
 }
 
-// Start запускает планировщик
+// Start запускает планировщик.
+//
+// По cron планируются только корневые задачи конвейеров; зависимые задачи
+// запускаются после успешного выполнения своих зависимостей, а с keep_cron -
+// ещё и по своему cron.
 func (s *SchedulerService) Start(ctx context.Context) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -68,22 +101,38 @@ func (s *SchedulerService) Start(ctx context.Context) error {
 		return nil
 	}
 
-	for name, jobCfg := range s.config.EnabledJobs() {
-		handler, ok := s.handlers[name]
-		if !ok {
-			logger.Warn(ctx, "No handler for job: "+name)
+	// Задачи не наследуют отмену ctx запуска, но останавливаются вместе с планировщиком
+	s.baseCtx, s.cancelJobs = context.WithCancel(context.WithoutCancel(ctx))
+
+	roots := 0
+	for _, job := range s.config.EnabledJobsOrdered() {
+		if _, ok := s.handlers[job.Name]; !ok {
+			logger.Warn(ctx, "No handler for job: "+job.Name)
+		}
+
+		if !s.config.IsPipelineRoot(job.Name) {
+			logger.Info(ctx, fmt.Sprintf("Job %s runs after: %s", job.Name, strings.Join(s.config.EnabledUpstreams(job.Name), ", ")))
 			continue
 		}
 
-		if err := s.scheduleJob(ctx, name, jobCfg, handler); err != nil {
+		if err := s.scheduleJob(ctx, job.Name, job.Job); err != nil {
 			return err
 		}
+		if upstreams := s.config.EnabledUpstreams(job.Name); len(upstreams) > 0 {
+			logger.Info(ctx, fmt.Sprintf("Job %s also runs after: %s", job.Name, strings.Join(upstreams, ", ")))
+		}
+		roots++
 	}
 
 	s.cron.Start()
 	s.running = true
 
-	logger.Info(ctx, fmt.Sprintf("Scheduler started (instance: %s, jobs: %d)", s.instanceID, len(s.config.EnabledJobs())))
+	if s.controls != nil {
+		s.background.Add(1)
+		go s.watchControls(s.baseCtx)
+	}
+
+	logger.Info(ctx, fmt.Sprintf("Scheduler started (instance: %s, jobs: %d, pipelines: %d)", s.instanceID, len(s.config.EnabledJobs()), roots))
 	return nil
 }
 
@@ -96,7 +145,10 @@ func (s *SchedulerService) Stop(ctx context.Context) error {
 		return nil
 	}
 
+	// Отменяем выполняющиеся задачи и ждём их завершения
+	s.cancelJobs()
 	s.cron.Stop()
+	s.background.Wait()
 	s.running = false
 
 	// Освобождаем все локи этого инстанса
@@ -113,56 +165,267 @@ func (s *SchedulerService) IsBootstrapMode() bool {
 	return s.config.BootstrapMode
 }
 
-func (s *SchedulerService) scheduleJob(ctx context.Context, name string, cfg modules.JobConfig, handler func() error) error {
-	wrappedHandler := s.wrapHandler(name, cfg.Timeout, handler)
-
-	_, err := s.cron.AddJob(cfg.Cron, wrappedHandler)
+func (s *SchedulerService) scheduleJob(ctx context.Context, name string, cfg modules.JobConfig) error {
+	_, err := s.cron.AddJob(cfg.Cron, func() {
+		s.RunPipeline(s.baseCtx, name, domain.TriggerCron)
+	})
 	if err != nil {
 		return err
 	}
 
-	logger.Info(ctx, fmt.Sprintf("Job scheduled: %s (cron: %s, timeout: %s)", name, cfg.Cron, cfg.Timeout))
+	pipeline := s.config.PipelineJobs(name)
+	names := make([]string, len(pipeline))
+	for i, job := range pipeline {
+		names[i] = job.Name
+	}
+	logger.Info(ctx, fmt.Sprintf("Job scheduled: %s (cron: %s, timeout: %s, pipeline: %s)", name, cfg.Cron, cfg.Timeout, strings.Join(names, " -> ")))
 	return nil
 }
 
-func (s *SchedulerService) wrapHandler(jobName string, timeout time.Duration, handler func() error) func() {
-	return func() {
-		ctx, cancel := context.WithTimeout(context.Background(), timeout)
-		defer cancel()
+// RunPipeline выполняет задачу и все включённые задачи, зависящие от неё
+func (s *SchedulerService) RunPipeline(ctx context.Context, root string, trigger domain.JobTrigger) domain.PipelineRun {
+	pipeline := executePipeline(root, s.config.PipelineJobs(root), s.jobRunner(ctx, root, trigger), s.upstreamsReady(ctx, root))
+	pipeline.Trigger = trigger
+	s.recordPipeline(ctx, pipeline)
+	return pipeline
+}
 
-		// Пытаемся получить блокировку
-		acquired, err := s.lockRepo.TryAcquire(ctx, jobName, timeout, s.instanceID)
-		if err != nil {
-			logger.Error(ctx, "Failed to acquire lock: "+err.Error())
-			if s.metrics != nil {
-				s.metrics.RecordError(ctx, jobName, "lock_failed")
-			}
-			return
+// RunAllOnce выполняет все включённые задачи один раз в порядке зависимостей
+func (s *SchedulerService) RunAllOnce(ctx context.Context) domain.PipelineRun {
+	pipeline := executePipeline(allJobsPipeline, s.config.EnabledJobsTopological(), s.jobRunner(ctx, allJobsPipeline, domain.TriggerRunOnce), nil)
+	pipeline.Trigger = domain.TriggerRunOnce
+	s.recordPipeline(ctx, pipeline)
+	return pipeline
+}
+
+// JobStatuses возвращает последний статус каждой запускавшейся задачи
+func (s *SchedulerService) JobStatuses() map[string]domain.JobRunStatus {
+	s.statusMu.RLock()
+	defer s.statusMu.RUnlock()
+
+	result := make(map[string]domain.JobRunStatus, len(s.lastStatuses))
+	for name, status := range s.lastStatuses {
+		result[name] = status
+	}
+	return result
+}
+
+// LastPipelines возвращает последний прогон каждого конвейера
+func (s *SchedulerService) LastPipelines() map[string]domain.PipelineRun {
+	s.statusMu.RLock()
+	defer s.statusMu.RUnlock()
+
+	result := make(map[string]domain.PipelineRun, len(s.lastPipelines))
+	for root, pipeline := range s.lastPipelines {
+		result[root] = pipeline
+	}
+	return result
+}
+
+func (s *SchedulerService) recordPipeline(ctx context.Context, pipeline domain.PipelineRun) {
+	s.statusMu.Lock()
+	s.lastPipelines[pipeline.Root] = pipeline
+	for _, job := range pipeline.Jobs {
+		s.lastStatuses[job.JobName] = job
+	}
+	s.statusMu.Unlock()
+
+	// Запущенные задачи уже записаны в историю в runJob, здесь - только пропущенные
+	for _, job := range pipeline.Jobs {
+		if job.RunID == "" {
+			s.saveSkipped(ctx, pipeline, job)
 		}
-		if !acquired {
-			logger.Info(ctx, "Job already running, skipping: "+jobName)
-			return
+	}
+
+	logPipeline(ctx, pipeline)
+}
+
+func (s *SchedulerService) jobRunner(parent context.Context, root string, trigger domain.JobTrigger) jobRunner {
+	return func(name string, cfg modules.JobConfig) domain.JobRunStatus {
+		if parent.Err() != nil {
+			return domain.JobRunStatus{JobName: name, Status: domain.JobStatusSkipped, SkipReason: "cancelled"}
 		}
-		defer func() { _ = s.lockRepo.Release(ctx, jobName, s.instanceID) }()
 
-		startedAt := time.Now()
-		logger.Info(ctx, "Job started: "+jobName)
+		jobTrigger := trigger
+		if name != root && trigger != domain.TriggerRunOnce {
+			jobTrigger = domain.TriggerUpstream
+		}
 
-		err = handler()
+		// Ручной запуск выполняет задачу и на паузе
+		if jobTrigger != domain.TriggerManual && s.isPaused(parent, name) {
+			logger.Info(parent, "Job paused, skipping: "+name)
+			return domain.JobRunStatus{JobName: name, Status: domain.JobStatusSkipped, SkipReason: "paused"}
+		}
 
-		duration := time.Since(startedAt)
-		success := err == nil
+		s.mu.RLock()
+		job, ok := s.handlers[name]
+		s.mu.RUnlock()
+		if !ok {
+			logger.Warn(parent, "No handler for job: "+name)
+			return domain.JobRunStatus{JobName: name, Status: domain.JobStatusSkipped, SkipReason: "no handler"}
+		}
+		return s.runJob(parent, runTarget{name: name, pipeline: root, trigger: jobTrigger, timeout: cfg.Timeout}, job)
+	}
+}
+
+// runTarget задача и обстоятельства её запуска
+type runTarget struct {
+	name     string
+	pipeline string
+	trigger  domain.JobTrigger
+	timeout  time.Duration
+}
+
+func (s *SchedulerService) runJob(parent context.Context, target runTarget, job domain.Job) domain.JobRunStatus {
+	jobName := target.name
+	status := domain.JobRunStatus{JobName: jobName, StartedAt: time.Now()}
+
+	ctx, cancel := context.WithTimeout(parent, target.timeout)
+	defer cancel()
 
-		// Записываем метрики
+	// Служебные запросы (блокировка, контрольные точки, история) выполняются и после отмены задачи
+	opCtx := context.WithoutCancel(ctx)
+
+	// Блокировка берётся в аренду на короткий TTL и продлевается, пока задача выполняется
+	lease, err := s.lockRepo.Acquire(ctx, jobName, s.instanceID, s.config.LeaseDuration())
+	if err != nil {
+		logger.Error(ctx, "Failed to acquire lock: "+err.Error())
 		if s.metrics != nil {
-			s.metrics.RecordJobExecution(ctx, jobName, duration, success)
+			s.metrics.RecordError(ctx, jobName, "lock_failed")
 		}
+		status.Status = domain.JobStatusFailed
+		status.Error = "acquire lock: " + err.Error()
+		return status
+	}
+	if lease == nil {
+		logger.Info(ctx, "Job already running, skipping: "+jobName)
+		status.Status = domain.JobStatusSkipped
+		status.SkipReason = "already running"
+		return status
+	}
+	defer func() { _ = s.lockRepo.Release(opCtx, lease) }()
+
+	if lease.TakenOver() {
+		s.abandonRun(opCtx, lease)
+	}
+
+	// Задачу останавливают heartbeat (отмена из админки, потеря аренды) и отклонённая
+	// по fencing token контрольная точка - что случится раньше
+	var cancelled, leaseLost atomic.Bool
+	var stopOnce sync.Once
+	stop := func(lost bool) {
+		stopOnce.Do(func() {
+			if lost {
+				leaseLost.Store(true)
+			} else {
+				cancelled.Store(true)
+			}
+			cancel()
+		})
+	}
+
+	run := s.newJobRun(ctx, jobName, lease)
+	run.OnLeaseLost = func() {
+		logger.Error(ctx, "Checkpoint rejected, lock taken over: "+jobName)
+		stop(true)
+	}
+	status.RunID = run.ID
+
+	result := domain.JobResult{
+		RunID:      run.ID,
+		JobName:    jobName,
+		Pipeline:   target.pipeline,
+		Trigger:    target.trigger,
+		InstanceID: s.instanceID,
+		Status:     domain.JobStatusRunning,
+		StartedAt:  status.StartedAt,
+	}
+	s.saveResult(opCtx, result)
+	if err := s.lockRepo.SetRunID(opCtx, lease, run.ID); err != nil {
+		logger.Warn(ctx, "Failed to bind lock to run: "+err.Error())
+	}
+
+	// Heartbeat продлевает аренду и приносит отмену из админки
+	heartbeatDone := make(chan struct{})
+	go s.keepAlive(ctx, lease, stop, heartbeatDone)
 
-		if success {
-			logger.Info(ctx, "Job completed: "+jobName+" ("+duration.String()+")")
-		} else {
-			logger.Error(ctx, "Job failed: "+jobName+" ("+duration.String()+"): "+err.Error())
+	if run.Resumed() {
+		logger.Info(ctx, "Job started (resuming from checkpoint): "+jobName)
+	} else {
+		logger.Info(ctx, "Job started: "+jobName)
+	}
+
+	// ID запуска - сессия парсинга, по которой группируется история игроков
+	err = job.Run(events.WithSession(ctx, run.ID), run)
+	close(heartbeatDone)
+	if err == nil && ctx.Err() != nil {
+		// Задача вернулась без ошибки, но не успела доделать работу
+		err = ctx.Err()
+	}
+
+	status.Duration = time.Since(status.StartedAt)
+	success := err == nil
+
+	// Записываем метрики
+	if s.metrics != nil {
+		s.metrics.RecordJobExecution(ctx, jobName, status.Duration, success)
+	}
+
+	switch {
+	case success:
+		status.Status = domain.JobStatusSucceeded
+		s.clearCheckpoint(opCtx, jobName, lease.Token)
+		logger.Info(ctx, "Job completed: "+jobName+" ("+status.Duration.String()+")")
+		s.checkQuality(opCtx, run.ID, &status)
+	case cancelled.Load():
+		status.Status = domain.JobStatusCancelled
+		status.Error = "cancelled by admin"
+		logger.Warn(ctx, "Job cancelled: "+jobName+" ("+status.Duration.String()+")")
+	case leaseLost.Load():
+		status.Status = domain.JobStatusFailed
+		status.Error = "lock lease lost: " + err.Error()
+		logger.Error(ctx, "Job stopped, lock taken over: "+jobName+" ("+status.Duration.String()+")")
+	default:
+		status.Status = domain.JobStatusFailed
+		status.Error = err.Error()
+		logger.Error(ctx, "Job failed: "+jobName+" ("+status.Duration.String()+"): "+err.Error())
+	}
+
+	result.Status = status.Status
+	result.Error = status.Error
+	result.EndedAt = time.Now()
+	result.ItemsProcessed = run.ItemsProcessed()
+	result.Stats = run.Stats()
+	s.saveResult(opCtx, result)
+
+	return status
+}
+
+// newJobRun создаёт запуск задачи с позицией прерванного запуска, если она сохранена
+func (s *SchedulerService) newJobRun(ctx context.Context, jobName string, lease *domain.Lease) domain.JobRun {
+	deadline, _ := ctx.Deadline()
+
+	var run domain.JobRun
+	if s.checkpoints == nil {
+		run = domain.NewJobRun(uuid.New().String(), jobName, deadline, nil, nil)
+	} else {
+		cursor, err := s.checkpoints.Load(ctx, jobName)
+		if err != nil {
+			logger.Warn(ctx, "Failed to load checkpoint for "+jobName+": "+err.Error())
+			cursor = nil
 		}
+		run = domain.NewJobRun(uuid.New().String(), jobName, deadline, s.checkpoints, cursor)
+	}
+	run.FencingToken = lease.Token
+	return run
+}
+
+func (s *SchedulerService) clearCheckpoint(ctx context.Context, jobName string, fencingToken int64) {
+	if s.checkpoints == nil {
+		return
+	}
+	if err := s.checkpoints.Clear(ctx, jobName, fencingToken); err != nil {
+		logger.Warn(ctx, "Failed to clear checkpoint for "+jobName+": "+err.Error())
 	}
 }
 
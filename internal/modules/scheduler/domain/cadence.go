@@ -0,0 +1,83 @@
+package domain
+
+import "time"
+
+// PriorityMatchDay турнир, у которого сегодня матч: обновляется чаще ACTIVE
+const PriorityMatchDay Priority = "MATCH_DAY"
+
+// PriorityOrder порядок классов приоритета: от самого срочного к архиву
+var PriorityOrder = []Priority{
+	PriorityMatchDay,
+	PriorityActive,
+	PriorityRecent,
+	PriorityMedium,
+	PriorityOld,
+	PriorityArchive,
+}
+
+// Rank возвращает позицию приоритета в PriorityOrder (меньше = срочнее)
+func (p Priority) Rank() int {
+	for i, priority := range PriorityOrder {
+		if priority == p {
+			return i
+		}
+	}
+	return len(PriorityOrder)
+}
+
+// CadenceKind что обновляется в турнире
+type CadenceKind string
+
+const (
+	CadenceStats   CadenceKind = "stats"
+	CadencePlayers CadenceKind = "players"
+)
+
+// CadenceCandidate турнир-кандидат на обновление
+type CadenceCandidate struct {
+	TournamentID string
+	Name         string
+	Source       string
+	Priority     Priority   // класс по датам турнира, без учёта дня матча
+	LastParsedAt *time.Time // nil - ни разу не обновлялся
+	NextMatchAt  *time.Time // ближайший матч в окне дня матча, nil если его нет
+}
+
+// CadenceUsage расход бюджета источника
+type CadenceUsage struct {
+	UsedToday    int        // турниров обновлено за последние сутки
+	LastParsedAt *time.Time // последнее обновление любого турнира источника
+}
+
+// PlannedTournament турнир в очереди планировщика обновлений
+type PlannedTournament struct {
+	TournamentID string
+	Name         string
+	Priority     Priority // с учётом дня матча
+	LastParsedAt *time.Time
+	DueAt        time.Time // когда турнир стал требовать обновления; нулевое - ни разу не обновлялся
+	Scheduled    bool      // попадает в текущий запуск
+	DeferReason  string    // почему отложен: budget, max_tournaments
+}
+
+// CadencePlan очередь обновления турниров источника
+type CadencePlan struct {
+	Source      string
+	Kind        CadenceKind
+	GeneratedAt time.Time
+	DailyBudget int // 0 - без ограничения
+	UsedToday   int
+	Quota       int // сколько турниров можно обновить в текущем запуске
+	Queue       []PlannedTournament
+}
+
+// ScheduledIDs возвращает ID турниров текущего запуска в порядке очереди
+func (p CadencePlan) ScheduledIDs() []string {
+	var ids []string
+	for _, t := range p.Queue {
+		if t.Scheduled {
+			ids = append(ids, t.TournamentID)
+		}
+	}
+	return ids
+}
@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // Priority приоритет парсинга турнира
 type Priority string
@@ -13,20 +16,44 @@ const (
 	PriorityArchive Priority = "ARCHIVE" // завершён > 1 года
 )
 
-// Job представляет задачу планировщика
-type Job struct {
-	Name    string
-	Cron    string
-	Timeout time.Duration
-	Handler func() error
+// Job задача планировщика.
+//
+// ctx отменяется по таймауту задачи и при остановке планировщика (SIGTERM);
+// через run задача сохраняет контрольные точки, чтобы после прерывания продолжить с места остановки.
+type Job interface {
+	Run(ctx context.Context, run JobRun) error
+}
+
+// JobFunc адаптер функции к интерфейсу Job
+type JobFunc func(ctx context.Context, run JobRun) error
+
+// Run вызывает функцию
+func (f JobFunc) Run(ctx context.Context, run JobRun) error {
+	return f(ctx, run)
 }
 
-// JobResult результат выполнения задачи
+// JobTrigger причина запуска задачи
+type JobTrigger string
+
+const (
+	TriggerCron     JobTrigger = "cron"
+	TriggerManual   JobTrigger = "manual"   // запуск из админки
+	TriggerRunOnce  JobTrigger = "run_once" // режим run-once / run_immediately
+	TriggerUpstream JobTrigger = "upstream" // после успешной зависимости в конвейере
+)
+
+// JobResult запись истории запусков задачи
 type JobResult struct {
+	RunID          string
 	JobName        string
+	Pipeline       string
+	Trigger        JobTrigger
+	InstanceID     string
+	Status         JobStatus
 	StartedAt      time.Time
 	EndedAt        time.Time
-	Success        bool
-	Error          error
+	Error          string
+	SkipReason     string
 	ItemsProcessed int
+	Stats          map[string]int
 }
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CheckpointStore хранилище контрольных точек задач
+type CheckpointStore interface {
+	// Load возвращает сохранённую позицию задачи, nil если её нет
+	Load(ctx context.Context, jobName string) ([]byte, error)
+	// Save и Clear выполняются, только если fencingToken - токен текущей блокировки задачи;
+	// Save возвращает ErrLeaseLost, если блокировку перехватил другой инстанс
+	Save(ctx context.Context, jobName, runID string, fencingToken int64, cursor []byte) error
+	Clear(ctx context.Context, jobName string, fencingToken int64) error
+}
+
+// JobRun текущий запуск задачи
+type JobRun struct {
+	ID        string
+	JobName   string
+	StartedAt time.Time
+	Deadline  time.Time
+	// FencingToken токен блокировки, под которой выполняется запуск
+	FencingToken int64
+	// OnLeaseLost останавливает запуск, если контрольная точка отклонена по fencing token
+	OnLeaseLost func()
+
+	store  CheckpointStore
+	cursor []byte // позиция, сохранённая прерванным запуском
+	stats  *runStats
+}
+
+// runStats счётчики обработанных сущностей, общие для копий JobRun
+type runStats struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+// NewJobRun создаёт запуск задачи; cursor - позиция прерванного запуска или nil
+func NewJobRun(id, jobName string, deadline time.Time, store CheckpointStore, cursor []byte) JobRun {
+	return JobRun{
+		ID:        id,
+		JobName:   jobName,
+		StartedAt: time.Now(),
+		Deadline:  deadline,
+		store:     store,
+		cursor:    cursor,
+		stats:     &runStats{counts: make(map[string]int)},
+	}
+}
+
+// Resumed возвращает true, если запуск продолжает прерванный
+func (r JobRun) Resumed() bool {
+	return len(r.cursor) > 0
+}
+
+// Checkpoint читает позицию прерванного запуска в v, false если продолжать нечего
+func (r JobRun) Checkpoint(v interface{}) (bool, error) {
+	if !r.Resumed() {
+		return false, nil
+	}
+	if err := json.Unmarshal(r.cursor, v); err != nil {
+		return false, fmt.Errorf("decode checkpoint %s: %w", r.JobName, err)
+	}
+	return true, nil
+}
+
+// SaveCheckpoint сохраняет текущую позицию задачи. Если блокировку перехватил другой
+// инстанс, запуск останавливается через OnLeaseLost: контрольная точка пишется после
+// каждого турнира, поэтому вытесненный запуск прекращает запись не позже чем через турнир
+func (r JobRun) SaveCheckpoint(ctx context.Context, v interface{}) error {
+	if r.store == nil {
+		return nil
+	}
+	cursor, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode checkpoint %s: %w", r.JobName, err)
+	}
+	// Позиция сохраняется и при отменённом ctx задачи, иначе прерванный запуск её потеряет
+	err = r.store.Save(context.WithoutCancel(ctx), r.JobName, r.ID, r.FencingToken, cursor)
+	if errors.Is(err, ErrLeaseLost) && r.OnLeaseLost != nil {
+		r.OnLeaseLost()
+	}
+	return err
+}
+
+// Track увеличивает счётчик обработанных сущностей (tournaments, matches, players, ...)
+func (r JobRun) Track(kind string, n int) {
+	if r.stats == nil || n == 0 {
+		return
+	}
+	r.stats.mu.Lock()
+	r.stats.counts[kind] += n
+	r.stats.mu.Unlock()
+}
+
+// Stats возвращает счётчики запуска
+func (r JobRun) Stats() map[string]int {
+	result := make(map[string]int)
+	if r.stats == nil {
+		return result
+	}
+	r.stats.mu.Lock()
+	defer r.stats.mu.Unlock()
+	for kind, n := range r.stats.counts {
+		result[kind] = n
+	}
+	return result
+}
+
+// ItemsProcessed возвращает общее количество обработанных сущностей
+func (r JobRun) ItemsProcessed() int {
+	total := 0
+	for _, n := range r.Stats() {
+		total += n
+	}
+	return total
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+type memoryCheckpoints struct {
+	cursors map[string][]byte
+}
+
+func (m *memoryCheckpoints) Load(_ context.Context, jobName string) ([]byte, error) {
+	return m.cursors[jobName], nil
+}
+
+func (m *memoryCheckpoints) Save(_ context.Context, jobName, _ string, _ int64, cursor []byte) error {
+	m.cursors[jobName] = cursor
+	return nil
+}
+
+func (m *memoryCheckpoints) Clear(_ context.Context, jobName string, _ int64) error {
+	delete(m.cursors, jobName)
+	return nil
+}
+
+type cursor struct {
+	LastTournamentID string `json:"last_tournament_id"`
+}
+
+func TestJobRun_CheckpointResume(t *testing.T) {
+	store := &memoryCheckpoints{cursors: make(map[string][]byte)}
+
+	first := NewJobRun("run-1", "junior_stats", time.Time{}, store, nil)
+	if first.Resumed() {
+		t.Fatal("fresh run should not be resumed")
+	}
+
+	// Запуск прерван: ctx отменён, позиция всё равно сохраняется
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := first.SaveCheckpoint(ctx, cursor{LastTournamentID: "t-2"}); err != nil {
+		t.Fatalf("save checkpoint: %v", err)
+	}
+
+	saved, _ := store.Load(context.Background(), "junior_stats")
+	second := NewJobRun("run-2", "junior_stats", time.Time{}, store, saved)
+	if !second.Resumed() {
+		t.Fatal("run with saved cursor should be resumed")
+	}
+
+	var got cursor
+	ok, err := second.Checkpoint(&got)
+	if err != nil || !ok {
+		t.Fatalf("checkpoint: ok=%v err=%v", ok, err)
+	}
+	if got.LastTournamentID != "t-2" {
+		t.Errorf("LastTournamentID = %q, want t-2", got.LastTournamentID)
+	}
+}
+
+func TestJobRun_WithoutStore(t *testing.T) {
+	run := NewJobRun("run-1", "league_strength", time.Time{}, nil, nil)
+	if err := run.SaveCheckpoint(context.Background(), cursor{}); err != nil {
+		t.Fatalf("save without store should be no-op: %v", err)
+	}
+
+	var got cursor
+	if ok, _ := run.Checkpoint(&got); ok {
+		t.Error("run without cursor should not resume")
+	}
+}
+
+// fencedCheckpoints отклоняет запись, как репозиторий при перехваченной блокировке
+type fencedCheckpoints struct {
+	memoryCheckpoints
+}
+
+func (f *fencedCheckpoints) Save(context.Context, string, string, int64, []byte) error {
+	return fmt.Errorf("save checkpoint: %w", ErrLeaseLost)
+}
+
+func TestJobRun_RejectedCheckpointStopsRun(t *testing.T) {
+	run := NewJobRun("run-1", "junior_stats", time.Time{}, &fencedCheckpoints{}, nil)
+	stopped := 0
+	run.OnLeaseLost = func() { stopped++ }
+
+	err := run.SaveCheckpoint(context.Background(), cursor{LastTournamentID: "t-1"})
+	if !errors.Is(err, ErrLeaseLost) {
+		t.Fatalf("err = %v, want ErrLeaseLost", err)
+	}
+	if stopped != 1 {
+		t.Errorf("OnLeaseLost called %d times, want 1", stopped)
+	}
+}
@@ -1,16 +1,57 @@
 package domain
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// ErrLeaseLost блокировка задачи перехвачена другим инстансом или снята
+var ErrLeaseLost = errors.New("lease lost")
 
 // Lock представляет блокировку задачи
 type Lock struct {
-	JobName     string
-	LockedAt    time.Time
-	LockedUntil time.Time
-	InstanceID  string
+	JobName      string
+	LockedAt     time.Time
+	LockedUntil  time.Time
+	InstanceID   string
+	FencingToken int64
 }
 
 // IsExpired проверяет истёк ли срок блокировки
 func (l *Lock) IsExpired() bool {
 	return time.Now().After(l.LockedUntil)
 }
+
+// Lease аренда блокировки задачи.
+//
+// Аренда выдаётся на короткий TTL и продлевается heartbeat, пока задача выполняется.
+// Если владелец перестал продлевать аренду (упал, завис), после TTL блокировку
+// может перехватить другой инстанс.
+type Lease struct {
+	JobName    string
+	InstanceID string
+	TTL        time.Duration
+	// Token fencing token: растёт с каждым захватом блокировки
+	Token int64
+
+	// Предыдущий владелец, если блокировка перехвачена после истечения аренды
+	PreviousInstanceID string
+	PreviousRunID      string
+}
+
+// TakenOver возвращает true, если блокировка перехвачена у инстанса, переставшего продлевать аренду
+func (l *Lease) TakenOver() bool {
+	return l.PreviousInstanceID != ""
+}
+
+// HeartbeatInterval интервал продления аренды: три попытки за TTL
+func (l *Lease) HeartbeatInterval() time.Duration {
+	return l.TTL / 3
+}
+
+// SafeUntil момент, до которого задача может писать данные, если аренду в последний раз
+// продлили в renewedAt: за интервал heartbeat до истечения аренды, чтобы задача остановилась
+// раньше, чем блокировку сможет перехватить другой инстанс
+func (l *Lease) SafeUntil(renewedAt time.Time) time.Time {
+	return renewedAt.Add(l.TTL - l.HeartbeatInterval())
+}
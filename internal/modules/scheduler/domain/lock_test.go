@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLeaseSafeUntil(t *testing.T) {
+	lease := Lease{TTL: 30 * time.Second}
+	renewedAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	safe := lease.SafeUntil(renewedAt)
+	if want := renewedAt.Add(20 * time.Second); !safe.Equal(want) {
+		t.Errorf("SafeUntil = %s, want %s", safe, want)
+	}
+	if !safe.Before(renewedAt.Add(lease.TTL)) {
+		t.Error("job must stop before the lease expires")
+	}
+}
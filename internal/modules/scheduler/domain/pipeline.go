@@ -0,0 +1,56 @@
+package domain
+
+import "time"
+
+// JobStatus итоговый статус задачи в прогоне конвейера
+type JobStatus string
+
+const (
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusCancelled JobStatus = "cancelled" // отменена из админки
+	JobStatusSkipped   JobStatus = "skipped"   // не запускалась: упала зависимость, пауза, задача уже выполняется или нет handler
+	JobStatusBlocked   JobStatus = "blocked"   // выполнена, но проверки качества нашли критические нарушения
+)
+
+// JobRunStatus результат задачи в прогоне конвейера
+type JobRunStatus struct {
+	RunID      string // пустой, если задача не запускалась
+	JobName    string
+	Status     JobStatus
+	Error      string
+	SkipReason string
+	StartedAt  time.Time
+	Duration   time.Duration
+}
+
+// PipelineRun прогон конвейера: корневая задача и все зависящие от неё
+type PipelineRun struct {
+	Root      string
+	Trigger   JobTrigger
+	StartedAt time.Time
+	EndedAt   time.Time
+	Jobs      []JobRunStatus // в порядке выполнения
+}
+
+// Succeeded возвращает true, если все задачи конвейера выполнены успешно
+func (p PipelineRun) Succeeded() bool {
+	for _, job := range p.Jobs {
+		if job.Status != JobStatusSucceeded {
+			return false
+		}
+	}
+	return true
+}
+
+// Count возвращает количество задач с указанным статусом
+func (p PipelineRun) Count(status JobStatus) int {
+	n := 0
+	for _, job := range p.Jobs {
+		if job.Status == status {
+			n++
+		}
+	}
+	return n
+}
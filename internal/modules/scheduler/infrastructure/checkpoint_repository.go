@@ -0,0 +1,70 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+)
+
+// CheckpointRepository репозиторий контрольных точек задач
+type CheckpointRepository struct {
+	db *sql.DB
+}
+
+// NewCheckpointRepository создаёт новый репозиторий контрольных точек
+func NewCheckpointRepository(db *sql.DB) *CheckpointRepository {
+	return &CheckpointRepository{db: db}
+}
+
+// Load возвращает сохранённую позицию задачи, nil если её нет
+func (r *CheckpointRepository) Load(ctx context.Context, jobName string) ([]byte, error) {
+	query := `SELECT cursor FROM scheduler_checkpoints WHERE job_name = $1`
+
+	var cursor []byte
+	err := r.db.QueryRowContext(ctx, query, jobName).Scan(&cursor)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// Save сохраняет позицию задачи, если fencingToken - токен текущей блокировки.
+// domain.ErrLeaseLost - блокировку уже перехватил другой инстанс.
+func (r *CheckpointRepository) Save(ctx context.Context, jobName, runID string, fencingToken int64, cursor []byte) error {
+	query := `
+		INSERT INTO scheduler_checkpoints (job_name, run_id, cursor, fencing_token, updated_at)
+		SELECT $1, $2, $3, $4, NOW()
+		WHERE EXISTS (SELECT 1 FROM scheduler_locks WHERE job_name = $1 AND fencing_token = $4)
+		ON CONFLICT (job_name) DO UPDATE
+		SET run_id = $2, cursor = $3, fencing_token = $4, updated_at = NOW()
+	`
+	result, err := r.db.ExecContext(ctx, query, jobName, runID, cursor, fencingToken)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("save checkpoint %s: %w", jobName, domain.ErrLeaseLost)
+	}
+	return nil
+}
+
+// Clear удаляет позицию задачи, если fencingToken - токен текущей блокировки
+func (r *CheckpointRepository) Clear(ctx context.Context, jobName string, fencingToken int64) error {
+	query := `
+		DELETE FROM scheduler_checkpoints
+		WHERE job_name = $1
+		  AND EXISTS (SELECT 1 FROM scheduler_locks WHERE job_name = $1 AND fencing_token = $2)
+	`
+	_, err := r.db.ExecContext(ctx, query, jobName, fencingToken)
+	return err
+}
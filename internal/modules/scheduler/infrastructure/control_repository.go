@@ -0,0 +1,53 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+)
+
+// ControlRepository репозиторий управления задачами (пауза, ручной запуск)
+type ControlRepository struct {
+	db *sql.DB
+}
+
+// NewControlRepository создаёт новый репозиторий управления задачами
+func NewControlRepository(db *sql.DB) *ControlRepository {
+	return &ControlRepository{db: db}
+}
+
+// IsPaused возвращает true, если задача поставлена на паузу
+func (r *ControlRepository) IsPaused(ctx context.Context, jobName string) (bool, error) {
+	query := `SELECT paused FROM scheduler_job_controls WHERE job_name = $1`
+
+	var paused bool
+	err := r.db.QueryRowContext(ctx, query, jobName).Scan(&paused)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return paused, err
+}
+
+// ClaimTriggers забирает запросы ручного запуска; каждый запрос достаётся одному инстансу
+func (r *ControlRepository) ClaimTriggers(ctx context.Context) ([]string, error) {
+	query := `
+		UPDATE scheduler_job_controls
+		SET trigger_requested_at = NULL, updated_at = NOW()
+		WHERE trigger_requested_at IS NOT NULL
+		RETURNING job_name
+	`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
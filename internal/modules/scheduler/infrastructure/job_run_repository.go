@@ -0,0 +1,108 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/lib/pq"
+)
+
+// JobRunRepository репозиторий истории запусков задач
+type JobRunRepository struct {
+	db *sql.DB
+}
+
+// NewJobRunRepository создаёт новый репозиторий истории запусков
+func NewJobRunRepository(db *sql.DB) *JobRunRepository {
+	return &JobRunRepository{db: db}
+}
+
+// Save создаёт или обновляет запись о запуске.
+// Завершённая запись не перезаписывается: запуск, блокировку которого перехватили,
+// уже отмечен упавшим, и его поздний результат игнорируется.
+func (r *JobRunRepository) Save(ctx context.Context, result domain.JobResult) error {
+	stats := result.Stats
+	if stats == nil {
+		stats = map[string]int{}
+	}
+	statsJSON, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO job_runs (id, job_name, pipeline, trigger, instance_id, status, started_at, ended_at,
+			items_processed, error, skip_reason, stats)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO UPDATE
+		SET status = $6, ended_at = $8, items_processed = $9, error = $10, skip_reason = $11, stats = $12
+		WHERE job_runs.ended_at IS NULL
+	`
+	_, err = r.db.ExecContext(ctx, query,
+		result.RunID,
+		result.JobName,
+		result.Pipeline,
+		string(result.Trigger),
+		result.InstanceID,
+		string(result.Status),
+		result.StartedAt,
+		nullTime(result.EndedAt),
+		result.ItemsProcessed,
+		nullString(result.Error),
+		nullString(result.SkipReason),
+		statsJSON,
+	)
+	return err
+}
+
+// Abandon завершает незавершённый запуск с ошибкой: его инстанс перестал продлевать аренду блокировки
+func (r *JobRunRepository) Abandon(ctx context.Context, runID, reason string) error {
+	query := `
+		UPDATE job_runs SET status = $2, ended_at = NOW(), error = $3
+		WHERE id = $1 AND ended_at IS NULL
+	`
+	_, err := r.db.ExecContext(ctx, query, runID, string(domain.JobStatusFailed), reason)
+	return err
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// LastFinished возвращает последний завершённый запуск каждой задачи; пропуски не учитываются
+func (r *JobRunRepository) LastFinished(ctx context.Context, jobNames []string) (map[string]domain.JobRunStatus, error) {
+	query := `
+		SELECT DISTINCT ON (job_name) id, job_name, status, COALESCE(error, ''), started_at, ended_at
+		FROM job_runs
+		WHERE job_name = ANY($1) AND ended_at IS NOT NULL AND status <> $2
+		ORDER BY job_name, started_at DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(jobNames), string(domain.JobStatusSkipped))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := make(map[string]domain.JobRunStatus, len(jobNames))
+	for rows.Next() {
+		var (
+			run     domain.JobRunStatus
+			status  string
+			endedAt time.Time
+		)
+		if err := rows.Scan(&run.RunID, &run.JobName, &status, &run.Error, &run.StartedAt, &endedAt); err != nil {
+			return nil, err
+		}
+		run.Status = domain.JobStatus(status)
+		run.Duration = endedAt.Sub(run.StartedAt)
+		result[run.JobName] = run
+	}
+	return result, rows.Err()
+}
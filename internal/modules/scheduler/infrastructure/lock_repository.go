@@ -3,6 +3,7 @@ package infrastructure
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
@@ -18,41 +19,118 @@ func NewLockRepository(db *sql.DB) *LockRepository {
 	return &LockRepository{db: db}
 }
 
-// TryAcquire пытается получить блокировку
-func (r *LockRepository) TryAcquire(ctx context.Context, jobName string, timeout time.Duration, instanceID string) (bool, error) {
-	query := `
-		INSERT INTO scheduler_locks (job_name, locked_at, locked_until, instance_id)
-		VALUES ($1, NOW(), NOW() + $2::interval, $3)
-		ON CONFLICT (job_name) DO UPDATE 
-		SET locked_at = NOW(), 
-		    locked_until = NOW() + $2::interval, 
-		    instance_id = $3
-		WHERE scheduler_locks.locked_until < NOW()
+// Acquire берёт аренду блокировки на ttl; nil, если блокировку держит живой инстанс.
+//
+// Блокировка с истёкшей арендой перехватывается: в Lease возвращается прежний владелец
+// и его запуск. Каждый захват получает новый fencing token.
+func (r *LockRepository) Acquire(ctx context.Context, jobName, instanceID string, ttl time.Duration) (*domain.Lease, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	lease := &domain.Lease{JobName: jobName, InstanceID: instanceID, TTL: ttl}
+
+	var (
+		prevInstance sql.NullString
+		prevRun      sql.NullString
+		expired      bool
+	)
+	selectQuery := `
+		SELECT instance_id, run_id, locked_until < NOW()
+		FROM scheduler_locks
+		WHERE job_name = $1
+		FOR UPDATE
 	`
+	err = tx.QueryRowContext(ctx, selectQuery, jobName).Scan(&prevInstance, &prevRun, &expired)
+	switch {
+	case err == sql.ErrNoRows:
+		// Параллельный захват той же задачи вставит строку первым - тогда RETURNING пуст
+		insertQuery := `
+			INSERT INTO scheduler_locks (job_name, locked_at, locked_until, instance_id, fencing_token, heartbeat_at)
+			VALUES ($1, NOW(), NOW() + $2::interval, $3, nextval('scheduler_fencing_token_seq'), NOW())
+			ON CONFLICT (job_name) DO NOTHING
+			RETURNING fencing_token
+		`
+		err = tx.QueryRowContext(ctx, insertQuery, jobName, ttl.String(), instanceID).Scan(&lease.Token)
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case !expired:
+		return nil, nil
+	default:
+		updateQuery := `
+			UPDATE scheduler_locks
+			SET locked_at = NOW(),
+			    locked_until = NOW() + $2::interval,
+			    heartbeat_at = NOW(),
+			    instance_id = $3,
+			    run_id = NULL,
+			    cancel_requested_at = NULL,
+			    fencing_token = nextval('scheduler_fencing_token_seq')
+			WHERE job_name = $1
+			RETURNING fencing_token
+		`
+		if err := tx.QueryRowContext(ctx, updateQuery, jobName, ttl.String(), instanceID).Scan(&lease.Token); err != nil {
+			return nil, err
+		}
+		// Освобождённая штатно блокировка удаляется, поэтому истёкшая строка - след упавшего владельца
+		lease.PreviousInstanceID = prevInstance.String
+		if lease.PreviousInstanceID == "" {
+			lease.PreviousInstanceID = "unknown"
+		}
+		lease.PreviousRunID = prevRun.String
+	}
 
-	result, err := r.db.ExecContext(ctx, query, jobName, timeout.String(), instanceID)
-	if err != nil {
-		return false, err
+	if err := tx.Commit(); err != nil {
+		return nil, err
 	}
+	return lease, nil
+}
 
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return false, err
+// Heartbeat продлевает аренду на TTL и возвращает true, если для задачи запрошена отмена.
+// domain.ErrLeaseLost - блокировку перехватил другой инстанс.
+func (r *LockRepository) Heartbeat(ctx context.Context, lease *domain.Lease) (bool, error) {
+	query := `
+		UPDATE scheduler_locks
+		SET locked_until = NOW() + $3::interval, heartbeat_at = NOW()
+		WHERE job_name = $1 AND fencing_token = $2
+		RETURNING cancel_requested_at IS NOT NULL
+	`
+	var cancelRequested bool
+	err := r.db.QueryRowContext(ctx, query, lease.JobName, lease.Token, lease.TTL.String()).Scan(&cancelRequested)
+	if err == sql.ErrNoRows {
+		return false, fmt.Errorf("%s: %w", lease.JobName, domain.ErrLeaseLost)
 	}
+	return cancelRequested, err
+}
 
-	return rows > 0, nil
+// SetRunID привязывает блокировку к запуску задачи
+func (r *LockRepository) SetRunID(ctx context.Context, lease *domain.Lease, runID string) error {
+	query := `UPDATE scheduler_locks SET run_id = $3 WHERE job_name = $1 AND fencing_token = $2`
+	_, err := r.db.ExecContext(ctx, query, lease.JobName, lease.Token, runID)
+	return err
 }
 
-// Release освобождает блокировку
-func (r *LockRepository) Release(ctx context.Context, jobName, instanceID string) error {
-	query := `DELETE FROM scheduler_locks WHERE job_name = $1 AND instance_id = $2`
-	_, err := r.db.ExecContext(ctx, query, jobName, instanceID)
+// Release освобождает блокировку; перехваченная блокировка не трогается
+func (r *LockRepository) Release(ctx context.Context, lease *domain.Lease) error {
+	query := `DELETE FROM scheduler_locks WHERE job_name = $1 AND fencing_token = $2`
+	_, err := r.db.ExecContext(ctx, query, lease.JobName, lease.Token)
 	return err
 }
 
 // Get получает информацию о блокировке
 func (r *LockRepository) Get(ctx context.Context, jobName string) (*domain.Lock, error) {
-	query := `SELECT job_name, locked_at, locked_until, instance_id FROM scheduler_locks WHERE job_name = $1`
+	query := `
+		SELECT job_name, locked_at, locked_until, instance_id, fencing_token
+		FROM scheduler_locks WHERE job_name = $1
+	`
 
 	var lock domain.Lock
 	err := r.db.QueryRowContext(ctx, query, jobName).Scan(
@@ -60,6 +138,7 @@ func (r *LockRepository) Get(ctx context.Context, jobName string) (*domain.Lock,
 		&lock.LockedAt,
 		&lock.LockedUntil,
 		&lock.InstanceID,
+		&lock.FencingToken,
 	)
 
 	if err == sql.ErrNoRows {
@@ -0,0 +1,243 @@
+//go:build integration
+
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/scheduler/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/migrator/pg"
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+)
+
+// testLeaseTTL короткая аренда, чтобы падение инстанса проявлялось за секунды
+const testLeaseTTL = time.Second
+
+// openTestDB подключается к TEST_DATABASE_URL и применяет миграции
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	if err := pg.NewMigrator(db, "../../../../migrations").Up(context.Background()); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+// testJobName уникальное имя задачи, чтобы тесты не мешали друг другу
+func testJobName(t *testing.T, db *sql.DB) string {
+	t.Helper()
+
+	name := "test_" + uuid.New().String()[:8]
+	t.Cleanup(func() {
+		ctx := context.Background()
+		_, _ = db.ExecContext(ctx, `DELETE FROM scheduler_locks WHERE job_name = $1`, name)
+		_, _ = db.ExecContext(ctx, `DELETE FROM scheduler_checkpoints WHERE job_name = $1`, name)
+		_, _ = db.ExecContext(ctx, `DELETE FROM job_runs WHERE job_name = $1`, name)
+	})
+	return name
+}
+
+func TestLease_TakeoverAfterCrash(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+	locks := NewLockRepository(db)
+	checkpoints := NewCheckpointRepository(db)
+	runs := NewJobRunRepository(db)
+	job := testJobName(t, db)
+
+	// Инстанс A берёт блокировку и начинает запуск
+	leaseA, err := locks.Acquire(ctx, job, "instance-a", testLeaseTTL)
+	if err != nil || leaseA == nil {
+		t.Fatalf("instance A acquire: lease=%v err=%v", leaseA, err)
+	}
+	runA := uuid.New().String()
+	if err := locks.SetRunID(ctx, leaseA, runA); err != nil {
+		t.Fatalf("set run id: %v", err)
+	}
+	resultA := domain.JobResult{
+		RunID: runA, JobName: job, Pipeline: job, Trigger: domain.TriggerCron,
+		InstanceID: "instance-a", Status: domain.JobStatusRunning, StartedAt: time.Now(),
+	}
+	if err := runs.Save(ctx, resultA); err != nil {
+		t.Fatalf("save running run: %v", err)
+	}
+	if err := checkpoints.Save(ctx, job, runA, leaseA.Token, []byte(`{"done":["t-1"]}`)); err != nil {
+		t.Fatalf("instance A checkpoint: %v", err)
+	}
+
+	// Пока аренда жива, инстанс B блокировку не получает
+	leaseB, err := locks.Acquire(ctx, job, "instance-b", testLeaseTTL)
+	if err != nil {
+		t.Fatalf("instance B acquire: %v", err)
+	}
+	if leaseB != nil {
+		t.Fatal("instance B acquired a live lock")
+	}
+
+	// Инстанс A падает: heartbeat прекращается, аренда истекает
+	time.Sleep(testLeaseTTL + 500*time.Millisecond)
+
+	leaseB, err = locks.Acquire(ctx, job, "instance-b", testLeaseTTL)
+	if err != nil || leaseB == nil {
+		t.Fatalf("instance B takeover: lease=%v err=%v", leaseB, err)
+	}
+	if !leaseB.TakenOver() || leaseB.PreviousInstanceID != "instance-a" || leaseB.PreviousRunID != runA {
+		t.Fatalf("takeover info = %+v", leaseB)
+	}
+	if leaseB.Token <= leaseA.Token {
+		t.Fatalf("fencing token did not grow: A=%d B=%d", leaseA.Token, leaseB.Token)
+	}
+	if err := runs.Abandon(ctx, leaseB.PreviousRunID, "instance-a stopped heartbeating"); err != nil {
+		t.Fatalf("abandon: %v", err)
+	}
+
+	// Инстанс A "оживает": продлить аренду и писать с устаревшим токеном он не может
+	if _, err := locks.Heartbeat(ctx, leaseA); !errors.Is(err, domain.ErrLeaseLost) {
+		t.Fatalf("instance A heartbeat err = %v, want ErrLeaseLost", err)
+	}
+	if err := checkpoints.Save(ctx, job, runA, leaseA.Token, []byte(`{"done":["t-1","t-2"]}`)); !errors.Is(err, domain.ErrLeaseLost) {
+		t.Fatalf("instance A checkpoint err = %v, want ErrLeaseLost", err)
+	}
+	if err := checkpoints.Clear(ctx, job, leaseA.Token); err != nil {
+		t.Fatalf("instance A clear: %v", err)
+	}
+	cursor, err := checkpoints.Load(ctx, job)
+	if err != nil {
+		t.Fatalf("load checkpoint: %v", err)
+	}
+	var saved struct {
+		Done []string `json:"done"`
+	}
+	if err := json.Unmarshal(cursor, &saved); err != nil || len(saved.Done) != 1 {
+		t.Fatalf("checkpoint after stale writes = %s (err %v)", cursor, err)
+	}
+
+	// Поздний результат A не перезаписывает отметку о падении
+	resultA.Status = domain.JobStatusSucceeded
+	resultA.EndedAt = time.Now()
+	if err := runs.Save(ctx, resultA); err != nil {
+		t.Fatalf("late save: %v", err)
+	}
+	var status string
+	if err := db.QueryRowContext(ctx, `SELECT status FROM job_runs WHERE id = $1`, runA).Scan(&status); err != nil {
+		t.Fatalf("load run: %v", err)
+	}
+	if status != string(domain.JobStatusFailed) {
+		t.Fatalf("abandoned run status = %s, want failed", status)
+	}
+
+	// Release инстанса A не снимает блокировку B
+	if err := locks.Release(ctx, leaseA); err != nil {
+		t.Fatalf("instance A release: %v", err)
+	}
+	if _, err := locks.Heartbeat(ctx, leaseB); err != nil {
+		t.Fatalf("instance B heartbeat after stale release: %v", err)
+	}
+	if err := checkpoints.Save(ctx, job, uuid.New().String(), leaseB.Token, []byte(`{"done":["t-1","t-3"]}`)); err != nil {
+		t.Fatalf("instance B checkpoint: %v", err)
+	}
+}
+
+func TestLease_HeartbeatKeepsLock(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+	locks := NewLockRepository(db)
+	job := testJobName(t, db)
+
+	lease, err := locks.Acquire(ctx, job, "instance-a", testLeaseTTL)
+	if err != nil || lease == nil {
+		t.Fatalf("acquire: lease=%v err=%v", lease, err)
+	}
+
+	// Живой владелец продлевает аренду дольше нескольких TTL
+	deadline := time.Now().Add(3 * testLeaseTTL)
+	for time.Now().Before(deadline) {
+		time.Sleep(lease.HeartbeatInterval())
+		if _, err := locks.Heartbeat(ctx, lease); err != nil {
+			t.Fatalf("heartbeat: %v", err)
+		}
+		other, err := locks.Acquire(ctx, job, "instance-b", testLeaseTTL)
+		if err != nil {
+			t.Fatalf("instance B acquire: %v", err)
+		}
+		if other != nil {
+			t.Fatal("lock taken over while holder was heartbeating")
+		}
+	}
+
+	// Запрос отмены приходит в ответе heartbeat
+	if _, err := db.ExecContext(ctx, `UPDATE scheduler_locks SET cancel_requested_at = NOW() WHERE job_name = $1`, job); err != nil {
+		t.Fatalf("request cancel: %v", err)
+	}
+	cancelRequested, err := locks.Heartbeat(ctx, lease)
+	if err != nil || !cancelRequested {
+		t.Fatalf("heartbeat after cancel: requested=%v err=%v", cancelRequested, err)
+	}
+
+	// Штатное освобождение - новый захват не считается перехватом
+	if err := locks.Release(ctx, lease); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	next, err := locks.Acquire(ctx, job, "instance-b", testLeaseTTL)
+	if err != nil || next == nil {
+		t.Fatalf("acquire after release: lease=%v err=%v", next, err)
+	}
+	if next.TakenOver() {
+		t.Fatalf("released lock reported as takeover: %+v", next)
+	}
+}
+
+func TestLease_ConcurrentAcquire(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+	locks := NewLockRepository(db)
+	job := testJobName(t, db)
+
+	// Инстансы одновременно стартуют одну задачу: блокировку получает ровно один
+	const instances = 8
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		acquired int
+	)
+	for range instances {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lease, err := locks.Acquire(ctx, job, uuid.New().String()[:8], testLeaseTTL)
+			if err != nil {
+				t.Errorf("acquire: %v", err)
+				return
+			}
+			if lease != nil {
+				mu.Lock()
+				acquired++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if acquired != 1 {
+		t.Fatalf("acquired by %d instances, want 1", acquired)
+	}
+}
@@ -0,0 +1,188 @@
+package charts
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// RGB цвет графика
+type RGB struct {
+	R, G, B uint8
+}
+
+// PathOp команда контура в абсолютных координатах: 'M', 'L', 'C' (кубическая
+// кривая, три точки) или 'Z'. Дуги SVG переводятся в кривые
+type PathOp struct {
+	Kind   byte
+	Points [3]Point
+}
+
+// Text подпись графика
+type Text struct {
+	X, Y    float64
+	Size    float64
+	Bold    bool
+	Anchor  string // start, middle, end
+	Middle  bool   // базовая линия по центру строки (dominant-baseline="middle")
+	Content string
+}
+
+// Shape элемент графика: контур с заливкой и обводкой или подпись
+type Shape struct {
+	Path        []PathOp
+	Fill        *RGB
+	FillOpacity float64
+	Stroke      *RGB
+	StrokeWidth float64
+	RoundCaps   bool
+	Text        *Text
+}
+
+// Drawing график, разобранный из SVG, для вывода в PDF и растровые изображения
+type Drawing struct {
+	Width, Height float64
+	Shapes        []Shape
+}
+
+// ParseSVG разбирает SVG, построенный генераторами пакета: rect, line, circle,
+// path и text. Анимации игнорируются, фигуры берутся в конечном состоянии
+func ParseSVG(svg string) (*Drawing, error) {
+	d := &Drawing{}
+	dec := xml.NewDecoder(strings.NewReader(svg))
+	var text *Shape
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return d, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse svg: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			a := attrMap(t.Attr)
+			switch t.Name.Local {
+			case "svg":
+				d.Width, d.Height = a.num("width"), a.num("height")
+			case "text":
+				shape := a.shape()
+				shape.Text = &Text{
+					X:      a.num("x"),
+					Y:      a.num("y"),
+					Size:   a.numOr("font-size", 16),
+					Bold:   a.num("font-weight") >= 600 || a["font-weight"] == "bold",
+					Anchor: a.strOr("text-anchor", "start"),
+					Middle: a["dominant-baseline"] == "middle" || a["dominant-baseline"] == "central",
+				}
+				if shape.Fill == nil {
+					shape.Fill = &RGB{}
+				}
+				text = &shape
+			default:
+				path, err := a.path(t.Name.Local)
+				if err != nil {
+					return nil, err
+				}
+				if path != nil {
+					shape := a.shape()
+					shape.Path = path
+					d.Shapes = append(d.Shapes, shape)
+				}
+			}
+		case xml.CharData:
+			if text != nil {
+				text.Text.Content += string(t)
+			}
+		case xml.EndElement:
+			if t.Name.Local == "text" && text != nil {
+				text.Text.Content = strings.TrimSpace(text.Text.Content)
+				d.Shapes = append(d.Shapes, *text)
+				text = nil
+			}
+		}
+	}
+}
+
+// attrs атрибуты элемента SVG
+type attrs map[string]string
+
+func attrMap(list []xml.Attr) attrs {
+	a := make(attrs, len(list))
+	for _, attr := range list {
+		a[attr.Name.Local] = attr.Value
+	}
+	return a
+}
+
+func (a attrs) num(name string) float64 { return a.numOr(name, 0) }
+
+func (a attrs) numOr(name string, def float64) float64 {
+	v, err := strconv.ParseFloat(strings.TrimSuffix(a[name], "px"), 64)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+func (a attrs) strOr(name, def string) string {
+	if v := a[name]; v != "" {
+		return v
+	}
+	return def
+}
+
+// shape оформление фигуры; заливка по умолчанию чёрная, как в SVG
+func (a attrs) shape() Shape {
+	s := Shape{
+		Fill:        parseColor(a.strOr("fill", "black")),
+		FillOpacity: a.numOr("fill-opacity", 1),
+		Stroke:      parseColor(a["stroke"]),
+		StrokeWidth: a.numOr("stroke-width", 1),
+		RoundCaps:   a["stroke-linecap"] == "round",
+	}
+	return s
+}
+
+// path контур фигуры, nil для элементов без геометрии
+func (a attrs) path(element string) ([]PathOp, error) {
+	switch element {
+	case "rect":
+		return rectPath(a.num("x"), a.num("y"), a.num("width"), a.num("height"), a.num("rx")), nil
+	case "circle":
+		return circlePath(a.num("cx"), a.num("cy"), a.num("r")), nil
+	case "line":
+		return []PathOp{
+			{Kind: 'M', Points: [3]Point{{a.num("x1"), a.num("y1")}}},
+			{Kind: 'L', Points: [3]Point{{a.num("x2"), a.num("y2")}}},
+		}, nil
+	case "path":
+		return parsePathData(a["d"])
+	}
+	return nil, nil
+}
+
+var namedColors = map[string]RGB{
+	"white": {255, 255, 255},
+	"black": {0, 0, 0},
+}
+
+// parseColor цвет "#rrggbb", "#rgb" или имя; nil для "none" и неизвестных значений
+func parseColor(s string) *RGB {
+	if c, ok := namedColors[s]; ok {
+		return &c
+	}
+	if len(s) == 4 && s[0] == '#' {
+		s = "#" + string([]byte{s[1], s[1], s[2], s[2], s[3], s[3]})
+	}
+	if len(s) != 7 || s[0] != '#' {
+		return nil
+	}
+	v, err := strconv.ParseUint(s[1:], 16, 32)
+	if err != nil {
+		return nil
+	}
+	return &RGB{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v)}
+}
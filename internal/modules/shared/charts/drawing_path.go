@@ -0,0 +1,178 @@
+package charts
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// kappa длина касательных кубической кривой, приближающей четверть окружности
+const kappa = 0.5522847498
+
+// rectPath прямоугольник со скруглёнными углами радиуса r
+func rectPath(x, y, w, h, r float64) []PathOp {
+	r = math.Min(r, math.Min(w, h)/2)
+	if r <= 0 {
+		return []PathOp{
+			{Kind: 'M', Points: [3]Point{{x, y}}},
+			{Kind: 'L', Points: [3]Point{{x + w, y}}},
+			{Kind: 'L', Points: [3]Point{{x + w, y + h}}},
+			{Kind: 'L', Points: [3]Point{{x, y + h}}},
+			{Kind: 'Z'},
+		}
+	}
+	k := r * kappa
+	return []PathOp{
+		{Kind: 'M', Points: [3]Point{{x + r, y}}},
+		{Kind: 'L', Points: [3]Point{{x + w - r, y}}},
+		{Kind: 'C', Points: [3]Point{{x + w - r + k, y}, {x + w, y + r - k}, {x + w, y + r}}},
+		{Kind: 'L', Points: [3]Point{{x + w, y + h - r}}},
+		{Kind: 'C', Points: [3]Point{{x + w, y + h - r + k}, {x + w - r + k, y + h}, {x + w - r, y + h}}},
+		{Kind: 'L', Points: [3]Point{{x + r, y + h}}},
+		{Kind: 'C', Points: [3]Point{{x + r - k, y + h}, {x, y + h - r + k}, {x, y + h - r}}},
+		{Kind: 'L', Points: [3]Point{{x, y + r}}},
+		{Kind: 'C', Points: [3]Point{{x, y + r - k}, {x + r - k, y}, {x + r, y}}},
+		{Kind: 'Z'},
+	}
+}
+
+// circlePath окружность из четырёх кривых
+func circlePath(cx, cy, r float64) []PathOp {
+	ops := []PathOp{{Kind: 'M', Points: [3]Point{{cx + r, cy}}}}
+	for i := 1; i <= 4; i++ {
+		ops = append(ops, arcSegment(cx, cy, r, r, 0, float64(i-1)*math.Pi/2, float64(i)*math.Pi/2))
+	}
+	return append(ops, PathOp{Kind: 'Z'})
+}
+
+// pathArgs число аргументов команд контура
+var pathArgs = map[byte]int{'M': 2, 'L': 2, 'H': 1, 'V': 1, 'C': 6, 'A': 7, 'Z': 0}
+
+// parsePathData разбирает атрибут d с абсолютными командами M, L, H, V, C, A, Z
+func parsePathData(d string) ([]PathOp, error) {
+	tokens := strings.FieldsFunc(d, func(r rune) bool { return unicode.IsSpace(r) || r == ',' })
+	var ops []PathOp
+	var cur, start Point
+	cmd := byte(0)
+	for i := 0; i < len(tokens); {
+		if t := tokens[i]; len(t) == 1 && unicode.IsLetter(rune(t[0])) {
+			cmd = t[0]
+			i++
+		}
+		n, ok := pathArgs[cmd]
+		if !ok {
+			return nil, fmt.Errorf("parse svg path: unsupported command %q", cmd)
+		}
+		if i+n > len(tokens) {
+			return nil, fmt.Errorf("parse svg path: not enough arguments for %q", cmd)
+		}
+		v := make([]float64, n)
+		for j := range v {
+			f, err := strconv.ParseFloat(tokens[i+j], 64)
+			if err != nil {
+				return nil, fmt.Errorf("parse svg path: %w", err)
+			}
+			v[j] = f
+		}
+		i += n
+
+		switch cmd {
+		case 'M':
+			cur = Point{v[0], v[1]}
+			start = cur
+			ops = append(ops, PathOp{Kind: 'M', Points: [3]Point{cur}})
+			cmd = 'L' // следующие пары координат - отрезки
+		case 'L', 'H', 'V':
+			switch cmd {
+			case 'L':
+				cur = Point{v[0], v[1]}
+			case 'H':
+				cur.X = v[0]
+			case 'V':
+				cur.Y = v[0]
+			}
+			ops = append(ops, PathOp{Kind: 'L', Points: [3]Point{cur}})
+		case 'C':
+			cur = Point{v[4], v[5]}
+			ops = append(ops, PathOp{Kind: 'C', Points: [3]Point{{v[0], v[1]}, {v[2], v[3]}, cur}})
+		case 'A':
+			end := Point{v[5], v[6]}
+			ops = append(ops, arcToCurves(cur, end, v[0], v[1], v[2], v[3] != 0, v[4] != 0)...)
+			cur = end
+		case 'Z':
+			ops = append(ops, PathOp{Kind: 'Z'})
+			cur = start
+			cmd = 0 // после Z нужна новая команда
+		}
+	}
+	return ops, nil
+}
+
+// arcToCurves переводит дугу эллипса SVG в кубические кривые не длиннее четверти оборота
+// (преобразование к центральной параметризации из приложения F спецификации SVG)
+func arcToCurves(from, to Point, rx, ry, rotation float64, large, sweep bool) []PathOp {
+	if from == to {
+		return nil
+	}
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 {
+		return []PathOp{{Kind: 'L', Points: [3]Point{to}}}
+	}
+	phi := degToRad(rotation)
+	cos, sin := math.Cos(phi), math.Sin(phi)
+	dx, dy := (from.X-to.X)/2, (from.Y-to.Y)/2
+	x1 := cos*dx + sin*dy
+	y1 := -sin*dx + cos*dy
+
+	// Слишком малые радиусы увеличиваются до минимально возможных
+	if l := x1*x1/(rx*rx) + y1*y1/(ry*ry); l > 1 {
+		rx, ry = rx*math.Sqrt(l), ry*math.Sqrt(l)
+	}
+	num := rx*rx*ry*ry - rx*rx*y1*y1 - ry*ry*x1*x1
+	den := rx*rx*y1*y1 + ry*ry*x1*x1
+	coef := math.Sqrt(math.Max(0, num/den))
+	if large == sweep {
+		coef = -coef
+	}
+	cxp, cyp := coef*rx*y1/ry, -coef*ry*x1/rx
+	cx := cos*cxp - sin*cyp + (from.X+to.X)/2
+	cy := sin*cxp + cos*cyp + (from.Y+to.Y)/2
+
+	start := math.Atan2((y1-cyp)/ry, (x1-cxp)/rx)
+	delta := math.Atan2((-y1-cyp)/ry, (-x1-cxp)/rx) - start
+	if sweep && delta < 0 {
+		delta += 2 * math.Pi
+	} else if !sweep && delta > 0 {
+		delta -= 2 * math.Pi
+	}
+
+	segments := int(math.Ceil(math.Abs(delta) / (math.Pi / 2)))
+	if segments == 0 {
+		return []PathOp{{Kind: 'L', Points: [3]Point{to}}}
+	}
+	ops := make([]PathOp, 0, segments)
+	for i := range segments {
+		a0 := start + delta*float64(i)/float64(segments)
+		a1 := start + delta*float64(i+1)/float64(segments)
+		ops = append(ops, arcSegment(cx, cy, rx, ry, phi, a0, a1))
+	}
+	ops[len(ops)-1].Points[2] = to
+	return ops
+}
+
+// arcSegment кривая, приближающая дугу эллипса от угла a0 до a1 (не больше четверти оборота)
+func arcSegment(cx, cy, rx, ry, phi, a0, a1 float64) PathOp {
+	t := 4.0 / 3 * math.Tan((a1-a0)/4)
+	cos, sin := math.Cos(phi), math.Sin(phi)
+	point := func(x, y float64) Point {
+		return Point{cx + cos*x - sin*y, cy + sin*x + cos*y}
+	}
+	c0, s0, c1, s1 := math.Cos(a0), math.Sin(a0), math.Cos(a1), math.Sin(a1)
+	return PathOp{Kind: 'C', Points: [3]Point{
+		point(rx*(c0-t*s0), ry*(s0+t*c0)),
+		point(rx*(c1+t*s1), ry*(s1-t*c1)),
+		point(rx*c1, ry*s1),
+	}}
+}
@@ -0,0 +1,63 @@
+package charts
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseSVGCharts(t *testing.T) {
+	charts := map[string]string{
+		"bar":   GenerateBarChart([]string{"1 период", "2 период"}, []int{3, 5}, nil),
+		"line":  GenerateLineChart([]string{"01.09", "01.10"}, []LineDataset{{Label: "Очки", Values: []int{1, 4}}, {Label: "Голы", Values: []int{0, 2}}}, nil),
+		"pie":   GeneratePieChart([]string{"В равных", "В большинстве"}, []int{7, 2}, nil),
+		"radar": GenerateRadarChart([]string{"Голы", "Пасы", "+/-"}, []float64{5, 3, 2}, nil),
+		"empty": GenerateBarChart(nil, nil, nil),
+	}
+	for name, svg := range charts {
+		d, err := ParseSVG(svg)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if d.Width != 300 || len(d.Shapes) == 0 {
+			t.Errorf("%s: width = %v, shapes = %d", name, d.Width, len(d.Shapes))
+		}
+		texts := 0
+		for _, s := range d.Shapes {
+			if s.Text != nil {
+				texts++
+			}
+		}
+		if texts == 0 {
+			t.Errorf("%s: no text shapes", name)
+		}
+	}
+}
+
+func TestParseSVGText(t *testing.T) {
+	d, err := ParseSVG(`<svg width="10" height="10"><text x="5" y="6" text-anchor="middle" font-size="11" font-weight="600" fill="#1a3a5c">A &amp; B</text></svg>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := d.Shapes[0].Text
+	if text.Content != "A & B" || !text.Bold || text.Anchor != "middle" || text.Size != 11 {
+		t.Errorf("text = %+v", text)
+	}
+	if fill := d.Shapes[0].Fill; fill == nil || *fill != (RGB{0x1a, 0x3a, 0x5c}) {
+		t.Errorf("fill = %v", fill)
+	}
+}
+
+func TestArcToCurves(t *testing.T) {
+	// Полуокружность радиуса 10 от (0,0) до (20,0) по часовой стрелке проходит через (10,-10)
+	ops := arcToCurves(Point{0, 0}, Point{20, 0}, 10, 10, 0, false, true)
+	if len(ops) != 2 {
+		t.Fatalf("segments = %d, want 2", len(ops))
+	}
+	mid := ops[0].Points[2]
+	if math.Abs(mid.X-10) > 1e-6 || math.Abs(mid.Y+10) > 1e-6 {
+		t.Errorf("midpoint = %+v, want (10,-10)", mid)
+	}
+	if end := ops[1].Points[2]; end != (Point{20, 0}) {
+		t.Errorf("end = %+v", end)
+	}
+}
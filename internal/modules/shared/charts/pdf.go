@@ -0,0 +1,77 @@
+package charts
+
+import "github.com/Daniil-Sakharov/HockeyProject/pkg/pdf"
+
+// DrawPDF рисует график на странице PDF с осью Y, направленной вниз (блок
+// pdf.Layout): левый верхний угол в точке (x, y), масштаб scale
+func (d *Drawing) DrawPDF(p *pdf.Page, regular, bold *pdf.Font, x, y, scale float64) {
+	p.Save()
+	defer p.Restore()
+	p.Transform(scale, 0, 0, scale, x, y)
+
+	for _, s := range d.Shapes {
+		if s.Text != nil {
+			drawPDFText(p, s, regular, bold)
+			continue
+		}
+		if s.Fill != nil {
+			p.Save()
+			if s.FillOpacity < 1 {
+				p.SetAlpha(s.FillOpacity)
+			}
+			p.SetFillColor(pdfColor(*s.Fill))
+			pdfPath(p, s.Path)
+			p.Fill()
+			p.Restore()
+		}
+		if s.Stroke != nil && s.StrokeWidth > 0 {
+			p.SetStrokeColor(pdfColor(*s.Stroke))
+			p.SetLineWidth(s.StrokeWidth)
+			if s.RoundCaps {
+				p.SetRoundCaps()
+			}
+			pdfPath(p, s.Path)
+			p.Stroke()
+		}
+	}
+}
+
+func drawPDFText(p *pdf.Page, s Shape, regular, bold *pdf.Font) {
+	t := s.Text
+	font := regular
+	if t.Bold {
+		font = bold
+	}
+	x, y := t.X, t.Y
+	switch t.Anchor {
+	case "middle":
+		x -= font.Width(t.Content, t.Size) / 2
+	case "end":
+		x -= font.Width(t.Content, t.Size)
+	}
+	if t.Middle {
+		y += t.Size * 0.35
+	}
+	p.SetFillColor(pdfColor(*s.Fill))
+	p.Text(font, t.Size, x, y, t.Content)
+}
+
+func pdfPath(p *pdf.Page, ops []PathOp) {
+	for _, op := range ops {
+		pt := op.Points
+		switch op.Kind {
+		case 'M':
+			p.MoveTo(pt[0].X, pt[0].Y)
+		case 'L':
+			p.LineTo(pt[0].X, pt[0].Y)
+		case 'C':
+			p.CurveTo(pt[0].X, pt[0].Y, pt[1].X, pt[1].Y, pt[2].X, pt[2].Y)
+		case 'Z':
+			p.ClosePath()
+		}
+	}
+}
+
+func pdfColor(c RGB) pdf.Color {
+	return pdf.Color{R: float64(c.R) / 255, G: float64(c.G) / 255, B: float64(c.B) / 255}
+}
@@ -0,0 +1,155 @@
+package charts
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/fonts"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/raster"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/ttf"
+)
+
+// PNGScale масштаб изображений по умолчанию: график 600x400 превращается в
+// 1200x800, чего хватает для чёткой картинки в Telegram и превью ссылок
+const PNGScale = 2
+
+// RenderPNG рисует SVG, построенный генераторами пакета, в PNG с масштабом scale
+func RenderPNG(svg string, scale float64) ([]byte, error) {
+	d, err := ParseSVG(svg)
+	if err != nil {
+		return nil, err
+	}
+	if d.Width <= 0 || d.Height <= 0 {
+		return nil, fmt.Errorf("render png: svg has no size")
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, d.Rasterize(scale)); err != nil {
+		return nil, fmt.Errorf("render png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Rasterize рисует график на белом фоне (в Telegram прозрачный фон становится тёмным)
+func (d *Drawing) Rasterize(scale float64) *image.RGBA {
+	c := raster.NewCanvas(int(math.Ceil(d.Width*scale)), int(math.Ceil(d.Height*scale)), color.White)
+	for _, s := range d.Shapes {
+		if s.Text != nil {
+			rasterText(c, s, scale)
+			continue
+		}
+		path := rasterPath(s.Path, scale)
+		if s.Fill != nil {
+			c.Fill(path, nrgba(*s.Fill, s.FillOpacity))
+		}
+		if s.Stroke != nil && s.StrokeWidth > 0 {
+			c.Fill(path.Stroke(s.StrokeWidth*scale, s.RoundCaps), nrgba(*s.Stroke, 1))
+		}
+	}
+	return c.Image()
+}
+
+func rasterPath(ops []PathOp, scale float64) *raster.Path {
+	p := &raster.Path{}
+	for _, op := range ops {
+		pt := op.Points
+		switch op.Kind {
+		case 'M':
+			p.MoveTo(pt[0].X*scale, pt[0].Y*scale)
+		case 'L':
+			p.LineTo(pt[0].X*scale, pt[0].Y*scale)
+		case 'C':
+			p.CubeTo(pt[0].X*scale, pt[0].Y*scale, pt[1].X*scale, pt[1].Y*scale, pt[2].X*scale, pt[2].Y*scale)
+		case 'Z':
+			p.Close()
+		}
+	}
+	return p
+}
+
+// rasterText рисует подпись контурами глифов встроенного шрифта, как в PDF
+func rasterText(c *raster.Canvas, s Shape, scale float64) {
+	t := s.Text
+	font := fonts.Regular()
+	if t.Bold {
+		font = fonts.Bold()
+	}
+	x, y := t.X, t.Y
+	switch t.Anchor {
+	case "middle":
+		x -= font.Width(t.Content, t.Size) / 2
+	case "end":
+		x -= font.Width(t.Content, t.Size)
+	}
+	if t.Middle {
+		y += t.Size * 0.35
+	}
+
+	k := t.Size / float64(font.UnitsPerEm())
+	p := &raster.Path{}
+	for _, r := range t.Content {
+		glyph := font.GlyphIndex(r)
+		for _, contour := range font.Outline(glyph) {
+			glyphContour(p, contour, func(pt ttf.Point) raster.Point {
+				return raster.Point{X: (x + pt.X*k) * scale, Y: (y - pt.Y*k) * scale}
+			})
+		}
+		x += float64(font.Advance(glyph)) * k
+	}
+	c.Fill(p, nrgba(*s.Fill, s.FillOpacity))
+}
+
+// glyphContour добавляет контур глифа из квадратичных кривых TrueType
+func glyphContour(p *raster.Path, points []ttf.Point, at func(ttf.Point) raster.Point) {
+	n := len(points)
+	if n == 0 {
+		return
+	}
+	// Контур начинается с точки на кривой; если таких нет - с середины между контрольными
+	var order []ttf.Point
+	first := -1
+	for i, pt := range points {
+		if pt.On {
+			first = i
+			break
+		}
+	}
+	if first >= 0 {
+		order = append(append(order, points[first+1:]...), points[:first+1]...)
+	} else {
+		order = append(append(order, points...), midpoint(points[n-1], points[0]))
+	}
+
+	start := at(order[len(order)-1])
+	p.MoveTo(start.X, start.Y)
+	var ctrl *ttf.Point
+	for _, pt := range order {
+		switch {
+		case pt.On && ctrl == nil:
+			end := at(pt)
+			p.LineTo(end.X, end.Y)
+		case pt.On:
+			cp, end := at(*ctrl), at(pt)
+			p.QuadTo(cp.X, cp.Y, end.X, end.Y)
+			ctrl = nil
+		default:
+			if ctrl != nil {
+				cp, end := at(*ctrl), at(midpoint(*ctrl, pt))
+				p.QuadTo(cp.X, cp.Y, end.X, end.Y)
+			}
+			ctrl = &pt
+		}
+	}
+	p.Close()
+}
+
+func midpoint(a, b ttf.Point) ttf.Point {
+	return ttf.Point{X: (a.X + b.X) / 2, Y: (a.Y + b.Y) / 2, On: true}
+}
+
+func nrgba(c RGB, opacity float64) color.NRGBA {
+	return color.NRGBA{R: c.R, G: c.G, B: c.B, A: uint8(math.Round(255 * math.Max(0, math.Min(1, opacity))))}
+}
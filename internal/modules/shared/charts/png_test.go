@@ -0,0 +1,73 @@
+package charts
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+)
+
+func decodePNG(t *testing.T, data []byte) image.Image {
+	t.Helper()
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return img
+}
+
+// darkPixels число пикселей заметно темнее белого фона в прямоугольнике r
+func darkPixels(img image.Image, r image.Rectangle) int {
+	n := 0
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			if cr, cg, cb, _ := img.At(x, y).RGBA(); (cr+cg+cb)/3 < 0xc000 {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func TestRenderPNGCharts(t *testing.T) {
+	charts := map[string]string{
+		"bar":   GenerateBarChart([]string{"1 период", "2 период"}, []int{3, 5}, nil),
+		"line":  GenerateLineChart([]string{"01.09", "01.10"}, []LineDataset{{Label: "Очки", Values: []int{1, 4}}, {Label: "Голы", Values: []int{0, 2}}}, nil),
+		"pie":   GeneratePieChart([]string{"В равных", "В большинстве"}, []int{7, 2}, nil),
+		"radar": GenerateRadarChart([]string{"Голы", "Пасы", "+/-"}, []float64{5, 3, 2}, nil),
+	}
+	for name, svg := range charts {
+		data, err := RenderPNG(svg, PNGScale)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		img := decodePNG(t, data)
+		if img.Bounds().Dx() != 600 {
+			t.Errorf("%s: width = %d, want 600", name, img.Bounds().Dx())
+		}
+		if n := darkPixels(img, img.Bounds()); n < 500 {
+			t.Errorf("%s: only %d dark pixels", name, n)
+		}
+	}
+}
+
+func TestRenderPNGText(t *testing.T) {
+	data, err := RenderPNG(`<svg width="60" height="30"><text x="30" y="15" font-size="20" text-anchor="middle" dominant-baseline="middle">Ж</text></svg>`, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := decodePNG(t, data)
+	// Буква по центру, края изображения пустые
+	if n := darkPixels(img, image.Rect(20, 5, 40, 25)); n < 40 {
+		t.Errorf("glyph has %d dark pixels", n)
+	}
+	if n := darkPixels(img, image.Rect(0, 0, 15, 30)); n != 0 {
+		t.Errorf("left margin has %d dark pixels", n)
+	}
+}
+
+func TestRenderPNGWithoutSize(t *testing.T) {
+	if _, err := RenderPNG(`<svg><rect width="5" height="5"/></svg>`, 1); err == nil {
+		t.Error("expected error for svg without size")
+	}
+}
@@ -63,6 +63,15 @@ func (c *Container) Telegram(ctx context.Context) (*modules.TelegramConfig, erro
 	return config.(*modules.TelegramConfig), nil
 }
 
+// FeatureFlags возвращает флаги переключения функциональности
+func (c *Container) FeatureFlags(ctx context.Context) (*FeatureFlags, error) {
+	config, err := c.getOrLoad(ctx, "feature_flags", NewFeatureFlags())
+	if err != nil {
+		return nil, err
+	}
+	return config.(*FeatureFlags), nil
+}
+
 // getOrLoad получает конфигурацию из кэша или загружает новую
 func (c *Container) getOrLoad(ctx context.Context, key string, target interface{}) (interface{}, error) {
 	c.mu.RLock()
@@ -0,0 +1,123 @@
+package modules
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// HTTPGovernorConfig ограничения запросов к внешним источникам
+type HTTPGovernorConfig struct {
+	UserAgent string               `yaml:"user_agent"` // Агент, по которому выбираются правила robots.txt
+	Default   HostLimit            `yaml:"default"`    // Лимит хостов без собственной записи
+	Hosts     map[string]HostLimit `yaml:"hosts"`      // Хост или *.домен -> лимит, у каждого хоста своя корзина
+	Robots    RobotsConfig         `yaml:"robots"`
+	Backoff   BackoffConfig        `yaml:"backoff"`
+	Breaker   BreakerConfig        `yaml:"breaker"`
+}
+
+// HostLimit корзина токенов хоста
+type HostLimit struct {
+	RPS   float64 `yaml:"rps"`   // Запросов в секунду в среднем
+	Burst int     `yaml:"burst"` // Сколько запросов можно отправить подряд без ожидания
+}
+
+// RobotsConfig соблюдение robots.txt
+type RobotsConfig struct {
+	Enabled  bool          `yaml:"enabled"`
+	CacheTTL time.Duration `yaml:"cache_ttl"` // Как долго правила хоста считаются актуальными
+}
+
+// BackoffConfig ожидание после ответов 429/503
+type BackoffConfig struct {
+	MaxRetries int           `yaml:"max_retries"` // Повторов запроса после 429/503
+	Base       time.Duration `yaml:"base"`        // Пауза первого повтора без Retry-After, дальше удваивается
+	Max        time.Duration `yaml:"max"`         // Верхняя граница паузы, в том числе из Retry-After
+}
+
+// BreakerConfig размыкатель цепи хоста
+type BreakerConfig struct {
+	FailureThreshold int           `yaml:"failure_threshold"` // Ошибок подряд до паузы источника
+	OpenTimeout      time.Duration `yaml:"open_timeout"`      // Пауза источника до пробного запроса
+}
+
+// DefaultHTTPGovernorConfig значения по умолчанию, если файл конфигурации не найден
+func DefaultHTTPGovernorConfig() HTTPGovernorConfig {
+	return HTTPGovernorConfig{
+		UserAgent: "HockeyProjectBot",
+		Default:   HostLimit{RPS: 5, Burst: 2},
+		Robots:    RobotsConfig{Enabled: true, CacheTTL: 6 * time.Hour},
+		Backoff:   BackoffConfig{MaxRetries: 3, Base: 2 * time.Second, Max: 5 * time.Minute},
+		Breaker:   BreakerConfig{FailureThreshold: 10, OpenTimeout: 2 * time.Minute},
+	}
+}
+
+// LoadHTTPGovernorConfig загружает конфигурацию из YAML файла поверх значений по умолчанию
+func LoadHTTPGovernorConfig(path string) (HTTPGovernorConfig, error) {
+	cfg := DefaultHTTPGovernorConfig()
+
+	data, err := os.ReadFile(path) //nolint:gosec // path is from trusted config
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, fmt.Errorf("read config file: %w", err)
+	}
+
+	wrapper := struct {
+		Governor *HTTPGovernorConfig `yaml:"http_governor"`
+	}{Governor: &cfg}
+
+	if err := yaml.Unmarshal(data, &wrapper); err != nil {
+		return cfg, fmt.Errorf("parse config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return cfg, fmt.Errorf("validate config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// Validate проверяет лимиты и параметры ожидания
+func (c HTTPGovernorConfig) Validate() error {
+	if err := c.Default.validate("default"); err != nil {
+		return err
+	}
+	for host, limit := range c.Hosts {
+		if err := limit.validate(host); err != nil {
+			return err
+		}
+	}
+	if c.Backoff.MaxRetries < 0 || c.Backoff.Base <= 0 || c.Backoff.Max < c.Backoff.Base {
+		return fmt.Errorf("backoff: base must be positive and not above max")
+	}
+	if c.Breaker.FailureThreshold < 1 || c.Breaker.OpenTimeout <= 0 {
+		return fmt.Errorf("breaker: failure_threshold and open_timeout must be positive")
+	}
+	return nil
+}
+
+// Limit возвращает лимит хоста: точная запись, затем *.домен, затем default
+func (c HTTPGovernorConfig) Limit(host string) (HostLimit, bool) {
+	if limit, ok := c.Hosts[host]; ok {
+		return limit, true
+	}
+	for pattern, limit := range c.Hosts {
+		if suffix, ok := strings.CutPrefix(pattern, "*"); ok && strings.HasSuffix(host, suffix) {
+			return limit, true
+		}
+	}
+	return c.Default, false
+}
+
+func (l HostLimit) validate(name string) error {
+	if l.RPS <= 0 || l.Burst < 1 {
+		return fmt.Errorf("host %s: rps and burst must be positive", name)
+	}
+	return nil
+}
@@ -42,6 +42,9 @@ type JuniorConfig struct {
 	MinBirthYear     int           `env:"JUNIOR_MIN_BIRTH_YEAR" validate:"min=2000,max=2020" default:"2008"`
 	BatchSize        int           `env:"JUNIOR_BATCH_SIZE" validate:"min=1,max=1000" default:"100"`
 	EnableAllSeasons bool          `env:"JUNIOR_ENABLE_ALL_SEASONS" default:"true"`
+	RetryEnabled     bool          `env:"JUNIOR_RETRY_ENABLED" default:"true"`
+	RetryMaxAttempts int           `env:"JUNIOR_RETRY_MAX_ATTEMPTS" validate:"min=1,max=10" default:"3"`
+	RetryDelay       time.Duration `env:"JUNIOR_RETRY_DELAY" default:"5m"`
 }
 
 // JuniorStatsConfig конфигурация для Junior Stats парсера
@@ -106,9 +109,13 @@ type FHMoscowConfig struct {
 	RetryDelay        time.Duration `env:"FHMOSCOW_RETRY_DELAY" default:"5m"`
 	MaxSeasons        int           `env:"FHMOSCOW_MAX_SEASONS" default:"0"`
 	TestSeason        string        `env:"FHMOSCOW_TEST_SEASON" default:""`
-	// Player scanning (since team roster pages are JavaScript-rendered)
-	ScanPlayers bool `env:"FHMOSCOW_SCAN_PLAYERS" default:"true"`
-	MaxPlayerID int  `env:"FHMOSCOW_MAX_PLAYER_ID" default:"15000"`
+	// Обход профилей игроков по фронтиру (страницы составов рендерятся JavaScript).
+	// Новые ID выше известных проверяются до ProbeMissLimit 404 подряд, но не дальше MaxPlayerID (0 - без границы)
+	ScanPlayers    bool `env:"FHMOSCOW_SCAN_PLAYERS" default:"true"`
+	MaxPlayerID    int  `env:"FHMOSCOW_MAX_PLAYER_ID" default:"15000"`
+	ProbeMissLimit int  `env:"FHMOSCOW_PROBE_MISS_LIMIT" validate:"min=1" default:"200"`
+	// Сохранённые профили запрашиваются повторно, если проверены раньше PlayerRefreshAfter назад (0 - не обновлять)
+	PlayerRefreshAfter time.Duration `env:"FHMOSCOW_PLAYER_REFRESH_AFTER" default:"720h"`
 }
 
 // IsValid проверяет валидность конфигурации парсинга
@@ -0,0 +1,65 @@
+package modules
+
+import (
+	"fmt"
+	"time"
+)
+
+// defaultCadenceIntervals интервалы обновления классов приоритета по умолчанию
+var defaultCadenceIntervals = map[string]time.Duration{
+	"MATCH_DAY": time.Hour,
+	"ACTIVE":    4 * time.Hour,
+	"RECENT":    24 * time.Hour,
+	"MEDIUM":    14 * 24 * time.Hour,
+	"OLD":       30 * 24 * time.Hour,
+	"ARCHIVE":   180 * 24 * time.Hour,
+}
+
+// defaultMatchDayWindow окно вокруг матча, в котором турнир считается турниром дня матча
+const defaultMatchDayWindow = 12 * time.Hour
+
+// CadenceConfig расписание обновления турниров по приоритету
+type CadenceConfig struct {
+	Intervals      map[string]time.Duration `yaml:"intervals"`        // Класс приоритета -> интервал обновления
+	MatchDayWindow time.Duration            `yaml:"match_day_window"` // Матч в пределах окна от текущего момента поднимает турнир до MATCH_DAY
+	DailyBudgets   map[string]int           `yaml:"daily_budgets"`    // Источник -> турниров в сутки (0 или нет записи - без ограничения)
+}
+
+// Interval возвращает интервал обновления класса приоритета
+func (c CadenceConfig) Interval(priority string) time.Duration {
+	if interval, ok := c.Intervals[priority]; ok && interval > 0 {
+		return interval
+	}
+	return defaultCadenceIntervals[priority]
+}
+
+// Window возвращает окно дня матча
+func (c CadenceConfig) Window() time.Duration {
+	if c.MatchDayWindow <= 0 {
+		return defaultMatchDayWindow
+	}
+	return c.MatchDayWindow
+}
+
+// DailyBudget возвращает суточный бюджет источника, 0 - без ограничения
+func (c CadenceConfig) DailyBudget(source string) int {
+	return c.DailyBudgets[source]
+}
+
+// Validate проверяет классы приоритета и бюджеты
+func (c CadenceConfig) Validate() error {
+	for priority, interval := range c.Intervals {
+		if _, ok := defaultCadenceIntervals[priority]; !ok {
+			return fmt.Errorf("cadence: unknown priority %s", priority)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("cadence: interval for %s must be positive", priority)
+		}
+	}
+	for source, budget := range c.DailyBudgets {
+		if budget < 0 {
+			return fmt.Errorf("cadence: daily budget for %s must not be negative", source)
+		}
+	}
+	return nil
+}
@@ -15,20 +15,34 @@ type JobWithName struct {
 	Job  JobConfig
 }
 
+// DefaultLeaseTTL срок аренды блокировки задачи, если lease_ttl не задан
+const DefaultLeaseTTL = 30 * time.Second
+
 // SchedulerConfig конфигурация планировщика
 type SchedulerConfig struct {
 	BootstrapMode  bool                 `yaml:"bootstrap_mode"`
 	RunImmediately bool                 `yaml:"run_immediately"`
+	LeaseTTL       time.Duration        `yaml:"lease_ttl"` // Аренда блокировки; продлевается heartbeat каждые lease_ttl/3
+	Cadence        CadenceConfig        `yaml:"cadence"`   // Частота обновления турниров по приоритету
+	Quality        QualityConfig        `yaml:"quality"`   // Проверки качества данных после задач
 	Jobs           map[string]JobConfig `yaml:"jobs"`
 }
 
+// QualityConfig проверки качества данных после каждой успешной задачи
+type QualityConfig struct {
+	Enabled         bool `yaml:"enabled"`
+	BlockOnCritical bool `yaml:"block_on_critical"` // Критические нарушения не пускают зависимые задачи
+}
+
 // JobConfig конфигурация отдельной задачи
 type JobConfig struct {
 	Cron           string        `yaml:"cron"`
 	Enabled        bool          `yaml:"enabled"`
 	Timeout        time.Duration `yaml:"timeout"`
 	MaxTournaments int           `yaml:"max_tournaments"`
-	Order          int           `yaml:"order"` // Порядок выполнения (меньше = раньше)
+	Order          int           `yaml:"order"`      // Порядок выполнения (меньше = раньше)
+	DependsOn      []string      `yaml:"depends_on"` // Задачи, после успешного выполнения которых запускается задача
+	KeepCron       bool          `yaml:"keep_cron"`  // Запускать по cron и при включённых зависимостях
 }
 
 // LoadSchedulerConfig загружает конфигурацию из YAML файла
@@ -58,9 +72,16 @@ func (c *SchedulerConfig) Validate() error {
 	if len(c.Jobs) == 0 {
 		return fmt.Errorf("no jobs configured")
 	}
+	if c.LeaseTTL < 0 || (c.LeaseTTL > 0 && c.LeaseTTL < 3*time.Second) {
+		return fmt.Errorf("lease_ttl must be at least 3s")
+	}
+	if err := c.Cadence.Validate(); err != nil {
+		return err
+	}
 
 	for name, job := range c.Jobs {
-		if job.Cron == "" {
+		// Зависимой задаче cron нужен только на случай, если все её зависимости выключены
+		if job.Cron == "" && len(job.DependsOn) == 0 {
 			return fmt.Errorf("job %s: cron expression required", name)
 		}
 		if job.Timeout <= 0 {
@@ -68,7 +89,15 @@ func (c *SchedulerConfig) Validate() error {
 		}
 	}
 
-	return nil
+	return c.validateDependencies()
+}
+
+// LeaseDuration возвращает срок аренды блокировки задачи
+func (c *SchedulerConfig) LeaseDuration() time.Duration {
+	if c.LeaseTTL <= 0 {
+		return DefaultLeaseTTL
+	}
+	return c.LeaseTTL
 }
 
 // GetJob возвращает конфигурацию задачи по имени
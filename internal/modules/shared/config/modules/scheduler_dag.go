@@ -0,0 +1,212 @@
+package modules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// validateDependencies проверяет, что зависимости существуют и не образуют циклов
+func (c *SchedulerConfig) validateDependencies() error {
+	for name, job := range c.Jobs {
+		for _, dep := range job.DependsOn {
+			if dep == name {
+				return fmt.Errorf("job %s: depends on itself", name)
+			}
+			if _, ok := c.Jobs[dep]; !ok {
+				return fmt.Errorf("job %s: unknown dependency %s", name, dep)
+			}
+		}
+	}
+
+	for name, job := range c.Jobs {
+		if job.KeepCron && job.Cron == "" {
+			return fmt.Errorf("job %s: keep_cron requires cron expression", name)
+		}
+		if job.Enabled && job.Cron == "" && c.IsPipelineRoot(name) {
+			return fmt.Errorf("job %s: all dependencies disabled, cron expression required", name)
+		}
+	}
+
+	if cycle := c.findCycle(); cycle != nil {
+		return fmt.Errorf("dependency cycle: %s", strings.Join(cycle, " -> "))
+	}
+	return nil
+}
+
+// findCycle ищет цикл в графе зависимостей (DFS с раскраской), nil если циклов нет
+func (c *SchedulerConfig) findCycle() []string {
+	const (
+		white = iota
+		grey
+		black
+	)
+	color := make(map[string]int, len(c.Jobs))
+	var stack []string
+	var cycle []string
+
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		color[name] = grey
+		stack = append(stack, name)
+		for _, dep := range sortedCopy(c.Jobs[name].DependsOn) {
+			switch color[dep] {
+			case grey:
+				for i, n := range stack {
+					if n == dep {
+						cycle = append(append([]string{}, stack[i:]...), dep)
+						break
+					}
+				}
+				return true
+			case white:
+				if visit(dep) {
+					return true
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		color[name] = black
+		return false
+	}
+
+	for _, name := range c.jobNames() {
+		if color[name] == white && visit(name) {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// EnabledUpstreams возвращает включённые задачи, от которых зависит задача
+func (c *SchedulerConfig) EnabledUpstreams(name string) []string {
+	var result []string
+	for _, dep := range c.Jobs[name].DependsOn {
+		if c.Jobs[dep].Enabled {
+			result = append(result, dep)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// IsPipelineRoot возвращает true, если задача запускается по своему cron.
+//
+// Задача с depends_on становится корнем, когда все её зависимости выключены или задан
+// keep_cron; с keep_cron она по-прежнему запускается и после своих зависимостей.
+func (c *SchedulerConfig) IsPipelineRoot(name string) bool {
+	return c.Jobs[name].KeepCron || len(c.EnabledUpstreams(name)) == 0
+}
+
+// EnabledDependants возвращает включённые задачи, напрямую зависящие от задачи
+func (c *SchedulerConfig) EnabledDependants(name string) []string {
+	var result []string
+	for other, job := range c.Jobs {
+		if !job.Enabled {
+			continue
+		}
+		for _, dep := range job.DependsOn {
+			if dep == name {
+				result = append(result, other)
+				break
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// PipelineJobs возвращает корень и все его включённые зависимые задачи в топологическом порядке
+func (c *SchedulerConfig) PipelineJobs(root string) []JobWithName {
+	members := map[string]bool{root: true}
+	queue := []string{root}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, dep := range c.EnabledDependants(name) {
+			if !members[dep] {
+				members[dep] = true
+				queue = append(queue, dep)
+			}
+		}
+	}
+	return c.topologicalOrder(members)
+}
+
+// EnabledJobsTopological возвращает включённые задачи так, что зависимости идут раньше зависимых.
+//
+// При равенстве порядок определяется полем Order, затем именем.
+func (c *SchedulerConfig) EnabledJobsTopological() []JobWithName {
+	members := make(map[string]bool)
+	for name, job := range c.Jobs {
+		if job.Enabled {
+			members[name] = true
+		}
+	}
+	return c.topologicalOrder(members)
+}
+
+// topologicalOrder сортирует подмножество задач алгоритмом Кана
+func (c *SchedulerConfig) topologicalOrder(members map[string]bool) []JobWithName {
+	indegree := make(map[string]int, len(members))
+	for name := range members {
+		indegree[name] = 0
+	}
+	for name := range members {
+		for _, dep := range c.Jobs[name].DependsOn {
+			if members[dep] {
+				indegree[name]++
+			}
+		}
+	}
+
+	less := func(a, b string) bool {
+		if c.Jobs[a].Order != c.Jobs[b].Order {
+			return c.Jobs[a].Order < c.Jobs[b].Order
+		}
+		return a < b
+	}
+
+	var ready []string
+	for name, d := range indegree {
+		if d == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	result := make([]JobWithName, 0, len(members))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool { return less(ready[i], ready[j]) })
+		name := ready[0]
+		ready = ready[1:]
+		result = append(result, JobWithName{Name: name, Job: c.Jobs[name]})
+
+		for other := range members {
+			for _, dep := range c.Jobs[other].DependsOn {
+				if dep != name {
+					continue
+				}
+				indegree[other]--
+				if indegree[other] == 0 {
+					ready = append(ready, other)
+				}
+			}
+		}
+	}
+	return result
+}
+
+func (c *SchedulerConfig) jobNames() []string {
+	names := make([]string, 0, len(c.Jobs))
+	for name := range c.Jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedCopy(values []string) []string {
+	result := append([]string(nil), values...)
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,193 @@
+package modules
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func dagJob(enabled bool, order int, deps ...string) JobConfig {
+	return JobConfig{Cron: "0 * * * *", Enabled: enabled, Timeout: time.Minute, Order: order, DependsOn: deps}
+}
+
+func jobNamesOf(jobs []JobWithName) []string {
+	names := make([]string, len(jobs))
+	for i, job := range jobs {
+		names[i] = job.Name
+	}
+	return names
+}
+
+func TestValidate_Dependencies(t *testing.T) {
+	tests := []struct {
+		name    string
+		jobs    map[string]JobConfig
+		wantErr string
+	}{
+		{
+			name: "valid chain",
+			jobs: map[string]JobConfig{
+				"parser":   dagJob(true, 1),
+				"stats":    dagJob(true, 2, "parser"),
+				"calendar": dagJob(true, 3, "parser", "stats"),
+			},
+		},
+		{
+			name:    "unknown dependency",
+			jobs:    map[string]JobConfig{"calendar": dagJob(true, 1, "parser")},
+			wantErr: "unknown dependency parser",
+		},
+		{
+			name:    "self dependency",
+			jobs:    map[string]JobConfig{"parser": dagJob(true, 1, "parser")},
+			wantErr: "depends on itself",
+		},
+		{
+			name: "cycle",
+			jobs: map[string]JobConfig{
+				"a": dagJob(true, 1, "c"),
+				"b": dagJob(true, 2, "a"),
+				"c": dagJob(true, 3, "b"),
+			},
+			wantErr: "dependency cycle: a -> c -> b -> a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &SchedulerConfig{Jobs: tt.jobs}
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidate_DependantCron(t *testing.T) {
+	stats := dagJob(true, 2, "parser")
+	stats.Cron = ""
+
+	cfg := &SchedulerConfig{Jobs: map[string]JobConfig{"parser": dagJob(true, 1), "stats": stats}}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("dependant job without cron should be valid: %v", err)
+	}
+
+	cfg.Jobs["parser"] = dagJob(false, 1)
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error: all dependencies disabled and no cron")
+	}
+}
+
+func TestPipelineJobs(t *testing.T) {
+	cfg := &SchedulerConfig{Jobs: map[string]JobConfig{
+		"parser":   dagJob(true, 1),
+		"stats":    dagJob(true, 11, "parser"),
+		"calendar": dagJob(true, 21, "parser", "stats"),
+		"strength": dagJob(true, 31, "calendar"),
+		"disabled": dagJob(false, 5, "parser"),
+		"other":    dagJob(true, 2),
+	}}
+
+	got := strings.Join(jobNamesOf(cfg.PipelineJobs("parser")), ",")
+	if got != "parser,stats,calendar,strength" {
+		t.Errorf("PipelineJobs(parser) = %s", got)
+	}
+
+	if !cfg.IsPipelineRoot("parser") || !cfg.IsPipelineRoot("other") || cfg.IsPipelineRoot("calendar") {
+		t.Error("unexpected pipeline roots")
+	}
+
+	// Выключенная зависимость делает задачу корнем
+	cfg.Jobs["parser"] = dagJob(false, 1)
+	if !cfg.IsPipelineRoot("stats") {
+		t.Error("stats should become root when parser is disabled")
+	}
+}
+
+func TestEnabledJobsTopological(t *testing.T) {
+	// order противоречит зависимостям: зависимости должны выигрывать
+	cfg := &SchedulerConfig{Jobs: map[string]JobConfig{
+		"calendar": dagJob(true, 1, "stats"),
+		"stats":    dagJob(true, 2, "parser"),
+		"parser":   dagJob(true, 3),
+		"retry":    dagJob(true, 0),
+		"off":      dagJob(false, 0),
+	}}
+
+	got := strings.Join(jobNamesOf(cfg.EnabledJobsTopological()), ",")
+	if got != "retry,parser,stats,calendar" {
+		t.Errorf("EnabledJobsTopological() = %s", got)
+	}
+}
+
+func TestPipelineRoot_KeepCron(t *testing.T) {
+	stats := dagJob(true, 2, "parser")
+	stats.KeepCron = true
+	cfg := &SchedulerConfig{Jobs: map[string]JobConfig{"parser": dagJob(true, 1), "stats": stats}}
+
+	if !cfg.IsPipelineRoot("stats") {
+		t.Error("keep_cron job should stay root with enabled upstreams")
+	}
+	if got := strings.Join(jobNamesOf(cfg.PipelineJobs("parser")), ","); got != "parser,stats" {
+		t.Errorf("PipelineJobs(parser) = %s, keep_cron job should still run after parser", got)
+	}
+
+	stats.Cron = ""
+	cfg.Jobs["stats"] = stats
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "keep_cron requires cron") {
+		t.Errorf("expected keep_cron without cron to be rejected, got %v", err)
+	}
+}
+
+// Статистика с включённым парсером остаётся на своём cron, а не раз в сутки после парсера
+func TestSchedulerYAML_StatsKeepCronWithParserEnabled(t *testing.T) {
+	cfg, err := LoadSchedulerConfig("../../../../../config/scheduler.yaml")
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+
+	for parser, stats := range map[string]string{"junior_parser": "junior_stats", "fhspb_parser": "fhspb_stats"} {
+		for _, name := range []string{parser, stats} {
+			job := cfg.Jobs[name]
+			job.Enabled = true
+			cfg.Jobs[name] = job
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+
+	for _, name := range []string{"junior_stats", "fhspb_stats"} {
+		if !cfg.IsPipelineRoot(name) {
+			t.Errorf("%s is not scheduled by its cron when its parser is enabled", name)
+		}
+		if cfg.Jobs[name].Cron == "" {
+			t.Errorf("%s has no cron", name)
+		}
+	}
+	if got := cfg.EnabledUpstreams("junior_stats"); len(got) != 1 || got[0] != "junior_parser" {
+		t.Errorf("junior_stats upstreams = %v, want [junior_parser]", got)
+	}
+}
+
+func TestSchedulerYAML_UniqueOrders(t *testing.T) {
+	cfg, err := LoadSchedulerConfig("../../../../../config/scheduler.yaml")
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+
+	owners := make(map[int]string)
+	for name, job := range cfg.Jobs {
+		if other, ok := owners[job.Order]; ok {
+			t.Errorf("jobs %s and %s share order %d", other, name, job.Order)
+		}
+		owners[job.Order] = name
+	}
+}
@@ -0,0 +1,67 @@
+package di
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/application"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/infrastructure"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/infrastructure/gazetteer"
+)
+
+// AnalyticsStrengthService возвращает сервис пересчёта коэффициентов силы турниров
+func (c *Container) AnalyticsStrengthService(ctx context.Context) (*application.StrengthService, error) {
+	db, err := c.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return application.NewStrengthService(infrastructure.NewStrengthRepository(db)), nil
+}
+
+// AnalyticsLinesService возвращает сервис анализа звеньев и партнёров
+func (c *Container) AnalyticsLinesService(ctx context.Context) (*application.LinesService, error) {
+	db, err := c.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return application.NewLinesService(infrastructure.NewLinesRepository(db)), nil
+}
+
+// AnalyticsGoalieService возвращает сервис вратарской аналитики
+func (c *Container) AnalyticsGoalieService(ctx context.Context) (*application.GoalieService, error) {
+	db, err := c.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return application.NewGoalieService(infrastructure.NewGoalieRepository(db)), nil
+}
+
+// AnalyticsDisciplineService возвращает сервис отчётов о дисциплине
+func (c *Container) AnalyticsDisciplineService(ctx context.Context) (*application.DisciplineService, error) {
+	db, err := c.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return application.NewDisciplineService(infrastructure.NewDisciplineRepository(db)), nil
+}
+
+// AnalyticsClubService возвращает сервис клубов и сопоставления команд
+func (c *Container) AnalyticsClubService(ctx context.Context) (*application.ClubService, error) {
+	db, err := c.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return application.NewClubService(infrastructure.NewClubRepository(db)), nil
+}
+
+// AnalyticsVenueService возвращает сервис арен со встроенным справочником координат
+func (c *Container) AnalyticsVenueService(ctx context.Context) (*application.VenueService, error) {
+	db, err := c.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	places, err := gazetteer.New()
+	if err != nil {
+		return nil, err
+	}
+	return application.NewVenueService(infrastructure.NewVenueRepository(db), places), nil
+}
@@ -78,3 +78,12 @@ func (c *Container) DB(ctx context.Context) (*sqlx.DB, error) {
 	c.db = db
 	return c.db, nil
 }
+
+// UseDB подменяет подключение к PostgreSQL, например подключением dry-run.
+// Должен вызываться до первого обращения к репозиториям
+func (c *Container) UseDB(db *sqlx.DB) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.db = db
+}
@@ -3,6 +3,7 @@ package di
 import (
 	"context"
 
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/outbox"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/store"
 )
 
@@ -23,3 +24,12 @@ func (c *Container) EventStore(ctx context.Context) (*store.SQLXEventStore, erro
 	c.eventStore = store.NewSQLXEventStore(db)
 	return c.eventStore, nil
 }
+
+// EventOutboxStore возвращает хранилище outbox и доставок событий между процессами
+func (c *Container) EventOutboxStore(ctx context.Context) (*outbox.Store, error) {
+	db, err := c.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return outbox.NewStore(db), nil
+}
@@ -59,3 +59,12 @@ func (c *Container) FHMoscowGoalieStatisticsRepository(ctx context.Context) (*fh
 	}
 	return fhmoscowrepo.NewGoalieStatisticsRepository(db), nil
 }
+
+// FHMoscowPlayerFrontierRepository возвращает FHMoscow репозиторий фронтира обхода игроков
+func (c *Container) FHMoscowPlayerFrontierRepository(ctx context.Context) (*fhmoscowrepo.PlayerFrontierRepository, error) {
+	db, err := c.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fhmoscowrepo.NewPlayerFrontierRepository(db), nil
+}
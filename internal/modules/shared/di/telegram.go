@@ -85,5 +85,9 @@ func (c *Container) TelegramReportService(ctx context.Context) (*services.Report
 	if err != nil {
 		return nil, err
 	}
-	return services.NewReportService(repo), nil
+	goalieService, err := c.AnalyticsGoalieService(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return services.NewReportService(repo, persistence.NewGoalieReportProvider(goalieService)), nil
 }
@@ -0,0 +1,161 @@
+package dryrun
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// Виды изменений строки
+const (
+	OpInsert = "insert"
+	OpUpdate = "update"
+	OpDelete = "delete"
+)
+
+// ignoredFields служебные поля, изменение которых само по себе не считается изменением строки
+var ignoredFields = map[string]bool{"created_at": true, "updated_at": true}
+
+// Report итог dry-run: какие строки задача вставила бы, изменила или удалила
+type Report struct {
+	Job        string                  `json:"job"`
+	StartedAt  time.Time               `json:"started_at"`
+	FinishedAt time.Time               `json:"finished_at"`
+	Error      string                  `json:"error,omitempty"`
+	Tables     map[string]TableSummary `json:"tables"`
+	Changes    []RowChange             `json:"changes"`
+}
+
+// TableSummary количество изменений таблицы
+type TableSummary struct {
+	Inserted int `json:"inserted"`
+	Updated  int `json:"updated"`
+	Deleted  int `json:"deleted"`
+}
+
+// RowChange итоговое изменение строки за весь запуск
+type RowChange struct {
+	Table  string                 `json:"table"`
+	Op     string                 `json:"op"`
+	Key    map[string]interface{} `json:"key"`
+	Row    map[string]interface{} `json:"row,omitempty"`    // вставленная или удалённая строка
+	Fields map[string]FieldChange `json:"fields,omitempty"` // изменённые поля обновлённой строки
+}
+
+// FieldChange значение поля до и после
+type FieldChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// record одно срабатывание триггера
+type record struct {
+	Table string
+	Op    string // INSERT, UPDATE, DELETE
+	Old   map[string]interface{}
+	New   map[string]interface{}
+}
+
+// buildChanges сворачивает срабатывания триггера в итоговые изменения строк:
+// состояние до запуска - старая версия первого срабатывания, после - новая версия последнего.
+// Строки, вставленные и удалённые за запуск, и upsert без изменений в отчёт не попадают
+func buildChanges(records []record, keys map[string][]string) []RowChange {
+	type rowState struct {
+		table  string
+		key    map[string]interface{}
+		before map[string]interface{}
+		after  map[string]interface{}
+	}
+
+	states := make(map[string]*rowState)
+	var order []string
+
+	for _, rec := range records {
+		row := rec.New
+		if row == nil {
+			row = rec.Old
+		}
+		key := rowKey(row, keys[rec.Table])
+		id := rec.Table + "\x00" + mustJSON(key)
+
+		state, ok := states[id]
+		if !ok {
+			state = &rowState{table: rec.Table, key: key, before: rec.Old}
+			states[id] = state
+			order = append(order, id)
+		}
+		state.after = rec.New
+	}
+
+	var changes []RowChange
+	for _, id := range order {
+		s := states[id]
+		switch {
+		case s.before == nil && s.after != nil:
+			changes = append(changes, RowChange{Table: s.table, Op: OpInsert, Key: s.key, Row: s.after})
+		case s.before != nil && s.after == nil:
+			changes = append(changes, RowChange{Table: s.table, Op: OpDelete, Key: s.key, Row: s.before})
+		case s.before != nil && s.after != nil:
+			if fields := diffFields(s.before, s.after); len(fields) > 0 {
+				changes = append(changes, RowChange{Table: s.table, Op: OpUpdate, Key: s.key, Fields: fields})
+			}
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Table != changes[j].Table {
+			return changes[i].Table < changes[j].Table
+		}
+		return changes[i].Op < changes[j].Op
+	})
+	return changes
+}
+
+// summarize считает изменения по таблицам
+func summarize(changes []RowChange) map[string]TableSummary {
+	tables := make(map[string]TableSummary)
+	for _, c := range changes {
+		s := tables[c.Table]
+		switch c.Op {
+		case OpInsert:
+			s.Inserted++
+		case OpUpdate:
+			s.Updated++
+		case OpDelete:
+			s.Deleted++
+		}
+		tables[c.Table] = s
+	}
+	return tables
+}
+
+func diffFields(before, after map[string]interface{}) map[string]FieldChange {
+	fields := make(map[string]FieldChange)
+	for name, value := range after {
+		if ignoredFields[name] {
+			continue
+		}
+		if old := before[name]; !reflect.DeepEqual(old, value) {
+			fields[name] = FieldChange{Before: old, After: value}
+		}
+	}
+	return fields
+}
+
+// rowKey значения первичного ключа; у таблицы без ключа ключом служит вся строка
+func rowKey(row map[string]interface{}, columns []string) map[string]interface{} {
+	if len(columns) == 0 {
+		return row
+	}
+	key := make(map[string]interface{}, len(columns))
+	for _, col := range columns {
+		key[col] = row[col]
+	}
+	return key
+}
+
+func mustJSON(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
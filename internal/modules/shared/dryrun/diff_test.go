@@ -0,0 +1,66 @@
+package dryrun
+
+import "testing"
+
+func row(kv ...interface{}) map[string]interface{} {
+	m := make(map[string]interface{})
+	for i := 0; i < len(kv); i += 2 {
+		m[kv[i].(string)] = kv[i+1]
+	}
+	return m
+}
+
+func TestBuildChanges(t *testing.T) {
+	keys := map[string][]string{"players": {"id"}, "player_statistics": {"id"}}
+	records := []record{
+		// вставка и последующее обновление той же строки - одна вставка с итоговыми значениями
+		{Table: "players", Op: "INSERT", New: row("id", "fhm:1", "name", "A")},
+		{Table: "players", Op: "UPDATE", Old: row("id", "fhm:1", "name", "A"), New: row("id", "fhm:1", "name", "B")},
+		// два обновления - разница между первым старым и последним новым значением
+		{Table: "players", Op: "UPDATE",
+			Old: row("id", "fhm:2", "position", "F", "updated_at", "t1"),
+			New: row("id", "fhm:2", "position", "D", "updated_at", "t2")},
+		{Table: "players", Op: "UPDATE",
+			Old: row("id", "fhm:2", "position", "D", "updated_at", "t2"),
+			New: row("id", "fhm:2", "position", "D", "updated_at", "t3")},
+		// upsert без изменений - только updated_at
+		{Table: "players", Op: "UPDATE",
+			Old: row("id", "fhm:3", "updated_at", "t1"),
+			New: row("id", "fhm:3", "updated_at", "t2")},
+		// вставка и удаление за один запуск
+		{Table: "player_statistics", Op: "INSERT", New: row("id", "s1", "goals", 1.0)},
+		{Table: "player_statistics", Op: "DELETE", Old: row("id", "s1", "goals", 1.0)},
+		{Table: "player_statistics", Op: "DELETE", Old: row("id", "s2", "goals", 2.0)},
+	}
+
+	changes := buildChanges(records, keys)
+	if len(changes) != 3 {
+		t.Fatalf("got %d changes, want 3: %+v", len(changes), changes)
+	}
+
+	del := changes[0]
+	if del.Table != "player_statistics" || del.Op != OpDelete || del.Key["id"] != "s2" {
+		t.Errorf("unexpected delete: %+v", del)
+	}
+
+	ins := changes[1]
+	if ins.Op != OpInsert || ins.Row["name"] != "B" {
+		t.Errorf("insert should carry final row: %+v", ins)
+	}
+
+	upd := changes[2]
+	if upd.Op != OpUpdate || upd.Key["id"] != "fhm:2" || len(upd.Fields) != 1 {
+		t.Fatalf("unexpected update: %+v", upd)
+	}
+	if f := upd.Fields["position"]; f.Before != "F" || f.After != "D" {
+		t.Errorf("position change = %+v, want F -> D", f)
+	}
+
+	summary := summarize(changes)
+	if s := summary["players"]; s.Inserted != 1 || s.Updated != 1 || s.Deleted != 0 {
+		t.Errorf("players summary = %+v", s)
+	}
+	if s := summary["player_statistics"]; s.Deleted != 1 || s.Inserted != 0 {
+		t.Errorf("player_statistics summary = %+v", s)
+	}
+}
@@ -0,0 +1,194 @@
+package dryrun
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+)
+
+// errConnectionLost второе подключение означало бы новую транзакцию без записанных изменений
+var errConnectionLost = errors.New("dry-run: database connection lost")
+
+// connector отдаёт единственное подключение, открытое внутри транзакции,
+// которая никогда не фиксируется
+type connector struct {
+	base   driver.Connector
+	opened atomic.Bool
+}
+
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	if !c.opened.CompareAndSwap(false, true) {
+		return nil, errConnectionLost
+	}
+
+	base, err := c.base.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cn := &conn{base: base}
+	if err := cn.exec("BEGIN"); err != nil {
+		_ = base.Close()
+		return nil, fmt.Errorf("begin dry-run transaction: %w", err)
+	}
+	return cn, nil
+}
+
+func (c *connector) Driver() driver.Driver { return c.base.Driver() }
+
+// conn выполняет каждый запрос под точкой сохранения: ошибка одного запроса
+// откатывает только его, а не всю транзакцию dry-run.
+// Транзакции приложения тоже становятся точками сохранения, COMMIT не выполняется никогда
+type conn struct {
+	base driver.Conn
+	seq  int
+}
+
+func (c *conn) exec(query string) error {
+	_, err := c.base.(driver.ExecerContext).ExecContext(context.Background(), query, nil)
+	return err
+}
+
+func (c *conn) savepoint() (string, error) {
+	c.seq++
+	name := fmt.Sprintf("dry_run_%d", c.seq)
+	return name, c.exec("SAVEPOINT " + name)
+}
+
+// settle снимает точку сохранения, при ошибке запроса предварительно откатив его
+func (c *conn) settle(name string, failed bool) {
+	if failed {
+		_ = c.exec("ROLLBACK TO SAVEPOINT " + name)
+	}
+	_ = c.exec("RELEASE SAVEPOINT " + name)
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	base, err := c.base.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	return &stmt{conn: c, base: base}, nil
+}
+
+// Close откатывает транзакцию dry-run
+func (c *conn) Close() error {
+	_ = c.exec("ROLLBACK")
+	return c.base.Close()
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) BeginTx(_ context.Context, _ driver.TxOptions) (driver.Tx, error) {
+	name, err := c.savepoint()
+	if err != nil {
+		return nil, err
+	}
+	return &tx{conn: c, name: name}, nil
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	name, err := c.savepoint()
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.base.(driver.ExecerContext).ExecContext(ctx, query, args)
+	c.settle(name, err != nil)
+	return res, err
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	name, err := c.savepoint()
+	if err != nil {
+		return nil, err
+	}
+	r, err := c.base.(driver.QueryerContext).QueryContext(ctx, query, args)
+	if err != nil {
+		c.settle(name, true)
+		return nil, err
+	}
+	// Точку сохранения можно снять только после чтения всех строк
+	return &rows{conn: c, base: r, name: name}, nil
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	if p, ok := c.base.(driver.Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
+
+// tx транзакция приложения внутри транзакции dry-run
+type tx struct {
+	conn *conn
+	name string
+}
+
+func (t *tx) Commit() error {
+	return t.conn.exec("RELEASE SAVEPOINT " + t.name)
+}
+
+func (t *tx) Rollback() error {
+	t.conn.settle(t.name, true)
+	return nil
+}
+
+type rows struct {
+	conn   *conn
+	base   driver.Rows
+	name   string
+	failed bool
+}
+
+func (r *rows) Columns() []string { return r.base.Columns() }
+
+func (r *rows) Next(dest []driver.Value) error {
+	err := r.base.Next(dest)
+	if err != nil && !errors.Is(err, io.EOF) {
+		r.failed = true
+	}
+	return err
+}
+
+func (r *rows) Close() error {
+	err := r.base.Close()
+	r.conn.settle(r.name, r.failed || err != nil)
+	return err
+}
+
+// stmt подготовленный запрос, выполняется под точкой сохранения как обычный
+type stmt struct {
+	conn *conn
+	base driver.Stmt
+}
+
+func (s *stmt) Close() error  { return s.base.Close() }
+func (s *stmt) NumInput() int { return s.base.NumInput() }
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	name, err := s.conn.savepoint()
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.base.Exec(args) //nolint:staticcheck // driver.Stmt не обязан поддерживать контекст
+	s.conn.settle(name, err != nil)
+	return res, err
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	name, err := s.conn.savepoint()
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.base.Query(args) //nolint:staticcheck // driver.Stmt не обязан поддерживать контекст
+	if err != nil {
+		s.conn.settle(name, true)
+		return nil, err
+	}
+	return &rows{conn: s.conn, base: r, name: name}, nil
+}
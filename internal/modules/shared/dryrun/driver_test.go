@@ -0,0 +1,237 @@
+package dryrun
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var errFake = errors.New("fake: query failed")
+
+// fakeConn подключение, которое записывает выполненные запросы.
+// Запросы, содержащие "fail", завершаются ошибкой
+type fakeConn struct {
+	log *[]string
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	*c.log = append(*c.log, query)
+	if strings.Contains(query, "fail") {
+		return nil, errFake
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	*c.log = append(*c.log, query)
+	if strings.Contains(query, "fail") {
+		return nil, errFake
+	}
+	return &fakeRows{left: 1}, nil
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("fake: not supported")
+}
+func (c *fakeConn) Close() error              { *c.log = append(*c.log, "CLOSE"); return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("fake: not supported") }
+
+type fakeRows struct{ left int }
+
+func (r *fakeRows) Columns() []string { return []string{"n"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.left == 0 {
+		return io.EOF
+	}
+	r.left--
+	dest[0] = int64(1)
+	return nil
+}
+
+type fakeConnector struct {
+	log []string
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{log: &c.log}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver { return nil }
+
+// openFake открывает пул dry-run поверх поддельного подключения
+func openFake(t *testing.T) (*sqlx.DB, *fakeConnector) {
+	t.Helper()
+	base := &fakeConnector{}
+	db := newDB(base)
+	t.Cleanup(func() { _ = db.Close() })
+	return db, base
+}
+
+func TestDriverSavepointPerStatement(t *testing.T) {
+	ctx := context.Background()
+	db, base := openFake(t)
+
+	if _, err := db.ExecContext(ctx, "INSERT ok"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ExecContext(ctx, "INSERT fail"); !errors.Is(err, errFake) {
+		t.Fatalf("err = %v, want errFake", err)
+	}
+	var n int
+	if err := db.QueryRowContext(ctx, "SELECT ok").Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"BEGIN",
+		"SAVEPOINT dry_run_1", "INSERT ok", "RELEASE SAVEPOINT dry_run_1",
+		// Ошибка откатывает только свой запрос, транзакция dry-run продолжается
+		"SAVEPOINT dry_run_2", "INSERT fail", "ROLLBACK TO SAVEPOINT dry_run_2", "RELEASE SAVEPOINT dry_run_2",
+		"SAVEPOINT dry_run_3", "SELECT ok", "RELEASE SAVEPOINT dry_run_3",
+	}
+	if !reflect.DeepEqual(base.log, want) {
+		t.Errorf("statements:\n%q\nwant:\n%q", base.log, want)
+	}
+}
+
+func TestDriverApplicationTransactions(t *testing.T) {
+	ctx := context.Background()
+	db, base := openFake(t)
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE ok"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err = db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"BEGIN",
+		"SAVEPOINT dry_run_1",
+		"SAVEPOINT dry_run_2", "UPDATE ok", "RELEASE SAVEPOINT dry_run_2",
+		"RELEASE SAVEPOINT dry_run_1", // COMMIT приложения не фиксирует транзакцию dry-run
+		"SAVEPOINT dry_run_3", "ROLLBACK TO SAVEPOINT dry_run_3", "RELEASE SAVEPOINT dry_run_3",
+	}
+	if !reflect.DeepEqual(base.log, want) {
+		t.Errorf("statements:\n%q\nwant:\n%q", base.log, want)
+	}
+	for _, q := range base.log {
+		if q == "COMMIT" {
+			t.Error("dry-run connection must never commit")
+		}
+	}
+}
+
+func TestDriverRollbackOnClose(t *testing.T) {
+	ctx := context.Background()
+	db, base := openFake(t)
+
+	if _, err := db.ExecContext(ctx, "DELETE ok"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got := base.log[len(base.log)-2:]; !reflect.DeepEqual(got, []string{"ROLLBACK", "CLOSE"}) {
+		t.Errorf("close statements = %q, want ROLLBACK then CLOSE", got)
+	}
+}
+
+func TestDriverSingleConnection(t *testing.T) {
+	c := &connector{base: &fakeConnector{}}
+	if _, err := c.Connect(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// Новое подключение открыло бы транзакцию без изменений предыдущего
+	if _, err := c.Connect(context.Background()); !errors.Is(err, errConnectionLost) {
+		t.Errorf("second connect err = %v, want errConnectionLost", err)
+	}
+}
+
+// TestNewDBConcurrentWorkers воспроизводит парсер с пулом воркеров: каждый читает и пишет
+// в своей транзакции через единственное подключение dry-run
+func TestNewDBConcurrentWorkers(t *testing.T) {
+	const workers = 8
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	db, base := openFake(t)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			var n int
+			if err := db.QueryRowContext(ctx, fmt.Sprintf("SELECT ok w%d", w)).Scan(&n); err != nil {
+				errs <- err
+				return
+			}
+			tx, err := db.BeginTxx(ctx, nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+			defer func() { _ = tx.Rollback() }()
+			for _, part := range []string{"a", "b"} {
+				if _, err := tx.ExecContext(ctx, fmt.Sprintf("INSERT ok w%d %s", w, part)); err != nil {
+					errs <- err
+					return
+				}
+			}
+			errs <- tx.Commit()
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+
+	// Зависший воркер вернул бы ошибку истёкшего ctx
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("worker: %v", err)
+		}
+	}
+
+	// Транзакция воркера занимает подключение целиком: чужие запросы между её записями не попадают
+	index := make(map[string]int, len(base.log))
+	for i, q := range base.log {
+		index[q] = i
+		if q == "COMMIT" {
+			t.Error("dry-run connection must never commit")
+		}
+	}
+	for w := 0; w < workers; w++ {
+		a, okA := index[fmt.Sprintf("INSERT ok w%d a", w)]
+		b, okB := index[fmt.Sprintf("INSERT ok w%d b", w)]
+		if !okA || !okB {
+			t.Fatalf("worker %d writes missing from %q", w, base.log)
+		}
+		// INSERT a, RELEASE, SAVEPOINT, INSERT b
+		if b-a != 3 {
+			t.Errorf("worker %d transaction interleaved with other statements: %q", w, base.log[a:b+1])
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package dryrun
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ErrProductionDatabase dry-run направлен в рабочую базу вместо её копии
+var ErrProductionDatabase = errors.New("dry-run: target is the production database, use a clone")
+
+// Recorder подключение dry-run к копии рабочей базы (восстановленному снимку или
+// клону): все запросы выполняются в одной транзакции, которая откатывается при Close,
+// а изменения строк записываются триггерами во временную таблицу. Без копии dry-run
+// не запускается: адрес копии задаёт DRY_RUN_DATABASE_URL (см. .env.example).
+//
+// Триггеры создаются внутри этой же транзакции и блокируют запись в таблицы копии
+// до её завершения, поэтому Open отказывается работать с рабочей базой. После отката
+// копия остаётся прежней и годится для следующих запусков
+type Recorder struct {
+	db        *sqlx.DB
+	job       string
+	startedAt time.Time
+}
+
+// Отслеживаются все таблицы схемы public, кроме служебных таблиц миграций и планировщика
+const tablesQuery = `
+	SELECT tablename FROM pg_tables
+	WHERE schemaname = 'public'
+	  AND tablename <> 'goose_db_version'
+	  AND tablename NOT LIKE 'scheduler\_%'
+	  AND tablename <> 'job_runs'
+	ORDER BY tablename`
+
+const setupQuery = `
+	CREATE TEMP TABLE dry_run_changes (
+		seq BIGSERIAL PRIMARY KEY,
+		table_name TEXT NOT NULL,
+		op TEXT NOT NULL,
+		old_row JSONB,
+		new_row JSONB
+	);
+
+	CREATE FUNCTION public.dry_run_record() RETURNS trigger LANGUAGE plpgsql AS $$
+	BEGIN
+		INSERT INTO pg_temp.dry_run_changes (table_name, op, old_row, new_row)
+		VALUES (
+			TG_TABLE_NAME,
+			TG_OP,
+			CASE WHEN TG_OP <> 'INSERT' THEN to_jsonb(OLD) END,
+			CASE WHEN TG_OP <> 'DELETE' THEN to_jsonb(NEW) END
+		);
+		RETURN NULL;
+	END
+	$$;`
+
+const primaryKeysQuery = `
+	SELECT c.relname, a.attname
+	FROM pg_index i
+	JOIN pg_class c ON c.oid = i.indrelid
+	JOIN pg_namespace n ON n.oid = c.relnamespace
+	JOIN pg_attribute a ON a.attrelid = c.oid AND a.attnum = ANY(i.indkey)
+	WHERE i.indisprimary AND n.nspname = 'public' AND c.relname = ANY($1)
+	ORDER BY c.relname, array_position(i.indkey::int2[], a.attnum)`
+
+// identityQuery отличает базы друг от друга: имя базы, адрес и порт сервера
+const identityQuery = `
+	SELECT current_database() || '@' || COALESCE(host(inet_server_addr()), 'local') || ':' ||
+		COALESCE(inet_server_port(), 0)::text`
+
+// Open открывает подключение dry-run к копии базы dsn и ставит триггеры записи
+// изменений. production - подключение к рабочей базе, с которой копия сверяется
+// до создания триггеров
+func Open(ctx context.Context, dsn, job string, production *sqlx.DB) (*Recorder, error) {
+	base, err := pq.NewConnector(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("dry-run connector: %w", err)
+	}
+
+	db := newDB(base)
+	r := &Recorder{db: db, job: job, startedAt: time.Now()}
+	if err := checkClone(ctx, db, production); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	if err := r.install(ctx); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// newDB открывает пул из единственного подключения dry-run: все записи задачи должны
+// попасть в одну транзакцию. Параллельные воркеры парсеров ждут подключение по очереди,
+// поэтому dry-run медленнее обычного запуска. Очередь не блокируется, пока код не
+// запрашивает пул, удерживая транзакцию или непрочитанные строки: репозитории пишут
+// внутри транзакции только через неё (см. TestNewDBConcurrentWorkers)
+func newDB(base driver.Connector) *sqlx.DB {
+	db := sqlx.NewDb(sql.OpenDB(&connector{base: base}), "postgres")
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	db.SetConnMaxLifetime(0)
+	db.SetConnMaxIdleTime(0)
+	return db
+}
+
+// DB возвращает подключение, через которое должны работать все репозитории задачи
+func (r *Recorder) DB() *sqlx.DB {
+	return r.db
+}
+
+// Close откатывает все изменения запуска
+func (r *Recorder) Close() error {
+	return r.db.Close()
+}
+
+// checkClone проверяет, что dry-run подключён не к рабочей базе
+func checkClone(ctx context.Context, clone, production *sqlx.DB) error {
+	var cloneID, productionID string
+	if err := clone.GetContext(ctx, &cloneID, identityQuery); err != nil {
+		return fmt.Errorf("identify dry-run database: %w", err)
+	}
+	if err := production.GetContext(ctx, &productionID, identityQuery); err != nil {
+		return fmt.Errorf("identify production database: %w", err)
+	}
+	if cloneID == productionID {
+		return fmt.Errorf("%w (%s)", ErrProductionDatabase, cloneID)
+	}
+	return nil
+}
+
+func (r *Recorder) install(ctx context.Context) error {
+	var tables []string
+	if err := r.db.SelectContext(ctx, &tables, tablesQuery); err != nil {
+		return fmt.Errorf("list tables: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, setupQuery); err != nil {
+		return fmt.Errorf("create dry-run recorder: %w", err)
+	}
+
+	var triggers strings.Builder
+	for _, table := range tables {
+		fmt.Fprintf(&triggers,
+			"CREATE TRIGGER dry_run_record AFTER INSERT OR UPDATE OR DELETE ON public.%s FOR EACH ROW EXECUTE FUNCTION public.dry_run_record();\n",
+			pq.QuoteIdentifier(table))
+	}
+	if _, err := r.db.ExecContext(ctx, triggers.String()); err != nil {
+		return fmt.Errorf("create dry-run triggers: %w", err)
+	}
+	return nil
+}
+
+// Report собирает изменения, сделанные задачей с момента Open
+func (r *Recorder) Report(ctx context.Context, runErr error) (*Report, error) {
+	var rows []struct {
+		Table string         `db:"table_name"`
+		Op    string         `db:"op"`
+		Old   sql.NullString `db:"old_row"`
+		New   sql.NullString `db:"new_row"`
+	}
+	query := `SELECT table_name, op, old_row, new_row FROM pg_temp.dry_run_changes ORDER BY seq`
+	if err := r.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, fmt.Errorf("read recorded changes: %w", err)
+	}
+
+	records := make([]record, 0, len(rows))
+	seen := make(map[string]bool)
+	var tables []string
+	for _, row := range rows {
+		rec := record{Table: row.Table, Op: row.Op}
+		if err := decodeRow(row.Old, &rec.Old); err != nil {
+			return nil, err
+		}
+		if err := decodeRow(row.New, &rec.New); err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+		if !seen[row.Table] {
+			seen[row.Table] = true
+			tables = append(tables, row.Table)
+		}
+	}
+
+	keys, err := r.primaryKeys(ctx, tables)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := buildChanges(records, keys)
+	report := &Report{
+		Job:        r.job,
+		StartedAt:  r.startedAt,
+		FinishedAt: time.Now(),
+		Tables:     summarize(changes),
+		Changes:    changes,
+	}
+	if runErr != nil {
+		report.Error = runErr.Error()
+	}
+	return report, nil
+}
+
+func (r *Recorder) primaryKeys(ctx context.Context, tables []string) (map[string][]string, error) {
+	keys := make(map[string][]string)
+	if len(tables) == 0 {
+		return keys, nil
+	}
+
+	var columns []struct {
+		Table  string `db:"relname"`
+		Column string `db:"attname"`
+	}
+	if err := r.db.SelectContext(ctx, &columns, primaryKeysQuery, pq.Array(tables)); err != nil {
+		return nil, fmt.Errorf("read primary keys: %w", err)
+	}
+	for _, c := range columns {
+		keys[c.Table] = append(keys[c.Table], c.Column)
+	}
+	return keys, nil
+}
+
+func decodeRow(raw sql.NullString, dst *map[string]interface{}) error {
+	if !raw.Valid {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(raw.String), dst); err != nil {
+		return fmt.Errorf("decode recorded row: %w", err)
+	}
+	return nil
+}
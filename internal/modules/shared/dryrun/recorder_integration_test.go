@@ -0,0 +1,39 @@
+//go:build integration
+
+package dryrun
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+func TestOpenRefusesProductionDatabase(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	production, err := sqlx.Connect("postgres", dsn)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	t.Cleanup(func() { _ = production.Close() })
+
+	if _, err := Open(ctx, dsn, "test", production); !errors.Is(err, ErrProductionDatabase) {
+		t.Fatalf("err = %v, want ErrProductionDatabase", err)
+	}
+
+	// Отказ происходит до создания триггеров
+	var triggers int
+	if err := production.GetContext(ctx, &triggers, `SELECT COUNT(*) FROM pg_trigger WHERE tgname = 'dry_run_record'`); err != nil {
+		t.Fatal(err)
+	}
+	if triggers != 0 {
+		t.Errorf("dry-run triggers = %d, want 0", triggers)
+	}
+}
@@ -0,0 +1,70 @@
+package dryrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// summarySamples сколько обновлений каждой таблицы показывать в сводке
+const summarySamples = 3
+
+// WriteFile сохраняет полный отчёт в JSON
+func (r *Report) WriteFile(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal dry-run report: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write dry-run report: %w", err)
+	}
+	return nil
+}
+
+// WriteSummary печатает краткую сводку: счётчики по таблицам и примеры изменённых полей
+func (r *Report) WriteSummary(w io.Writer) {
+	fmt.Fprintf(w, "Dry run of %s: %s\n", r.Job, r.FinishedAt.Sub(r.StartedAt).Round(1e6))
+	if r.Error != "" {
+		fmt.Fprintf(w, "Job failed: %s\n", r.Error)
+	}
+	if len(r.Tables) == 0 {
+		fmt.Fprintln(w, "No changes")
+		return
+	}
+
+	tables := make([]string, 0, len(r.Tables))
+	for table := range r.Tables {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	samples := make(map[string][]RowChange)
+	for _, c := range r.Changes {
+		if c.Op == OpUpdate && len(samples[c.Table]) < summarySamples {
+			samples[c.Table] = append(samples[c.Table], c)
+		}
+	}
+
+	for _, table := range tables {
+		s := r.Tables[table]
+		fmt.Fprintf(w, "  %-32s +%d ~%d -%d\n", table, s.Inserted, s.Updated, s.Deleted)
+		for _, c := range samples[table] {
+			fmt.Fprintf(w, "      %s\n", mustJSON(c.Key))
+			for _, name := range sortedFields(c.Fields) {
+				f := c.Fields[name]
+				fmt.Fprintf(w, "        %s: %s -> %s\n", name, mustJSON(f.Before), mustJSON(f.After))
+			}
+		}
+	}
+}
+
+func sortedFields(fields map[string]FieldChange) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -5,6 +5,8 @@ import (
 	"sync"
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
 )
 
 // EventHandler функция обработки события
@@ -53,9 +55,25 @@ func (b *InMemoryEventBus) Publish(ctx context.Context, event events.Event) erro
 	return nil
 }
 
-// PublishAsync публикует событие асинхронно
+// PublishAsync публикует событие асинхронно.
+// Обработчики не отменяются вместе с контекстом публикующего, ошибки и паники записываются в лог.
+// Доставка только внутри процесса; между процессами события идут через outbox
 func (b *InMemoryEventBus) PublishAsync(ctx context.Context, event events.Event) {
+	ctx = context.WithoutCancel(ctx)
 	go func() {
-		_ = b.Publish(ctx, event)
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error(ctx, "Event handler panic",
+					zap.String("event_type", event.EventType()),
+					zap.Any("panic", r))
+			}
+		}()
+
+		if err := b.Publish(ctx, event); err != nil {
+			logger.Error(ctx, "Async event handler failed",
+				zap.String("event_type", event.EventType()),
+				zap.String("event_id", event.EventID()),
+				zap.Error(err))
+		}
 	}()
 }
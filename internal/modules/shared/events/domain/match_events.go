@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
+)
+
+// Типы событий матчей и статистики
+const (
+	EventMatchCreated  = "match.created"
+	EventMatchFinished = "match.finished"
+	EventMatchUpdated  = "match.updated"
+	EventStatsUpdated  = "stats.updated"
+)
+
+// MatchData данные матча в событии
+type MatchData struct {
+	MatchID      string `json:"match_id"`
+	TournamentID string `json:"tournament_id"`
+	Source       string `json:"source"`
+	Status       string `json:"status"`
+	HomeTeamID   string `json:"home_team_id,omitempty"`
+	AwayTeamID   string `json:"away_team_id,omitempty"`
+	HomeScore    *int   `json:"home_score,omitempty"`
+	AwayScore    *int   `json:"away_score,omitempty"`
+}
+
+// StatsData данные об обновлении статистики турнира
+type StatsData struct {
+	TournamentID string `json:"tournament_id"`
+	Rows         int    `json:"rows"`
+}
+
+// NewMatchCreated создаёт событие появления нового матча
+func NewMatchCreated(data MatchData) *events.BaseEvent {
+	return events.NewBaseEvent(EventMatchCreated, data.MatchID, "match", data, 1)
+}
+
+// NewMatchFinished создаёт событие завершения матча
+func NewMatchFinished(data MatchData) *events.BaseEvent {
+	return events.NewBaseEvent(EventMatchFinished, data.MatchID, "match", data, 1)
+}
+
+// NewMatchUpdated создаёт событие исправления счёта завершённого матча
+func NewMatchUpdated(data MatchData) *events.BaseEvent {
+	return events.NewBaseEvent(EventMatchUpdated, data.MatchID, "match", data, 1)
+}
+
+// NewStatsUpdated создаёт событие обновления статистики игроков турнира
+func NewStatsUpdated(data StatsData) *events.BaseEvent {
+	return events.NewBaseEvent(EventStatsUpdated, data.TournamentID, "tournament", data, 1)
+}
@@ -62,3 +62,66 @@ func NewPlayerUpdated(playerID string, oldData, newData PlayerData) *PlayerUpdat
 		NewData:   newData,
 	}
 }
+
+// Типы событий истории игрока, которые пишут парсеры
+const (
+	EventPlayerCreated       = "player_created"
+	EventPositionChanged     = "position_changed"
+	EventMeasurementsUpdated = "measurements_updated"
+	EventTeamTransfer        = "team_transfer"
+	EventTournamentJoined    = "tournament_joined"
+	EventStatsDelta          = "stats_delta"
+)
+
+// PlayerChange изменение игрока, обнаруженное при сохранении данных парсера
+type PlayerChange struct {
+	*events.BaseEvent
+	Source string `json:"source"`
+}
+
+// EventSource источник данных, в котором обнаружено изменение
+func (e *PlayerChange) EventSource() string { return e.Source }
+
+// NewPlayerChange создаёт событие истории игрока; data - значения до и после
+func NewPlayerChange(eventType, playerID, source string, data interface{}) *PlayerChange {
+	return &PlayerChange{
+		BaseEvent: events.NewBaseEvent(eventType, playerID, "player", data, 1),
+		Source:    source,
+	}
+}
+
+// ValueChange значение поля до и после
+type ValueChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// TeamChange переход игрока в другую команду
+type TeamChange struct {
+	FromTeamID   string `json:"from_team_id"`
+	ToTeamID     string `json:"to_team_id"`
+	TournamentID string `json:"tournament_id"`
+}
+
+// TournamentEntry появление игрока в новом турнире
+type TournamentEntry struct {
+	TournamentID string `json:"tournament_id"`
+	TeamID       string `json:"team_id"`
+}
+
+// StatLine итоговая статистика игрока в турнире
+type StatLine struct {
+	Games          int `json:"games"`
+	Goals          int `json:"goals"`
+	Assists        int `json:"assists"`
+	Points         int `json:"points"`
+	PenaltyMinutes int `json:"penalty_minutes"`
+}
+
+// StatsDelta изменение статистики игрока в турнире
+type StatsDelta struct {
+	TournamentID string   `json:"tournament_id"`
+	Before       StatLine `json:"before"`
+	After        StatLine `json:"after"`
+	Delta        StatLine `json:"delta"`
+}
@@ -0,0 +1,150 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events/bus"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/retry"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// deliveryStore операции хранилища, нужные подписчику
+type deliveryStore interface {
+	Subscribe(ctx context.Context, subscriber string, eventTypes []string) error
+	Claim(ctx context.Context, subscriber string, limit int, lease time.Duration) ([]Delivery, error)
+	Ack(ctx context.Context, subscriber, eventID string) error
+	Retry(ctx context.Context, subscriber, eventID string, nextAttemptAt time.Time, cause error) error
+	Poison(ctx context.Context, subscriber string, d Delivery, cause error) error
+}
+
+// Consumer подписчик процесса: получает события, записанные другими процессами.
+//
+// Доставка не реже одного раза: после сбоя обработчика или процесса событие
+// выдаётся повторно, поэтому обработчики должны быть идемпотентны по EventID.
+type Consumer struct {
+	name     string
+	store    deliveryStore
+	dsn      string
+	opts     Options
+	policy   retry.Policy
+	handlers map[string][]bus.EventHandler
+	now      func() time.Time
+}
+
+// NewConsumer создаёт подписчика с именем name; имя определяет набор доставок,
+// поэтому несколько экземпляров одного процесса делят события между собой
+func NewConsumer(name string, store *Store, dsn string, opts Options) *Consumer {
+	return newConsumer(name, store, dsn, opts)
+}
+
+func newConsumer(name string, store deliveryStore, dsn string, opts Options) *Consumer {
+	return &Consumer{
+		name:  name,
+		store: store,
+		dsn:   dsn,
+		opts:  opts,
+		policy: retry.Policy{
+			MaxRetries: opts.MaxAttempts,
+			BaseDelay:  opts.RetryBase,
+			MaxDelay:   opts.RetryMax,
+			Jitter:     retry.DefaultJitter,
+		},
+		handlers: make(map[string][]bus.EventHandler),
+		now:      time.Now,
+	}
+}
+
+// Subscribe добавляет обработчик типа события (AllEvents - всех типов).
+// Вызывается до Run
+func (c *Consumer) Subscribe(eventType string, handler bus.EventHandler) {
+	c.handlers[eventType] = append(c.handlers[eventType], handler)
+}
+
+// Run регистрирует подписки и обрабатывает доставки до отмены контекста
+func (c *Consumer) Run(ctx context.Context) error {
+	eventTypes := make([]string, 0, len(c.handlers))
+	for eventType := range c.handlers {
+		eventTypes = append(eventTypes, eventType)
+	}
+	sort.Strings(eventTypes)
+
+	if err := c.store.Subscribe(ctx, c.name, eventTypes); err != nil {
+		return fmt.Errorf("subscribe %s: %w", c.name, err)
+	}
+
+	l := newListener(ctx, c.dsn, ChannelDeliveries, c.opts.PollInterval, func(subscriber string) bool {
+		return subscriber == c.name
+	})
+	defer l.close()
+
+	for {
+		for ctx.Err() == nil {
+			processed, err := c.processBatch(ctx)
+			if err != nil {
+				logger.Warn(ctx, "Event delivery failed", zap.String("subscriber", c.name), zap.Error(err))
+				break
+			}
+			if processed < c.opts.BatchSize {
+				break
+			}
+		}
+		if !l.wait(ctx) {
+			return nil
+		}
+	}
+}
+
+// processBatch обрабатывает одну выборку доставок
+func (c *Consumer) processBatch(ctx context.Context) (int, error) {
+	deliveries, err := c.store.Claim(ctx, c.name, c.opts.BatchSize, c.opts.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, d := range deliveries {
+		if err := c.deliver(ctx, d); err != nil {
+			return 0, err
+		}
+	}
+	return len(deliveries), nil
+}
+
+// deliver вызывает обработчики события и записывает итог доставки
+func (c *Consumer) deliver(ctx context.Context, d Delivery) error {
+	handleErr := c.handle(ctx, d)
+	switch {
+	case handleErr == nil:
+		return c.store.Ack(ctx, c.name, d.EventID)
+	case c.policy.Exhausted(d.Attempts, c.opts.MaxAttempts):
+		logger.Error(ctx, "Event moved to poison messages",
+			zap.String("subscriber", c.name),
+			zap.String("event_id", d.EventID),
+			zap.String("event_type", d.EventType),
+			zap.Error(handleErr))
+		return c.store.Poison(ctx, c.name, d, handleErr)
+	default:
+		next := c.now().Add(c.policy.Backoff(d.Attempts))
+		return c.store.Retry(ctx, c.name, d.EventID, next, handleErr)
+	}
+}
+
+func (c *Consumer) handle(ctx context.Context, d Delivery) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panic: %v", r)
+		}
+	}()
+
+	event := d.Event()
+	handlers := append(append([]bus.EventHandler{}, c.handlers[d.EventType]...), c.handlers[AllEvents]...)
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
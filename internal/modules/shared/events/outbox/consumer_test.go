@@ -0,0 +1,104 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
+)
+
+type fakeStore struct {
+	pending  []Delivery
+	acked    []string
+	retried  map[string]time.Time
+	poisoned []string
+}
+
+func (s *fakeStore) Subscribe(context.Context, string, []string) error { return nil }
+
+func (s *fakeStore) Claim(_ context.Context, _ string, limit int, _ time.Duration) ([]Delivery, error) {
+	n := min(limit, len(s.pending))
+	claimed := s.pending[:n]
+	s.pending = s.pending[n:]
+	return claimed, nil
+}
+
+func (s *fakeStore) Ack(_ context.Context, _, eventID string) error {
+	s.acked = append(s.acked, eventID)
+	return nil
+}
+
+func (s *fakeStore) Retry(_ context.Context, _, eventID string, next time.Time, _ error) error {
+	s.retried[eventID] = next
+	return nil
+}
+
+func (s *fakeStore) Poison(_ context.Context, _ string, d Delivery, _ error) error {
+	s.poisoned = append(s.poisoned, d.EventID)
+	return nil
+}
+
+func TestConsumerDelivery(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := &fakeStore{
+		retried: make(map[string]time.Time),
+		pending: []Delivery{
+			{EventID: "ok", EventType: "match.finished", Payload: json.RawMessage(`{"match_id":"m1"}`), Attempts: 1},
+			{EventID: "flaky", EventType: "match.finished", Payload: json.RawMessage(`{}`), Attempts: 1},
+			{EventID: "broken", EventType: "match.finished", Payload: json.RawMessage(`{}`), Attempts: 3},
+			{EventID: "panic", EventType: "stats.updated", Payload: json.RawMessage(`{}`), Attempts: 3},
+		},
+	}
+
+	opts := DefaultOptions()
+	opts.MaxAttempts = 3
+	c := newConsumer("api", store, "", opts)
+	c.now = func() time.Time { return now }
+
+	var seen []string
+	c.Subscribe("match.finished", func(_ context.Context, e events.Event) error {
+		seen = append(seen, e.EventID())
+		if e.EventID() != "ok" {
+			return errors.New("handler failed")
+		}
+		if string(e.EventData().(json.RawMessage)) != `{"match_id":"m1"}` {
+			t.Errorf("payload = %s", e.EventData())
+		}
+		return nil
+	})
+	c.Subscribe("stats.updated", func(context.Context, events.Event) error {
+		panic("boom")
+	})
+
+	processed, err := c.processBatch(context.Background())
+	if err != nil {
+		t.Fatalf("processBatch: %v", err)
+	}
+	if processed != 4 || len(seen) != 3 {
+		t.Fatalf("processed %d, handled %v", processed, seen)
+	}
+
+	if len(store.acked) != 1 || store.acked[0] != "ok" {
+		t.Errorf("acked = %v, want [ok]", store.acked)
+	}
+	next, ok := store.retried["flaky"]
+	if !ok || !next.After(now) {
+		t.Errorf("flaky delivery not rescheduled: %v", store.retried)
+	}
+	if len(store.poisoned) != 2 {
+		t.Errorf("poisoned = %v, want broken and panic", store.poisoned)
+	}
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	event := events.NewBaseEvent("match.finished", "m1", "match", nil, 1)
+	if got := idempotencyKey(event); got != event.EventID() {
+		t.Errorf("key without WithKey = %q, want event id", got)
+	}
+	if got := idempotencyKey(WithKey(event, "match.finished:m1")); got != "match.finished:m1" {
+		t.Errorf("key = %q", got)
+	}
+}
@@ -0,0 +1,142 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
+)
+
+// Состояния доставки
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusPoison    = "poison"
+)
+
+// AllEvents подписка на все типы событий
+const AllEvents = "*"
+
+// Delivery событие, выданное подписчику
+type Delivery struct {
+	EventID        string          `db:"event_id"`
+	IdempotencyKey string          `db:"idempotency_key"`
+	EventType      string          `db:"event_type"`
+	AggregateType  string          `db:"aggregate_type"`
+	AggregateID    string          `db:"aggregate_id"`
+	Payload        json.RawMessage `db:"payload"`
+	OccurredAt     time.Time       `db:"occurred_at"`
+	Attempts       int             `db:"attempts"` // с учётом текущей
+}
+
+// Event восстанавливает событие; EventData - исходный JSON payload.
+// ID события стабилен между повторными доставками и служит ключом идемпотентности обработчика
+func (d Delivery) Event() events.Event {
+	return &events.BaseEvent{
+		ID:       d.EventID,
+		Type:     d.EventType,
+		AggID:    d.AggregateID,
+		AggType:  d.AggregateType,
+		Data:     d.Payload,
+		Occurred: d.OccurredAt,
+		Ver:      1,
+	}
+}
+
+// Decode разбирает данные события, полученного подписчиком, в структуру
+func Decode(event events.Event, dst any) error {
+	raw, ok := event.EventData().(json.RawMessage)
+	if !ok {
+		data, err := json.Marshal(event.EventData())
+		if err != nil {
+			return err
+		}
+		raw = data
+	}
+	if err := json.Unmarshal(raw, dst); err != nil {
+		return fmt.Errorf("decode event %s: %w", event.EventID(), err)
+	}
+	return nil
+}
+
+// Claim выдаёт подписчику готовые доставки и арендует их на lease.
+// Попытка засчитывается при выдаче: доставка, обработчик которой упал вместе
+// с процессом, вернётся после окончания аренды и в итоге уйдёт в poison
+func (s *Store) Claim(ctx context.Context, subscriber string, limit int, lease time.Duration) ([]Delivery, error) {
+	var deliveries []Delivery
+	err := s.db.SelectContext(ctx, &deliveries, `
+		WITH due AS (
+			SELECT event_id FROM event_deliveries
+			WHERE subscriber = $1 AND status = 'pending' AND next_attempt_at <= NOW()
+			  AND (locked_until IS NULL OR locked_until < NOW())
+			ORDER BY next_attempt_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE event_deliveries d
+		SET attempts = d.attempts + 1, locked_until = NOW() + make_interval(secs => $3)
+		FROM due, event_outbox o
+		WHERE d.subscriber = $1 AND d.event_id = due.event_id AND o.event_id = d.event_id
+		RETURNING d.event_id, o.idempotency_key, o.event_type, o.aggregate_type, o.aggregate_id,
+		          o.payload, o.occurred_at, d.attempts`,
+		subscriber, limit, lease.Seconds(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("claim deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// Ack отмечает доставку обработанной
+func (s *Store) Ack(ctx context.Context, subscriber, eventID string) error {
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE event_deliveries
+		SET status = 'delivered', delivered_at = NOW(), locked_until = NULL, last_error = NULL
+		WHERE subscriber = $1 AND event_id = $2`,
+		subscriber, eventID,
+	)
+	return err
+}
+
+// Retry откладывает доставку до nextAttemptAt
+func (s *Store) Retry(ctx context.Context, subscriber, eventID string, nextAttemptAt time.Time, cause error) error {
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE event_deliveries
+		SET next_attempt_at = $3, locked_until = NULL, last_error = $4
+		WHERE subscriber = $1 AND event_id = $2`,
+		subscriber, eventID, nextAttemptAt, cause.Error(),
+	)
+	return err
+}
+
+// Poison переносит доставку с исчерпанными попытками в event_poison_messages
+func (s *Store) Poison(ctx context.Context, subscriber string, d Delivery, cause error) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO event_poison_messages (subscriber, event_id, event_type, payload, attempts, error_message)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (subscriber, event_id) DO UPDATE SET
+			attempts = EXCLUDED.attempts, error_message = EXCLUDED.error_message, created_at = NOW()`,
+		subscriber, d.EventID, d.EventType, d.Payload, d.Attempts, cause.Error(),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+		UPDATE event_deliveries
+		SET status = 'poison', locked_until = NULL, last_error = $3
+		WHERE subscriber = $1 AND event_id = $2`,
+		subscriber, d.EventID, cause.Error(),
+	)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,94 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// Options настройки relay и подписчиков
+type Options struct {
+	BatchSize    int           // событий или доставок за одну выборку
+	PollInterval time.Duration // опрос на случай пропущенного NOTIFY
+	Lease        time.Duration // аренда доставки обработчиком
+	MaxAttempts  int           // попыток до переноса в poison
+	RetryBase    time.Duration // задержка перед первой повторной попыткой
+	RetryMax     time.Duration // потолок задержки
+	Retention    time.Duration // сколько хранить разосланные события
+}
+
+// DefaultOptions настройки по умолчанию
+func DefaultOptions() Options {
+	return Options{
+		BatchSize:    100,
+		PollInterval: 30 * time.Second,
+		Lease:        5 * time.Minute,
+		MaxAttempts:  5,
+		RetryBase:    10 * time.Second,
+		RetryMax:     time.Hour,
+		Retention:    7 * 24 * time.Hour,
+	}
+}
+
+// listener будит цикл обработки по NOTIFY канала и по таймеру опроса.
+// Без dsn (или при ошибке подключения) остаётся только опрос
+type listener struct {
+	pq     *pq.Listener
+	poll   time.Duration
+	filter func(payload string) bool
+}
+
+func newListener(ctx context.Context, dsn, channel string, poll time.Duration, filter func(string) bool) *listener {
+	l := &listener{poll: poll, filter: filter}
+	if dsn == "" {
+		return l
+	}
+
+	pl := pq.NewListener(dsn, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Warn(ctx, "Event listener connection problem", zap.String("channel", channel), zap.Error(err))
+		}
+	})
+	if err := pl.Listen(channel); err != nil {
+		logger.Warn(ctx, "LISTEN failed, falling back to polling", zap.String("channel", channel), zap.Error(err))
+		_ = pl.Close()
+		return l
+	}
+	l.pq = pl
+	return l
+}
+
+// wait ждёт уведомления, срабатывания таймера или отмены контекста.
+// Возвращает false, если контекст отменён
+func (l *listener) wait(ctx context.Context) bool {
+	timer := time.NewTimer(l.poll)
+	defer timer.Stop()
+
+	var notify <-chan *pq.Notification
+	if l.pq != nil {
+		notify = l.pq.Notify
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			return true
+		case n := <-notify:
+			// nil приходит после переподключения: уведомления могли потеряться
+			if n == nil || l.filter == nil || l.filter(n.Extra) {
+				return true
+			}
+		}
+	}
+}
+
+func (l *listener) close() {
+	if l.pq != nil {
+		_ = l.pq.Close()
+	}
+}
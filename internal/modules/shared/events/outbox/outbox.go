@@ -0,0 +1,70 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
+	"github.com/jmoiron/sqlx"
+)
+
+// Каналы LISTEN/NOTIFY
+const (
+	ChannelOutbox     = "event_outbox"     // в outbox появились события для relay
+	ChannelDeliveries = "event_deliveries" // у подписчика появились доставки, payload - имя подписчика
+)
+
+// keyedEvent событие с ключом идемпотентности производителя
+type keyedEvent struct {
+	events.Event
+	key string
+}
+
+// WithKey задаёт ключ идемпотентности: повторная запись события с тем же ключом
+// (например, при повторном парсинге того же матча) игнорируется.
+// Без ключа событие идентифицируется своим ID
+func WithKey(event events.Event, key string) events.Event {
+	return keyedEvent{Event: event, key: key}
+}
+
+func idempotencyKey(event events.Event) string {
+	if keyed, ok := event.(keyedEvent); ok && keyed.key != "" {
+		return keyed.key
+	}
+	return event.EventID()
+}
+
+// Append пишет события в outbox. Вызывается в транзакции изменения данных:
+// событие становится видимым relay только вместе с изменением и только после фиксации
+func Append(ctx context.Context, tx sqlx.ExecerContext, evts ...events.Event) error {
+	if len(evts) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO event_outbox (event_id, idempotency_key, event_type, aggregate_type, aggregate_id, payload, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (idempotency_key) DO NOTHING`
+
+	for _, event := range evts {
+		payload, err := json.Marshal(event.EventData())
+		if err != nil {
+			return fmt.Errorf("marshal event %s: %w", event.EventType(), err)
+		}
+
+		_, err = tx.ExecContext(ctx, query,
+			event.EventID(), idempotencyKey(event), event.EventType(),
+			event.AggregateType(), event.AggregateID(), payload, event.OccurredAt(),
+		)
+		if err != nil {
+			return fmt.Errorf("append event %s: %w", event.EventType(), err)
+		}
+	}
+
+	// NOTIFY доставляется слушателям только при фиксации транзакции
+	if _, err := tx.ExecContext(ctx, `SELECT pg_notify($1, '')`, ChannelOutbox); err != nil {
+		return fmt.Errorf("notify outbox: %w", err)
+	}
+	return nil
+}
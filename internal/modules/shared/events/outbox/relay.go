@@ -0,0 +1,60 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Relay раскладывает события outbox по подписчикам.
+// Несколько relay могут работать одновременно: пачки событий разбираются с SKIP LOCKED
+type Relay struct {
+	store *Store
+	dsn   string
+	opts  Options
+}
+
+// NewRelay создаёт relay; dsn нужен для LISTEN, без него relay работает опросом
+func NewRelay(store *Store, dsn string, opts Options) *Relay {
+	return &Relay{store: store, dsn: dsn, opts: opts}
+}
+
+// Run обрабатывает outbox до отмены контекста
+func (r *Relay) Run(ctx context.Context) {
+	l := newListener(ctx, r.dsn, ChannelOutbox, r.opts.PollInterval, nil)
+	defer l.close()
+
+	lastCleanup := time.Time{}
+	for {
+		r.Drain(ctx)
+
+		if time.Since(lastCleanup) > time.Hour {
+			if removed, err := r.store.Cleanup(ctx, r.opts.Retention); err != nil {
+				logger.Warn(ctx, "Outbox cleanup failed", zap.Error(err))
+			} else if removed > 0 {
+				logger.Info(ctx, "Outbox cleaned up", zap.Int64("events", removed))
+			}
+			lastCleanup = time.Now()
+		}
+
+		if !l.wait(ctx) {
+			return
+		}
+	}
+}
+
+// Drain разбирает outbox пачками, пока он не опустеет
+func (r *Relay) Drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		relayed, err := r.store.Relay(ctx, r.opts.BatchSize)
+		if err != nil {
+			logger.Warn(ctx, "Outbox relay failed", zap.Error(err))
+			return
+		}
+		if relayed < r.opts.BatchSize {
+			return
+		}
+	}
+}
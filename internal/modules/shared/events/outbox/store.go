@@ -0,0 +1,113 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Store хранилище outbox и доставок (таблицы event_outbox, event_subscriptions, event_deliveries)
+type Store struct {
+	db *sqlx.DB
+}
+
+// NewStore создаёт хранилище outbox
+func NewStore(db *sqlx.DB) *Store {
+	return &Store{db: db}
+}
+
+// Subscribe заменяет набор типов событий, на которые подписан subscriber
+func (s *Store) Subscribe(ctx context.Context, subscriber string, eventTypes []string) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx,
+		`DELETE FROM event_subscriptions WHERE subscriber = $1 AND NOT (event_type = ANY($2))`,
+		subscriber, pq.Array(eventTypes),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO event_subscriptions (subscriber, event_type)
+		SELECT $1, unnest($2::text[])
+		ON CONFLICT DO NOTHING`,
+		subscriber, pq.Array(eventTypes),
+	)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Relay раскладывает до limit событий outbox по подписчикам и будит их через NOTIFY.
+// Возвращает число обработанных событий
+func (s *Store) Relay(ctx context.Context, limit int) (int, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var result struct {
+		Relayed     int            `db:"relayed"`
+		Subscribers pq.StringArray `db:"subscribers"`
+	}
+	err = tx.GetContext(ctx, &result, `
+		WITH batch AS (
+			SELECT id, event_id, event_type FROM event_outbox
+			WHERE relayed_at IS NULL
+			ORDER BY id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		), fanout AS (
+			INSERT INTO event_deliveries (subscriber, event_id)
+			SELECT DISTINCT s.subscriber, b.event_id
+			FROM batch b
+			JOIN event_subscriptions s ON s.event_type = b.event_type OR s.event_type = $2
+			ON CONFLICT DO NOTHING
+			RETURNING subscriber
+		), relayed AS (
+			UPDATE event_outbox o SET relayed_at = NOW()
+			FROM batch b WHERE o.id = b.id
+			RETURNING o.id
+		)
+		SELECT (SELECT COUNT(*) FROM relayed) AS relayed,
+		       ARRAY(SELECT DISTINCT subscriber FROM fanout)::text[] AS subscribers`,
+		limit, AllEvents,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("relay outbox: %w", err)
+	}
+
+	for _, subscriber := range result.Subscribers {
+		if _, err := tx.ExecContext(ctx, `SELECT pg_notify($1, $2)`, ChannelDeliveries, subscriber); err != nil {
+			return 0, fmt.Errorf("notify %s: %w", subscriber, err)
+		}
+	}
+	return result.Relayed, tx.Commit()
+}
+
+// Cleanup удаляет разосланные события старше olderThan, у которых не осталось
+// ожидающих доставок; доставки удаляются каскадно, poison-сообщения остаются
+func (s *Store) Cleanup(ctx context.Context, olderThan time.Duration) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `
+		DELETE FROM event_outbox o
+		WHERE o.relayed_at < $1
+		  AND NOT EXISTS (
+			SELECT 1 FROM event_deliveries d
+			WHERE d.event_id = o.event_id AND d.status = 'pending'
+		  )`,
+		time.Now().Add(-olderThan),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
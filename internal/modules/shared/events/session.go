@@ -0,0 +1,17 @@
+package events
+
+import "context"
+
+type sessionKey struct{}
+
+// WithSession привязывает к контексту ID сессии парсинга (запуска задачи планировщика).
+// События, записанные в этом контексте, можно сгруппировать по сессии
+func WithSession(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionKey{}, sessionID)
+}
+
+// SessionID возвращает ID сессии парсинга из контекста или пустую строку
+func SessionID(ctx context.Context) string {
+	id, _ := ctx.Value(sessionKey{}).(string)
+	return id
+}
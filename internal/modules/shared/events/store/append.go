@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/events"
+	"github.com/jmoiron/sqlx"
+)
+
+// sourced событие с известным источником данных
+type sourced interface {
+	EventSource() string
+}
+
+func eventSource(event events.Event) string {
+	if s, ok := event.(sourced); ok && s.EventSource() != "" {
+		return s.EventSource()
+	}
+	return "system"
+}
+
+// Append записывает события игроков в player_events в транзакции сохранения данных.
+// ID сессии парсинга берётся из контекста (events.WithSession)
+func Append(ctx context.Context, q sqlx.ExecerContext, evts ...events.Event) error {
+	var sessionID *string
+	if id := events.SessionID(ctx); id != "" {
+		sessionID = &id
+	}
+
+	query := `
+		INSERT INTO player_events (player_id, event_type, event_data, source, parsing_session_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	for _, event := range evts {
+		data, err := json.Marshal(event.EventData())
+		if err != nil {
+			return fmt.Errorf("marshal %s event: %w", event.EventType(), err)
+		}
+		_, err = q.ExecContext(ctx, query,
+			event.AggregateID(), event.EventType(), data, eventSource(event), sessionID, event.OccurredAt(),
+		)
+		if err != nil {
+			return fmt.Errorf("append %s event: %w", event.EventType(), err)
+		}
+	}
+	return nil
+}
@@ -34,7 +34,7 @@ func (s *SQLXEventStore) SaveEvent(ctx context.Context, event events.Event) erro
 		event.AggregateID(),
 		event.EventType(),
 		eventData,
-		"system",
+		eventSource(event),
 		event.OccurredAt(),
 	)
 	return err
@@ -62,7 +62,7 @@ func (s *SQLXEventStore) SaveEvents(ctx context.Context, evts []events.Event) er
 			event.AggregateID(),
 			event.EventType(),
 			eventData,
-			"system",
+			eventSource(event),
 			event.OccurredAt(),
 		)
 		if err != nil {
@@ -0,0 +1,37 @@
+// Package fonts встроенные шрифты с кириллицей для PDF-отчётов и изображений графиков
+package fonts
+
+import (
+	_ "embed"
+	"sync"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/ttf"
+)
+
+// Шрифты DejaVu Sans, лицензия в файле LICENSE
+var (
+	//go:embed DejaVuSans.ttf
+	regularData []byte
+	//go:embed DejaVuSans-Bold.ttf
+	boldData []byte
+)
+
+var (
+	regular = sync.OnceValue(func() *ttf.Font { return mustParse(regularData) })
+	bold    = sync.OnceValue(func() *ttf.Font { return mustParse(boldData) })
+)
+
+// Regular обычное начертание
+func Regular() *ttf.Font { return regular() }
+
+// Bold полужирное начертание
+func Bold() *ttf.Font { return bold() }
+
+// mustParse встроенные шрифты проверяются тестом, ошибка разбора - ошибка сборки
+func mustParse(data []byte) *ttf.Font {
+	font, err := ttf.Parse(data)
+	if err != nil {
+		panic(err)
+	}
+	return font
+}
@@ -0,0 +1,20 @@
+package fonts
+
+import (
+	"testing"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/ttf"
+)
+
+func TestEmbeddedFonts(t *testing.T) {
+	for name, font := range map[string]*ttf.Font{"regular": Regular(), "bold": Bold()} {
+		for _, r := range "Aa1Яяё№" {
+			if font.GlyphIndex(r) == 0 {
+				t.Errorf("%s: no glyph for %q", name, r)
+			}
+		}
+		if w := font.Width("Голы", 10); w <= 0 || w > 40 {
+			t.Errorf("%s: width of %q = %v", name, "Голы", w)
+		}
+	}
+}
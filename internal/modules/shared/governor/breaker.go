@@ -0,0 +1,104 @@
+package governor
+
+import (
+	"sync"
+	"time"
+)
+
+// BreakerState состояние размыкателя цепи хоста
+type BreakerState int
+
+const (
+	BreakerClosed   BreakerState = iota // запросы проходят
+	BreakerHalfOpen                     // пропускается один пробный запрос
+	BreakerOpen                         // запросы отклоняются до истечения паузы
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerHalfOpen:
+		return "half_open"
+	case BreakerOpen:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+// breaker размыкает цепь после threshold ошибок подряд и через openTimeout
+// пропускает пробный запрос: успех замыкает цепь, ошибка снова её размыкает
+type breaker struct {
+	mu          sync.Mutex
+	threshold   int
+	openTimeout time.Duration
+	state       BreakerState
+	failures    int
+	openedAt    time.Time
+	probing     bool
+}
+
+func newBreaker(threshold int, openTimeout time.Duration) *breaker {
+	return &breaker{threshold: threshold, openTimeout: openTimeout}
+}
+
+// allow решает, можно ли отправить запрос
+func (b *breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if now.Sub(b.openedAt) < b.openTimeout {
+			return false
+		}
+		b.state = BreakerHalfOpen
+		b.probing = true
+		return true
+	case BreakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// success замыкает цепь и сбрасывает счётчик ошибок
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = BreakerClosed
+	b.failures = 0
+	b.probing = false
+}
+
+// failure считает ошибку и размыкает цепь при достижении порога или неудачной пробе
+func (b *breaker) failure(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+	if b.state == BreakerHalfOpen || b.failures >= b.threshold {
+		b.state = BreakerOpen
+		b.openedAt = now
+	}
+}
+
+// release освобождает пробу, если запрос не дошёл до источника
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+func (b *breaker) current() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state
+}
@@ -0,0 +1,46 @@
+package governor
+
+import (
+	"sync"
+	"time"
+)
+
+// tokenBucket корзина токенов хоста, запрос забирает токен заранее и ждёт его появления
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // токенов в секунду
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rps float64, burst int) *tokenBucket {
+	return &tokenBucket{rate: rps, burst: float64(burst), tokens: float64(burst)}
+}
+
+// reserve забирает токен и возвращает, сколько нужно подождать до его появления
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.last.IsZero() {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// setRate меняет скорость, накопленные токены сохраняются в пределах новой ёмкости
+func (b *tokenBucket) setRate(rps float64, burst int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.rate = rps
+	b.burst = float64(burst)
+	b.tokens = min(b.tokens, b.burst)
+}
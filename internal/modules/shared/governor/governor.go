@@ -0,0 +1,227 @@
+package governor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/config/modules"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrCircuitOpen источник приостановлен после серии ошибок
+	ErrCircuitOpen = errors.New("circuit open")
+	// ErrDisallowed путь запрещён robots.txt
+	ErrDisallowed = errors.New("disallowed by robots.txt")
+)
+
+const (
+	robotsTimeout  = 10 * time.Second
+	robotsErrorTTL = 5 * time.Minute // повторная попытка получить недоступный robots.txt
+)
+
+// Governor общий для всех клиентов источников регулятор запросов:
+// корзина токенов и размыкатель цепи на хост, robots.txt и пауза после 429/503
+type Governor struct {
+	cfg     modules.HTTPGovernorConfig
+	base    http.RoundTripper
+	now     func() time.Time
+	metrics *governorMetrics
+
+	mu    sync.Mutex
+	hosts map[string]*hostState
+}
+
+// hostState состояние ограничений одного хоста
+type hostState struct {
+	name       string
+	bucket     *tokenBucket
+	breaker    *breaker
+	configured bool // лимит задан в конфигурации и не переопределяется клиентом
+
+	mu            sync.Mutex
+	pausedUntil   time.Time
+	rps           float64
+	robots        *robotsRules
+	robotsExpires time.Time
+}
+
+// New создаёт регулятор с заданной конфигурацией
+func New(cfg modules.HTTPGovernorConfig) *Governor {
+	g := &Governor{
+		cfg:   cfg,
+		base:  http.DefaultTransport,
+		now:   time.Now,
+		hosts: make(map[string]*hostState),
+	}
+
+	metrics, err := newGovernorMetrics(g)
+	if err != nil {
+		logger.Warn(context.Background(), "Failed to initialize http governor metrics", zap.Error(err))
+	}
+	g.metrics = metrics
+
+	return g
+}
+
+var (
+	defaultMu       sync.Mutex
+	defaultGovernor *Governor
+)
+
+// Default возвращает общий регулятор процесса, до SetDefault - с настройками по умолчанию
+func Default() *Governor {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+
+	if defaultGovernor == nil {
+		defaultGovernor = New(modules.DefaultHTTPGovernorConfig())
+	}
+	return defaultGovernor
+}
+
+// SetDefault заменяет общий регулятор, вызывается при старте процесса до создания клиентов
+func SetDefault(g *Governor) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+
+	defaultGovernor = g
+}
+
+// SuggestInterval задаёт минимальный интервал запросов к хосту базового URL,
+// если для хоста нет записи в конфигурации
+func (g *Governor) SuggestInterval(baseURL string, interval time.Duration) {
+	u, err := url.Parse(baseURL)
+	if err != nil || interval <= 0 {
+		return
+	}
+
+	h := g.host(u.Host)
+	if h.configured {
+		return
+	}
+	h.setRate(1/interval.Seconds(), 1)
+}
+
+// State возвращает состояние размыкателя цепи хоста
+func (g *Governor) State(host string) BreakerState {
+	return g.host(host).breaker.current()
+}
+
+func (g *Governor) host(name string) *hostState {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if h, ok := g.hosts[name]; ok {
+		return h
+	}
+
+	limit, configured := g.cfg.Limit(name)
+	h := &hostState{
+		name:       name,
+		bucket:     newTokenBucket(limit.RPS, limit.Burst),
+		breaker:    newBreaker(g.cfg.Breaker.FailureThreshold, g.cfg.Breaker.OpenTimeout),
+		configured: configured,
+		rps:        limit.RPS,
+	}
+	g.hosts[name] = h
+	return h
+}
+
+// snapshot копирует список хостов для метрик
+func (g *Governor) snapshot() []*hostState {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	hosts := make([]*hostState, 0, len(g.hosts))
+	for _, h := range g.hosts {
+		hosts = append(hosts, h)
+	}
+	return hosts
+}
+
+// robotsFor возвращает правила robots.txt хоста, загружая их при истечении кеша
+func (g *Governor) robotsFor(ctx context.Context, h *hostState, u *url.URL) *robotsRules {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := g.now()
+	if h.robots != nil && now.Before(h.robotsExpires) {
+		return h.robots
+	}
+
+	rules, ttl := g.fetchRobots(ctx, u)
+	h.robots, h.robotsExpires = rules, now.Add(ttl)
+
+	// Crawl-delay строже лимита хоста замедляет корзину
+	if rules.crawlDelay > 0 {
+		if rps := 1 / rules.crawlDelay.Seconds(); rps < h.rps {
+			h.rps = rps
+			h.bucket.setRate(rps, 1)
+		}
+	}
+	return rules
+}
+
+func (g *Governor) fetchRobots(ctx context.Context, u *url.URL) (*robotsRules, time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, robotsTimeout)
+	defer cancel()
+
+	robotsURL := u.Scheme + "://" + u.Host + "/robots.txt"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsURL, nil)
+	if err != nil {
+		return allowAll, robotsErrorTTL
+	}
+	req.Header.Set("User-Agent", g.cfg.UserAgent)
+
+	resp, err := g.base.RoundTrip(req)
+	if err != nil {
+		logger.Debug(ctx, "robots.txt unavailable", zap.String("url", robotsURL), zap.Error(err))
+		return allowAll, robotsErrorTTL
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		data, err := io.ReadAll(io.LimitReader(resp.Body, 512*1024))
+		if err != nil {
+			return allowAll, robotsErrorTTL
+		}
+		return parseRobots(data, g.cfg.UserAgent), g.cfg.Robots.CacheTTL
+	case resp.StatusCode >= 500:
+		return allowAll, robotsErrorTTL
+	default:
+		// 4xx: robots.txt отсутствует, ограничений нет
+		return allowAll, g.cfg.Robots.CacheTTL
+	}
+}
+
+func (h *hostState) setRate(rps float64, burst int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.rps = rps
+	h.bucket.setRate(rps, burst)
+}
+
+func (h *hostState) pause(until time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if until.After(h.pausedUntil) {
+		h.pausedUntil = until
+	}
+}
+
+func (h *hostState) pausedFor(now time.Time) time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return max(h.pausedUntil.Sub(now), 0)
+}
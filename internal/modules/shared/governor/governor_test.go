@@ -0,0 +1,159 @@
+package governor
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/config/modules"
+)
+
+func testConfig() modules.HTTPGovernorConfig {
+	cfg := modules.DefaultHTTPGovernorConfig()
+	cfg.Default = modules.HostLimit{RPS: 1000, Burst: 10}
+	cfg.Backoff = modules.BackoffConfig{MaxRetries: 2, Base: 10 * time.Millisecond, Max: time.Second}
+	cfg.Breaker = modules.BreakerConfig{FailureThreshold: 3, OpenTimeout: time.Hour}
+	return cfg
+}
+
+func newTestClient(g *Governor) *http.Client {
+	return &http.Client{Transport: g, Timeout: 5 * time.Second}
+}
+
+func TestRetryAfterPausesHostAndRetries(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	g := New(testConfig())
+	start := time.Now()
+	resp, err := newTestClient(g).Get(srv.URL + "/page")
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || atomic.LoadInt32(&calls) != 2 {
+		t.Fatalf("status %d after %d calls, want 200 after 2", resp.StatusCode, calls)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("retried after %s, Retry-After not honoured", elapsed)
+	}
+}
+
+func TestBreakerOpensAfterRepeatedFailures(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	cfg := testConfig()
+	cfg.Robots.Enabled = false
+	g := New(cfg)
+	client := newTestClient(g)
+
+	for i := 0; i < cfg.Breaker.FailureThreshold; i++ {
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	if _, err := client.Get(srv.URL); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("err = %v, want ErrCircuitOpen", err)
+	}
+	if state := g.State(srv.Listener.Addr().String()); state != BreakerOpen {
+		t.Fatalf("state = %s, want open", state)
+	}
+}
+
+func TestRobotsDisallow(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			_, _ = w.Write([]byte("User-agent: *\nDisallow: /private\n"))
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	client := newTestClient(New(testConfig()))
+
+	if _, err := client.Get(srv.URL + "/private/page"); !errors.Is(err, ErrDisallowed) {
+		t.Fatalf("err = %v, want ErrDisallowed", err)
+	}
+	resp, err := client.Get(srv.URL + "/public")
+	if err != nil {
+		t.Fatalf("allowed request: %v", err)
+	}
+	_ = resp.Body.Close()
+}
+
+func TestParseRobots(t *testing.T) {
+	data := []byte(`
+User-agent: Googlebot
+Disallow: /
+
+User-agent: HockeyProjectBot
+User-agent: OtherBot
+Disallow: /admin
+Allow: /admin/public$
+Disallow: /*.pdf$
+Crawl-delay: 2
+
+User-agent: *
+Disallow: /search
+`)
+	rules := parseRobots(data, "HockeyProjectBot/1.0")
+
+	cases := map[string]bool{
+		"/player/1":       true,
+		"/admin/users":    false,
+		"/admin/public":   true,
+		"/files/a.pdf":    false,
+		"/files/a.pdf?x":  true,
+		"/search?q=hello": true, // правило группы "*" к нашему агенту не относится
+	}
+	for path, want := range cases {
+		if got := rules.allowed(path); got != want {
+			t.Errorf("allowed(%q) = %v, want %v", path, got, want)
+		}
+	}
+	if rules.crawlDelay != 2*time.Second {
+		t.Errorf("crawl delay = %s, want 2s", rules.crawlDelay)
+	}
+
+	if parseRobots(data, "SomeCrawler").allowed("/search") {
+		t.Error("wildcard group not applied to unknown agent")
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(2, 2)
+
+	if b.reserve(now) != 0 || b.reserve(now) != 0 {
+		t.Fatal("burst requests should not wait")
+	}
+	if wait := b.reserve(now); wait != 500*time.Millisecond {
+		t.Fatalf("third request waits %s, want 500ms", wait)
+	}
+	if wait := b.reserve(now.Add(time.Second)); wait != 0 {
+		t.Fatalf("request after refill waits %s", wait)
+	}
+}
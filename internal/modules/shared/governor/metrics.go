@@ -0,0 +1,80 @@
+package governor
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Исходы запроса в метриках
+const (
+	outcomeOK          = "ok"
+	outcomeError       = "error"
+	outcomeThrottled   = "throttled"
+	outcomeCircuitOpen = "circuit_open"
+	outcomeDisallowed  = "robots_disallowed"
+)
+
+// governorMetrics метрики регулятора запросов
+type governorMetrics struct {
+	requestsTotal metric.Int64Counter
+	waitSeconds   metric.Float64Histogram
+}
+
+func newGovernorMetrics(g *Governor) (*governorMetrics, error) {
+	meter := otel.Meter("hockey-http-governor")
+
+	requestsTotal, err := meter.Int64Counter(
+		"http_governor_requests_total",
+		metric.WithDescription("Total number of source requests by host and outcome"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	waitSeconds, err := meter.Float64Histogram(
+		"http_governor_wait_seconds",
+		metric.WithDescription("Time requests waited for rate limit or host pause"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// 0 - closed, 1 - half_open, 2 - open
+	_, err = meter.Int64ObservableGauge(
+		"http_governor_circuit_state",
+		metric.WithDescription("Circuit breaker state per source host (0 closed, 1 half-open, 2 open)"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			for _, h := range g.snapshot() {
+				o.Observe(int64(h.breaker.current()), metric.WithAttributes(attribute.String("host", h.name)))
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &governorMetrics{requestsTotal: requestsTotal, waitSeconds: waitSeconds}, nil
+}
+
+func (m *governorMetrics) request(ctx context.Context, host, outcome string) {
+	if m == nil {
+		return
+	}
+	m.requestsTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("host", host),
+		attribute.String("outcome", outcome),
+	))
+}
+
+func (m *governorMetrics) wait(ctx context.Context, host string, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.waitSeconds.Record(ctx, d.Seconds(), metric.WithAttributes(attribute.String("host", host)))
+}
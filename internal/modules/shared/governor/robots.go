@@ -0,0 +1,136 @@
+package governor
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// robotsRule правило Allow/Disallow
+type robotsRule struct {
+	pattern string
+	allow   bool
+}
+
+// robotsRules правила robots.txt, относящиеся к нашему агенту
+type robotsRules struct {
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+// allowAll правила для хоста без robots.txt или с недоступным robots.txt
+var allowAll = &robotsRules{}
+
+// parseRobots выбирает группу агента (по вхождению имени), иначе группу "*"
+func parseRobots(data []byte, agent string) *robotsRules {
+	agent = strings.ToLower(agent)
+
+	type group struct {
+		agents []string
+		rules  robotsRules
+	}
+	var (
+		groups  []*group
+		current *group
+		inRules bool
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			// Подряд идущие User-agent относятся к одной группе
+			if current == nil || inRules {
+				current = &group{}
+				groups = append(groups, current)
+				inRules = false
+			}
+			current.agents = append(current.agents, strings.ToLower(value))
+		case "allow", "disallow":
+			if current == nil {
+				continue
+			}
+			inRules = true
+			if value == "" {
+				continue // пустой Disallow разрешает всё
+			}
+			current.rules.rules = append(current.rules.rules, robotsRule{pattern: value, allow: key == "allow"})
+		case "crawl-delay":
+			if current == nil {
+				continue
+			}
+			inRules = true
+			if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+				current.rules.crawlDelay = time.Duration(seconds * float64(time.Second))
+			}
+		}
+	}
+
+	var wildcard *robotsRules
+	for _, g := range groups {
+		for _, a := range g.agents {
+			if a == "*" {
+				if wildcard == nil {
+					wildcard = &g.rules
+				}
+				continue
+			}
+			if strings.Contains(agent, a) {
+				return &g.rules
+			}
+		}
+	}
+	if wildcard != nil {
+		return wildcard
+	}
+	return &robotsRules{}
+}
+
+// allowed применяет самое длинное совпавшее правило, при равной длине побеждает Allow
+func (r *robotsRules) allowed(path string) bool {
+	best, allow := -1, true
+	for _, rule := range r.rules {
+		if !matchRobotsPattern(rule.pattern, path) {
+			continue
+		}
+		if n := len(rule.pattern); n > best || (n == best && rule.allow) {
+			best, allow = n, rule.allow
+		}
+	}
+	return allow
+}
+
+// matchRobotsPattern сопоставляет путь с шаблоном robots.txt (* - любая строка, $ - конец пути)
+func matchRobotsPattern(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+	pos := len(parts[0])
+	for _, part := range parts[1:] {
+		idx := strings.Index(path[pos:], part)
+		if idx < 0 {
+			return false
+		}
+		pos += idx + len(part)
+	}
+
+	if !anchored {
+		return true
+	}
+	last := parts[len(parts)-1]
+	return pos == len(path) || (len(parts) > 1 && strings.HasSuffix(path, last))
+}
@@ -0,0 +1,150 @@
+package governor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// transport http.RoundTripper поверх общего регулятора процесса
+type transport struct{}
+
+// NewTransport возвращает транспорт для http.Client клиентов источников.
+// Регулятор выбирается при каждом запросе, поэтому клиент можно создать до SetDefault
+func NewTransport() http.RoundTripper {
+	return transport{}
+}
+
+// NewHTTPClient возвращает http.Client с транспортом регулятора
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{Timeout: timeout, Transport: NewTransport()}
+}
+
+func (transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return Default().RoundTrip(req)
+}
+
+// RoundTrip выполняет запрос с учётом robots.txt, лимита и состояния хоста.
+// Ответы 429/503 приостанавливают хост и повторяются до backoff.max_retries
+func (g *Governor) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	h := g.host(req.URL.Host)
+
+	if g.cfg.Robots.Enabled && req.URL.Path != "/robots.txt" {
+		if !g.robotsFor(ctx, h, req.URL).allowed(req.URL.RequestURI()) {
+			g.metrics.request(ctx, h.name, outcomeDisallowed)
+			return nil, fmt.Errorf("%w: %s", ErrDisallowed, req.URL)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if !h.breaker.allow(g.now()) {
+			g.metrics.request(ctx, h.name, outcomeCircuitOpen)
+			return nil, fmt.Errorf("%w: %s", ErrCircuitOpen, h.name)
+		}
+
+		if err := g.wait(ctx, h); err != nil {
+			h.breaker.release()
+			return nil, err
+		}
+
+		attemptReq, err := rewind(req, attempt)
+		if err != nil {
+			h.breaker.release()
+			return nil, err
+		}
+
+		resp, err := g.base.RoundTrip(attemptReq)
+		if err != nil {
+			if ctx.Err() != nil {
+				h.breaker.release()
+			} else {
+				h.breaker.failure(g.now())
+			}
+			g.metrics.request(ctx, h.name, outcomeError)
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+			if resp.StatusCode >= 500 {
+				h.breaker.failure(g.now())
+				g.metrics.request(ctx, h.name, outcomeError)
+			} else {
+				h.breaker.success()
+				g.metrics.request(ctx, h.name, outcomeOK)
+			}
+			return resp, nil
+		}
+
+		now := g.now()
+		delay := g.backoff(resp.Header.Get("Retry-After"), attempt, now)
+		h.breaker.failure(now)
+		h.pause(now.Add(delay))
+		g.metrics.request(ctx, h.name, outcomeThrottled)
+
+		logger.Warn(ctx, "Source throttled request, pausing host",
+			zap.String("host", h.name),
+			zap.Int("status", resp.StatusCode),
+			zap.Duration("pause", delay),
+			zap.Int("attempt", attempt+1),
+		)
+
+		if attempt >= g.cfg.Backoff.MaxRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+		_ = resp.Body.Close()
+	}
+}
+
+// wait ждёт окончания паузы хоста и токена корзины
+func (g *Governor) wait(ctx context.Context, h *hostState) error {
+	now := g.now()
+	delay := h.pausedFor(now) + h.bucket.reserve(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	g.metrics.wait(ctx, h.name, delay)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// backoff пауза после 429/503: Retry-After (секунды или дата), иначе base * 2^attempt
+func (g *Governor) backoff(retryAfter string, attempt int, now time.Time) time.Duration {
+	delay := g.cfg.Backoff.Base << attempt
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		delay = time.Duration(seconds) * time.Second
+	} else if at, err := http.ParseTime(retryAfter); err == nil {
+		delay = at.Sub(now)
+	}
+	return min(max(delay, 0), g.cfg.Backoff.Max)
+}
+
+// rewind возвращает запрос для повторной попытки с новым телом
+func rewind(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.GetBody == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("rewind body: %w", err)
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	return clone, nil
+}
@@ -0,0 +1,104 @@
+package retry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// JobType тип сущности, обработку которой нужно повторить
+type JobType string
+
+const (
+	JobTypeTournament JobType = "tournament"
+	JobTypeTeam       JobType = "team"
+	JobTypePlayer     JobType = "player"
+	JobTypeMatch      JobType = "match"
+	JobTypeProtocol   JobType = "protocol"
+)
+
+// JobTypes все типы задач
+var JobTypes = []JobType{JobTypeTournament, JobTypeTeam, JobTypePlayer, JobTypeMatch, JobTypeProtocol}
+
+// Valid проверяет, что тип задачи известен
+func (t JobType) Valid() bool {
+	for _, jobType := range JobTypes {
+		if jobType == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Status состояние задачи в очереди
+type Status string
+
+const (
+	StatusPending Status = "pending" // ждёт очередной попытки
+	StatusDead    Status = "dead"    // попытки исчерпаны, ждёт решения администратора
+)
+
+var (
+	ErrJobNotFound = errors.New("failed job not found")
+	ErrJobNotDead  = errors.New("failed job is not in dead-letter state")
+)
+
+// FailedJob задача, обработка которой завершилась ошибкой
+type FailedJob struct {
+	ID           int             `db:"id"`
+	JobType      JobType         `db:"job_type"`
+	Source       string          `db:"source"`
+	ExternalID   string          `db:"external_id"`
+	URL          string          `db:"url"`
+	Payload      json.RawMessage `db:"payload"`
+	Status       Status          `db:"status"`
+	ErrorMessage string          `db:"error_message"`
+	RetryCount   int             `db:"retry_count"`
+	MaxRetries   int             `db:"max_retries"`
+	NextRetryAt  time.Time       `db:"next_retry_at"`
+	DeadAt       *time.Time      `db:"dead_at"`
+	CreatedAt    time.Time       `db:"created_at"`
+	UpdatedAt    time.Time       `db:"updated_at"`
+}
+
+// Decode разбирает payload задачи в структуру источника
+func (j *FailedJob) Decode(payload any) error {
+	if len(j.Payload) == 0 {
+		return fmt.Errorf("job %d: empty payload", j.ID)
+	}
+	if err := json.Unmarshal(j.Payload, payload); err != nil {
+		return fmt.Errorf("job %d: decode payload: %w", j.ID, err)
+	}
+	return nil
+}
+
+// Job задача для постановки в очередь.
+//
+// Payload - данные, достаточные обработчику источника, чтобы повторить
+// обработку одной сущности без повторного обхода турнира (DTO со страницы,
+// внутренние ID турнира и команды).
+type Job struct {
+	Type       JobType
+	Source     string
+	ExternalID string
+	URL        string
+	Payload    any
+}
+
+// Filter отбор задач для администрирования
+type Filter struct {
+	Source  string
+	JobType JobType
+	Status  Status
+	Limit   int
+	Offset  int
+}
+
+// Counts число задач по источнику, типу и состоянию
+type Counts struct {
+	Source  string  `db:"source"`
+	JobType JobType `db:"job_type"`
+	Status  Status  `db:"status"`
+	Count   int     `db:"count"`
+}
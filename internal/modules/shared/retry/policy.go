@@ -0,0 +1,83 @@
+package retry
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Значения политики по умолчанию
+const (
+	DefaultMaxRetries = 3
+	DefaultBaseDelay  = 5 * time.Minute
+	DefaultMaxDelay   = 24 * time.Hour
+	DefaultJitter     = 0.2
+)
+
+// Policy политика повторов источника: экспоненциальная задержка с разбросом
+type Policy struct {
+	MaxRetries int           // попыток до перевода в dead-letter
+	BaseDelay  time.Duration // задержка перед первой повторной попыткой
+	MaxDelay   time.Duration // потолок задержки
+	Jitter     float64       // доля случайного разброса задержки, 0..1
+}
+
+// DefaultPolicy политика для источников без собственных настроек
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxRetries: DefaultMaxRetries,
+		BaseDelay:  DefaultBaseDelay,
+		MaxDelay:   DefaultMaxDelay,
+		Jitter:     DefaultJitter,
+	}
+}
+
+// withDefaults подставляет значения по умолчанию вместо незаданных
+func (p Policy) withDefaults() Policy {
+	if p.MaxRetries <= 0 {
+		p.MaxRetries = DefaultMaxRetries
+	}
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = DefaultBaseDelay
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = DefaultMaxDelay
+	}
+	if p.Jitter < 0 {
+		p.Jitter = 0
+	}
+	if p.Jitter > 1 {
+		p.Jitter = 1
+	}
+	return p
+}
+
+// Backoff возвращает задержку перед попыткой attempt (1 - первая повторная).
+// Задержка удваивается с каждой попыткой, не превышает MaxDelay и
+// случайно сдвигается на ±Jitter, чтобы задачи одного сбоя не повторялись разом.
+func (p Policy) Backoff(attempt int) time.Duration {
+	return p.backoff(attempt, rand.Float64)
+}
+
+func (p Policy) backoff(attempt int, random func() float64) time.Duration {
+	p = p.withDefaults()
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := float64(p.BaseDelay) * math.Pow(2, float64(attempt-1))
+	delay = min(delay, float64(p.MaxDelay))
+
+	if p.Jitter > 0 {
+		delay *= 1 + p.Jitter*(2*random()-1)
+	}
+	return time.Duration(delay)
+}
+
+// Exhausted сообщает, что после attempt неудачных попыток задача уходит в dead-letter
+func (p Policy) Exhausted(attempt, maxRetries int) bool {
+	if maxRetries <= 0 {
+		maxRetries = p.withDefaults().MaxRetries
+	}
+	return attempt >= maxRetries
+}
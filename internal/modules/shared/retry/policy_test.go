@@ -0,0 +1,49 @@
+package retry
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolicy_BackoffIsExponentialAndCapped(t *testing.T) {
+	p := Policy{BaseDelay: time.Minute, MaxDelay: 10 * time.Minute}
+	middle := func() float64 { return 0.5 }
+
+	want := []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 10 * time.Minute, 10 * time.Minute}
+	for i, w := range want {
+		if got := p.backoff(i+1, middle); got != w {
+			t.Fatalf("attempt %d: backoff = %s, want %s", i+1, got, w)
+		}
+	}
+}
+
+func TestPolicy_BackoffJitterBounds(t *testing.T) {
+	p := Policy{BaseDelay: 10 * time.Minute, MaxDelay: time.Hour, Jitter: 0.2}
+
+	if got := p.backoff(1, func() float64 { return 0 }); got != 8*time.Minute {
+		t.Fatalf("lower bound = %s, want 8m", got)
+	}
+	if got := p.backoff(1, func() float64 { return 1 }); got != 12*time.Minute {
+		t.Fatalf("upper bound = %s, want 12m", got)
+	}
+	for range 100 {
+		if got := p.Backoff(2); got < 16*time.Minute || got > 24*time.Minute {
+			t.Fatalf("jittered backoff = %s, want within 16m..24m", got)
+		}
+	}
+}
+
+func TestPolicy_Exhausted(t *testing.T) {
+	p := Policy{MaxRetries: 3}
+
+	if p.Exhausted(2, 0) {
+		t.Fatal("exhausted after 2 of 3 attempts")
+	}
+	if !p.Exhausted(3, 0) {
+		t.Fatal("not exhausted after 3 of 3 attempts")
+	}
+	// Лимит, сохранённый в задаче, важнее политики
+	if p.Exhausted(3, 5) || !p.Exhausted(5, 5) {
+		t.Fatal("job max_retries is ignored")
+	}
+}
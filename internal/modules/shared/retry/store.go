@@ -0,0 +1,233 @@
+package retry
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// failedJobColumns колонки задачи; в ранних записях 014 часть колонок могла остаться NULL
+const failedJobColumns = `id, job_type, source, external_id, COALESCE(url, '') AS url, payload, status,
+	COALESCE(error_message, '') AS error_message, COALESCE(retry_count, 0) AS retry_count,
+	COALESCE(max_retries, 0) AS max_retries, COALESCE(next_retry_at, NOW()) AS next_retry_at, dead_at,
+	COALESCE(created_at, NOW()) AS created_at, COALESCE(updated_at, NOW()) AS updated_at`
+
+// Store очередь неудачных задач (таблица failed_parsing_jobs)
+type Store struct {
+	db *sqlx.DB
+}
+
+// NewStore создаёт хранилище очереди
+func NewStore(db *sqlx.DB) *Store {
+	return &Store{db: db}
+}
+
+// Enqueue ставит задачу в очередь.
+// Для одной сущности источника держится одна ожидающая задача: повторная
+// ошибка обновляет payload и текст ошибки, но не сбрасывает счётчик попыток.
+func (s *Store) Enqueue(ctx context.Context, job Job, cause error, maxRetries int, nextRetryAt time.Time) error {
+	payload, err := json.Marshal(job.Payload)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	errorMessage := ""
+	if cause != nil {
+		errorMessage = cause.Error()
+	}
+
+	query := `
+		INSERT INTO failed_parsing_jobs (job_type, source, external_id, url, payload, status, error_message, max_retries, next_retry_at)
+		VALUES ($1, $2, $3, $4, $5, 'pending', $6, $7, $8)
+		ON CONFLICT (source, job_type, external_id) WHERE status = 'pending'
+		DO UPDATE SET
+			url = EXCLUDED.url,
+			payload = EXCLUDED.payload,
+			error_message = EXCLUDED.error_message,
+			updated_at = NOW()`
+
+	_, err = s.db.ExecContext(ctx, query,
+		job.Type, job.Source, job.ExternalID, job.URL, payload, errorMessage, maxRetries, nextRetryAt,
+	)
+	return err
+}
+
+// Due возвращает ожидающие задачи, время повтора которых наступило
+func (s *Store) Due(ctx context.Context, limit int) ([]FailedJob, error) {
+	query := `
+		SELECT ` + failedJobColumns + `
+		FROM failed_parsing_jobs
+		WHERE status = 'pending' AND next_retry_at <= NOW()
+		ORDER BY next_retry_at
+		LIMIT $1`
+
+	var jobs []FailedJob
+	if err := s.db.SelectContext(ctx, &jobs, query, limit); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// Reschedule записывает неудачную попытку и время следующей
+func (s *Store) Reschedule(ctx context.Context, id int, nextRetryAt time.Time, cause error) error {
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE failed_parsing_jobs
+		SET retry_count = retry_count + 1,
+		    next_retry_at = $2,
+		    error_message = $3,
+		    updated_at = NOW()
+		WHERE id = $1`,
+		id, nextRetryAt, cause.Error(),
+	)
+	return err
+}
+
+// MarkDead переводит задачу в dead-letter
+func (s *Store) MarkDead(ctx context.Context, id int, countAttempt bool, cause error) error {
+	increment := 0
+	if countAttempt {
+		increment = 1
+	}
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE failed_parsing_jobs
+		SET status = 'dead',
+		    retry_count = retry_count + $2,
+		    error_message = $3,
+		    dead_at = NOW(),
+		    updated_at = NOW()
+		WHERE id = $1`,
+		id, increment, cause.Error(),
+	)
+	return err
+}
+
+// Delete удаляет задачу после успешной обработки
+func (s *Store) Delete(ctx context.Context, id int) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM failed_parsing_jobs WHERE id = $1`, id)
+	return err
+}
+
+// List возвращает страницу задач и их общее число
+func (s *Store) List(ctx context.Context, filter Filter) ([]FailedJob, int, error) {
+	where := `WHERE ($1 = '' OR source = $1) AND ($2 = '' OR job_type = $2) AND ($3 = '' OR status = $3)`
+	args := []any{filter.Source, string(filter.JobType), string(filter.Status)}
+
+	var total int
+	if err := s.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM failed_parsing_jobs `+where, args...); err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT ` + failedJobColumns + `
+		FROM failed_parsing_jobs ` + where + `
+		ORDER BY updated_at DESC, id DESC
+		LIMIT $4 OFFSET $5`
+
+	var jobs []FailedJob
+	if err := s.db.SelectContext(ctx, &jobs, query, append(args, filter.Limit, filter.Offset)...); err != nil {
+		return nil, 0, err
+	}
+	return jobs, total, nil
+}
+
+// Get возвращает задачу по ID
+func (s *Store) Get(ctx context.Context, id int) (*FailedJob, error) {
+	var job FailedJob
+	err := s.db.GetContext(ctx, &job, `SELECT `+failedJobColumns+` FROM failed_parsing_jobs WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrJobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// Requeue возвращает задачу из dead-letter в очередь с обнулённым счётчиком.
+// Если для сущности уже есть ожидающая задача, dead-задача удаляется.
+func (s *Store) Requeue(ctx context.Context, id int) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var job FailedJob
+	err = tx.GetContext(ctx, &job, `SELECT `+failedJobColumns+` FROM failed_parsing_jobs WHERE id = $1 FOR UPDATE`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrJobNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if job.Status != StatusDead {
+		return ErrJobNotDead
+	}
+
+	var pending bool
+	err = tx.GetContext(ctx, &pending, `
+		SELECT EXISTS (
+			SELECT 1 FROM failed_parsing_jobs
+			WHERE source = $1 AND job_type = $2 AND external_id = $3 AND status = 'pending'
+		)`,
+		job.Source, job.JobType, job.ExternalID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if pending {
+		_, err = tx.ExecContext(ctx, `DELETE FROM failed_parsing_jobs WHERE id = $1`, id)
+	} else {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE failed_parsing_jobs
+			SET status = 'pending', retry_count = 0, next_retry_at = NOW(), dead_at = NULL, updated_at = NOW()
+			WHERE id = $1`,
+			id,
+		)
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Discard удаляет задачу без повторной обработки
+func (s *Store) Discard(ctx context.Context, id int) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM failed_parsing_jobs WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrJobNotFound
+	}
+	return nil
+}
+
+// Counts возвращает число задач по источнику, типу и состоянию
+func (s *Store) Counts(ctx context.Context) ([]Counts, error) {
+	var counts []Counts
+	err := s.db.SelectContext(ctx, &counts, `
+		SELECT source, job_type, status, COUNT(*) AS count
+		FROM failed_parsing_jobs
+		GROUP BY source, job_type, status
+		ORDER BY source, job_type, status`)
+	return counts, err
+}
+
+// CleanupDead удаляет dead-задачи старше olderThan
+func (s *Store) CleanupDead(ctx context.Context, olderThan time.Duration) (int64, error) {
+	result, err := s.db.ExecContext(ctx,
+		`DELETE FROM failed_parsing_jobs WHERE status = 'dead' AND dead_at < $1`,
+		time.Now().Add(-olderThan),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,159 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Handler повторяет обработку одной сущности по payload задачи
+type Handler func(ctx context.Context, job *FailedJob) error
+
+// ErrNoHandler для задачи не зарегистрирован обработчик
+var ErrNoHandler = errors.New("no retry handler registered")
+
+// Queue операции очереди, нужные воркеру
+type Queue interface {
+	Due(ctx context.Context, limit int) ([]FailedJob, error)
+	Reschedule(ctx context.Context, id int, nextRetryAt time.Time, cause error) error
+	MarkDead(ctx context.Context, id int, countAttempt bool, cause error) error
+	Delete(ctx context.Context, id int) error
+}
+
+// Result итог прохода воркера по очереди
+type Result struct {
+	Processed int
+	Succeeded int
+	Retried   int
+	Dead      int
+}
+
+// Worker повторяет задачи очереди обработчиками источников
+type Worker struct {
+	queue    Queue
+	handlers map[string]Handler
+	policies map[string]Policy
+	now      func() time.Time
+}
+
+// NewWorker создаёт воркер очереди повторов
+func NewWorker(queue Queue) *Worker {
+	return &Worker{
+		queue:    queue,
+		handlers: make(map[string]Handler),
+		policies: make(map[string]Policy),
+		now:      time.Now,
+	}
+}
+
+func handlerKey(source string, jobType JobType) string {
+	return source + ":" + string(jobType)
+}
+
+// Handle регистрирует обработчик задач типа jobType источника source
+func (w *Worker) Handle(source string, jobType JobType, handler Handler) {
+	w.handlers[handlerKey(source, jobType)] = handler
+}
+
+// SetPolicy задаёт политику повторов источника
+func (w *Worker) SetPolicy(source string, policy Policy) {
+	w.policies[source] = policy.withDefaults()
+}
+
+// Policy возвращает политику повторов источника
+func (w *Worker) Policy(source string) Policy {
+	if policy, ok := w.policies[source]; ok {
+		return policy
+	}
+	return DefaultPolicy()
+}
+
+// Process обрабатывает до limit задач, время повтора которых наступило.
+//
+// Успешная задача удаляется, неудачная откладывается по политике источника,
+// а после max_retries попыток уходит в dead-letter. Задачи без обработчика
+// сразу попадают в dead-letter, чтобы не крутиться в очереди вечно.
+// Ошибка записи в очередь прерывает проход: иначе задача осталась бы в выдаче Due.
+func (w *Worker) Process(ctx context.Context, limit int) (Result, error) {
+	var result Result
+
+	jobs, err := w.queue.Due(ctx, limit)
+	if err != nil {
+		return result, fmt.Errorf("get due jobs: %w", err)
+	}
+
+	for i := range jobs {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		job := &jobs[i]
+		result.Processed++
+
+		handler, ok := w.handlers[handlerKey(job.Source, job.JobType)]
+		if !ok {
+			cause := fmt.Errorf("%w: %s/%s", ErrNoHandler, job.Source, job.JobType)
+			if err := w.queue.MarkDead(ctx, job.ID, false, cause); err != nil {
+				return result, fmt.Errorf("mark job %d dead: %w", job.ID, err)
+			}
+			result.Dead++
+			continue
+		}
+
+		if err := w.retry(ctx, job, handler); err != nil {
+			if ctx.Err() != nil {
+				// Остановка задачи планировщика - не ошибка сущности, попытку не засчитываем
+				return result, ctx.Err()
+			}
+			dead, updErr := w.fail(ctx, job, err)
+			if updErr != nil {
+				return result, fmt.Errorf("update job %d: %w", job.ID, updErr)
+			}
+			if dead {
+				result.Dead++
+			} else {
+				result.Retried++
+			}
+			continue
+		}
+
+		if err := w.queue.Delete(ctx, job.ID); err != nil {
+			return result, fmt.Errorf("delete job %d: %w", job.ID, err)
+		}
+		result.Succeeded++
+	}
+
+	return result, nil
+}
+
+// retry вызывает обработчик, превращая панику в ошибку попытки
+func (w *Worker) retry(ctx context.Context, job *FailedJob, handler Handler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return handler(ctx, job)
+}
+
+// fail записывает неудачную попытку; возвращает true, если задача ушла в dead-letter
+func (w *Worker) fail(ctx context.Context, job *FailedJob, cause error) (bool, error) {
+	policy := w.Policy(job.Source)
+	attempt := job.RetryCount + 1
+
+	if policy.Exhausted(attempt, job.MaxRetries) {
+		logger.Warn(ctx, "Retry attempts exhausted, job moved to dead-letter",
+			zap.Int("id", job.ID),
+			zap.String("source", job.Source),
+			zap.String("type", string(job.JobType)),
+			zap.String("external_id", job.ExternalID),
+			zap.Error(cause))
+		return true, w.queue.MarkDead(ctx, job.ID, true, cause)
+	}
+
+	return false, w.queue.Reschedule(ctx, job.ID, w.now().Add(policy.Backoff(attempt+1)), cause)
+}
@@ -0,0 +1,108 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// memoryQueue очередь в памяти для тестов воркера
+type memoryQueue struct {
+	jobs        []FailedJob
+	rescheduled map[int]time.Time
+	dead        map[int]string
+	deleted     map[int]bool
+}
+
+func newMemoryQueue(jobs ...FailedJob) *memoryQueue {
+	return &memoryQueue{
+		jobs:        jobs,
+		rescheduled: map[int]time.Time{},
+		dead:        map[int]string{},
+		deleted:     map[int]bool{},
+	}
+}
+
+func (q *memoryQueue) Due(_ context.Context, limit int) ([]FailedJob, error) {
+	return q.jobs[:min(limit, len(q.jobs))], nil
+}
+
+func (q *memoryQueue) Reschedule(_ context.Context, id int, next time.Time, _ error) error {
+	q.rescheduled[id] = next
+	return nil
+}
+
+func (q *memoryQueue) MarkDead(_ context.Context, id int, _ bool, cause error) error {
+	q.dead[id] = cause.Error()
+	return nil
+}
+
+func (q *memoryQueue) Delete(_ context.Context, id int) error {
+	q.deleted[id] = true
+	return nil
+}
+
+func TestWorker_Process(t *testing.T) {
+	now := time.Date(2025, 1, 18, 12, 0, 0, 0, time.UTC)
+	queue := newMemoryQueue(
+		FailedJob{ID: 1, Source: "fhspb", JobType: JobTypePlayer, RetryCount: 0, MaxRetries: 3},
+		FailedJob{ID: 2, Source: "fhspb", JobType: JobTypeTeam, RetryCount: 1, MaxRetries: 3},
+		FailedJob{ID: 3, Source: "fhspb", JobType: JobTypeTeam, RetryCount: 2, MaxRetries: 3},
+		FailedJob{ID: 4, Source: "junior", JobType: JobTypeMatch},
+		FailedJob{ID: 5, Source: "fhspb", JobType: JobTypeTournament, MaxRetries: 3},
+	)
+
+	worker := NewWorker(queue)
+	worker.now = func() time.Time { return now }
+	worker.SetPolicy("fhspb", Policy{BaseDelay: time.Minute, MaxDelay: time.Hour})
+	worker.Handle("fhspb", JobTypePlayer, func(context.Context, *FailedJob) error { return nil })
+	worker.Handle("fhspb", JobTypeTeam, func(context.Context, *FailedJob) error { return errors.New("timeout") })
+	worker.Handle("fhspb", JobTypeTournament, func(context.Context, *FailedJob) error { panic("boom") })
+
+	result, err := worker.Process(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	if result != (Result{Processed: 5, Succeeded: 1, Retried: 2, Dead: 2}) {
+		t.Fatalf("result = %+v", result)
+	}
+
+	if !queue.deleted[1] {
+		t.Fatal("succeeded job was not deleted")
+	}
+
+	// Вторая неудачная попытка откладывается на 4 минуты ±20%
+	next, ok := queue.rescheduled[2]
+	if delay := next.Sub(now); !ok || delay < 3*time.Minute || delay > 5*time.Minute {
+		t.Fatalf("job 2 rescheduled in %s", next.Sub(now))
+	}
+	if _, ok := queue.rescheduled[5]; !ok {
+		t.Fatal("panicking handler was not counted as a failed attempt")
+	}
+
+	if _, ok := queue.dead[3]; !ok {
+		t.Fatal("job with exhausted retries is not dead")
+	}
+	if _, ok := queue.dead[4]; !ok {
+		t.Fatal("job without handler is not dead")
+	}
+}
+
+func TestWorker_CancelledRunDoesNotCountAttempt(t *testing.T) {
+	queue := newMemoryQueue(FailedJob{ID: 1, Source: "mihf", JobType: JobTypePlayer, MaxRetries: 3})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	worker := NewWorker(queue)
+	worker.Handle("mihf", JobTypePlayer, func(context.Context, *FailedJob) error {
+		cancel()
+		return context.Canceled
+	})
+
+	if _, err := worker.Process(ctx, 10); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if len(queue.rescheduled) != 0 || len(queue.dead) != 0 {
+		t.Fatalf("cancelled attempt was recorded: rescheduled=%v dead=%v", queue.rescheduled, queue.dead)
+	}
+}
@@ -21,8 +21,8 @@ type ReportRepository interface {
 }
 
 // NewReportService создает новый сервис отчётов
-func NewReportService(repo ReportRepository) *ReportService {
-	dataCollector := NewDataCollector(repo)
+func NewReportService(repo ReportRepository, goalies GoalieReportProvider) *ReportService {
+	dataCollector := NewDataCollector(repo, goalies)
 
 	funcMap := template.FuncMap{
 		"formatFloat": func(f float64) string {
@@ -75,6 +75,7 @@ type SVGCharts struct {
 	GoalsTypePie template.HTML
 	PeriodBar    template.HTML
 	ProgressLine template.HTML
+	SeasonLine   template.HTML
 	ProfileRadar template.HTML
 }
 
@@ -124,6 +125,24 @@ func (s *ReportService) generateCharts(report *FullPlayerReport) SVGCharts {
 		result.ProgressLine = template.HTML(charts.GenerateLineChart(seasonLabels, datasets, nil)) //nolint:gosec
 	}
 
+	// Линейный график - накопление очков и голов по ходу текущего сезона
+	if report.Progress != nil {
+		var dateLabels []string
+		var goalsValues, pointsValues []int
+
+		for _, p := range report.Progress.Points {
+			dateLabels = append(dateLabels, p.Date.Format("02.01"))
+			goalsValues = append(goalsValues, p.Goals)
+			pointsValues = append(pointsValues, p.Points)
+		}
+
+		datasets := []charts.LineDataset{
+			{Label: "Очки", Values: pointsValues, Color: charts.Colors.Primary},
+			{Label: "Голы", Values: goalsValues, Color: charts.Colors.Accent},
+		}
+		result.SeasonLine = template.HTML(charts.GenerateLineChart(dateLabels, datasets, nil)) //nolint:gosec
+	}
+
 	// Radar диаграмма - профиль игрока
 	radarLabels := []string{"Голы", "Пасы", "+/-", "Хет-трики", "Поб. голы"}
 	radarValues := []float64{
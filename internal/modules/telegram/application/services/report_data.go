@@ -2,18 +2,35 @@ package services
 
 import "context"
 
+// GoalieReportProvider источник вратарской статистики для отчёта
+type GoalieReportProvider interface {
+	GetGoalieReport(ctx context.Context, playerID string) (*GoalieReport, error)
+}
+
 // DataCollector собирает все данные для отчёта
 type DataCollector struct {
-	repo ReportRepository
+	repo    ReportRepository
+	goalies GoalieReportProvider
 }
 
-// NewDataCollector создает новый DataCollector
-func NewDataCollector(repo ReportRepository) *DataCollector {
-	return &DataCollector{repo: repo}
+// NewDataCollector создает новый DataCollector, goalies может быть nil
+func NewDataCollector(repo ReportRepository, goalies GoalieReportProvider) *DataCollector {
+	return &DataCollector{repo: repo, goalies: goalies}
 }
 
 // CollectFullReport собирает все данные для полного отчёта игрока
 func (dc *DataCollector) CollectFullReport(ctx context.Context, playerID string) (*FullPlayerReport, error) {
 	// Используем существующий метод GetFullReport из ReportRepository
-	return dc.repo.GetFullReport(ctx, playerID)
+	report, err := dc.repo.GetFullReport(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Вратарская секция строится по протоколам матчей
+	if dc.goalies != nil {
+		goalie, _ := dc.goalies.GetGoalieReport(ctx, playerID)
+		report.Goalie = goalie
+	}
+
+	return report, nil
 }
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/charts"
+)
+
+// ChartImage график в PNG с подписью
+type ChartImage struct {
+	Caption string
+	PNG     []byte
+}
+
+// GenerateProfileCharts рисует графики для профиля в боте: прогресс в текущем
+// сезоне и распределение голов по типу. Графики без данных пропускаются
+func (s *ReportService) GenerateProfileCharts(ctx context.Context, playerID string) ([]ChartImage, error) {
+	report, err := s.dataCollector.CollectFullReport(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect report data: %w", err)
+	}
+	if !report.HasStats {
+		return nil, nil
+	}
+
+	svg := s.generateCharts(report)
+	var list []reportChart
+	if report.Progress != nil {
+		list = append(list, reportChart{"📈 Прогресс в сезоне " + report.Progress.Season, string(svg.SeasonLine)})
+	}
+	if report.HasDetailedStats && report.TotalStats.TotalGoals > 0 {
+		list = append(list, reportChart{"🥅 Голы по типу", string(svg.GoalsTypePie)})
+	}
+
+	images := make([]ChartImage, 0, len(list))
+	for _, c := range list {
+		data, err := charts.RenderPNG(c.svg, charts.PNGScale)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render chart: %w", err)
+		}
+		images = append(images, ChartImage{Caption: c.title, PNG: data})
+	}
+	return images, nil
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+func TestGenerateProfileCharts(t *testing.T) {
+	service := NewReportService(&mockReportRepo{report: sampleReport()}, nil)
+	images, err := service.GenerateProfileCharts(context.Background(), "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 2 {
+		t.Fatalf("charts = %d, want 2", len(images))
+	}
+	if !strings.Contains(images[0].Caption, "2024/2025") {
+		t.Errorf("caption = %q", images[0].Caption)
+	}
+	for _, img := range images {
+		if !bytes.HasPrefix(img.PNG, pngSignature) {
+			t.Errorf("%q is not a PNG", img.Caption)
+		}
+	}
+}
+
+func TestGenerateProfileChartsWithoutStats(t *testing.T) {
+	service := NewReportService(&mockReportRepo{report: &FullPlayerReport{Player: ReportPlayerInfo{Name: "Петров Пётр"}}}, nil)
+	images, err := service.GenerateProfileCharts(context.Background(), "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 0 {
+		t.Errorf("charts = %d, want 0", len(images))
+	}
+}
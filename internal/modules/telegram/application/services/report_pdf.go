@@ -0,0 +1,244 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/fonts"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/pdf"
+)
+
+// Цвета PDF отчёта, как в HTML шаблоне
+var (
+	pdfPrimaryDark = pdf.MustHexColor("#0a1628")
+	pdfAccent      = pdf.MustHexColor("#4a90d9")
+	pdfAccentLight = pdf.MustHexColor("#7bb8e8")
+	pdfIce         = pdf.MustHexColor("#e8f4fc")
+	pdfGray        = pdf.MustHexColor("#6b7280")
+	pdfWhite       = pdf.MustHexColor("#ffffff")
+)
+
+const pdfMargin = 36
+
+// GeneratePDFReport генерирует PDF отчёт для игрока: те же данные и графики, что в HTML
+func (s *ReportService) GeneratePDFReport(ctx context.Context, playerID string) ([]byte, string, error) {
+	report, err := s.dataCollector.CollectFullReport(ctx, playerID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to collect report data: %w", err)
+	}
+
+	data, err := renderReportPDF(report, s.generateCharts(report))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to render pdf: %w", err)
+	}
+
+	filename := fmt.Sprintf("%s_report.pdf", transliterate(report.Player.Name))
+	return data, filename, nil
+}
+
+// reportPDF вёрстка PDF отчёта
+type reportPDF struct {
+	layout  *pdf.Layout
+	regular *pdf.Font
+	bold    *pdf.Font
+}
+
+func renderReportPDF(report *FullPlayerReport, svg SVGCharts) ([]byte, error) {
+	doc := pdf.New()
+	doc.Title = report.Player.Name + " - HockeyStats"
+	r := &reportPDF{
+		layout:  pdf.NewLayout(doc, pdfMargin),
+		regular: doc.AddFont(fonts.Regular(), "DejaVuSans"),
+		bold:    doc.AddFont(fonts.Bold(), "DejaVuSans-Bold"),
+	}
+
+	r.header()
+	r.playerCard(report.Player)
+	if report.Goalie != nil {
+		r.goalie(report.Goalie)
+	}
+
+	if report.HasStats {
+		t := report.TotalStats
+		r.statCards([]statCard{
+			{strconv.Itoa(t.TotalGames), "Игр", fmt.Sprintf("%d турниров", t.TotalTournaments)},
+			{strconv.Itoa(t.TotalGoals), "Голов", fmt.Sprintf("%.2f за игру", t.GoalsPerGame)},
+			{strconv.Itoa(t.TotalAssists), "Передач", fmt.Sprintf("%.2f за игру", t.AssistsPerGame)},
+			{strconv.Itoa(t.TotalPoints), "Очков", fmt.Sprintf("%.2f за игру", t.PointsPerGame)},
+		})
+		if err := r.charts(reportChartList(report, svg)); err != nil {
+			return nil, err
+		}
+
+		r.sectionTitle("Детальная статистика")
+		r.keyValues([][2]string{
+			{"+/-", formatPlusMinus(t.TotalPlusMinus)},
+			{"Штраф. минут", strconv.Itoa(t.TotalPenalties)},
+			{"Хет-трики", strconv.Itoa(t.TotalHatTricks)},
+			{"Победные голы", strconv.Itoa(t.TotalWinningGoals)},
+		})
+
+		if len(report.Tournaments) > 0 {
+			r.sectionTitle("История выступлений")
+			r.tournaments(report.Tournaments)
+		}
+	} else {
+		r.layout.Space(24)
+		r.layout.Text("Нет статистики для отображения", pdf.TextStyle{
+			Font: r.regular, Size: 12, Color: pdfGray, Align: pdf.AlignCenter,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Write(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// reportChart график с заголовком
+type reportChart struct {
+	title string
+	svg   string
+}
+
+// reportChartList графики в порядке и при тех же условиях, что в HTML шаблоне
+func reportChartList(report *FullPlayerReport, svg SVGCharts) []reportChart {
+	var list []reportChart
+	if report.HasDetailedStats {
+		list = append(list, reportChart{"Распределение голов по типу", string(svg.GoalsTypePie)})
+	}
+	list = append(list, reportChart{"Голы по периодам", string(svg.PeriodBar)})
+	if report.HasMultipleSeasons && svg.ProgressLine != "" {
+		list = append(list, reportChart{"Прогресс по сезонам", string(svg.ProgressLine)})
+	}
+	if report.Progress != nil {
+		list = append(list, reportChart{"Прогресс в сезоне " + report.Progress.Season, string(svg.SeasonLine)})
+	}
+	return append(list, reportChart{"Профиль игрока", string(svg.ProfileRadar)})
+}
+
+func (r *reportPDF) goalie(g *GoalieReport) {
+	r.sectionTitle("Статистика вратаря")
+	r.statCards([]statCard{
+		{strconv.Itoa(g.Games), "Игр", fmt.Sprintf("%d бросков", g.ShotsAgainst)},
+		{fmt.Sprintf("%.2f", g.SavePct), "% отражённых", fmt.Sprintf("%d сейвов", g.Saves)},
+		{fmt.Sprintf("%.2f", g.GoalsAgainstAvg), "Коэф. надёжности", fmt.Sprintf("%d пропущено", g.GoalsAgainst)},
+		{fmt.Sprintf("%.2f", g.QualityStartPct), "% кач. стартов", fmt.Sprintf("%d стартов", g.QualityStarts)},
+	})
+
+	var rows [][2]string
+	for _, p := range g.Periods {
+		value := fmt.Sprintf("%d ГП", p.Goals)
+		if p.HasSavePct {
+			value += fmt.Sprintf(" · %.2f%%", p.SavePct)
+		}
+		rows = append(rows, [2]string{p.Label, value})
+	}
+	rows = append(rows,
+		[2]string{"В равных", strconv.Itoa(g.GoalsEven)},
+		[2]string{"В меньшинстве", strconv.Itoa(g.GoalsOnPenaltyKill)},
+		[2]string{"В большинстве", strconv.Itoa(g.GoalsOnPowerPlay)},
+	)
+	r.keyValues(rows)
+
+	if len(g.RecentGames) == 0 {
+		return
+	}
+	r.layout.Space(10)
+	table := r.table([]pdf.TableColumn{
+		{Title: "Дата", Width: 2},
+		{Title: "Броски", Width: 1, Align: pdf.AlignRight},
+		{Title: "Сейвы", Width: 1, Align: pdf.AlignRight},
+		{Title: "ГП", Width: 1, Align: pdf.AlignRight},
+		{Title: "%", Width: 1, Align: pdf.AlignRight},
+	})
+	table.Highlight = make(map[int]bool)
+	for i, game := range g.RecentGames {
+		table.Rows = append(table.Rows, []string{
+			game.Date, strconv.Itoa(game.ShotsAgainst), strconv.Itoa(game.Saves),
+			strconv.Itoa(game.GoalsAgainst), fmt.Sprintf("%.2f", game.SavePct),
+		})
+		table.Highlight[i] = game.QualityStart
+	}
+	r.layout.Table(table)
+}
+
+// tournaments история выступлений: таблица турниров каждого сезона
+func (r *reportPDF) tournaments(list []TournamentStats) {
+	for start := 0; start < len(list); {
+		season := list[start].Season
+		end := start
+		for end < len(list) && list[end].Season == season {
+			end++
+		}
+
+		table := r.table([]pdf.TableColumn{
+			{Title: "Турнир", Width: 5},
+			{Title: "Команда", Width: 3.5},
+			{Title: "И", Width: 1, Align: pdf.AlignRight},
+			{Title: "Г+П=О", Width: 2, Align: pdf.AlignRight},
+			{Title: "+/-", Width: 1, Align: pdf.AlignRight},
+			{Title: "Штр", Width: 1, Align: pdf.AlignRight},
+		})
+		for _, t := range list[start:end] {
+			name := t.TournamentName
+			if t.GroupName != "" {
+				name += " (" + t.GroupName + ")"
+			}
+			table.Rows = append(table.Rows, []string{
+				name, t.TeamName, strconv.Itoa(t.Games),
+				fmt.Sprintf("%d+%d=%d", t.Goals, t.Assists, t.Points),
+				formatPlusMinus(t.PlusMinus), strconv.Itoa(t.PenaltyMinutes),
+			})
+		}
+
+		r.seasonHeader("Сезон " + season)
+		r.layout.Table(table)
+		start = end
+	}
+}
+
+func (r *reportPDF) table(columns []pdf.TableColumn) pdf.Table {
+	return pdf.Table{
+		Columns:        columns,
+		Font:           r.regular,
+		Bold:           r.bold,
+		Size:           9,
+		TextColor:      pdfPrimaryDark,
+		HeaderColor:    pdfGray,
+		HighlightColor: pdfAccent,
+		StripeColor:    pdfIce,
+		BorderColor:    pdfIce,
+	}
+}
+
+func formatPlusMinus(v int) string {
+	if v > 0 {
+		return "+" + strconv.Itoa(v)
+	}
+	return strconv.Itoa(v)
+}
+
+// playerDetails строка сведений об игроке
+func playerDetails(p ReportPlayerInfo) string {
+	details := []string{fmt.Sprintf("%d г.р.", p.BirthYear)}
+	if p.Position != "" {
+		details = append(details, p.Position)
+	}
+	if p.Height != nil {
+		details = append(details, fmt.Sprintf("%d см", *p.Height))
+	}
+	if p.Weight != nil {
+		details = append(details, fmt.Sprintf("%d кг", *p.Weight))
+	}
+	for _, v := range []string{p.Team, p.Region} {
+		if v != "" {
+			details = append(details, v)
+		}
+	}
+	return strings.Join(details, " · ")
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/shared/charts"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/pdf"
+)
+
+// Размеры блоков PDF отчёта
+const (
+	pdfGap         = 10
+	pdfCardHeight  = 58
+	pdfRowHeight   = 20
+	pdfChartTitle  = 18
+	pdfChartsInRow = 2
+)
+
+// statCard карточка показателя: значение, подпись и среднее
+type statCard struct {
+	value, label, avg string
+}
+
+// header шапка отчёта
+func (r *reportPDF) header() {
+	r.layout.Block(56, func(p *pdf.Page, width float64) {
+		p.SetFillColor(pdfPrimaryDark)
+		p.RoundRect(0, 0, width, 48, 10)
+		p.Fill()
+		p.SetFillColor(pdfWhite)
+		p.Text(r.bold, 18, 16, 22, "HockeyStats")
+		p.SetFillColor(pdfAccentLight)
+		p.Text(r.regular, 10, 16, 38, "Полный отчет игрока")
+	})
+}
+
+// playerCard имя и сведения об игроке
+func (r *reportPDF) playerCard(player ReportPlayerInfo) {
+	r.layout.Text(player.Name, pdf.TextStyle{Font: r.bold, Size: 20, Color: pdfPrimaryDark})
+	r.layout.Space(2)
+	r.layout.Text(playerDetails(player), pdf.TextStyle{Font: r.regular, Size: 10, Color: pdfGray})
+	r.layout.Space(pdfGap)
+}
+
+// sectionTitle заголовок раздела с чертой
+func (r *reportPDF) sectionTitle(title string) {
+	r.layout.Space(pdfGap)
+	r.layout.Ensure(24 + pdfRowHeight)
+	r.layout.Block(24, func(p *pdf.Page, width float64) {
+		p.SetFillColor(pdfPrimaryDark)
+		p.Text(r.bold, 13, 0, 14, title)
+		p.SetStrokeColor(pdfIce)
+		p.SetLineWidth(2)
+		p.MoveTo(0, 20)
+		p.LineTo(width, 20)
+		p.Stroke()
+	})
+}
+
+// seasonHeader плашка сезона в истории выступлений
+func (r *reportPDF) seasonHeader(title string) {
+	r.layout.Space(6)
+	r.layout.Ensure(22 + 2*pdfRowHeight)
+	r.layout.Block(22, func(p *pdf.Page, width float64) {
+		p.SetFillColor(pdfIce)
+		p.RoundRect(0, 0, width, 18, 5)
+		p.Fill()
+		p.SetFillColor(pdfAccent)
+		p.Text(r.bold, 10, 8, 13, title)
+	})
+}
+
+// statCards ряд карточек показателей
+func (r *reportPDF) statCards(cards []statCard) {
+	r.layout.Block(pdfCardHeight+pdfGap, func(p *pdf.Page, width float64) {
+		cardWidth := (width - pdfGap*float64(len(cards)-1)) / float64(len(cards))
+		for i, c := range cards {
+			x := float64(i) * (cardWidth + pdfGap)
+			p.SetFillColor(pdfIce)
+			p.RoundRect(x, 0, cardWidth, pdfCardHeight, 8)
+			p.Fill()
+			p.SetFillColor(pdfAccent)
+			p.TextAligned(r.bold, 18, x, 24, cardWidth, pdf.AlignCenter, c.value)
+			p.SetFillColor(pdfGray)
+			p.TextAligned(r.regular, 7.5, x, 38, cardWidth, pdf.AlignCenter, strings.ToUpper(c.label))
+			p.TextAligned(r.regular, 7.5, x, 50, cardWidth, pdf.AlignCenter, c.avg)
+		}
+	})
+}
+
+// keyValues показатели в три колонки: подпись слева, значение справа
+func (r *reportPDF) keyValues(rows [][2]string) {
+	const columns = 3
+	for start := 0; start < len(rows); start += columns {
+		line := rows[start:min(start+columns, len(rows))]
+		r.layout.Block(pdfRowHeight+4, func(p *pdf.Page, width float64) {
+			cellWidth := (width - pdfGap*(columns-1)) / columns
+			for i, kv := range line {
+				x := float64(i) * (cellWidth + pdfGap)
+				p.SetFillColor(pdfIce)
+				p.RoundRect(x, 0, cellWidth, pdfRowHeight, 5)
+				p.Fill()
+				p.SetFillColor(pdfGray)
+				p.Text(r.regular, 9, x+8, 13.5, kv[0])
+				p.SetFillColor(pdfPrimaryDark)
+				p.TextAligned(r.bold, 9, x, 13.5, cellWidth-8, pdf.AlignRight, kv[1])
+			}
+		})
+	}
+}
+
+// charts графики по два в ряд с заголовками
+func (r *reportPDF) charts(list []reportChart) error {
+	drawings := make([]*charts.Drawing, len(list))
+	for i, c := range list {
+		d, err := charts.ParseSVG(c.svg)
+		if err != nil {
+			return err
+		}
+		drawings[i] = d
+	}
+
+	cellWidth := (r.layout.Width() - pdfGap*(pdfChartsInRow-1)) / pdfChartsInRow
+	for start := 0; start < len(list); start += pdfChartsInRow {
+		end := min(start+pdfChartsInRow, len(list))
+		height := 0.0
+		for _, d := range drawings[start:end] {
+			height = max(height, d.Height*cellWidth/d.Width)
+		}
+
+		r.layout.Block(pdfChartTitle+height+pdfGap, func(p *pdf.Page, width float64) {
+			for i := start; i < end; i++ {
+				x := float64(i-start) * (cellWidth + pdfGap)
+				p.SetFillColor(pdfPrimaryDark)
+				p.TextAligned(r.bold, 10, x, 11, cellWidth, pdf.AlignCenter, list[i].title)
+				drawings[i].DrawPDF(p, r.regular, r.bold, x, pdfChartTitle, cellWidth/drawings[i].Width)
+			}
+		})
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+)
+
+type mockReportRepo struct {
+	report *FullPlayerReport
+}
+
+func (m *mockReportRepo) GetFullReport(_ context.Context, _ string) (*FullPlayerReport, error) {
+	return m.report, nil
+}
+
+func sampleReport() *FullPlayerReport {
+	height := 172
+	report := &FullPlayerReport{
+		Player:        ReportPlayerInfo{ID: "1", Name: "Иванов Иван", BirthYear: 2012, Position: "Нападающий", Height: &height, Team: "СКА-Стрельна"},
+		TotalStats:    ReportTotalStats{TotalTournaments: 3, TotalGames: 40, TotalGoals: 21, TotalAssists: 15, TotalPoints: 36, GoalsPerGame: 0.52},
+		GoalsByType:   GoalsBreakdown{EvenStrength: 15, PowerPlay: 5, ShortHanded: 1},
+		GoalsByPeriod: PeriodGoals{Period1: 7, Period2: 8, Period3: 6},
+		SeasonStats:   []SeasonSummary{{Season: "2023/2024", Goals: 8, Points: 14}, {Season: "2024/2025", Goals: 13, Points: 22}},
+		Progress: &SeasonProgress{Season: "2024/2025", Points: []ProgressPoint{
+			{Date: time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), Goals: 2, Points: 3},
+			{Date: time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC), Goals: 6, Points: 10},
+		}},
+		HasStats:           true,
+		HasDetailedStats:   true,
+		HasMultipleSeasons: true,
+	}
+	for i := range 30 {
+		season := "2023/2024"
+		if i%2 == 0 {
+			season = "2024/2025"
+		}
+		report.Tournaments = append(report.Tournaments, TournamentStats{
+			Season: season, TournamentName: "Первенство Санкт-Петербурга среди юношей", TeamName: "СКА-Стрельна", Games: 10, Goals: i,
+		})
+	}
+	return report
+}
+
+func TestGeneratePDFReport(t *testing.T) {
+	service := NewReportService(&mockReportRepo{report: sampleReport()}, nil)
+
+	data, filename, err := service.GeneratePDFReport(context.Background(), "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filename != "Ivanov_Ivan_report.pdf" {
+		t.Errorf("filename = %q", filename)
+	}
+	if !bytes.HasPrefix(data, []byte("%PDF-")) || !bytes.Contains(data, []byte("/FontFile2")) {
+		t.Error("not a PDF with embedded fonts")
+	}
+}
+
+func TestGeneratePDFReportWithoutStats(t *testing.T) {
+	service := NewReportService(&mockReportRepo{report: &FullPlayerReport{Player: ReportPlayerInfo{Name: "Петров Пётр"}}}, nil)
+	if _, _, err := service.GeneratePDFReport(context.Background(), "2"); err != nil {
+		t.Fatal(err)
+	}
+}
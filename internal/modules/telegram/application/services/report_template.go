@@ -100,6 +100,17 @@ const reportHTMLTemplate = `<!DOCTYPE html>
         .tournament-stats { display: flex; gap: 8px; }
         .tournament-stat { background: var(--ice); padding: 4px 10px; border-radius: 6px; font-size: 12px; font-weight: 600; }
         .tournament-stat.goals { background: var(--accent); color: var(--white); }
+        .goalie-section {
+            background: var(--white);
+            border-radius: 12px;
+            padding: 24px;
+            margin-bottom: 20px;
+            box-shadow: 0 2px 4px rgba(0, 0, 0, 0.05);
+        }
+        .goalie-games { width: 100%; border-collapse: collapse; margin-top: 16px; font-size: 13px; }
+        .goalie-games th { text-align: left; color: var(--gray); font-weight: 500; padding: 6px 8px; }
+        .goalie-games td { padding: 6px 8px; border-top: 1px solid var(--ice); }
+        .goalie-games .qs { color: var(--accent); font-weight: 600; }
         .empty-state { text-align: center; padding: 40px; color: var(--gray); }
         @media (max-width: 768px) {
             .stats-grid { grid-template-columns: repeat(2, 1fr); }
@@ -127,6 +138,52 @@ const reportHTMLTemplate = `<!DOCTYPE html>
             </div>
         </div>
 
+        {{with .Report.Goalie}}
+        <div class="goalie-section">
+            <div class="section-title">Статистика вратаря</div>
+            <div class="stats-grid">
+                <div class="stat-card">
+                    <div class="stat-value">{{.Games}}</div>
+                    <div class="stat-label">Игр</div>
+                    <div class="stat-avg">{{.ShotsAgainst}} бросков</div>
+                </div>
+                <div class="stat-card">
+                    <div class="stat-value">{{formatFloat .SavePct}}</div>
+                    <div class="stat-label">% отражённых</div>
+                    <div class="stat-avg">{{.Saves}} сейвов</div>
+                </div>
+                <div class="stat-card">
+                    <div class="stat-value">{{formatFloat .GoalsAgainstAvg}}</div>
+                    <div class="stat-label">Коэф. надёжности</div>
+                    <div class="stat-avg">{{.GoalsAgainst}} пропущено</div>
+                </div>
+                <div class="stat-card">
+                    <div class="stat-value">{{formatFloat .QualityStartPct}}</div>
+                    <div class="stat-label">% кач. стартов</div>
+                    <div class="stat-avg">{{.QualityStarts}} стартов</div>
+                </div>
+            </div>
+            <div class="stats-table">
+                {{range .Periods}}
+                <div class="stat-row"><span class="label">{{.Label}}</span><span class="value">{{.Goals}} ГП{{if .HasSavePct}} · {{formatFloat .SavePct}}%{{end}}</span></div>
+                {{end}}
+                <div class="stat-row"><span class="label">Пропущено в равных</span><span class="value">{{.GoalsEven}}</span></div>
+                <div class="stat-row"><span class="label">Пропущено в меньшинстве</span><span class="value">{{.GoalsOnPenaltyKill}}</span></div>
+                <div class="stat-row"><span class="label">Пропущено в большинстве</span><span class="value">{{.GoalsOnPowerPlay}}</span></div>
+            </div>
+            {{if .RecentGames}}
+            <table class="goalie-games">
+                <tr><th>Дата</th><th>Броски</th><th>Сейвы</th><th>ГП</th><th>%</th></tr>
+                {{range .RecentGames}}
+                <tr{{if .QualityStart}} class="qs"{{end}}>
+                    <td>{{.Date}}</td><td>{{.ShotsAgainst}}</td><td>{{.Saves}}</td><td>{{.GoalsAgainst}}</td><td>{{formatFloat .SavePct}}</td>
+                </tr>
+                {{end}}
+            </table>
+            {{end}}
+        </div>
+        {{end}}
+
         {{if .Report.HasStats}}
         <div class="stats-grid">
             <div class="stat-card">
@@ -168,6 +225,12 @@ const reportHTMLTemplate = `<!DOCTYPE html>
                 <div class="chart-container">{{.Charts.ProgressLine}}</div>
             </div>
             {{end}}
+            {{with .Report.Progress}}
+            <div class="chart-card">
+                <div class="chart-title">Прогресс в сезоне {{.Season}}</div>
+                <div class="chart-container">{{$.Charts.SeasonLine}}</div>
+            </div>
+            {{end}}
             <div class="chart-card">
                 <div class="chart-title">Профиль игрока</div>
                 <div class="chart-container">{{.Charts.ProfileRadar}}</div>
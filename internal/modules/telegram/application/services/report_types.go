@@ -1,5 +1,7 @@
 package services
 
+import "time"
+
 // FullPlayerReport полные данные для HTML отчета игрока
 type FullPlayerReport struct {
 	Player        ReportPlayerInfo
@@ -7,7 +9,9 @@ type FullPlayerReport struct {
 	GoalsByType   GoalsBreakdown
 	GoalsByPeriod PeriodGoals
 	SeasonStats   []SeasonSummary
+	Progress      *SeasonProgress // nil, если по текущему сезону меньше двух версий статистики
 	Tournaments   []TournamentStats
+	Goalie        *GoalieReport // nil, если у игрока нет вратарских протоколов
 
 	HasStats           bool
 	HasDetailedStats   bool
@@ -77,6 +81,21 @@ type SeasonSummary struct {
 	Points  int
 }
 
+// SeasonProgress накопленная статистика игрока по дням сезона (из версий статистики)
+type SeasonProgress struct {
+	Season string
+	Points []ProgressPoint
+}
+
+// ProgressPoint статистика игрока на конец дня
+type ProgressPoint struct {
+	Date    time.Time
+	Games   int
+	Goals   int
+	Assists int
+	Points  int
+}
+
 // TournamentStats статистика по турниру
 type TournamentStats struct {
 	Season         string
@@ -94,3 +113,40 @@ type TournamentStats struct {
 	HatTricks        int
 	GameWinningGoals int
 }
+
+// GoalieReport вратарская статистика по протоколам матчей
+type GoalieReport struct {
+	Games           int
+	ShotsAgainst    int
+	Saves           int
+	GoalsAgainst    int
+	SavePct         float64 // в процентах
+	GoalsAgainstAvg float64
+	QualityStarts   int
+	QualityStartPct float64 // в процентах
+
+	Periods            []GoaliePeriod
+	GoalsEven          int
+	GoalsOnPenaltyKill int // пропущено в меньшинстве (соперник в большинстве)
+	GoalsOnPowerPlay   int // пропущено в большинстве
+
+	RecentGames []GoalieGameLine
+}
+
+// GoaliePeriod пропущенные и процент отражённых за период
+type GoaliePeriod struct {
+	Label      string
+	Goals      int
+	SavePct    float64
+	HasSavePct bool
+}
+
+// GoalieGameLine игровая строка вратаря
+type GoalieGameLine struct {
+	Date         string
+	ShotsAgainst int
+	Saves        int
+	GoalsAgainst int
+	SavePct      float64 // в процентах
+	QualityStart bool
+}
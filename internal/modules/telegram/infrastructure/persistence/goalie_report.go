@@ -0,0 +1,73 @@
+package persistence
+
+import (
+	"context"
+
+	analyticsApp "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/application"
+	analyticsDomain "github.com/Daniil-Sakharov/HockeyProject/internal/modules/analytics/domain"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/telegram/application/services"
+)
+
+// goalieReportRecentGames количество последних матчей в отчёте
+const goalieReportRecentGames = 10
+
+var goaliePeriodLabels = [analyticsDomain.PeriodCount]string{"1 период", "2 период", "3 период", "OT"}
+
+// GoalieReportProvider строит вратарскую секцию отчёта из аналитики
+type GoalieReportProvider struct {
+	service *analyticsApp.GoalieService
+}
+
+// NewGoalieReportProvider создает провайдер вратарской секции отчёта
+func NewGoalieReportProvider(service *analyticsApp.GoalieService) *GoalieReportProvider {
+	return &GoalieReportProvider{service: service}
+}
+
+// GetGoalieReport возвращает вратарскую статистику игрока, nil если протоколов нет
+func (p *GoalieReportProvider) GetGoalieReport(ctx context.Context, playerID string) (*services.GoalieReport, error) {
+	profile, err := p.service.GoalieProfile(ctx, playerID, "")
+	if err != nil || profile == nil {
+		return nil, err
+	}
+
+	s := profile.Summary
+	report := &services.GoalieReport{
+		Games:              s.Games,
+		ShotsAgainst:       s.Saves + s.GoalsAgainst,
+		Saves:              s.Saves,
+		GoalsAgainst:       s.GoalsAgainst,
+		SavePct:            s.SavePct * 100,
+		GoalsAgainstAvg:    s.GoalsAgainstAvg,
+		QualityStarts:      s.QualityStarts,
+		QualityStartPct:    s.QualityStartPct * 100,
+		GoalsEven:          s.GoalsByState[analyticsDomain.GameStateEven],
+		GoalsOnPenaltyKill: s.GoalsByState[analyticsDomain.GameStatePowerPlay],
+		GoalsOnPowerPlay:   s.GoalsByState[analyticsDomain.GameStateShortHand],
+	}
+
+	for i, label := range goaliePeriodLabels {
+		period := services.GoaliePeriod{Label: label, Goals: s.PeriodGoals[i]}
+		if pct := s.PeriodSavePct[i]; pct != nil {
+			period.SavePct, period.HasSavePct = *pct*100, true
+		}
+		report.Periods = append(report.Periods, period)
+	}
+
+	// Последние матчи в обратном порядке
+	for i := len(profile.Games) - 1; i >= 0 && len(report.RecentGames) < goalieReportRecentGames; i-- {
+		g := profile.Games[i]
+		line := services.GoalieGameLine{
+			ShotsAgainst: g.Shots(),
+			Saves:        g.Saves,
+			GoalsAgainst: g.GoalsAgainst,
+			SavePct:      g.SavePct() * 100,
+			QualityStart: g.QualityStart,
+		}
+		if g.PlayedAt != nil {
+			line.Date = g.PlayedAt.Format("02.01.2006")
+		}
+		report.RecentGames = append(report.RecentGames, line)
+	}
+
+	return report, nil
+}
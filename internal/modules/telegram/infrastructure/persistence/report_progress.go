@@ -0,0 +1,68 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/telegram/application/services"
+)
+
+// getSeasonProgress строит накопленную статистику игрока по дням последнего сезона.
+// Для каждого дня, когда менялась статистика, суммируются версии, действовавшие на конец дня.
+// Сводная группа турнира учитывается, только если других групп в турнире нет
+func (r *ReportRepository) getSeasonProgress(ctx context.Context, playerID string) (*services.SeasonProgress, error) {
+	query := `
+		WITH season AS (
+			SELECT t.season FROM player_statistics_versions v
+			JOIN tournaments t ON t.id = v.tournament_id
+			WHERE v.player_id = $1
+			ORDER BY t.season DESC
+			LIMIT 1
+		),
+		versions AS (
+			SELECT v.* FROM player_statistics_versions v
+			JOIN tournaments t ON t.id = v.tournament_id
+			JOIN season s ON s.season = t.season
+			WHERE v.player_id = $1
+		),
+		tournament_groups AS (
+			SELECT tournament_id, COUNT(DISTINCT COALESCE(group_name, '')) AS groups
+			FROM versions GROUP BY tournament_id
+		),
+		days AS (
+			SELECT DISTINCT date_trunc('day', valid_from) + INTERVAL '1 day' AS day_end FROM versions
+		)
+		SELECT (SELECT season FROM season) AS season,
+		       d.day_end - INTERVAL '1 day' AS day,
+		       COALESCE(SUM(v.games), 0) AS games, COALESCE(SUM(v.goals), 0) AS goals,
+		       COALESCE(SUM(v.assists), 0) AS assists, COALESCE(SUM(v.points), 0) AS points
+		FROM days d
+		JOIN versions v ON v.valid_from < d.day_end AND (v.valid_to IS NULL OR v.valid_to >= d.day_end)
+		JOIN tournament_groups tg ON tg.tournament_id = v.tournament_id
+		WHERE v.group_name IS DISTINCT FROM 'Общая статистика' OR tg.groups = 1
+		GROUP BY d.day_end
+		ORDER BY d.day_end
+	`
+
+	rows, err := r.db.QueryxContext(ctx, query, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	progress := &services.SeasonProgress{}
+	for rows.Next() {
+		var p services.ProgressPoint
+		if err := rows.Scan(&progress.Season, &p.Date, &p.Games, &p.Goals, &p.Assists, &p.Points); err != nil {
+			return nil, err
+		}
+		progress.Points = append(progress.Points, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(progress.Points) < 2 {
+		return nil, nil
+	}
+	return progress, nil
+}
@@ -58,6 +58,9 @@ func (r *ReportRepository) GetFullReport(ctx context.Context, playerID string) (
 	report.SeasonStats = seasons
 	report.HasMultipleSeasons = len(seasons) > 1
 
+	// Прогресс в последнем сезоне по версиям статистики
+	report.Progress, _ = r.getSeasonProgress(ctx, playerID)
+
 	// Получаем турниры
 	tournaments, _ := r.getTournaments(ctx, playerID)
 	report.Tournaments = tournaments
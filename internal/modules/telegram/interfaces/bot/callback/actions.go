@@ -67,3 +67,9 @@ const (
 const (
 	PlayerProfile = "profile"
 )
+
+// Report formats (parts[2] для download_report:*:*)
+const (
+	ReportPDF  = "pdf"
+	ReportHTML = "html"
+)
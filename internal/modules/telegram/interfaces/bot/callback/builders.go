@@ -29,3 +29,8 @@ func Player(action, playerID string) string {
 func Report(playerID string) string {
 	return ActionReport + ":" + playerID
 }
+
+// ReportFormat создает callback data для скачивания отчета в выбранном формате
+func ReportFormat(playerID, format string) string {
+	return ActionReport + ":" + playerID + ":" + format
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/telegram/application/services"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/telegram/interfaces/bot/presenter"
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/telegram/interfaces/bot/presenter/keyboard"
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/logger"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
 )
 
 // Handler обрабатывает профиль
@@ -15,6 +17,7 @@ type Handler struct {
 	presenter      *presenter.Presenter
 	keyboard       *keyboard.KeyboardPresenter
 	profileService *services.ProfileService
+	reportService  *services.ReportService
 }
 
 // NewHandler создает новый Handler
@@ -22,11 +25,13 @@ func NewHandler(
 	presenter *presenter.Presenter,
 	keyboard *keyboard.KeyboardPresenter,
 	profileService *services.ProfileService,
+	reportService *services.ReportService,
 ) *Handler {
 	return &Handler{
 		presenter:      presenter,
 		keyboard:       keyboard,
 		profileService: profileService,
+		reportService:  reportService,
 	}
 }
 
@@ -49,9 +54,28 @@ func (h *Handler) HandleProfile(ctx context.Context, bot *tgbotapi.BotAPI, query
 		return err
 	}
 
+	// Графики отправляются до текста, чтобы клавиатура профиля осталась последней
+	h.sendCharts(ctx, bot, query.Message.Chat.ID, playerID)
+
 	msg := tgbotapi.NewMessage(query.Message.Chat.ID, text)
 	msg.ReplyMarkup = h.keyboard.ProfileKeyboard(playerID)
 
 	_, err = bot.Send(msg)
 	return err
 }
+
+// sendCharts отправляет графики профиля фотографиями. Ошибки графиков не мешают
+// показать профиль и только логируются
+func (h *Handler) sendCharts(ctx context.Context, bot *tgbotapi.BotAPI, chatID int64, playerID string) {
+	images, err := h.reportService.GenerateProfileCharts(ctx, playerID)
+	if err != nil {
+		logger.Error(ctx, "Failed to generate profile charts", zap.Error(err))
+		return
+	}
+	for _, photo := range h.presenter.ProfileChartPhotos(chatID, images) {
+		if _, err := bot.Send(photo); err != nil {
+			logger.Error(ctx, "Failed to send profile chart", zap.Error(err))
+			return
+		}
+	}
+}
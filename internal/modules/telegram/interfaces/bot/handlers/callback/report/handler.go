@@ -5,40 +5,65 @@ import (
 	"strings"
 
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/telegram/application/services"
+	cb "github.com/Daniil-Sakharov/HockeyProject/internal/modules/telegram/interfaces/bot/callback"
+	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/telegram/interfaces/bot/presenter/keyboard"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 )
 
 // Handler обрабатывает отчёты
 type Handler struct {
+	keyboard      *keyboard.KeyboardPresenter
 	reportService *services.ReportService
 }
 
 // NewHandler создает новый Handler
-func NewHandler(reportService *services.ReportService) *Handler {
-	return &Handler{reportService: reportService}
+func NewHandler(keyboard *keyboard.KeyboardPresenter, reportService *services.ReportService) *Handler {
+	return &Handler{keyboard: keyboard, reportService: reportService}
 }
 
-// HandleDownloadReport генерирует и отправляет отчёт
+// HandleDownloadReport предлагает выбрать формат, затем генерирует и отправляет отчёт
 func (h *Handler) HandleDownloadReport(ctx context.Context, bot *tgbotapi.BotAPI, query *tgbotapi.CallbackQuery) error {
 	parts := strings.Split(query.Data, ":")
-	if len(parts) != 2 {
+	switch len(parts) {
+	case 2:
+		msg := tgbotapi.NewMessage(query.Message.Chat.ID, "📊 В каком формате отправить отчёт?")
+		msg.ReplyMarkup = h.keyboard.ReportFormatKeyboard(parts[1])
+		_, err := bot.Send(msg)
+		return err
+	case 3:
+	default:
 		return nil
 	}
 
-	playerID := parts[1]
+	playerID, format := parts[1], parts[2]
 
-	htmlData, filename, err := h.reportService.GenerateReport(ctx, playerID)
+	var (
+		data     []byte
+		filename string
+		caption  string
+		err      error
+	)
+	switch format {
+	case cb.ReportPDF:
+		data, filename, err = h.reportService.GeneratePDFReport(ctx, playerID)
+		caption = "📊 Полный отчет игрока"
+	case cb.ReportHTML:
+		data, filename, err = h.reportService.GenerateReport(ctx, playerID)
+		caption = "📊 Полный отчет игрока\n\nОткройте файл в браузере для просмотра графиков."
+	default:
+		return nil
+	}
 	if err != nil {
 		return err
 	}
 
 	fileBytes := tgbotapi.FileBytes{
 		Name:  filename,
-		Bytes: htmlData,
+		Bytes: data,
 	}
 
 	doc := tgbotapi.NewDocument(query.Message.Chat.ID, fileBytes)
-	doc.Caption = "📊 Полный отчет игрока\n\nОткройте файл в браузере для просмотра графиков."
+	doc.Caption = caption
 
 	_, err = bot.Send(doc)
 	return err
@@ -51,3 +51,13 @@ func (p *KeyboardPresenter) ProfileKeyboard(playerID string) tgbotapi.InlineKeyb
 		),
 	)
 }
+
+// ReportFormatKeyboard создает клавиатуру выбора формата отчёта
+func (p *KeyboardPresenter) ReportFormatKeyboard(playerID string) tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("📄 PDF", cb.ReportFormat(playerID, cb.ReportPDF)),
+			tgbotapi.NewInlineKeyboardButtonData("🌐 HTML", cb.ReportFormat(playerID, cb.ReportHTML)),
+		),
+	)
+}
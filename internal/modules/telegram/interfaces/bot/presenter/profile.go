@@ -2,9 +2,20 @@ package presenter
 
 import (
 	"github.com/Daniil-Sakharov/HockeyProject/internal/modules/telegram/application/services"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 )
 
 // RenderProfile рендерит профиль игрока
 func (p *Presenter) RenderProfile(profile *services.PlayerProfile) (string, error) {
 	return p.renderer.Render("player_profile.tmpl", profile)
 }
+
+// ProfileChartPhotos готовит графики профиля к отправке фотографиями
+func (p *Presenter) ProfileChartPhotos(chatID int64, images []services.ChartImage) []tgbotapi.PhotoConfig {
+	photos := make([]tgbotapi.PhotoConfig, len(images))
+	for i, img := range images {
+		photos[i] = tgbotapi.NewPhoto(chatID, tgbotapi.FileBytes{Name: "chart.png", Bytes: img.PNG})
+		photos[i].Caption = img.Caption
+	}
+	return photos
+}
@@ -0,0 +1,25 @@
+-- +goose Up
+-- Коэффициенты силы турниров для сравнения игроков из разных лиг.
+-- Рассчитываются джобой league_strength по игрокам и командам,
+-- выступавшим в нескольких турнирах одного сезона.
+
+CREATE TABLE IF NOT EXISTS tournament_strength (
+    tournament_id TEXT NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+    birth_year INTEGER NOT NULL,
+    season TEXT NOT NULL,
+    coefficient DOUBLE PRECISION NOT NULL DEFAULT 1.0,
+    shared_entities INTEGER NOT NULL DEFAULT 0,
+    games INTEGER NOT NULL DEFAULT 0,
+    computed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (tournament_id, birth_year)
+);
+
+CREATE INDEX IF NOT EXISTS idx_tournament_strength_season ON tournament_strength(season);
+
+COMMENT ON TABLE tournament_strength IS 'Коэффициенты силы турнира/возрастной группы (1.0 = средний уровень сезона)';
+COMMENT ON COLUMN tournament_strength.coefficient IS 'Множитель очков: >1 - сильнее среднего, <1 - слабее';
+COMMENT ON COLUMN tournament_strength.shared_entities IS 'Число игроков и команд, связывающих турнир с другими';
+
+-- +goose Down
+DROP TABLE IF EXISTS tournament_strength;
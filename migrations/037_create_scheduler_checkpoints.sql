@@ -0,0 +1,15 @@
+-- +goose Up
+-- Контрольные точки задач планировщика: прерванная задача (таймаут, SIGTERM)
+-- продолжает с сохранённой позиции. Запись удаляется после успешного выполнения.
+
+CREATE TABLE IF NOT EXISTS scheduler_checkpoints (
+    job_name VARCHAR(50) PRIMARY KEY,
+    run_id VARCHAR(36) NOT NULL,
+    cursor JSONB NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE scheduler_checkpoints IS 'Позиция прерванной задачи планировщика (последний обработанный турнир и т.п.)';
+
+-- +goose Down
+DROP TABLE IF EXISTS scheduler_checkpoints;
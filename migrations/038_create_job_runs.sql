@@ -0,0 +1,44 @@
+-- +goose Up
+-- История запусков задач планировщика и управление задачами из админки.
+
+CREATE TABLE IF NOT EXISTS job_runs (
+    id VARCHAR(36) PRIMARY KEY,
+    job_name VARCHAR(50) NOT NULL,
+    pipeline VARCHAR(50) NOT NULL,
+    trigger VARCHAR(20) NOT NULL,
+    instance_id VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    started_at TIMESTAMP NOT NULL,
+    ended_at TIMESTAMP,
+    items_processed INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    skip_reason TEXT,
+    stats JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_runs_job_started ON job_runs(job_name, started_at DESC);
+CREATE INDEX IF NOT EXISTS idx_job_runs_started ON job_runs(started_at DESC);
+CREATE INDEX IF NOT EXISTS idx_job_runs_running ON job_runs(job_name) WHERE status = 'running';
+
+COMMENT ON TABLE job_runs IS 'Запуски задач планировщика: статус, длительность, ошибки, счётчики обработанных сущностей';
+COMMENT ON COLUMN job_runs.trigger IS 'cron, manual, run_once или upstream (после зависимости)';
+COMMENT ON COLUMN job_runs.stats IS 'Счётчики запуска: {"tournaments": 12, "matches": 340, ...}';
+
+-- Пауза и ручной запуск задач; читается всеми инстансами планировщика
+CREATE TABLE IF NOT EXISTS scheduler_job_controls (
+    job_name VARCHAR(50) PRIMARY KEY,
+    paused BOOLEAN NOT NULL DEFAULT FALSE,
+    paused_at TIMESTAMP,
+    trigger_requested_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Отмена выполняющейся задачи: инстанс-владелец блокировки проверяет флаг
+ALTER TABLE scheduler_locks ADD COLUMN IF NOT EXISTS run_id VARCHAR(36);
+ALTER TABLE scheduler_locks ADD COLUMN IF NOT EXISTS cancel_requested_at TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE scheduler_locks DROP COLUMN IF EXISTS cancel_requested_at;
+ALTER TABLE scheduler_locks DROP COLUMN IF EXISTS run_id;
+DROP TABLE IF EXISTS scheduler_job_controls;
+DROP TABLE IF EXISTS job_runs;
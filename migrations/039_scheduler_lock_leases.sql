@@ -0,0 +1,20 @@
+-- +goose Up
+-- Аренда блокировок планировщика: короткий TTL, продлеваемый heartbeat, и fencing token.
+-- Токен растёт при каждом захвате; записи задачи (контрольные точки) принимаются
+-- только от текущего владельца блокировки.
+
+CREATE SEQUENCE IF NOT EXISTS scheduler_fencing_token_seq;
+
+ALTER TABLE scheduler_locks ADD COLUMN IF NOT EXISTS fencing_token BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE scheduler_locks ADD COLUMN IF NOT EXISTS heartbeat_at TIMESTAMP;
+
+ALTER TABLE scheduler_checkpoints ADD COLUMN IF NOT EXISTS fencing_token BIGINT NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN scheduler_locks.fencing_token IS 'Номер захвата блокировки; запись с устаревшим токеном отклоняется';
+COMMENT ON COLUMN scheduler_locks.heartbeat_at IS 'Последнее продление аренды владельцем';
+
+-- +goose Down
+ALTER TABLE scheduler_checkpoints DROP COLUMN IF EXISTS fencing_token;
+ALTER TABLE scheduler_locks DROP COLUMN IF EXISTS heartbeat_at;
+ALTER TABLE scheduler_locks DROP COLUMN IF EXISTS fencing_token;
+DROP SEQUENCE IF EXISTS scheduler_fencing_token_seq;
@@ -0,0 +1,39 @@
+-- +goose Up
+-- Единая очередь повторов: payload задачи для обработчика источника и dead-letter
+-- после исчерпания попыток. На одну сущность источника - одна ожидающая задача.
+
+ALTER TABLE failed_parsing_jobs ADD COLUMN IF NOT EXISTS payload JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE failed_parsing_jobs ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'pending';
+ALTER TABLE failed_parsing_jobs ADD COLUMN IF NOT EXISTS dead_at TIMESTAMP;
+
+-- Задачи с исчерпанными попытками становятся dead-letter
+UPDATE failed_parsing_jobs
+SET status = 'dead', dead_at = COALESCE(updated_at, NOW())
+WHERE retry_count >= max_retries;
+
+-- Старые задачи без payload обработать нельзя: отдаём их на решение администратору
+UPDATE failed_parsing_jobs
+SET status = 'dead', dead_at = NOW(), error_message = COALESCE(error_message, '') || ' [no payload]'
+WHERE status = 'pending' AND payload = '{}';
+
+-- Дубликаты ожидающих задач одной сущности
+DELETE FROM failed_parsing_jobs a
+USING failed_parsing_jobs b
+WHERE a.status = 'pending' AND b.status = 'pending'
+  AND a.source = b.source AND a.job_type = b.job_type AND a.external_id = b.external_id
+  AND a.id < b.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_failed_jobs_pending_entity
+    ON failed_parsing_jobs(source, job_type, external_id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_failed_jobs_due
+    ON failed_parsing_jobs(next_retry_at) WHERE status = 'pending';
+
+COMMENT ON COLUMN failed_parsing_jobs.payload IS 'Данные для повторной обработки сущности обработчиком источника';
+COMMENT ON COLUMN failed_parsing_jobs.status IS 'pending - ждёт повтора, dead - попытки исчерпаны';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_failed_jobs_due;
+DROP INDEX IF EXISTS idx_failed_jobs_pending_entity;
+ALTER TABLE failed_parsing_jobs DROP COLUMN IF EXISTS dead_at;
+ALTER TABLE failed_parsing_jobs DROP COLUMN IF EXISTS status;
+ALTER TABLE failed_parsing_jobs DROP COLUMN IF EXISTS payload;
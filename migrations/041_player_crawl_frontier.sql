@@ -0,0 +1,26 @@
+-- +goose Up
+-- Фронтир обхода профилей игроков: какие ID источника известны, откуда они
+-- найдены и чем закончился запрос профиля. Отсутствующие ID больше не запрашиваются.
+
+CREATE TABLE IF NOT EXISTS player_crawl_frontier (
+    source VARCHAR(50) NOT NULL,
+    external_id INTEGER NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    discovered_from VARCHAR(20) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    checked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (source, external_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_crawl_frontier_pending
+    ON player_crawl_frontier(source, external_id) WHERE status = 'pending';
+
+COMMENT ON TABLE player_crawl_frontier IS 'Известные ID профилей игроков источника и результат их обхода';
+COMMENT ON COLUMN player_crawl_frontier.status IS 'pending - ждёт запроса, fetched - профиль сохранён, skipped - вне диапазона годов, missing - профиля нет (404)';
+COMMENT ON COLUMN player_crawl_frontier.discovered_from IS 'roster, lineup, known или probe';
+
+-- +goose Down
+DROP TABLE IF EXISTS player_crawl_frontier;
@@ -0,0 +1,66 @@
+-- +goose Up
+-- Транзакционный outbox: событие пишется в той же транзакции, что и изменение данных,
+-- relay раскладывает его по подписчикам, подписчики других процессов забирают свои доставки.
+
+CREATE TABLE IF NOT EXISTS event_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL UNIQUE,
+    idempotency_key TEXT NOT NULL UNIQUE,
+    event_type VARCHAR(100) NOT NULL,
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    relayed_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_outbox_unrelayed ON event_outbox(id) WHERE relayed_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_event_outbox_relayed ON event_outbox(relayed_at) WHERE relayed_at IS NOT NULL;
+
+-- Подписки процессов: relay создаёт доставки только для подписанных типов событий
+CREATE TABLE IF NOT EXISTS event_subscriptions (
+    subscriber VARCHAR(100) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (subscriber, event_type)
+);
+
+CREATE TABLE IF NOT EXISTS event_deliveries (
+    subscriber VARCHAR(100) NOT NULL,
+    event_id UUID NOT NULL REFERENCES event_outbox(event_id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    locked_until TIMESTAMP,
+    last_error TEXT,
+    delivered_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (subscriber, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_deliveries_due
+    ON event_deliveries(subscriber, next_attempt_at) WHERE status = 'pending';
+
+-- Сообщения, которые подписчик так и не смог обработать
+CREATE TABLE IF NOT EXISTS event_poison_messages (
+    id BIGSERIAL PRIMARY KEY,
+    subscriber VARCHAR(100) NOT NULL,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL,
+    error_message TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (subscriber, event_id)
+);
+
+COMMENT ON COLUMN event_outbox.idempotency_key IS 'Ключ события у производителя: повторная запись того же факта игнорируется';
+COMMENT ON COLUMN event_deliveries.status IS 'pending - ждёт обработки, delivered - обработано, poison - перенесено в event_poison_messages';
+COMMENT ON COLUMN event_deliveries.locked_until IS 'Аренда доставки обработчиком; после истечения доставка выдаётся повторно';
+
+-- +goose Down
+DROP TABLE IF EXISTS event_poison_messages;
+DROP TABLE IF EXISTS event_deliveries;
+DROP TABLE IF EXISTS event_subscriptions;
+DROP TABLE IF EXISTS event_outbox;
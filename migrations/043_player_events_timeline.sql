@@ -0,0 +1,9 @@
+-- +goose Up
+-- История игрока: лента изменений по игроку и отчёт по сессии парсинга
+CREATE INDEX IF NOT EXISTS idx_player_events_player_created ON player_events(player_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_player_events_session_created ON player_events(parsing_session_id, created_at DESC)
+    WHERE parsing_session_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_player_events_session_created;
+DROP INDEX IF EXISTS idx_player_events_player_created;
@@ -0,0 +1,107 @@
+-- +goose Up
+-- Версии статистики игроков и турнирных таблиц: каждая версия действует с valid_from
+-- до valid_to (NULL - текущая). Новая версия пишется только при изменении значений,
+-- поэтому повторный сбор без изменений историю не раздувает.
+
+CREATE TABLE IF NOT EXISTS player_statistics_versions (
+    id BIGSERIAL PRIMARY KEY,
+    tournament_id TEXT NOT NULL,
+    player_id TEXT NOT NULL,
+    team_id TEXT NOT NULL,
+    group_name TEXT,
+    birth_year INT,
+
+    games INT,
+    goals INT,
+    assists INT,
+    points INT,
+    plus INT,
+    minus INT,
+    plus_minus INT,
+    penalty_minutes INT,
+    goals_even_strength INT,
+    goals_power_play INT,
+    goals_short_handed INT,
+    goals_period_1 INT,
+    goals_period_2 INT,
+    goals_period_3 INT,
+    goals_overtime INT,
+    hat_tricks INT,
+    game_winning_goals INT,
+
+    valid_from TIMESTAMPTZ NOT NULL,
+    valid_to TIMESTAMPTZ,
+    session_id UUID
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_player_statistics_versions_current
+    ON player_statistics_versions (tournament_id, player_id, team_id, COALESCE(group_name, ''), COALESCE(birth_year, 0))
+    WHERE valid_to IS NULL;
+CREATE INDEX IF NOT EXISTS idx_player_statistics_versions_player
+    ON player_statistics_versions (player_id, valid_from);
+CREATE INDEX IF NOT EXISTS idx_player_statistics_versions_tournament
+    ON player_statistics_versions (tournament_id, valid_from);
+
+CREATE TABLE IF NOT EXISTS team_standings_versions (
+    id BIGSERIAL PRIMARY KEY,
+    tournament_id TEXT NOT NULL,
+    team_id TEXT NOT NULL,
+    group_name VARCHAR(50),
+    birth_year INT,
+    source VARCHAR(50) NOT NULL,
+
+    position INT,
+    points INT,
+    games INT,
+    wins INT,
+    wins_ot INT,
+    wins_so INT,
+    losses_so INT,
+    losses_ot INT,
+    losses INT,
+    draws INT,
+    goals_for INT,
+    goals_against INT,
+    goal_difference INT,
+
+    valid_from TIMESTAMPTZ NOT NULL,
+    valid_to TIMESTAMPTZ,
+    session_id UUID
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_standings_versions_current
+    ON team_standings_versions (tournament_id, team_id, COALESCE(birth_year, 0), COALESCE(group_name, ''), source)
+    WHERE valid_to IS NULL;
+CREATE INDEX IF NOT EXISTS idx_team_standings_versions_tournament
+    ON team_standings_versions (tournament_id, valid_from);
+
+-- Текущее состояние становится первой версией
+INSERT INTO player_statistics_versions (
+    tournament_id, player_id, team_id, group_name, birth_year,
+    games, goals, assists, points, plus, minus, plus_minus, penalty_minutes,
+    goals_even_strength, goals_power_play, goals_short_handed,
+    goals_period_1, goals_period_2, goals_period_3, goals_overtime,
+    hat_tricks, game_winning_goals, valid_from
+)
+SELECT DISTINCT ON (tournament_id, player_id, team_id, COALESCE(group_name, ''), COALESCE(birth_year, 0))
+    tournament_id, player_id, team_id, group_name, birth_year,
+    games, goals, assists, points, plus, minus, plus_minus, penalty_minutes,
+    goals_even_strength, goals_power_play, goals_short_handed,
+    goals_period_1, goals_period_2, goals_period_3, goals_overtime,
+    hat_tricks, game_winning_goals, updated_at
+FROM player_statistics
+ORDER BY tournament_id, player_id, team_id, COALESCE(group_name, ''), COALESCE(birth_year, 0), updated_at DESC;
+
+INSERT INTO team_standings_versions (
+    tournament_id, team_id, group_name, birth_year, source,
+    position, points, games, wins, wins_ot, wins_so, losses_so, losses_ot, losses, draws,
+    goals_for, goals_against, goal_difference, valid_from
+)
+SELECT tournament_id, team_id, group_name, birth_year, source,
+    position, points, games, wins, wins_ot, wins_so, losses_so, losses_ot, losses, draws,
+    goals_for, goals_against, goal_difference, COALESCE(updated_at, created_at, NOW())
+FROM team_standings;
+
+-- +goose Down
+DROP TABLE IF EXISTS team_standings_versions;
+DROP TABLE IF EXISTS player_statistics_versions;
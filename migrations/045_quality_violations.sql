@@ -0,0 +1,26 @@
+-- +goose Up
+-- Нарушения правил качества данных: проверки выполняются после каждой задачи планировщика.
+-- Строка - нарушение правила одной сущностью; исправленное нарушение закрывается resolved_at
+-- и открывается заново, если появится снова.
+
+CREATE TABLE IF NOT EXISTS quality_violations (
+    id BIGSERIAL PRIMARY KEY,
+    rule VARCHAR(100) NOT NULL,
+    entity VARCHAR(50) NOT NULL,
+    entity_id TEXT NOT NULL,
+    severity VARCHAR(20) NOT NULL,
+    details TEXT NOT NULL DEFAULT '',
+    job_name VARCHAR(100),
+    run_id UUID,
+    first_seen_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP,
+    UNIQUE (rule, entity_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_quality_violations_open
+    ON quality_violations(severity, rule) WHERE resolved_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_quality_violations_entity ON quality_violations(entity);
+
+-- +goose Down
+DROP TABLE IF EXISTS quality_violations;
@@ -0,0 +1,49 @@
+-- +goose Up
+-- Клубы: одна организация за строками команд разных источников, турниров и годов рождения.
+-- Команды привязываются к клубу задачей club_matcher по нормализованному названию,
+-- сомнительные сопоставления ждут решения администратора в club_match_reviews.
+
+CREATE TABLE IF NOT EXISTS clubs (
+    id TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    name TEXT NOT NULL,
+    city TEXT,
+    region TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_clubs_name ON clubs(name);
+
+-- Названия команд клуба в источниках
+CREATE TABLE IF NOT EXISTS club_aliases (
+    club_id TEXT NOT NULL REFERENCES clubs(id) ON DELETE CASCADE,
+    alias TEXT NOT NULL,
+    source VARCHAR(50),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (club_id, alias)
+);
+
+CREATE INDEX IF NOT EXISTS idx_club_aliases_alias ON club_aliases(alias);
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS club_id TEXT REFERENCES clubs(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_teams_club ON teams(club_id);
+
+-- Сомнительные сопоставления: предложенный клуб и сходство названий
+CREATE TABLE IF NOT EXISTS club_match_reviews (
+    id BIGSERIAL PRIMARY KEY,
+    team_id TEXT NOT NULL UNIQUE REFERENCES teams(id) ON DELETE CASCADE,
+    club_id TEXT NOT NULL REFERENCES clubs(id) ON DELETE CASCADE,
+    score REAL NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_club_match_reviews_pending ON club_match_reviews(created_at) WHERE status = 'pending';
+
+-- +goose Down
+DROP TABLE IF EXISTS club_match_reviews;
+DROP INDEX IF EXISTS idx_teams_club;
+ALTER TABLE teams DROP COLUMN IF EXISTS club_id;
+DROP TABLE IF EXISTS club_aliases;
+DROP TABLE IF EXISTS clubs;
@@ -0,0 +1,36 @@
+-- +goose Up
+-- Арены: каноническое название, город и координаты из офлайн-справочника.
+-- Матчи привязываются к арене задачей venue_linker по названию арены из источника
+-- (matches.venue), все встреченные названия хранятся в venue_aliases.
+
+CREATE TABLE IF NOT EXISTS venues (
+    id TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    name TEXT NOT NULL,
+    city TEXT,
+    region TEXT,
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    precision VARCHAR(10),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_venues_city ON venues(city);
+
+-- Названия арены в матчах источников: одно название - одна арена
+CREATE TABLE IF NOT EXISTS venue_aliases (
+    alias TEXT PRIMARY KEY,
+    venue_id TEXT NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_venue_aliases_venue ON venue_aliases(venue_id);
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS venue_id TEXT REFERENCES venues(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_matches_venue ON matches(venue_id, scheduled_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_matches_venue;
+ALTER TABLE matches DROP COLUMN IF EXISTS venue_id;
+DROP TABLE IF EXISTS venue_aliases;
+DROP TABLE IF EXISTS venues;
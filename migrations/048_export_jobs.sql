@@ -0,0 +1,25 @@
+-- +goose Up
+-- Фоновые выгрузки данных: большие выгрузки API ставит в очередь, воркер API пишет
+-- файл в каталог выгрузок, пользователь скачивает его по ссылке до expires_at.
+
+CREATE TABLE IF NOT EXISTS export_jobs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    dataset VARCHAR(30) NOT NULL,
+    format VARCHAR(10) NOT NULL,
+    params JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    rows_count INTEGER,
+    file_path TEXT,
+    error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    expires_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_export_jobs_pending ON export_jobs(created_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_export_jobs_user ON export_jobs(user_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS export_jobs;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Проверки качества после задачи смотрят только строки, изменённые за её запуск
+-- (updated_at >= начала запуска), полный просмотр таблиц - в задаче data_quality.
+
+CREATE INDEX IF NOT EXISTS idx_player_statistics_updated_at ON player_statistics(updated_at);
+CREATE INDEX IF NOT EXISTS idx_team_standings_updated_at ON team_standings(updated_at);
+CREATE INDEX IF NOT EXISTS idx_matches_updated_at ON matches(updated_at);
+CREATE INDEX IF NOT EXISTS idx_players_updated_at ON players(updated_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_players_updated_at;
+DROP INDEX IF EXISTS idx_matches_updated_at;
+DROP INDEX IF EXISTS idx_team_standings_updated_at;
+DROP INDEX IF EXISTS idx_player_statistics_updated_at;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Версия данных турнира: подписчик событий API увеличивает её, когда планировщик
+-- сохраняет матчи или статистику турнира. Ответы API по турниру отдают версию
+-- как ETag, поэтому закэшированные клиентами ответы устаревают вместе с данными.
+
+CREATE TABLE IF NOT EXISTS tournament_data_versions (
+    tournament_id TEXT PRIMARY KEY REFERENCES tournaments(id) ON DELETE CASCADE,
+    version BIGINT NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS tournament_data_versions;
@@ -127,6 +127,31 @@ func InitProviderWithPrometheus(ctx context.Context, cfg Config) error {
 	return nil
 }
 
+// InitPrometheus инициализирует метрики только с Prometheus exporter, без OTEL Collector.
+// Метрики отдаются через MetricsHandler
+func InitPrometheus(ctx context.Context, serviceName string) error {
+	var err error
+
+	prometheusExporter, err = prometheus.New()
+	if err != nil {
+		return errors.Wrap(err, "failed to create prometheus exporter")
+	}
+
+	res, err := resource.New(ctx, resource.WithAttributes(attribute.String("service.name", serviceName)))
+	if err != nil {
+		return errors.Wrap(err, "failed to set metrics attributes")
+	}
+
+	meterProvider = metric.NewMeterProvider(
+		metric.WithResource(res),
+		metric.WithReader(prometheusExporter),
+	)
+
+	otel.SetMeterProvider(meterProvider)
+
+	return nil
+}
+
 // MetricsHandler возвращает HTTP handler для Prometheus метрик
 func MetricsHandler() http.Handler {
 	return promhttp.Handler()
@@ -0,0 +1,198 @@
+// Package pdf запись PDF-документов без внешних зависимостей: векторная графика,
+// встроенные шрифты TrueType с кириллицей и простая потоковая вёрстка
+package pdf
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/ttf"
+)
+
+// Размер страницы A4 в пунктах
+const (
+	A4Width  = 595.28
+	A4Height = 841.89
+)
+
+// Document PDF-документ. Объекты шрифтов и страниц пишутся при вызове Write
+type Document struct {
+	Title string
+
+	pages  []*Page
+	fonts  []*Font
+	alphas map[float64]string
+}
+
+// New создаёт пустой документ
+func New() *Document {
+	return &Document{alphas: make(map[float64]string)}
+}
+
+// AddFont подключает шрифт; в документ встраиваются только использованные глифы
+func (d *Document) AddFont(font *ttf.Font, name string) *Font {
+	f := &Font{
+		font:     font,
+		name:     name,
+		resource: fmt.Sprintf("F%d", len(d.fonts)+1),
+		used:     make(map[uint16]rune),
+	}
+	d.fonts = append(d.fonts, f)
+	return f
+}
+
+// AddPage добавляет страницу размером width x height пунктов
+func (d *Document) AddPage(width, height float64) *Page {
+	p := &Page{doc: d, width: width, height: height}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// alphaState имя графического состояния с прозрачностью a
+func (d *Document) alphaState(a float64) string {
+	if name, ok := d.alphas[a]; ok {
+		return name
+	}
+	name := fmt.Sprintf("GS%d", len(d.alphas)+1)
+	d.alphas[a] = name
+	return name
+}
+
+// Write записывает документ
+func (d *Document) Write(w io.Writer) error {
+	ow := &objectWriter{w: bufio.NewWriter(w)}
+	ow.printf("%%PDF-1.7\n%%\xe2\xe3\xcf\xd3\n")
+
+	// Номера объектов: 1 - каталог, 2 - дерево страниц, 3 - ресурсы, 4 - сведения
+	next := 5
+	alloc := func() int { next++; return next - 1 }
+
+	var fonts strings.Builder
+	for _, f := range d.fonts {
+		f.object = alloc()
+		fmt.Fprintf(&fonts, "/%s %d 0 R ", f.resource, f.object)
+	}
+	var states strings.Builder
+	alphas := make([]float64, 0, len(d.alphas))
+	for a := range d.alphas {
+		alphas = append(alphas, a)
+	}
+	sort.Float64s(alphas)
+	for _, a := range alphas {
+		fmt.Fprintf(&states, "/%s <</Type /ExtGState /ca %.3f /CA %.3f>> ", d.alphas[a], a, a)
+	}
+
+	pageObjects := make([]int, len(d.pages))
+	var kids strings.Builder
+	for i := range d.pages {
+		pageObjects[i] = alloc()
+		fmt.Fprintf(&kids, "%d 0 R ", pageObjects[i])
+	}
+
+	ow.object(1, "<</Type /Catalog /Pages 2 0 R>>")
+	ow.object(2, fmt.Sprintf("<</Type /Pages /Kids [%s] /Count %d>>", kids.String(), len(d.pages)))
+	ow.object(3, fmt.Sprintf("<</Font <<%s>> /ExtGState <<%s>>>>", fonts.String(), states.String()))
+	ow.object(4, fmt.Sprintf("<</Producer (HockeyStats) /Title %s>>", textString(d.Title)))
+
+	for i, p := range d.pages {
+		contents := alloc()
+		ow.object(pageObjects[i], fmt.Sprintf(
+			"<</Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources 3 0 R /Contents %d 0 R>>",
+			p.width, p.height, contents))
+		ow.stream(contents, "", p.content.Bytes())
+	}
+	for _, f := range d.fonts {
+		f.write(ow, alloc)
+	}
+	return ow.finish()
+}
+
+// objectWriter пишет объекты и запоминает их смещения для таблицы xref
+type objectWriter struct {
+	w       *bufio.Writer
+	offset  int
+	offsets map[int]int
+	err     error
+}
+
+func (ow *objectWriter) printf(format string, args ...any) {
+	if ow.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(ow.w, format, args...)
+	ow.offset += n
+	ow.err = err
+}
+
+func (ow *objectWriter) write(b []byte) {
+	if ow.err != nil {
+		return
+	}
+	n, err := ow.w.Write(b)
+	ow.offset += n
+	ow.err = err
+}
+
+func (ow *objectWriter) object(num int, body string) {
+	if ow.offsets == nil {
+		ow.offsets = make(map[int]int)
+	}
+	ow.offsets[num] = ow.offset
+	ow.printf("%d 0 obj\n%s\nendobj\n", num, body)
+}
+
+// stream пишет поток, сжатый Flate; extra - дополнительные ключи словаря
+func (ow *objectWriter) stream(num int, extra string, data []byte) {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	_, _ = zw.Write(data)
+	_ = zw.Close()
+
+	if ow.offsets == nil {
+		ow.offsets = make(map[int]int)
+	}
+	ow.offsets[num] = ow.offset
+	ow.printf("%d 0 obj\n<</Length %d /Filter /FlateDecode%s>>\nstream\n", num, buf.Len(), extra)
+	ow.write(buf.Bytes())
+	ow.printf("\nendstream\nendobj\n")
+}
+
+func (ow *objectWriter) finish() error {
+	size := 1
+	for num := range ow.offsets {
+		size = max(size, num+1)
+	}
+	xref := ow.offset
+	ow.printf("xref\n0 %d\n0000000000 65535 f \n", size)
+	for num := 1; num < size; num++ {
+		if offset, ok := ow.offsets[num]; ok {
+			ow.printf("%010d 00000 n \n", offset)
+		} else {
+			ow.printf("0000000000 65535 f \n")
+		}
+	}
+	ow.printf("trailer\n<</Size %d /Root 1 0 R /Info 4 0 R>>\nstartxref\n%d\n%%%%EOF\n", size, xref)
+	if ow.err != nil {
+		return ow.err
+	}
+	return ow.w.Flush()
+}
+
+// textString строка PDF в UTF-16BE с меткой порядка байтов
+func textString(s string) string {
+	var b strings.Builder
+	b.WriteString("<FEFF")
+	for _, r := range s {
+		if r > 0xFFFF {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	b.WriteString(">")
+	return b.String()
+}
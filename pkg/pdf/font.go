@@ -0,0 +1,133 @@
+package pdf
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/ttf"
+)
+
+// Font шрифт документа. Текст кодируется номерами глифов (Identity-H), поэтому
+// доступны все символы шрифта, включая кириллицу
+type Font struct {
+	font     *ttf.Font
+	name     string
+	resource string
+	object   int
+	// used использованные глифы и их символы для ToUnicode
+	used map[uint16]rune
+}
+
+// Width ширина строки кеглем size
+func (f *Font) Width(s string, size float64) float64 {
+	return f.font.Width(s, size)
+}
+
+// LineHeight высота строки кеглем size
+func (f *Font) LineHeight(size float64) float64 {
+	return float64(f.font.Ascent()-f.font.Descent()) * size / float64(f.font.UnitsPerEm())
+}
+
+// Ascent высота над базовой линией кеглем size
+func (f *Font) Ascent(size float64) float64 {
+	return float64(f.font.Ascent()) * size / float64(f.font.UnitsPerEm())
+}
+
+// encode кодирует строку в шестнадцатеричные номера глифов
+func (f *Font) encode(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		g := f.font.GlyphIndex(r)
+		if _, ok := f.used[g]; !ok && g != 0 {
+			f.used[g] = r
+		}
+		fmt.Fprintf(&b, "%04X", g)
+	}
+	return b.String()
+}
+
+// scale переводит единицы шрифта в тысячные доли кегля
+func (f *Font) scale(v int) int {
+	return v * 1000 / f.font.UnitsPerEm()
+}
+
+// write пишет составной шрифт Type0 с подмножеством глифов
+func (f *Font) write(ow *objectWriter, alloc func() int) {
+	glyphs := make([]uint16, 0, len(f.used))
+	for g := range f.used {
+		glyphs = append(glyphs, g)
+	}
+	sort.Slice(glyphs, func(i, j int) bool { return glyphs[i] < glyphs[j] })
+
+	name := subsetTag(glyphs) + "+" + f.name
+	cidFont, descriptor, fontFile, toUnicode := alloc(), alloc(), alloc(), alloc()
+
+	ow.object(f.object, fmt.Sprintf(
+		"<</Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R>>",
+		name, cidFont, toUnicode))
+
+	var widths strings.Builder
+	for _, g := range glyphs {
+		fmt.Fprintf(&widths, "%d [%d] ", g, f.scale(f.font.Advance(g)))
+	}
+	ow.object(cidFont, fmt.Sprintf(
+		"<</Type /Font /Subtype /CIDFontType2 /BaseFont /%s "+
+			"/CIDSystemInfo <</Registry (Adobe) /Ordering (Identity) /Supplement 0>> "+
+			"/FontDescriptor %d 0 R /CIDToGIDMap /Identity /DW %d /W [%s]>>",
+		name, descriptor, f.scale(f.font.Advance(0)), widths.String()))
+
+	bbox := f.font.BBox()
+	ow.object(descriptor, fmt.Sprintf(
+		"<</Type /FontDescriptor /FontName /%s /Flags 32 /FontBBox [%d %d %d %d] /ItalicAngle 0 "+
+			"/Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R>>",
+		name, f.scale(bbox[0]), f.scale(bbox[1]), f.scale(bbox[2]), f.scale(bbox[3]),
+		f.scale(f.font.Ascent()), f.scale(f.font.Descent()), f.scale(f.font.CapHeight()), fontFile))
+
+	subset := f.font.Subset(glyphs)
+	ow.stream(fontFile, fmt.Sprintf(" /Length1 %d", len(subset)), subset)
+	ow.stream(toUnicode, "", f.toUnicode(glyphs))
+}
+
+// toUnicode таблица обратного отображения глифов в символы для поиска и копирования текста
+func (f *Font) toUnicode(glyphs []uint16) []byte {
+	var b strings.Builder
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo <</Registry (Adobe) /Ordering (UCS) /Supplement 0>> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	for start := 0; start < len(glyphs); start += 100 {
+		chunk := glyphs[start:min(start+100, len(glyphs))]
+		fmt.Fprintf(&b, "%d beginbfchar\n", len(chunk))
+		for _, g := range chunk {
+			fmt.Fprintf(&b, "<%04X> %s\n", g, utf16Hex(f.used[g]))
+		}
+		b.WriteString("endbfchar\n")
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	return []byte(b.String())
+}
+
+func utf16Hex(r rune) string {
+	if r > 0xFFFF {
+		r -= 0x10000
+		return fmt.Sprintf("<%04X%04X>", 0xD800+(r>>10), 0xDC00+(r&0x3FF))
+	}
+	return fmt.Sprintf("<%04X>", r)
+}
+
+// subsetTag метка подмножества шрифта из шести прописных букв
+func subsetTag(glyphs []uint16) string {
+	h := fnv.New32a()
+	for _, g := range glyphs {
+		_, _ = h.Write([]byte{byte(g >> 8), byte(g)})
+	}
+	sum := h.Sum32()
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = 'A' + byte(sum%26)
+		sum /= 26
+	}
+	return string(tag)
+}
@@ -0,0 +1,123 @@
+package pdf
+
+import "strings"
+
+// Align выравнивание текста
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignCenter
+	AlignRight
+)
+
+// TextStyle оформление абзаца
+type TextStyle struct {
+	Font  *Font
+	Size  float64
+	Color Color
+	Align Align
+}
+
+// Layout потоковая вёрстка: блоки располагаются сверху вниз, блок, который не
+// помещается на страницу, переносится на новую
+type Layout struct {
+	doc    *Document
+	page   *Page
+	width  float64
+	height float64
+	margin float64
+	// y расстояние от верха страницы до текущей позиции
+	y float64
+}
+
+// NewLayout создаёт вёрстку страниц A4 с полями margin
+func NewLayout(doc *Document, margin float64) *Layout {
+	return &Layout{doc: doc, width: A4Width, height: A4Height, margin: margin}
+}
+
+// Width ширина области содержимого
+func (l *Layout) Width() float64 { return l.width - 2*l.margin }
+
+// Remaining высота, оставшаяся на текущей странице
+func (l *Layout) Remaining() float64 {
+	if l.page == nil {
+		return l.height - 2*l.margin
+	}
+	return l.height - l.margin - l.y
+}
+
+// NewPage начинает новую страницу
+func (l *Layout) NewPage() {
+	l.page = l.doc.AddPage(l.width, l.height)
+	l.y = l.margin
+}
+
+// Ensure начинает новую страницу, если на текущей не осталось высоты h
+func (l *Layout) Ensure(h float64) {
+	if l.page == nil || (h > l.Remaining() && l.y > l.margin) {
+		l.NewPage()
+	}
+}
+
+// Space добавляет вертикальный отступ; в начале страницы отступ не нужен
+func (l *Layout) Space(h float64) {
+	if l.page != nil && l.y > l.margin {
+		l.y = min(l.y+h, l.height-l.margin)
+	}
+}
+
+// Block выделяет блок высотой h и рисует его в координатах блока: начало в левом
+// верхнем углу, ось Y направлена вниз, ширина равна ширине области содержимого
+func (l *Layout) Block(h float64, draw func(p *Page, width float64)) {
+	l.Ensure(h)
+	l.page.Save()
+	l.page.Flip(l.margin, l.height-l.y)
+	draw(l.page, l.Width())
+	l.page.Restore()
+	l.y += h
+}
+
+// Text выводит абзац с переносом по словам
+func (l *Layout) Text(s string, style TextStyle) {
+	lineHeight := style.Font.LineHeight(style.Size)
+	for _, line := range WrapText(style.Font, style.Size, l.Width(), s) {
+		l.Block(lineHeight, func(p *Page, width float64) {
+			p.SetFillColor(style.Color)
+			p.TextAligned(style.Font, style.Size, 0, style.Font.Ascent(style.Size), width, style.Align, line)
+		})
+	}
+}
+
+// TextAligned пишет строку в полосе от x шириной width с базовой линией y
+func (p *Page) TextAligned(font *Font, size, x, y, width float64, align Align, s string) {
+	switch align {
+	case AlignCenter:
+		x += (width - font.Width(s, size)) / 2
+	case AlignRight:
+		x += width - font.Width(s, size)
+	}
+	p.Text(font, size, x, y, s)
+}
+
+// WrapText разбивает текст на строки не шире width; слово длиннее строки
+// остаётся целым. Переводы строк сохраняются
+func WrapText(font *Font, size, width float64, s string) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(s, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+			if line != "" && font.Width(candidate, size) > width {
+				lines = append(lines, line)
+				candidate = word
+			}
+			line = candidate
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
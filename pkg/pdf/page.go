@@ -0,0 +1,173 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Color цвет RGB, компоненты от 0 до 1
+type Color struct {
+	R, G, B float64
+}
+
+// HexColor разбирает цвет вида "#4a90d9" или "#fff"
+func HexColor(s string) (Color, bool) {
+	if len(s) == 4 && s[0] == '#' {
+		s = "#" + string([]byte{s[1], s[1], s[2], s[2], s[3], s[3]})
+	}
+	if len(s) != 7 || s[0] != '#' {
+		return Color{}, false
+	}
+	v, err := strconv.ParseUint(s[1:], 16, 32)
+	if err != nil {
+		return Color{}, false
+	}
+	return Color{R: float64(v>>16&0xff) / 255, G: float64(v>>8&0xff) / 255, B: float64(v&0xff) / 255}, true
+}
+
+// MustHexColor как HexColor, для цветов-констант
+func MustHexColor(s string) Color {
+	c, ok := HexColor(s)
+	if !ok {
+		panic("pdf: invalid color " + s)
+	}
+	return c
+}
+
+// kappa длина касательных кривой Безье, приближающей четверть окружности
+const kappa = 0.5522847498
+
+// Page страница документа. Координаты - в пунктах от левого нижнего угла,
+// пока Flip не перевернул ось Y
+type Page struct {
+	doc     *Document
+	width   float64
+	height  float64
+	content bytes.Buffer
+	// flipped для каждого уровня Save: ось Y направлена вниз
+	flipped []bool
+}
+
+// Width ширина страницы
+func (p *Page) Width() float64 { return p.width }
+
+// Height высота страницы
+func (p *Page) Height() float64 { return p.height }
+
+func (p *Page) op(format string, args ...any) {
+	fmt.Fprintf(&p.content, format, args...)
+	p.content.WriteByte('\n')
+}
+
+func (p *Page) isFlipped() bool {
+	return len(p.flipped) > 0 && p.flipped[len(p.flipped)-1]
+}
+
+// Save сохраняет графическое состояние
+func (p *Page) Save() {
+	p.flipped = append(p.flipped, p.isFlipped())
+	p.op("q")
+}
+
+// Restore восстанавливает графическое состояние
+func (p *Page) Restore() {
+	if len(p.flipped) > 0 {
+		p.flipped = p.flipped[:len(p.flipped)-1]
+	}
+	p.op("Q")
+}
+
+// Transform умножает текущую матрицу преобразования на [a b c d e f]
+func (p *Page) Transform(a, b, c, d, e, f float64) {
+	p.op("%s %s %s %s %s %s cm", num(a), num(b), num(c), num(d), num(e), num(f))
+}
+
+// Flip переносит начало координат в точку (x, top) и направляет ось Y вниз,
+// как в SVG. Вызывается после Save и действует до Restore
+func (p *Page) Flip(x, top float64) {
+	p.Transform(1, 0, 0, -1, x, top)
+	if len(p.flipped) == 0 {
+		p.flipped = append(p.flipped, false)
+	}
+	p.flipped[len(p.flipped)-1] = !p.flipped[len(p.flipped)-1]
+}
+
+// SetFillColor задаёт цвет заливки и текста
+func (p *Page) SetFillColor(c Color) { p.op("%s %s %s rg", num(c.R), num(c.G), num(c.B)) }
+
+// SetStrokeColor задаёт цвет линий
+func (p *Page) SetStrokeColor(c Color) { p.op("%s %s %s RG", num(c.R), num(c.G), num(c.B)) }
+
+// SetLineWidth задаёт толщину линий
+func (p *Page) SetLineWidth(w float64) { p.op("%s w", num(w)) }
+
+// SetRoundCaps скругляет концы и стыки линий
+func (p *Page) SetRoundCaps() { p.op("1 J 1 j") }
+
+// SetAlpha задаёт прозрачность заливки и линий
+func (p *Page) SetAlpha(a float64) {
+	p.op("/%s gs", p.doc.alphaState(a))
+}
+
+// MoveTo начинает новый контур
+func (p *Page) MoveTo(x, y float64) { p.op("%s %s m", num(x), num(y)) }
+
+// LineTo добавляет отрезок
+func (p *Page) LineTo(x, y float64) { p.op("%s %s l", num(x), num(y)) }
+
+// CurveTo добавляет кубическую кривую Безье
+func (p *Page) CurveTo(x1, y1, x2, y2, x, y float64) {
+	p.op("%s %s %s %s %s %s c", num(x1), num(y1), num(x2), num(y2), num(x), num(y))
+}
+
+// ClosePath замыкает контур
+func (p *Page) ClosePath() { p.op("h") }
+
+// Rect добавляет прямоугольник
+func (p *Page) Rect(x, y, w, h float64) { p.op("%s %s %s %s re", num(x), num(y), num(w), num(h)) }
+
+// RoundRect добавляет прямоугольник со скруглёнными углами радиуса r
+func (p *Page) RoundRect(x, y, w, h, r float64) {
+	r = min(r, w/2, h/2)
+	c := r * kappa
+	p.MoveTo(x+r, y)
+	p.LineTo(x+w-r, y)
+	p.CurveTo(x+w-r+c, y, x+w, y+r-c, x+w, y+r)
+	p.LineTo(x+w, y+h-r)
+	p.CurveTo(x+w, y+h-r+c, x+w-r+c, y+h, x+w-r, y+h)
+	p.LineTo(x+r, y+h)
+	p.CurveTo(x+r-c, y+h, x, y+h-r+c, x, y+h-r)
+	p.LineTo(x, y+r)
+	p.CurveTo(x, y+r-c, x+r-c, y, x+r, y)
+	p.ClosePath()
+}
+
+// Fill заливает контуры (правило ненулевого индекса)
+func (p *Page) Fill() { p.op("f") }
+
+// Stroke обводит контуры
+func (p *Page) Stroke() { p.op("S") }
+
+// FillStroke заливает и обводит контуры
+func (p *Page) FillStroke() { p.op("B") }
+
+// Text пишет строку с базовой линией в точке (x, y) текущим цветом заливки
+func (p *Page) Text(font *Font, size, x, y float64, s string) {
+	d := 1.0
+	if p.isFlipped() {
+		d = -1
+	}
+	p.op("BT /%s %s Tf 1 0 0 %s %s %s Tm <%s> Tj ET", font.resource, num(size), num(d), num(x), num(y), font.encode(s))
+}
+
+// num число для потока содержимого: без экспоненты и лишних нулей
+func num(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 3, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" || s == "" {
+		return "0"
+	}
+	return s
+}
@@ -0,0 +1,102 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Daniil-Sakharov/HockeyProject/pkg/ttf"
+)
+
+func loadFont(t *testing.T) *ttf.Font {
+	t.Helper()
+	data, err := os.ReadFile("../../internal/modules/shared/fonts/DejaVuSans.ttf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	font, err := ttf.Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return font
+}
+
+func TestWrapText(t *testing.T) {
+	doc := New()
+	font := doc.AddFont(loadFont(t), "DejaVuSans")
+	lines := WrapText(font, 10, font.Width("Первенство России", 10)+1, "Первенство России среди юношей\nСезон")
+	want := []string{"Первенство России", "среди юношей", "Сезон"}
+	if strings.Join(lines, "|") != strings.Join(want, "|") {
+		t.Errorf("WrapText = %q, want %q", lines, want)
+	}
+}
+
+func TestDocumentWrite(t *testing.T) {
+	doc := New()
+	doc.Title = "Отчёт"
+	font := doc.AddFont(loadFont(t), "DejaVuSans")
+	layout := NewLayout(doc, 40)
+	layout.Text("Полный отчёт игрока", TextStyle{Font: font, Size: 18})
+
+	table := Table{Columns: []TableColumn{{Title: "Сезон", Width: 1}, {Title: "Голы", Width: 1, Align: AlignRight}}, Font: font, Bold: font, Size: 10}
+	for i := range 80 {
+		table.Rows = append(table.Rows, []string{"2024/2025", strconv.Itoa(i)})
+	}
+	layout.Table(table)
+
+	var buf bytes.Buffer
+	if err := doc.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	if !bytes.HasPrefix(data, []byte("%PDF-1.7")) || !bytes.HasSuffix(data, []byte("%%EOF\n")) {
+		t.Fatal("missing PDF header or trailer")
+	}
+	if !bytes.Contains(data, []byte("/Count 3")) {
+		t.Error("80 table rows must span three pages")
+	}
+
+	// Каждая запись xref указывает на начало своего объекта
+	m := regexp.MustCompile(`startxref\n(\d+)`).FindSubmatch(data)
+	xref, _ := strconv.Atoi(string(m[1]))
+	entries := strings.Split(string(data[xref:]), "\n")[2:]
+	for num, entry := range entries {
+		if !strings.HasSuffix(entry, " n ") {
+			continue
+		}
+		offset, _ := strconv.Atoi(entry[:10])
+		if want := fmt.Sprintf("%d 0 obj", num); !bytes.HasPrefix(data[offset:], []byte(want)) {
+			t.Errorf("xref entry %d points to %q", num, data[offset:offset+10])
+		}
+	}
+
+	// ToUnicode отображает глифы обратно в кириллицу
+	if !strings.Contains(inflateAll(t, data), "<041F>") {
+		t.Error("ToUnicode has no mapping for П")
+	}
+}
+
+// inflateAll распаковывает все потоки документа
+func inflateAll(t *testing.T, data []byte) string {
+	t.Helper()
+	var out strings.Builder
+	for _, m := range regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`).FindAllSubmatch(data, -1) {
+		r, err := zlib.NewReader(bytes.NewReader(m[1]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out.Write(b)
+	}
+	return out.String()
+}
@@ -0,0 +1,116 @@
+package pdf
+
+// TableColumn колонка таблицы; Width - доля ширины таблицы относительно других колонок
+type TableColumn struct {
+	Title string
+	Width float64
+	Align Align
+}
+
+// Table таблица с заголовком, который повторяется на каждой странице
+type Table struct {
+	Columns []TableColumn
+	Rows    [][]string
+	// Highlight строки, выделенные полужирным шрифтом и цветом HighlightColor
+	Highlight map[int]bool
+
+	Font, Bold     *Font
+	Size           float64
+	TextColor      Color
+	HeaderColor    Color
+	HighlightColor Color
+	StripeColor    Color
+	BorderColor    Color
+}
+
+// tablePadding внутренние отступы ячеек
+const tablePadding = 4
+
+// Table выводит таблицу; строки не разрываются между страницами
+func (l *Layout) Table(t Table) {
+	widths := t.columnWidths(l.Width())
+	lineHeight := t.Font.LineHeight(t.Size)
+
+	header := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		header[i] = c.Title
+	}
+	headerLines := t.wrap(header, widths, t.Bold)
+	headerHeight := t.height(headerLines, lineHeight)
+
+	drawHeader := func() {
+		l.Block(headerHeight, func(p *Page, width float64) {
+			t.drawRow(p, widths, headerLines, lineHeight, t.Bold, t.HeaderColor)
+			p.SetStrokeColor(t.BorderColor)
+			p.SetLineWidth(1)
+			p.MoveTo(0, headerHeight)
+			p.LineTo(width, headerHeight)
+			p.Stroke()
+		})
+	}
+
+	for i, row := range t.Rows {
+		font, color := t.Font, t.TextColor
+		if t.Highlight[i] {
+			font, color = t.Bold, t.HighlightColor
+		}
+		lines := t.wrap(row, widths, font)
+		h := t.height(lines, lineHeight)
+
+		// Заголовок не остаётся на странице без строк
+		if i == 0 || h > l.Remaining() {
+			l.Ensure(headerHeight + h)
+			drawHeader()
+		}
+		l.Block(h, func(p *Page, width float64) {
+			if i%2 == 1 {
+				p.SetFillColor(t.StripeColor)
+				p.Rect(0, 0, width, h)
+				p.Fill()
+			}
+			t.drawRow(p, widths, lines, lineHeight, font, color)
+		})
+	}
+}
+
+func (t Table) columnWidths(total float64) []float64 {
+	sum := 0.0
+	for _, c := range t.Columns {
+		sum += c.Width
+	}
+	widths := make([]float64, len(t.Columns))
+	for i, c := range t.Columns {
+		widths[i] = total * c.Width / sum
+	}
+	return widths
+}
+
+func (t Table) wrap(cells []string, widths []float64, font *Font) [][]string {
+	lines := make([][]string, len(t.Columns))
+	for i := range t.Columns {
+		if i < len(cells) {
+			lines[i] = WrapText(font, t.Size, widths[i]-2*tablePadding, cells[i])
+		}
+	}
+	return lines
+}
+
+func (t Table) height(lines [][]string, lineHeight float64) float64 {
+	n := 1
+	for _, cell := range lines {
+		n = max(n, len(cell))
+	}
+	return float64(n)*lineHeight + 2*tablePadding
+}
+
+func (t Table) drawRow(p *Page, widths []float64, lines [][]string, lineHeight float64, font *Font, color Color) {
+	p.SetFillColor(color)
+	x := 0.0
+	for i, cell := range lines {
+		for j, line := range cell {
+			baseline := tablePadding + float64(j)*lineHeight + font.Ascent(t.Size)
+			p.TextAligned(font, t.Size, x+tablePadding, baseline, widths[i]-2*tablePadding, t.Columns[i].Align, line)
+		}
+		x += widths[i]
+	}
+}
@@ -0,0 +1,136 @@
+package raster
+
+import "math"
+
+// Point точка в пикселях, ось Y направлена вниз
+type Point struct {
+	X, Y float64
+}
+
+// Path контур из ломаных: кривые спрямляются при добавлении
+type Path struct {
+	contours [][]Point
+	closed   []bool
+}
+
+// curveStep длина отрезка, которым спрямляются кривые, в пикселях
+const curveStep = 1.5
+
+// MoveTo начинает новый контур
+func (p *Path) MoveTo(x, y float64) {
+	p.contours = append(p.contours, []Point{{x, y}})
+	p.closed = append(p.closed, false)
+}
+
+// LineTo отрезок от текущей точки
+func (p *Path) LineTo(x, y float64) {
+	if len(p.contours) == 0 {
+		p.MoveTo(x, y)
+		return
+	}
+	last := len(p.contours) - 1
+	p.contours[last] = append(p.contours[last], Point{x, y})
+}
+
+// QuadTo квадратичная кривая с контрольной точкой (cx, cy)
+func (p *Path) QuadTo(cx, cy, x, y float64) {
+	from := p.current()
+	n := segments(dist(from, Point{cx, cy}) + dist(Point{cx, cy}, Point{x, y}))
+	for i := 1; i <= n; i++ {
+		t := float64(i) / float64(n)
+		u := 1 - t
+		p.LineTo(u*u*from.X+2*u*t*cx+t*t*x, u*u*from.Y+2*u*t*cy+t*t*y)
+	}
+}
+
+// CubeTo кубическая кривая с контрольными точками (x1, y1) и (x2, y2)
+func (p *Path) CubeTo(x1, y1, x2, y2, x, y float64) {
+	from := p.current()
+	n := segments(dist(from, Point{x1, y1}) + dist(Point{x1, y1}, Point{x2, y2}) + dist(Point{x2, y2}, Point{x, y}))
+	for i := 1; i <= n; i++ {
+		t := float64(i) / float64(n)
+		u := 1 - t
+		p.LineTo(
+			u*u*u*from.X+3*u*u*t*x1+3*u*t*t*x2+t*t*t*x,
+			u*u*u*from.Y+3*u*u*t*y1+3*u*t*t*y2+t*t*t*y,
+		)
+	}
+}
+
+// Close замыкает текущий контур
+func (p *Path) Close() {
+	if len(p.closed) > 0 {
+		p.closed[len(p.closed)-1] = true
+	}
+}
+
+// Empty контур без точек
+func (p *Path) Empty() bool {
+	return len(p.contours) == 0
+}
+
+func (p *Path) current() Point {
+	if len(p.contours) == 0 {
+		return Point{}
+	}
+	c := p.contours[len(p.contours)-1]
+	return c[len(c)-1]
+}
+
+// Stroke контур обводки толщиной width. Соединения всегда скруглены, концы
+// незамкнутых линий скруглены при roundCaps, иначе обрезаны
+func (p *Path) Stroke(width float64, roundCaps bool) *Path {
+	out := &Path{}
+	hw := width / 2
+	for i, c := range p.contours {
+		points := c
+		if p.closed[i] && len(c) > 1 {
+			points = append(append([]Point(nil), c...), c[0])
+		}
+		for j := 1; j < len(points); j++ {
+			out.segment(points[j-1], points[j], hw)
+		}
+		for j, pt := range points {
+			end := j == 0 || j == len(points)-1
+			if !end || p.closed[i] || roundCaps {
+				out.circle(pt, hw)
+			}
+		}
+	}
+	return out
+}
+
+// segment прямоугольник вдоль отрезка. Обход всех прямоугольников и кругов
+// обводки одного направления, поэтому их пересечения не вычитаются при заливке
+func (p *Path) segment(a, b Point, hw float64) {
+	l := dist(a, b)
+	if l == 0 {
+		return
+	}
+	nx, ny := -(b.Y-a.Y)/l*hw, (b.X-a.X)/l*hw
+	p.MoveTo(a.X+nx, a.Y+ny)
+	p.LineTo(b.X+nx, b.Y+ny)
+	p.LineTo(b.X-nx, b.Y-ny)
+	p.LineTo(a.X-nx, a.Y-ny)
+	p.Close()
+}
+
+// circle многоугольник, приближающий окружность
+func (p *Path) circle(c Point, r float64) {
+	n := max(8, segments(2*math.Pi*r))
+	p.MoveTo(c.X+r, c.Y)
+	for i := 1; i < n; i++ {
+		a := -2 * math.Pi * float64(i) / float64(n)
+		p.LineTo(c.X+r*math.Cos(a), c.Y+r*math.Sin(a))
+	}
+	p.Close()
+}
+
+// segments число отрезков для кривой длиной length
+func segments(length float64) int {
+	return max(1, min(100, int(math.Ceil(length/curveStep))))
+}
+
+func dist(a, b Point) float64 {
+	return math.Hypot(b.X-a.X, b.Y-a.Y)
+}
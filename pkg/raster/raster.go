@@ -0,0 +1,165 @@
+// Package raster растеризация контуров со сглаживанием в изображение RGBA.
+// Покрытие пикселей считается точно по площади: каждый отрезок контура
+// добавляет в буфер накопления долю площади, накопленная сумма по строке даёт
+// покрытие. Пересечения контуров одного направления обхода не вычитаются
+package raster
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// minCoverage покрытие, ниже которого пиксель не меняется
+const minCoverage = 1.0 / 512
+
+// Canvas холст для заливки контуров
+type Canvas struct {
+	img *image.RGBA
+	// acc буфер накопления площадей, в строке два лишних столбца для отрезков у правого края
+	acc    []float32
+	stride int
+}
+
+// NewCanvas создаёт холст, залитый цветом background
+func NewCanvas(width, height int, background color.Color) *Canvas {
+	c := &Canvas{
+		img:    image.NewRGBA(image.Rect(0, 0, width, height)),
+		acc:    make([]float32, (width+2)*height),
+		stride: width + 2,
+	}
+	r, g, b, a := background.RGBA()
+	bg := color.RGBA{uint8(r >> 8), uint8(g >> 8), uint8(b >> 8), uint8(a >> 8)}
+	for i := 0; i < len(c.img.Pix); i += 4 {
+		c.img.Pix[i], c.img.Pix[i+1], c.img.Pix[i+2], c.img.Pix[i+3] = bg.R, bg.G, bg.B, bg.A
+	}
+	return c
+}
+
+// Image изображение холста
+func (c *Canvas) Image() *image.RGBA {
+	return c.img
+}
+
+// Fill заливает контур цветом col с учётом его прозрачности. Незамкнутые
+// контуры замыкаются
+func (c *Canvas) Fill(p *Path, col color.NRGBA) {
+	w, h := c.img.Rect.Dx(), c.img.Rect.Dy()
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for _, contour := range p.contours {
+		for i, a := range contour {
+			b := contour[(i+1)%len(contour)]
+			c.clip(a, b, w, h)
+			minY, maxY = math.Min(minY, a.Y), math.Max(maxY, a.Y)
+		}
+	}
+	if minY > maxY {
+		return
+	}
+
+	y0, y1 := max(0, int(minY)), min(h, int(math.Ceil(maxY)))
+	alpha := float32(col.A) / 255
+	for y := y0; y < y1; y++ {
+		row := c.acc[y*c.stride : (y+1)*c.stride]
+		pix := c.img.Pix[y*c.img.Stride:]
+		var sum float32
+		for x := range row {
+			sum += row[x]
+			row[x] = 0
+			if x >= w || abs32(sum) < minCoverage {
+				continue
+			}
+			coverage := min(1, abs32(sum)) * alpha
+			blend(pix[4*x:4*x+4], col, coverage)
+		}
+	}
+}
+
+// clip делит отрезок на границах холста по X, чтобы части за краем можно было
+// прижать к нему: это не меняет покрытие внутри холста
+func (c *Canvas) clip(a, b Point, w, h int) {
+	for _, edge := range [2]float64{0, float64(w)} {
+		if (a.X-edge)*(b.X-edge) < 0 {
+			m := Point{edge, a.Y + (edge-a.X)/(b.X-a.X)*(b.Y-a.Y)}
+			c.clip(a, m, w, h)
+			c.clip(m, b, w, h)
+			return
+		}
+	}
+	c.line(a, b, w, h)
+}
+
+// line добавляет в буфер площади под отрезком a-b, лежащим по X в пределах холста
+func (c *Canvas) line(a, b Point, w, h int) {
+	if a.Y == b.Y {
+		return
+	}
+	dir := float32(1)
+	if a.Y > b.Y {
+		dir = -1
+		a, b = b, a
+	}
+	a.X, b.X = clamp(a.X, 0, float64(w)), clamp(b.X, 0, float64(w))
+	dxdy := (b.X - a.X) / (b.Y - a.Y)
+	x := a.X
+	if a.Y < 0 {
+		x -= a.Y * dxdy
+	}
+
+	for y := max(0, int(a.Y)); y < min(h, int(math.Ceil(b.Y))); y++ {
+		row := c.acc[y*c.stride : (y+1)*c.stride]
+		dy := math.Min(float64(y+1), b.Y) - math.Max(float64(y), a.Y)
+		xnext := x + dxdy*dy
+		d := float32(dy) * dir
+		x0, x1 := math.Min(x, xnext), math.Max(x, xnext)
+		x0floor, x1ceil := math.Floor(x0), math.Ceil(x1)
+		x0i, x1i := int(x0floor), int(x1ceil)
+
+		if x1i <= x0i+1 {
+			// Отрезок в пределах одного пикселя: площадь делится по средней точке
+			xmf := float32(0.5*(x+xnext) - x0floor)
+			row[x0i] += d - d*xmf
+			row[x0i+1] += d * xmf
+		} else {
+			s := float32(1 / (x1 - x0))
+			x0f := float32(x0 - x0floor)
+			a0 := 0.5 * s * (1 - x0f) * (1 - x0f)
+			x1f := float32(x1 - x1ceil + 1)
+			am := 0.5 * s * x1f * x1f
+			row[x0i] += d * a0
+			if x1i == x0i+2 {
+				row[x0i+1] += d * (1 - a0 - am)
+			} else {
+				a1 := s * (1.5 - x0f)
+				row[x0i+1] += d * (a1 - a0)
+				for xi := x0i + 2; xi < x1i-1; xi++ {
+					row[xi] += d * s
+				}
+				a2 := a1 + float32(x1i-x0i-3)*s
+				row[x1i-1] += d * (1 - a2 - am)
+			}
+			row[x1i] += d * am
+		}
+		x = xnext
+	}
+}
+
+// blend накладывает цвет с непрозрачностью alpha на пиксель RGBA (альфа умножена)
+func blend(px []uint8, col color.NRGBA, alpha float32) {
+	inv := 1 - alpha
+	px[0] = uint8(float32(col.R)*alpha + float32(px[0])*inv + 0.5)
+	px[1] = uint8(float32(col.G)*alpha + float32(px[1])*inv + 0.5)
+	px[2] = uint8(float32(col.B)*alpha + float32(px[2])*inv + 0.5)
+	px[3] = uint8(255*alpha + float32(px[3])*inv + 0.5)
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, v))
+}
+
+func abs32(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
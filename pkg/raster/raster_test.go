@@ -0,0 +1,99 @@
+package raster
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+var (
+	white = color.NRGBA{255, 255, 255, 255}
+	black = color.NRGBA{0, 0, 0, 255}
+)
+
+// coverage доля чёрного в пикселе на белом фоне
+func coverage(c *Canvas, x, y int) float64 {
+	return 1 - float64(c.Image().RGBAAt(x, y).R)/255
+}
+
+func TestFillRect(t *testing.T) {
+	c := NewCanvas(10, 10, white)
+	p := &Path{}
+	p.MoveTo(2.5, 2)
+	p.LineTo(8, 2)
+	p.LineTo(8, 8)
+	p.LineTo(2.5, 8)
+	p.Close()
+	c.Fill(p, black)
+
+	tests := []struct {
+		x, y int
+		want float64
+	}{
+		{5, 5, 1},
+		{1, 5, 0},
+		{8, 5, 0},
+		{5, 1, 0},
+		{2, 5, 0.5},
+	}
+	for _, tt := range tests {
+		if got := coverage(c, tt.x, tt.y); math.Abs(got-tt.want) > 0.01 {
+			t.Errorf("coverage(%d, %d) = %.3f, want %.3f", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestFillOutsideCanvas(t *testing.T) {
+	c := NewCanvas(4, 4, white)
+	p := &Path{}
+	p.MoveTo(-10, -10)
+	p.LineTo(20, -10)
+	p.LineTo(20, 2)
+	p.LineTo(-10, 2)
+	c.Fill(p, black)
+
+	for x := range 4 {
+		if got := coverage(c, x, 1); got < 0.99 {
+			t.Errorf("coverage(%d, 1) = %.3f, want 1", x, got)
+		}
+		if got := coverage(c, x, 2); got > 0.01 {
+			t.Errorf("coverage(%d, 2) = %.3f, want 0", x, got)
+		}
+	}
+}
+
+func TestStrokeOverlapsNotSubtracted(t *testing.T) {
+	c := NewCanvas(40, 40, white)
+	p := &Path{}
+	p.MoveTo(5, 20)
+	p.LineTo(35, 20)
+	p.LineTo(5, 22) // обратный ход поверх первого отрезка
+	c.Fill(p.Stroke(6, true), color.NRGBA{0, 0, 0, 128})
+
+	// Полупрозрачная обводка в месте наложения не темнее, чем в одном слое
+	single, overlap := coverage(c, 30, 17), coverage(c, 20, 21)
+	if math.Abs(single-overlap) > 0.02 || math.Abs(overlap-0.5) > 0.02 {
+		t.Errorf("coverage single = %.3f, overlap = %.3f, want 0.5", single, overlap)
+	}
+}
+
+func TestFillCircleArea(t *testing.T) {
+	c := NewCanvas(40, 40, white)
+	p := &Path{}
+	p.MoveTo(30, 20)
+	p.CubeTo(30, 25.5, 25.5, 30, 20, 30)
+	p.CubeTo(14.5, 30, 10, 25.5, 10, 20)
+	p.CubeTo(10, 14.5, 14.5, 10, 20, 10)
+	p.CubeTo(25.5, 10, 30, 14.5, 30, 20)
+	c.Fill(p, black)
+
+	area := 0.0
+	for y := range 40 {
+		for x := range 40 {
+			area += coverage(c, x, y)
+		}
+	}
+	if want := math.Pi * 100; math.Abs(area-want) > want*0.01 {
+		t.Errorf("circle area = %.1f, want %.1f", area, want)
+	}
+}
@@ -0,0 +1,48 @@
+package tabular
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// utf8BOM метка порядка байтов: без неё Excel открывает кириллицу в CSV как cp1251
+const utf8BOM = "\ufeff"
+
+// WriteCSV записывает таблицу в CSV с заголовком
+func WriteCSV(w io.Writer, t *Table) error {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+
+	record := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		record[i] = c.Name
+	}
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	for _, row := range t.Rows {
+		for i, v := range row {
+			record[i] = formatValue(v)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatValue(v any) string {
+	switch n := v.(type) {
+	case int64:
+		return strconv.FormatInt(n, 10)
+	case float64:
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	case string:
+		return n
+	}
+	return ""
+}
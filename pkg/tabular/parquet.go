@@ -0,0 +1,140 @@
+package tabular
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+const parquetMagic = "PAR1"
+
+// Значения перечислений формата Parquet
+const (
+	parquetInt64     = 2
+	parquetDouble    = 5
+	parquetByteArray = 6
+
+	parquetRequired     = 0
+	parquetUTF8         = 0 // ConvertedType
+	parquetPlain        = 0
+	parquetRLE          = 3
+	parquetDataPage     = 0
+	parquetUncompressed = 0
+)
+
+// WriteParquet записывает таблицу в Parquet: одна группа строк, по одной странице
+// данных на колонку, PLAIN-кодирование без сжатия. Все колонки обязательные (REQUIRED),
+// поэтому уровни определения и повторения не пишутся
+func WriteParquet(w io.Writer, t *Table) error {
+	if _, err := io.WriteString(w, parquetMagic); err != nil {
+		return err
+	}
+	offset := int64(len(parquetMagic))
+
+	chunks := make([]parquetChunk, len(t.Columns))
+	var totalSize int64
+	for i, col := range t.Columns {
+		var values []byte
+		for _, row := range t.Rows {
+			values = appendPlain(values, row[i])
+		}
+
+		header := newThriftWriter()
+		header.i32(1, parquetDataPage)
+		header.i32(2, int32(len(values)))
+		header.i32(3, int32(len(values)))
+		header.beginStruct(5)
+		header.i32(1, int32(len(t.Rows)))
+		header.i32(2, parquetPlain)
+		header.i32(3, parquetRLE)
+		header.i32(4, parquetRLE)
+		header.endStruct()
+		header.buf = append(header.buf, 0)
+
+		if _, err := w.Write(header.buf); err != nil {
+			return err
+		}
+		if _, err := w.Write(values); err != nil {
+			return err
+		}
+		size := int64(len(header.buf) + len(values))
+		chunks[i] = parquetChunk{column: col, offset: offset, size: size}
+		offset += size
+		totalSize += size
+	}
+
+	meta := parquetFooter(t, chunks, totalSize)
+	if _, err := w.Write(meta); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, uint32(len(meta))); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, parquetMagic)
+	return err
+}
+
+type parquetChunk struct {
+	column Column
+	offset int64
+	size   int64
+}
+
+// parquetFooter кодирует FileMetaData: схему и расположение колонок
+func parquetFooter(t *Table, chunks []parquetChunk, totalSize int64) []byte {
+	m := newThriftWriter()
+	m.i32(1, 1)
+
+	m.listHeader(2, thriftStruct, len(t.Columns)+1)
+	m.beginListStruct()
+	m.str(4, "schema")
+	m.i32(5, int32(len(t.Columns)))
+	m.endStruct()
+	for _, col := range t.Columns {
+		m.beginListStruct()
+		m.i32(1, parquetType(col.Type))
+		m.i32(3, parquetRequired)
+		m.str(4, col.Name)
+		if col.Type == String {
+			m.i32(6, parquetUTF8)
+		}
+		m.endStruct()
+	}
+
+	m.i64(3, int64(len(t.Rows)))
+
+	m.listHeader(4, thriftStruct, 1)
+	m.beginListStruct()
+	m.listHeader(1, thriftStruct, len(chunks))
+	for _, c := range chunks {
+		m.beginListStruct()
+		m.i64(2, c.offset)
+		m.beginStruct(3)
+		m.i32(1, parquetType(c.column.Type))
+		m.i32List(2, parquetPlain, parquetRLE)
+		m.strList(3, c.column.Name)
+		m.i32(4, parquetUncompressed)
+		m.i64(5, int64(len(t.Rows)))
+		m.i64(6, c.size)
+		m.i64(7, c.size)
+		m.i64(9, c.offset)
+		m.endStruct()
+		m.endStruct()
+	}
+	m.i64(2, totalSize)
+	m.i64(3, int64(len(t.Rows)))
+	m.endStruct()
+
+	m.str(6, "HockeyProject tabular")
+	m.buf = append(m.buf, 0)
+	return m.buf
+}
+
+func parquetType(t ColumnType) int32 {
+	switch t {
+	case Int:
+		return parquetInt64
+	case Float:
+		return parquetDouble
+	}
+	return parquetByteArray
+}
@@ -0,0 +1,141 @@
+// Package tabular таблицы данных и их запись в CSV, XLSX и Parquet без внешних зависимостей
+package tabular
+
+import (
+	"fmt"
+	"io"
+)
+
+// ColumnType тип значений колонки
+type ColumnType int
+
+const (
+	String ColumnType = iota
+	Int
+	Float
+)
+
+// Column колонка таблицы
+type Column struct {
+	Name string
+	Type ColumnType
+}
+
+// Table таблица: значения строк соответствуют типам колонок (string, int64, float64)
+type Table struct {
+	Name    string
+	Columns []Column
+	Rows    [][]any
+}
+
+// NewTable создаёт пустую таблицу
+func NewTable(name string, columns ...Column) *Table {
+	return &Table{Name: name, Columns: columns}
+}
+
+// StringCol колонка строк
+func StringCol(name string) Column { return Column{Name: name, Type: String} }
+
+// IntCol колонка целых чисел
+func IntCol(name string) Column { return Column{Name: name, Type: Int} }
+
+// FloatCol колонка дробных чисел
+func FloatCol(name string) Column { return Column{Name: name, Type: Float} }
+
+// Append добавляет строку, приводя значения к типам колонок. Целые любых размеров
+// приводятся к int64, nil - к нулевому значению колонки
+func (t *Table) Append(values ...any) {
+	if len(values) != len(t.Columns) {
+		panic(fmt.Sprintf("tabular: %d values for %d columns", len(values), len(t.Columns)))
+	}
+	row := make([]any, len(values))
+	for i, v := range values {
+		row[i] = normalize(t.Columns[i].Type, v)
+	}
+	t.Rows = append(t.Rows, row)
+}
+
+func normalize(typ ColumnType, v any) any {
+	switch typ {
+	case Int:
+		switch n := v.(type) {
+		case int:
+			return int64(n)
+		case int32:
+			return int64(n)
+		case int64:
+			return n
+		case *int:
+			if n != nil {
+				return int64(*n)
+			}
+		}
+		return int64(0)
+	case Float:
+		switch n := v.(type) {
+		case float64:
+			return n
+		case float32:
+			return float64(n)
+		case int:
+			return float64(n)
+		}
+		return float64(0)
+	default:
+		switch s := v.(type) {
+		case string:
+			return s
+		case *string:
+			if s != nil {
+				return *s
+			}
+			return ""
+		case nil:
+			return ""
+		}
+		return fmt.Sprint(v)
+	}
+}
+
+// Format формат файла выгрузки
+type Format string
+
+const (
+	CSV     Format = "csv"
+	XLSX    Format = "xlsx"
+	Parquet Format = "parquet"
+)
+
+// ParseFormat проверяет название формата
+func ParseFormat(s string) (Format, bool) {
+	switch f := Format(s); f {
+	case CSV, XLSX, Parquet:
+		return f, true
+	}
+	return "", false
+}
+
+// ContentType MIME-тип файла формата
+func (f Format) ContentType() string {
+	switch f {
+	case XLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case Parquet:
+		return "application/vnd.apache.parquet"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// Write записывает таблицу в формате f
+func Write(w io.Writer, t *Table, f Format) error {
+	switch f {
+	case CSV:
+		return WriteCSV(w, t)
+	case XLSX:
+		return WriteXLSX(w, t)
+	case Parquet:
+		return WriteParquet(w, t)
+	}
+	return fmt.Errorf("tabular: unknown format %q", f)
+}
@@ -0,0 +1,156 @@
+package tabular
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"strings"
+	"testing"
+)
+
+func sampleTable() *Table {
+	t := NewTable("Бомбардиры", StringCol("player"), IntCol("goals"), FloatCol("points_avg"))
+	t.Append("Иванов, Иван", 12, 1.5)
+	t.Append(`Петров "Пётр"`, int64(7), 0.25)
+	return t
+}
+
+func TestWriteCSV(t *testing.T) {
+	var b bytes.Buffer
+	if err := WriteCSV(&b, sampleTable()); err != nil {
+		t.Fatal(err)
+	}
+	want := utf8BOM + "player,goals,points_avg\n\"Иванов, Иван\",12,1.5\n\"Петров \"\"Пётр\"\"\",7,0.25\n"
+	if b.String() != want {
+		t.Errorf("CSV = %q, want %q", b.String(), want)
+	}
+}
+
+func TestWriteXLSX(t *testing.T) {
+	var b bytes.Buffer
+	if err := WriteXLSX(&b, sampleTable()); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sheet string
+	for _, f := range zr.File {
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			rc, _ := f.Open()
+			data, _ := io.ReadAll(rc)
+			sheet = string(data)
+		}
+	}
+	for _, want := range []string{
+		`<c r="A2" t="inlineStr"><is><t xml:space="preserve">Иванов, Иван</t></is></c>`,
+		`<c r="B2"><v>12</v></c>`,
+		`<c r="C3"><v>0.25</v></c>`,
+		`Петров &#34;Пётр&#34;`,
+	} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("sheet does not contain %s", want)
+		}
+	}
+
+	for i, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 701: "ZZ", 702: "AAA"} {
+		if got := columnName(i); got != want {
+			t.Errorf("columnName(%d) = %s, want %s", i, got, want)
+		}
+	}
+}
+
+func TestWriteParquet(t *testing.T) {
+	var b bytes.Buffer
+	if err := WriteParquet(&b, sampleTable()); err != nil {
+		t.Fatal(err)
+	}
+	data := b.Bytes()
+	if !bytes.HasPrefix(data, []byte(parquetMagic)) || !bytes.HasSuffix(data, []byte(parquetMagic)) {
+		t.Fatal("missing PAR1 magic")
+	}
+
+	size := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	meta, _ := readThriftStruct(data[len(data)-8-size:])
+	if meta[3] != int64(2) {
+		t.Errorf("num_rows = %v, want 2", meta[3])
+	}
+	schema := meta[2].([]any)
+	if len(schema) != 4 || schema[2].(map[int16]any)[4] != "goals" {
+		t.Fatalf("schema = %v", schema)
+	}
+
+	// Значения второй колонки (goals) читаются по смещению из метаданных
+	chunk := meta[4].([]any)[0].(map[int16]any)[1].([]any)[1].(map[int16]any)
+	offset := chunk[3].(map[int16]any)[9].(int64)
+	_, n := readThriftStruct(data[offset:])
+	values := data[int(offset)+n:]
+	if got := int64(binary.LittleEndian.Uint64(values)); got != 12 {
+		t.Errorf("goals[0] = %d, want 12", got)
+	}
+	if got := int64(binary.LittleEndian.Uint64(values[8:])); got != 7 {
+		t.Errorf("goals[1] = %d, want 7", got)
+	}
+
+	avg := meta[4].([]any)[0].(map[int16]any)[1].([]any)[2].(map[int16]any)[3].(map[int16]any)[9].(int64)
+	_, n = readThriftStruct(data[avg:])
+	if got := math.Float64frombits(binary.LittleEndian.Uint64(data[int(avg)+n:])); got != 1.5 {
+		t.Errorf("points_avg[0] = %v, want 1.5", got)
+	}
+}
+
+// readThriftStruct декодирует структуру компактного протокола Thrift в карту номер поля -
+// значение; возвращает число прочитанных байтов
+func readThriftStruct(b []byte) (map[int16]any, int) {
+	result := make(map[int16]any)
+	pos := 0
+	var id int16
+	for {
+		header := b[pos]
+		pos++
+		if header == 0 {
+			return result, pos
+		}
+		typ := header & 0x0F
+		if delta := int16(header >> 4); delta != 0 {
+			id += delta
+		} else {
+			v, n := binary.Varint(b[pos:])
+			id, pos = int16(v), pos+n
+		}
+		var n int
+		result[id], n = readThriftValue(b[pos:], typ)
+		pos += n
+	}
+}
+
+func readThriftValue(b []byte, typ byte) (any, int) {
+	switch typ {
+	case thriftI32, thriftI64:
+		v, n := binary.Varint(b)
+		return v, n
+	case thriftBinary:
+		l, n := binary.Uvarint(b)
+		return string(b[n : n+int(l)]), n + int(l)
+	case thriftStruct:
+		return readThriftStruct(b)
+	case thriftList:
+		size, elem, pos := int(b[0]>>4), b[0]&0x0F, 1
+		if size == 15 {
+			s, n := binary.Uvarint(b[1:])
+			size, pos = int(s), 1+n
+		}
+		list := make([]any, size)
+		for i := range list {
+			var n int
+			list[i], n = readThriftValue(b[pos:], elem)
+			pos += n
+		}
+		return list, pos
+	}
+	panic("unsupported thrift type")
+}
@@ -0,0 +1,114 @@
+package tabular
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// Типы компактного протокола Thrift, которым сериализуются метаданные Parquet
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter кодирует структуры в компактный протокол Thrift
+type thriftWriter struct {
+	buf    []byte
+	lastID []int16 // последний номер поля на каждом уровне вложенности
+}
+
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{lastID: []int16{0}}
+}
+
+func (w *thriftWriter) fieldHeader(id int16, typ byte) {
+	last := &w.lastID[len(w.lastID)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.varint(int64(id))
+	}
+	*last = id
+}
+
+func (w *thriftWriter) varint(v int64) {
+	w.buf = binary.AppendUvarint(w.buf, uint64((v<<1)^(v>>63)))
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.fieldHeader(id, thriftI32)
+	w.varint(int64(v))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.fieldHeader(id, thriftI64)
+	w.varint(v)
+}
+
+func (w *thriftWriter) str(id int16, s string) {
+	w.fieldHeader(id, thriftBinary)
+	w.bytes(s)
+}
+
+func (w *thriftWriter) bytes(s string) {
+	w.buf = binary.AppendUvarint(w.buf, uint64(len(s)))
+	w.buf = append(w.buf, s...)
+}
+
+// beginStruct открывает поле-структуру; закрывается endStruct
+func (w *thriftWriter) beginStruct(id int16) {
+	w.fieldHeader(id, thriftStruct)
+	w.lastID = append(w.lastID, 0)
+}
+
+// beginListStruct открывает элемент списка структур
+func (w *thriftWriter) beginListStruct() {
+	w.lastID = append(w.lastID, 0)
+}
+
+func (w *thriftWriter) endStruct() {
+	w.buf = append(w.buf, 0)
+	w.lastID = w.lastID[:len(w.lastID)-1]
+}
+
+func (w *thriftWriter) listHeader(id int16, elemType byte, size int) {
+	w.fieldHeader(id, thriftList)
+	if size < 15 {
+		w.buf = append(w.buf, byte(size)<<4|elemType)
+		return
+	}
+	w.buf = append(w.buf, 0xF0|elemType)
+	w.buf = binary.AppendUvarint(w.buf, uint64(size))
+}
+
+func (w *thriftWriter) i32List(id int16, values ...int32) {
+	w.listHeader(id, thriftI32, len(values))
+	for _, v := range values {
+		w.varint(int64(v))
+	}
+}
+
+func (w *thriftWriter) strList(id int16, values ...string) {
+	w.listHeader(id, thriftBinary, len(values))
+	for _, v := range values {
+		w.bytes(v)
+	}
+}
+
+// appendPlain кодирует значение в PLAIN-кодировке Parquet
+func appendPlain(buf []byte, v any) []byte {
+	switch n := v.(type) {
+	case int64:
+		return binary.LittleEndian.AppendUint64(buf, uint64(n))
+	case float64:
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(n))
+	case string:
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(n)))
+		return append(buf, n...)
+	}
+	return buf
+}
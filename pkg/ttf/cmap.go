@@ -0,0 +1,114 @@
+package ttf
+
+import "fmt"
+
+// parseCmap выбирает таблицу символов Unicode: формат 12 (все плоскости)
+// или формат 4 (только BMP)
+func (f *Font) parseCmap() error {
+	cmap := f.tables["cmap"]
+	if len(cmap) < 4 {
+		return errMalformed
+	}
+	best := -1
+	for i := range int(u16(cmap, 2)) {
+		rec := 4 + 8*i
+		if rec+8 > len(cmap) {
+			return errMalformed
+		}
+		platform, encoding := u16(cmap, rec), u16(cmap, rec+2)
+		offset := int(u32(cmap, rec+4))
+		if offset+4 > len(cmap) {
+			return errMalformed
+		}
+		unicode := platform == 0 || (platform == 3 && (encoding == 1 || encoding == 10))
+		if !unicode {
+			continue
+		}
+		switch format := u16(cmap, offset); {
+		case format == 12:
+			f.cmap, f.cmapFormat = cmap[offset:], 12
+			return nil
+		case format == 4 && best < 0:
+			best = offset
+		}
+	}
+	if best < 0 {
+		return fmt.Errorf("ttf: no unicode cmap")
+	}
+	f.cmap, f.cmapFormat = cmap[best:], 4
+	return nil
+}
+
+// GlyphIndex номер глифа символа, 0 (.notdef) если символа нет в шрифте
+func (f *Font) GlyphIndex(r rune) uint16 {
+	if f.cmapFormat == 12 {
+		return f.lookup12(r)
+	}
+	return f.lookup4(r)
+}
+
+func (f *Font) lookup4(r rune) uint16 {
+	t := f.cmap
+	if r < 0 || r > 0xFFFF || len(t) < 14 {
+		return 0
+	}
+	c := uint16(r)
+	segCount := int(u16(t, 6)) / 2
+	ends, starts := 14, 16+2*segCount
+	deltas, ranges := starts+2*segCount, starts+4*segCount
+	if ranges+2*segCount > len(t) {
+		return 0
+	}
+	// Сегменты отсортированы по концу диапазона
+	lo, hi := 0, segCount
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if u16(t, ends+2*mid) < c {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo == segCount || u16(t, starts+2*lo) > c {
+		return 0
+	}
+	delta := u16(t, deltas+2*lo)
+	rangeOffset := int(u16(t, ranges+2*lo))
+	if rangeOffset == 0 {
+		return c + delta
+	}
+	at := ranges + 2*lo + rangeOffset + 2*int(c-u16(t, starts+2*lo))
+	if at+2 > len(t) {
+		return 0
+	}
+	if g := u16(t, at); g != 0 {
+		return g + delta
+	}
+	return 0
+}
+
+func (f *Font) lookup12(r rune) uint16 {
+	t := f.cmap
+	if len(t) < 16 {
+		return 0
+	}
+	c := uint32(r)
+	n := int(u32(t, 12))
+	if 16+12*n > len(t) {
+		return 0
+	}
+	lo, hi := 0, n
+	for lo < hi {
+		mid := (lo + hi) / 2
+		group := 16 + 12*mid
+		switch {
+		case u32(t, group+4) < c:
+			lo = mid + 1
+		case u32(t, group) > c:
+			hi = mid
+		default:
+			return uint16(u32(t, group+8) + c - u32(t, group))
+		}
+	}
+	return 0
+}
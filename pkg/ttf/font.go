@@ -0,0 +1,161 @@
+// Package ttf чтение шрифтов TrueType: метрики, таблица символов, контуры глифов
+// и подмножество шрифта для встраивания в PDF
+package ttf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+var errMalformed = errors.New("ttf: malformed font")
+
+// Font разобранный шрифт TrueType. Размеры - в единицах шрифта (UnitsPerEm на кегль)
+type Font struct {
+	data   []byte
+	tables map[string][]byte
+
+	unitsPerEm int
+	bbox       [4]int
+	ascent     int
+	descent    int
+	capHeight  int
+	numGlyphs  int
+	longLoca   bool
+	advances   []uint16
+	cmap       []byte
+	cmapFormat uint16
+}
+
+// Parse разбирает шрифт. Данные не копируются и не должны изменяться
+func Parse(data []byte) (*Font, error) {
+	if len(data) < 12 {
+		return nil, errMalformed
+	}
+	if v := binary.BigEndian.Uint32(data); v != 0x00010000 && v != 0x74727565 {
+		return nil, fmt.Errorf("ttf: unsupported font version %#x", v)
+	}
+	f := &Font{data: data, tables: make(map[string][]byte)}
+	numTables := int(u16(data, 4))
+	for i := range numTables {
+		rec := 12 + 16*i
+		if rec+16 > len(data) {
+			return nil, errMalformed
+		}
+		offset, length := int(u32(data, rec+8)), int(u32(data, rec+12))
+		if offset+length > len(data) {
+			return nil, errMalformed
+		}
+		f.tables[string(data[rec:rec+4])] = data[offset : offset+length]
+	}
+	for _, tag := range []string{"head", "hhea", "maxp", "hmtx", "cmap", "loca", "glyf"} {
+		if f.tables[tag] == nil {
+			return nil, fmt.Errorf("ttf: missing %s table", tag)
+		}
+	}
+	if err := f.parseMetrics(); err != nil {
+		return nil, err
+	}
+	if err := f.parseCmap(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *Font) parseMetrics() error {
+	head, hhea, maxp := f.tables["head"], f.tables["hhea"], f.tables["maxp"]
+	if len(head) < 54 || len(hhea) < 36 || len(maxp) < 6 {
+		return errMalformed
+	}
+	f.unitsPerEm = int(u16(head, 18))
+	if f.unitsPerEm == 0 {
+		return errMalformed
+	}
+	for i := range f.bbox {
+		f.bbox[i] = int(int16(u16(head, 36+2*i)))
+	}
+	f.longLoca = u16(head, 50) == 1
+	f.ascent = int(int16(u16(hhea, 4)))
+	f.descent = int(int16(u16(hhea, 6)))
+	f.numGlyphs = int(u16(maxp, 4))
+
+	// Высота прописных есть в OS/2 начиная со второй версии
+	f.capHeight = f.ascent
+	if os2 := f.tables["OS/2"]; len(os2) >= 90 && u16(os2, 0) >= 2 {
+		f.capHeight = int(int16(u16(os2, 88)))
+	}
+
+	hmtx := f.tables["hmtx"]
+	numMetrics := int(u16(hhea, 34))
+	if numMetrics == 0 || len(hmtx) < 4*numMetrics {
+		return errMalformed
+	}
+	f.advances = make([]uint16, f.numGlyphs)
+	for i := range f.advances {
+		// Глифы после numberOfHMetrics наследуют ширину последнего
+		f.advances[i] = u16(hmtx, 4*min(i, numMetrics-1))
+	}
+	return nil
+}
+
+// UnitsPerEm число единиц шрифта на кегль
+func (f *Font) UnitsPerEm() int { return f.unitsPerEm }
+
+// Ascent высота над базовой линией
+func (f *Font) Ascent() int { return f.ascent }
+
+// Descent глубина под базовой линией (отрицательная)
+func (f *Font) Descent() int { return f.descent }
+
+// CapHeight высота прописных букв
+func (f *Font) CapHeight() int { return f.capHeight }
+
+// BBox общий габарит глифов: xMin, yMin, xMax, yMax
+func (f *Font) BBox() [4]int { return f.bbox }
+
+// NumGlyphs число глифов шрифта
+func (f *Font) NumGlyphs() int { return f.numGlyphs }
+
+// Advance ширина глифа
+func (f *Font) Advance(glyph uint16) int {
+	if int(glyph) >= len(f.advances) {
+		return 0
+	}
+	return int(f.advances[glyph])
+}
+
+// Width ширина строки в долях кегля с размером size
+func (f *Font) Width(s string, size float64) float64 {
+	units := 0
+	for _, r := range s {
+		units += f.Advance(f.GlyphIndex(r))
+	}
+	return float64(units) * size / float64(f.unitsPerEm)
+}
+
+// glyphData данные глифа в таблице glyf, nil для пустого глифа
+func (f *Font) glyphData(glyph uint16) []byte {
+	if int(glyph) >= f.numGlyphs {
+		return nil
+	}
+	loca, glyf := f.tables["loca"], f.tables["glyf"]
+	var start, end int
+	if f.longLoca {
+		if 4*int(glyph)+8 > len(loca) {
+			return nil
+		}
+		start, end = int(u32(loca, 4*int(glyph))), int(u32(loca, 4*int(glyph)+4))
+	} else {
+		if 2*int(glyph)+4 > len(loca) {
+			return nil
+		}
+		start, end = 2*int(u16(loca, 2*int(glyph))), 2*int(u16(loca, 2*int(glyph)+2))
+	}
+	if start >= end || end > len(glyf) {
+		return nil
+	}
+	return glyf[start:end]
+}
+
+func u16(b []byte, i int) uint16 { return binary.BigEndian.Uint16(b[i:]) }
+func u32(b []byte, i int) uint32 { return binary.BigEndian.Uint32(b[i:]) }
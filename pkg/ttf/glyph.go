@@ -0,0 +1,181 @@
+package ttf
+
+// Флаги точек простого глифа
+const (
+	flagOnCurve = 0x01
+	flagXShort  = 0x02
+	flagYShort  = 0x04
+	flagRepeat  = 0x08
+	flagXSame   = 0x10
+	flagYSame   = 0x20
+)
+
+// maxCompositeDepth ограничение вложенности составных глифов
+const maxCompositeDepth = 8
+
+// Point точка контура глифа в единицах шрифта, ось Y направлена вверх.
+// On - точка на кривой, иначе контрольная точка квадратичной кривой
+type Point struct {
+	X, Y float64
+	On   bool
+}
+
+// Outline контуры глифа. Между двумя соседними контрольными точками лежит
+// подразумеваемая точка на кривой посередине, как принято в TrueType
+func (f *Font) Outline(glyph uint16) [][]Point {
+	return f.outline(glyph, 0)
+}
+
+func (f *Font) outline(glyph uint16, depth int) [][]Point {
+	data := f.glyphData(glyph)
+	if len(data) < 10 || depth > maxCompositeDepth {
+		return nil
+	}
+	if n := int16(u16(data, 0)); n >= 0 {
+		return simpleOutline(data, int(n))
+	}
+	return f.compositeOutline(data, depth)
+}
+
+// simpleOutline разбирает простой глиф из n контуров
+func simpleOutline(data []byte, n int) [][]Point {
+	at := 10
+	if at+2*n+2 > len(data) {
+		return nil
+	}
+	ends := make([]int, n)
+	for i := range ends {
+		ends[i] = int(u16(data, at+2*i))
+	}
+	at += 2 * n
+	at += 2 + int(u16(data, at)) // инструкции хинтинга пропускаются
+	if n == 0 {
+		return nil
+	}
+	count := ends[n-1] + 1
+
+	flags := make([]byte, 0, count)
+	for len(flags) < count {
+		if at >= len(data) {
+			return nil
+		}
+		flag := data[at]
+		at++
+		flags = append(flags, flag)
+		if flag&flagRepeat != 0 && at < len(data) {
+			for range data[at] {
+				flags = append(flags, flag)
+			}
+			at++
+		}
+	}
+	flags = flags[:count]
+
+	xs, at := coordinates(data, at, flags, flagXShort, flagXSame)
+	ys, _ := coordinates(data, at, flags, flagYShort, flagYSame)
+	if xs == nil || ys == nil {
+		return nil
+	}
+
+	contours := make([][]Point, 0, n)
+	start := 0
+	for _, end := range ends {
+		if end < start || end >= count {
+			return nil
+		}
+		contour := make([]Point, 0, end-start+1)
+		for i := start; i <= end; i++ {
+			contour = append(contour, Point{X: float64(xs[i]), Y: float64(ys[i]), On: flags[i]&flagOnCurve != 0})
+		}
+		contours = append(contours, contour)
+		start = end + 1
+	}
+	return contours
+}
+
+// coordinates читает координаты одной оси, заданные приращениями
+func coordinates(data []byte, at int, flags []byte, short, same byte) ([]int, int) {
+	values := make([]int, len(flags))
+	v := 0
+	for i, flag := range flags {
+		switch {
+		case flag&short != 0:
+			if at >= len(data) {
+				return nil, at
+			}
+			d := int(data[at])
+			at++
+			if flag&same == 0 {
+				d = -d
+			}
+			v += d
+		case flag&same == 0:
+			if at+2 > len(data) {
+				return nil, at
+			}
+			v += int(int16(u16(data, at)))
+			at += 2
+		}
+		values[i] = v
+	}
+	return values, at
+}
+
+// compositeOutline собирает контуры компонентов с их смещениями и преобразованиями
+func (f *Font) compositeOutline(data []byte, depth int) [][]Point {
+	var contours [][]Point
+	for at := 10; at+4 <= len(data); {
+		flags := u16(data, at)
+		glyph := u16(data, at+2)
+		at += 4
+
+		var dx, dy float64
+		if flags&compArgWords != 0 {
+			if at+4 > len(data) {
+				break
+			}
+			dx, dy = float64(int16(u16(data, at))), float64(int16(u16(data, at+2)))
+			at += 4
+		} else {
+			if at+2 > len(data) {
+				break
+			}
+			dx, dy = float64(int8(data[at])), float64(int8(data[at+1]))
+			at += 2
+		}
+		if flags&compXYValues == 0 {
+			dx, dy = 0, 0 // привязка по номерам точек не используется в шрифтах проекта
+		}
+
+		a, b, c, d := 1.0, 0.0, 0.0, 1.0
+		switch {
+		case flags&compScale != 0 && at+2 <= len(data):
+			a = f2dot14(data, at)
+			d = a
+			at += 2
+		case flags&compXYScale != 0 && at+4 <= len(data):
+			a, d = f2dot14(data, at), f2dot14(data, at+2)
+			at += 4
+		case flags&compTwoByTwo != 0 && at+8 <= len(data):
+			a, b, c, d = f2dot14(data, at), f2dot14(data, at+2), f2dot14(data, at+4), f2dot14(data, at+6)
+			at += 8
+		}
+
+		for _, contour := range f.outline(glyph, depth+1) {
+			moved := make([]Point, len(contour))
+			for i, p := range contour {
+				moved[i] = Point{X: a*p.X + c*p.Y + dx, Y: b*p.X + d*p.Y + dy, On: p.On}
+			}
+			contours = append(contours, moved)
+		}
+		if flags&compMore == 0 {
+			break
+		}
+	}
+	return contours
+}
+
+// f2dot14 число с фиксированной точкой 2.14
+func f2dot14(data []byte, at int) float64 {
+	return float64(int16(u16(data, at))) / (1 << 14)
+}
@@ -0,0 +1,151 @@
+package ttf
+
+import (
+	"encoding/binary"
+	"sort"
+)
+
+// Флаги компонента составного глифа
+const (
+	compArgWords    = 0x0001
+	compXYValues    = 0x0002
+	compScale       = 0x0008
+	compMore        = 0x0020
+	compXYScale     = 0x0040
+	compTwoByTwo    = 0x0080
+	checksumMagic   = 0xB1B0AFBA
+	headChecksumPos = 8
+)
+
+// subsetTables таблицы, нужные PDF-просмотрщику для встроенного шрифта
+var subsetTables = []string{"cvt ", "fpgm", "glyf", "head", "hhea", "hmtx", "loca", "maxp", "prep"}
+
+// Subset возвращает шрифт, в котором сохранены только данные указанных глифов и
+// глифов, из которых они составлены. Номера глифов не меняются, поэтому подмножество
+// встраивается в PDF с тождественным отображением CID в глифы
+func (f *Font) Subset(glyphs []uint16) []byte {
+	keep := map[uint16]bool{0: true}
+	queue := append([]uint16(nil), glyphs...)
+	for len(queue) > 0 {
+		g := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		if keep[g] || int(g) >= f.numGlyphs {
+			continue
+		}
+		keep[g] = true
+		queue = append(queue, components(f.glyphData(g))...)
+	}
+
+	var glyf []byte
+	loca := make([]byte, 4*(f.numGlyphs+1))
+	for g := range f.numGlyphs {
+		if keep[uint16(g)] {
+			glyf = append(glyf, f.glyphData(uint16(g))...)
+			for len(glyf)%4 != 0 {
+				glyf = append(glyf, 0)
+			}
+		}
+		binary.BigEndian.PutUint32(loca[4*(g+1):], uint32(len(glyf)))
+	}
+
+	head := append([]byte(nil), f.tables["head"]...)
+	binary.BigEndian.PutUint16(head[50:], 1) // длинный формат loca
+	binary.BigEndian.PutUint32(head[headChecksumPos:], 0)
+
+	tables := map[string][]byte{"glyf": glyf, "loca": loca, "head": head}
+	for _, tag := range subsetTables {
+		if tables[tag] == nil && f.tables[tag] != nil {
+			tables[tag] = f.tables[tag]
+		}
+	}
+	font := writeFont(tables)
+	binary.BigEndian.PutUint32(font[tableOffset(font, "head")+headChecksumPos:], checksumMagic-checksum(font))
+	return font
+}
+
+// components глифы, из которых состоит составной глиф
+func components(glyph []byte) []uint16 {
+	if len(glyph) < 10 || int16(u16(glyph, 0)) >= 0 {
+		return nil
+	}
+	var result []uint16
+	for at := 10; at+4 <= len(glyph); {
+		flags := u16(glyph, at)
+		result = append(result, u16(glyph, at+2))
+		at += 4
+		if flags&compArgWords != 0 {
+			at += 4
+		} else {
+			at += 2
+		}
+		switch {
+		case flags&compScale != 0:
+			at += 2
+		case flags&compXYScale != 0:
+			at += 4
+		case flags&compTwoByTwo != 0:
+			at += 8
+		}
+		if flags&compMore == 0 {
+			break
+		}
+	}
+	return result
+}
+
+// writeFont собирает файл шрифта из таблиц, выровненных по 4 байта
+func writeFont(tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	n := len(tags)
+	searchRange, selector := 1, 0
+	for searchRange*2 <= n {
+		searchRange *= 2
+		selector++
+	}
+	font := make([]byte, 12+16*n)
+	binary.BigEndian.PutUint32(font, 0x00010000)
+	binary.BigEndian.PutUint16(font[4:], uint16(n))
+	binary.BigEndian.PutUint16(font[6:], uint16(searchRange*16))
+	binary.BigEndian.PutUint16(font[8:], uint16(selector))
+	binary.BigEndian.PutUint16(font[10:], uint16((n-searchRange)*16))
+
+	for i, tag := range tags {
+		data := tables[tag]
+		rec := 12 + 16*i
+		copy(font[rec:], tag)
+		binary.BigEndian.PutUint32(font[rec+4:], checksum(data))
+		binary.BigEndian.PutUint32(font[rec+8:], uint32(len(font)))
+		binary.BigEndian.PutUint32(font[rec+12:], uint32(len(data)))
+		font = append(font, data...)
+		for len(font)%4 != 0 {
+			font = append(font, 0)
+		}
+	}
+	return font
+}
+
+func tableOffset(font []byte, tag string) int {
+	for i := range int(u16(font, 4)) {
+		rec := 12 + 16*i
+		if string(font[rec:rec+4]) == tag {
+			return int(u32(font, rec+8))
+		}
+	}
+	return 0
+}
+
+// checksum сумма 32-битных слов, неполное последнее слово дополняется нулями
+func checksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
@@ -0,0 +1,100 @@
+package ttf
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// Шрифт берётся из встроенных шрифтов проекта
+func loadFont(t *testing.T) *Font {
+	t.Helper()
+	data, err := os.ReadFile("../../internal/modules/shared/fonts/DejaVuSans.ttf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	font, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return font
+}
+
+func TestGlyphIndex(t *testing.T) {
+	font := loadFont(t)
+	if font.GlyphIndex('A') == 0 || font.GlyphIndex('ж') == 0 {
+		t.Error("missing glyphs for A/ж")
+	}
+	if font.GlyphIndex('A') == font.GlyphIndex('B') {
+		t.Error("A and B map to the same glyph")
+	}
+	if g := font.GlyphIndex(0xE000); g != 0 {
+		t.Errorf("private use glyph = %d, want 0", g)
+	}
+	if font.Advance(font.GlyphIndex('W')) <= font.Advance(font.GlyphIndex('i')) {
+		t.Error("W must be wider than i")
+	}
+}
+
+func TestSubset(t *testing.T) {
+	font := loadFont(t)
+	kept, dropped := font.GlyphIndex('Й'), font.GlyphIndex('Z')
+	data := font.Subset([]uint16{kept})
+
+	if checksum(data) != checksumMagic {
+		t.Errorf("font checksum = %#x, want %#x", checksum(data), uint32(checksumMagic))
+	}
+	subset := &Font{data: data, tables: make(map[string][]byte), numGlyphs: font.numGlyphs, longLoca: true}
+	for i := range int(u16(data, 4)) {
+		rec := 12 + 16*i
+		offset, length := int(u32(data, rec+8)), int(u32(data, rec+12))
+		subset.tables[string(data[rec:rec+4])] = data[offset : offset+length]
+	}
+	if subset.tables["cmap"] != nil {
+		t.Error("subset must not contain cmap")
+	}
+	if !bytes.HasPrefix(subset.glyphData(kept), font.glyphData(kept)) {
+		t.Error("kept glyph data differs")
+	}
+	if subset.glyphData(dropped) != nil {
+		t.Error("dropped glyph still has data")
+	}
+	// Й составлен из И и бреве: компоненты тоже сохраняются
+	for _, c := range components(font.glyphData(kept)) {
+		if subset.glyphData(c) == nil && font.glyphData(c) != nil {
+			t.Errorf("component %d dropped", c)
+		}
+	}
+	if len(data) > len(font.data)/4 {
+		t.Errorf("subset size = %d, full font %d", len(data), len(font.data))
+	}
+}
+
+func TestOutline(t *testing.T) {
+	font := loadFont(t)
+	if n := len(font.Outline(font.GlyphIndex('O'))); n != 2 {
+		t.Errorf("O contours = %d, want 2", n)
+	}
+	if outline := font.Outline(font.GlyphIndex(' ')); outline != nil {
+		t.Errorf("space has %d contours", len(outline))
+	}
+
+	// Й составной: контуры И и бреве, бреве выше буквы
+	short, plain := font.Outline(font.GlyphIndex('Й')), font.Outline(font.GlyphIndex('И'))
+	if len(short) <= len(plain) {
+		t.Fatalf("Й contours = %d, И contours = %d", len(short), len(plain))
+	}
+	if top(short) <= top(plain) || top(short) > float64(font.BBox()[3]) {
+		t.Errorf("Й top = %v, И top = %v", top(short), top(plain))
+	}
+}
+
+func top(outline [][]Point) float64 {
+	y := 0.0
+	for _, contour := range outline {
+		for _, p := range contour {
+			y = max(y, p.Y)
+		}
+	}
+	return y
+}